@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryAbandonedExampleUsage = `  # list the modules whose repositories are archived or haven't had a commit in 2 years
+  perseus query abandoned -o table
+
+  # only consider modules from a single organization and a 5 year period of inactivity
+  perseus query abandoned 'github.com/example/*' --inactive-years 5`
+
+// abandonedModule defines the information returned by the 'query abandoned' CLI sub-command for each
+// module whose source repository appears to be abandoned
+type abandonedModule struct {
+	// the module path, ex: github.com/CrowdStrike/perseus
+	Path string `yaml:"path"`
+	// true if the module's repository has been archived by its owner
+	Archived bool `yaml:"archived"`
+	// the time of the most recent commit to the module's repository, if it is known
+	LastCommit *time.Time `yaml:"lastCommit,omitempty"`
+	// when the server last checked the module's repository
+	Checked time.Time `yaml:"checked"`
+	// the number of modules that currently depend directly on the module
+	Dependents int `yaml:"dependents"`
+}
+
+// runQueryAbandonedCmd implements the logic behind the 'query abandoned' CLI sub-command
+func runQueryAbandonedCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	filter := "*"
+	if len(args) > 0 {
+		filter = args[0]
+	}
+	years, _ := cmd.Flags().GetInt("inactive-years")
+	if years < 1 || years > 100 {
+		return fmt.Errorf("The --inactive-years flag must be between 1 and 100")
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("querying abandoned modules")
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().ListAbandonedModules(ctx, connect.NewRequest(&perseusapi.ListAbandonedModulesRequest{
+		ModuleFilter:  filter,
+		InactiveYears: int32(years), //nolint: gosec // validated above
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to query the abandoned modules: %w", err)
+	}
+	results := make([]abandonedModule, 0, len(resp.Msg.GetModules()))
+	for _, m := range resp.Msg.GetModules() {
+		am := abandonedModule{
+			Path:       m.GetName(),
+			Archived:   m.GetArchived(),
+			Checked:    m.GetCheckedTime().AsTime(),
+			Dependents: int(m.GetDependents()),
+		}
+		if m.GetLastCommitTime() != nil {
+			t := m.GetLastCommitTime().AsTime()
+			am.LastCommit = &t
+		}
+		results = append(results, am)
+	}
+	if len(results) == 0 {
+		infof("no abandoned modules found matching %q\n", filter)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeAbandoned(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// writeAbandoned writes the contents of results to the provided io.Writer in the specified format
+func writeAbandoned(w io.Writer, format string, results []abandonedModule) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Module\tArchived\tLast Commit\tDependents\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, m := range results {
+			lastCommit := "unknown"
+			if m.LastCommit != nil {
+				lastCommit = m.LastCommit.Format(time.DateOnly)
+			}
+			if _, err := fmt.Fprintf(tw, "%s\t%v\t%s\t%d\n", m.Path, m.Archived, lastCommit, m.Dependents); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,421 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	"github.com/CrowdStrike/perseus/internal/seed"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const adminRefreshExampleUsage = `  # re-process the go.mod files for all stable versions of Perseus
+  perseus admin refresh github.com/CrowdStrike/perseus
+
+  # re-process the latest version of all CrowdStrike GitHub modules, including pre-releases
+  perseus admin refresh 'github.com/CrowdStrike/*' --latest --include-prerelease`
+
+const adminMergeExampleUsage = `  # merge a module that was recorded with the wrong case into the correct module
+  perseus admin merge GitHub.com/CrowdStrike/perseus github.com/CrowdStrike/perseus`
+
+const adminRenameExampleUsage = `  # record that a module moved to a new GitHub organization
+  perseus admin rename github.com/old-org/foo github.com/new-org/foo
+
+  # include the dependents of both names when querying
+  perseus query descendants github.com/new-org/foo@v1.2.3 --follow-renames`
+
+const adminSetLicenseExampleUsage = `  # record the SPDX license identifier of a module so that it can be checked by the license policy
+  perseus admin set-license github.com/example/foo Apache-2.0
+
+  # clear a module's license
+  perseus admin set-license github.com/example/foo ""`
+
+const adminUndeleteExampleUsage = `  # restore a module that was deleted by mistake, along with its versions and dependencies
+  perseus admin undelete github.com/example/foo
+
+  # restore a single deleted version
+  perseus admin undelete github.com/example/foo@v1.2.3`
+
+// createAdminCommand initializes and returns a *cobra.Command that implements the 'admin' CLI sub-command
+func createAdminCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:          "admin ...",
+		Short:        "Performs administrative and maintenance operations on the Perseus graph",
+		SilenceUsage: true,
+	}
+	fset := cmd.PersistentFlags()
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
+	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.Duration("timeout", 0, "the maximum amount of time to wait for the Perseus server, ex: 30s or 2m (default is $PERSEUS_TIMEOUT environment variable or no limit)")
+	addRetryFlags(fset)
+	addConnectionFlags(fset)
+
+	refreshCmd := cobra.Command{
+		Use:          "refresh (module glob)",
+		Example:      adminRefreshExampleUsage,
+		Short:        "Re-fetches go.mod data from the module proxy for matching module versions and repairs their dependencies",
+		RunE:         runAdminRefreshCmd,
+		SilenceUsage: true,
+	}
+	refreshCmd.Flags().StringP("versions", "v", "", "optional glob pattern specifying which module version(s) should be refreshed")
+	refreshCmd.Flags().Bool("latest", false, "specifies that only the latest/highest version of each matching module should be refreshed")
+	refreshCmd.Flags().BoolP("include-prerelease", "p", false, "specifies that pre-release versions should also be refreshed")
+	cmd.AddCommand(&refreshCmd)
+
+	fsckCmd := cobra.Command{
+		Use:          "fsck [--repair]",
+		Short:        "Checks the Perseus graph for consistency problems and optionally repairs them",
+		RunE:         runAdminFsckCmd,
+		SilenceUsage: true,
+	}
+	fsckCmd.Flags().Bool("repair", false, "repair the problems that can be fixed safely")
+	cmd.AddCommand(&fsckCmd)
+
+	mergeCmd := cobra.Command{
+		Use:          "merge (source module) (target module)",
+		Example:      adminMergeExampleUsage,
+		Short:        "Merges the source module into the target module and records the source as an alias of the target",
+		Args:         cobra.ExactArgs(2),
+		RunE:         runAdminMergeCmd,
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(&mergeCmd)
+
+	renameCmd := cobra.Command{
+		Use:          "rename (old module) (new module)",
+		Example:      adminRenameExampleUsage,
+		Short:        "Records that a module was renamed so that dependency queries can follow the rename",
+		Args:         cobra.ExactArgs(2),
+		RunE:         runAdminRenameCmd,
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(&renameCmd)
+
+	setLicenseCmd := cobra.Command{
+		Use:          "set-license (module) (SPDX license identifier)",
+		Example:      adminSetLicenseExampleUsage,
+		Short:        "Records the license of a module, which is checked by the license rules of the server's dependency policy",
+		Args:         cobra.ExactArgs(2),
+		RunE:         runAdminSetLicenseCmd,
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(&setLicenseCmd)
+
+	undeleteCmd := cobra.Command{
+		Use:          "undelete module[@version]",
+		Example:      adminUndeleteExampleUsage,
+		Short:        "Restores a deleted module, or a deleted version of a module, along with its dependencies",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runAdminUndeleteCmd,
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(&undeleteCmd)
+
+	jobsCmd := cobra.Command{
+		Use:          "jobs",
+		Short:        "Shows the schedule and the status of the most recent run of the server's maintenance jobs",
+		Args:         cobra.NoArgs,
+		RunE:         runAdminJobsCmd,
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(&jobsCmd)
+
+	seedCmd := cobra.Command{
+		Use:          "seed [--modules N] [--fanout K]",
+		Example:      adminSeedExampleUsage,
+		Short:        "Generates a synthetic but realistic dependency graph for demos, UI development, and performance testing",
+		Args:         cobra.NoArgs,
+		RunE:         runAdminSeedCmd,
+		SilenceUsage: true,
+	}
+	seedCmd.Flags().Int("modules", 100, "the number of modules to generate")
+	seedCmd.Flags().Int("fanout", 5, "the average number of direct dependencies of each module version")
+	seedCmd.Flags().Int("versions", 5, "the maximum number of versions of each module")
+	seedCmd.Flags().String("prefix", seed.DefaultPrefix, "the module path prefix of the generated modules, which makes them easy to find and delete")
+	seedCmd.Flags().Uint64("seed", 1, "the seed for the random generator, where the same seed and flags always generate the same graph")
+	cmd.AddCommand(&seedCmd)
+
+	return &cmd
+}
+
+// runAdminRefreshCmd implements the logic behind the 'admin refresh' CLI sub-command
+func runAdminRefreshCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 1 {
+		return fmt.Errorf("The module match pattern must be provided")
+	}
+	versionFilter, _ := cmd.Flags().GetString("versions")
+	latest, _ := cmd.Flags().GetBool("latest")
+	includePrerelease, _ := cmd.Flags().GetBool("include-prerelease")
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner(fmt.Sprintf("refreshing modules matching %q", args[0]))
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().RefreshModules(ctx, connect.NewRequest(&perseusapi.RefreshModulesRequest{
+		ModuleFilter:      args[0],
+		VersionFilter:     versionFilter,
+		IncludePrerelease: includePrerelease,
+		LatestOnly:        latest,
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to refresh modules matching the provided filter: %w", err)
+	}
+
+	results := resp.Msg.GetResults()
+	if len(results) == 0 {
+		infof("no module versions matched %q\n", args[0])
+		return nil
+	}
+	failed := 0
+	tw := tabwriter.NewWriter(os.Stdout, 10, 4, 2, ' ', 0)
+	if _, err := tw.Write([]byte("Module\tDependencies\tStatus\n")); err != nil {
+		return fmt.Errorf("Error writing tabular output: %w", err)
+	}
+	for _, r := range results {
+		status := "refreshed"
+		if r.GetError() != "" {
+			failed++
+			status = "failed: " + r.GetError()
+		}
+		if _, err := fmt.Fprintf(tw, "%s@%s\t%d\t%s\n", r.GetModuleName(), r.GetVersion(), r.GetDependencyCount(), status); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("Error writing tabular output: %w", err)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d module version(s) could not be refreshed", failed, len(results))
+	}
+	return nil
+}
+
+// runAdminFsckCmd implements the logic behind the 'admin fsck' CLI sub-command
+func runAdminFsckCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	repair, _ := cmd.Flags().GetBool("repair")
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("checking the graph")
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().CheckGraph(ctx, connect.NewRequest(&perseusapi.CheckGraphRequest{Repair: repair}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to check the graph: %w", err)
+	}
+
+	issues := resp.Msg.GetIssues()
+	if len(issues) == 0 {
+		infof("no problems found\n")
+		return nil
+	}
+	unrepaired := 0
+	tw := tabwriter.NewWriter(os.Stdout, 10, 4, 2, ' ', 0)
+	if _, err := tw.Write([]byte("Problem\tRepaired\tDescription\n")); err != nil {
+		return fmt.Errorf("Error writing tabular output: %w", err)
+	}
+	for _, i := range issues {
+		if !i.GetRepaired() {
+			unrepaired++
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%v\t%s\n", i.GetKind(), i.GetRepaired(), i.GetDescription()); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("Error writing tabular output: %w", err)
+	}
+	if unrepaired > 0 {
+		return fmt.Errorf("%d of %d problem(s) remain", unrepaired, len(issues))
+	}
+	return nil
+}
+
+// runAdminMergeCmd implements the logic behind the 'admin merge' CLI sub-command
+func runAdminMergeCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	source, target := args[0], args[1]
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner(fmt.Sprintf("merging %s into %s", source, target))
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().MergeModules(ctx, connect.NewRequest(&perseusapi.MergeModulesRequest{
+		SourceModule: source,
+		TargetModule: target,
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to merge %s into %s: %w", source, target, err)
+	}
+	fmt.Printf("Merged %s into %s: %d version(s) moved, %d version(s) combined\n",
+		source, target, resp.Msg.GetMovedVersions(), resp.Msg.GetMergedVersions())
+	return nil
+}
+
+// runAdminRenameCmd implements the logic behind the 'admin rename' CLI sub-command
+func runAdminRenameCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	oldName, newName := args[0], args[1]
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	_, err = ps.API().RenameModule(ctx, connect.NewRequest(&perseusapi.RenameModuleRequest{
+		OldModule: oldName,
+		NewModule: newName,
+	}))
+	if err != nil {
+		return fmt.Errorf("Unable to rename %s to %s: %w", oldName, newName, err)
+	}
+	fmt.Printf("Recorded that %s was renamed to %s\n", oldName, newName)
+	return nil
+}
+
+// runAdminUndeleteCmd implements the logic behind the 'admin undelete' CLI sub-command
+func runAdminUndeleteCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	modulePath, version, _ := strings.Cut(args[0], "@")
+	if version != "" && !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().UndeleteModule(ctx, connect.NewRequest(&perseusapi.UndeleteModuleRequest{
+		ModuleName: modulePath,
+		Version:    version,
+	}))
+	if err != nil {
+		return fmt.Errorf("Unable to undelete %s: %w", args[0], err)
+	}
+	fmt.Printf("Restored %s with %d versions and %d dependencies\n", args[0], resp.Msg.GetRestoredVersions(), resp.Msg.GetRestoredDependencies())
+	return nil
+}
+
+// runAdminSetLicenseCmd implements the logic behind the 'admin set-license' CLI sub-command
+func runAdminSetLicenseCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	modulePath, license := args[0], args[1]
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	_, err = ps.API().SetModuleLicense(ctx, connect.NewRequest(&perseusapi.SetModuleLicenseRequest{
+		ModuleName: modulePath,
+		License:    license,
+	}))
+	if err != nil {
+		return fmt.Errorf("Unable to set the license of %s: %w", modulePath, err)
+	}
+	if license == "" {
+		fmt.Printf("Cleared the license of %s\n", modulePath)
+	} else {
+		fmt.Printf("Recorded that %s is licensed under %s\n", modulePath, license)
+	}
+	return nil
+}
+
+// runAdminJobsCmd implements the logic behind the 'admin jobs' CLI sub-command
+func runAdminJobsCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().ListJobs(ctx, connect.NewRequest(&perseusapi.ListJobsRequest{}))
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve the server's scheduled jobs: %w", err)
+	}
+
+	jobs := resp.Msg.GetJobs()
+	if len(jobs) == 0 {
+		infof("the server has no scheduled jobs\n")
+		return nil
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 10, 4, 2, ' ', 0)
+	if _, err := tw.Write([]byte("Job\tType\tSchedule\tLast Run\tStatus\tNext Run\tRuns\tFailures\n")); err != nil {
+		return fmt.Errorf("Error writing tabular output: %w", err)
+	}
+	for _, j := range jobs {
+		lastRun, nextRun := "-", "-"
+		if j.GetLastRunTime() != nil {
+			lastRun = fmt.Sprintf("%s (%s)", j.GetLastRunTime().AsTime().Local().Format(time.DateTime), j.GetLastRunDuration().AsDuration().Round(time.Millisecond))
+		}
+		if j.GetNextRunTime() != nil {
+			nextRun = j.GetNextRunTime().AsTime().Local().Format(time.DateTime)
+		}
+		status := j.GetLastStatus()
+		if j.GetLastError() != "" {
+			status += ": " + j.GetLastError()
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%d\n", j.GetName(), j.GetType(), j.GetSchedule(), lastRun, status, nextRun, j.GetRunCount(), j.GetFailureCount()); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("Error writing tabular output: %w", err)
+	}
+	return nil
+}
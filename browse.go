@@ -0,0 +1,558 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const browseExampleUsage = `  # search for modules interactively
+  perseus browse
+
+  # start at the latest version of Perseus
+  perseus browse github.com/CrowdStrike/perseus
+
+  # start at a specific version of Perseus
+  perseus browse github.com/CrowdStrike/perseus@v0.22.0`
+
+// createBrowseCommand initializes and returns a *cobra.Command that implements the 'browse' CLI sub-command
+func createBrowseCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:          "browse [module[@version]]",
+		Example:      browseExampleUsage,
+		Short:        "Interactively explores the Perseus graph",
+		Long:         "Starts an interactive terminal UI for searching modules and expanding their dependencies and dependents",
+		RunE:         runBrowseCmd,
+		SilenceUsage: true,
+	}
+	fset := cmd.Flags()
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
+	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.Duration("timeout", 0, "the maximum amount of time to wait for each call to the Perseus server, ex: 30s (default is $PERSEUS_TIMEOUT environment variable or no limit)")
+	addRetryFlags(fset)
+	addConnectionFlags(fset)
+	fset.Duration("cache-ttl", 0, "cache the responses to read-only queries on disk for the specified amount of time, ex: 10m, so that repeated queries are not re-fetched (default is $PERSEUS_CACHE_TTL environment variable or no caching)")
+
+	return &cmd
+}
+
+// runBrowseCmd implements the logic behind the 'browse' CLI sub-command
+func runBrowseCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("At most 1 module may be specified")
+	}
+	if !tty() {
+		return fmt.Errorf("The browse command requires an interactive terminal")
+	}
+
+	m, err := newBrowseModel(conf)
+	if err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		start, err := parseModuleArg(context.Background(), args[0], m.client, false, func(string) {})
+		if err != nil {
+			return err
+		}
+		m.mode = browseModeTree
+		m.root = &browseNode{mod: start}
+	}
+
+	if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		return fmt.Errorf("Error running the interactive browser: %w", err)
+	}
+	return nil
+}
+
+// browsePageSize is the number of results requested per page.  An explicit page size is required because
+// the server always returns a next page token when no page size is specified.
+const browsePageSize = 100
+
+// browseMode identifies which screen of the interactive browser is active
+type browseMode int
+
+const (
+	// the user is typing a module search pattern
+	browseModeSearch browseMode = iota
+	// the user is selecting from the results of a module search
+	browseModeResults
+	// the user is exploring the dependency tree of a module
+	browseModeTree
+)
+
+// browseNode is a single module in the interactive dependency tree.  The children of a node are
+// retrieved from the server the first time it is expanded.
+type browseNode struct {
+	mod      module.Version
+	parent   *browseNode
+	depth    int
+	loaded   bool
+	loading  bool
+	expanded bool
+	children []*browseNode
+}
+
+// browseModel is the bubbletea model that implements the interactive graph browser.
+type browseModel struct {
+	conf   clientConfig
+	client *client.Client
+
+	mode   browseMode
+	height int
+
+	// module search state
+	query         string
+	results       []module.Version
+	resultsFilter string
+	resultsToken  string
+	searching     bool
+
+	// dependency tree state
+	root    *browseNode
+	dir     perseusapi.DependencyDirection
+	history []module.Version
+
+	cursor int
+	err    error
+}
+
+// messages delivered to the model by asynchronous commands
+type (
+	// searchResultsMsg contains a page of results from a module search
+	searchResultsMsg struct {
+		filter    string
+		mods      []module.Version
+		pageToken string
+		more      bool
+		err       error
+	}
+	// childrenLoadedMsg contains the dependencies, or dependents, of a tree node
+	childrenLoadedMsg struct {
+		node *browseNode
+		dir  perseusapi.DependencyDirection
+		mods []module.Version
+		err  error
+	}
+	// rootResolvedMsg contains the version of the root module if none was specified
+	rootResolvedMsg struct {
+		version string
+		err     error
+	}
+)
+
+// newBrowseModel initializes and returns a new [browseModel] that starts on the module search screen.
+func newBrowseModel(conf clientConfig) (*browseModel, error) {
+	ps, err := conf.getClient()
+	if err != nil {
+		return nil, err
+	}
+	return &browseModel{
+		conf:   conf,
+		client: ps,
+		mode:   browseModeSearch,
+		dir:    perseusapi.DependencyDirection_dependencies,
+		height: 24,
+	}, nil
+}
+
+// Init satisfies the [tea.Model] interface and loads the root module, if one was specified.
+func (m *browseModel) Init() tea.Cmd {
+	if m.root == nil {
+		return nil
+	}
+	return m.openRoot()
+}
+
+// Update satisfies the [tea.Model] interface and handles key presses and the results of API calls.
+func (m *browseModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+		return m, nil
+
+	case searchResultsMsg:
+		m.searching = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if !msg.more {
+			m.results, m.cursor = nil, 0
+		}
+		m.results = append(m.results, msg.mods...)
+		m.resultsFilter, m.resultsToken = msg.filter, msg.pageToken
+		return m, nil
+
+	case childrenLoadedMsg:
+		msg.node.loading = false
+		if msg.dir != m.dir {
+			// the direction was toggled while the request was in flight
+			return m, nil
+		}
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		msg.node.loaded, msg.node.expanded = true, true
+		msg.node.children = make([]*browseNode, 0, len(msg.mods))
+		for _, mod := range msg.mods {
+			msg.node.children = append(msg.node.children, &browseNode{mod: mod, parent: msg.node, depth: msg.node.depth + 1})
+		}
+		return m, nil
+
+	case rootResolvedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.root.mod.Version = msg.version
+		return m, m.expand(m.root)
+
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+		m.err = nil
+		switch m.mode {
+		case browseModeSearch:
+			return m.updateSearch(msg)
+		case browseModeResults:
+			return m.updateResults(msg)
+		default:
+			return m.updateTree(msg)
+		}
+	}
+	return m, nil
+}
+
+// updateSearch handles key presses on the module search screen
+func (m *browseModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		if m.root == nil {
+			return m, tea.Quit
+		}
+		m.mode, m.cursor = browseModeTree, 0
+	case tea.KeyEnter:
+		if strings.TrimSpace(m.query) == "" {
+			return m, nil
+		}
+		filter := strings.TrimSpace(m.query)
+		if !strings.ContainsAny(filter, "*?") {
+			filter = "*" + filter + "*"
+		}
+		m.mode, m.searching = browseModeResults, true
+		return m, m.search(filter, "")
+	case tea.KeyBackspace:
+		if m.query != "" {
+			r := []rune(m.query)
+			m.query = string(r[:len(r)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.query += string(msg.Runes)
+	}
+	return m, nil
+}
+
+// updateResults handles key presses on the module search results screen
+func (m *browseModel) updateResults(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "/", "esc":
+		m.mode = browseModeSearch
+	case "up", "k":
+		m.moveCursor(-1, len(m.results))
+	case "down", "j":
+		m.moveCursor(1, len(m.results))
+		// lazily load the next page of results when the user reaches the end of the list
+		if m.cursor == len(m.results)-1 && m.resultsToken != "" && !m.searching {
+			m.searching = true
+			return m, m.search(m.resultsFilter, m.resultsToken)
+		}
+	case "enter", "right", "l":
+		if len(m.results) == 0 {
+			return m, nil
+		}
+		m.history = nil
+		m.root = &browseNode{mod: m.results[m.cursor]}
+		m.mode, m.cursor = browseModeTree, 0
+		return m, m.openRoot()
+	}
+	return m, nil
+}
+
+// updateTree handles key presses on the dependency tree screen
+func (m *browseModel) updateTree(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	visible := m.visibleNodes()
+	if len(visible) == 0 {
+		if msg.String() == "q" {
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+	if m.cursor >= len(visible) {
+		m.cursor = len(visible) - 1
+	}
+	node := visible[m.cursor]
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "/":
+		m.mode = browseModeSearch
+	case "up", "k":
+		m.moveCursor(-1, len(visible))
+	case "down", "j":
+		m.moveCursor(1, len(visible))
+	case "right", "l", " ":
+		if node.expanded {
+			return m, nil
+		}
+		return m, m.expand(node)
+	case "left", "h":
+		switch {
+		case node.expanded:
+			node.expanded = false
+		case node.parent != nil:
+			for i, n := range visible {
+				if n == node.parent {
+					m.cursor = i
+					break
+				}
+			}
+		}
+	case "enter":
+		// jump to the selected module, making it the new root of the tree
+		if node == m.root {
+			return m, nil
+		}
+		m.history = append(m.history, m.root.mod)
+		m.root = &browseNode{mod: node.mod}
+		m.cursor = 0
+		return m, m.expand(m.root)
+	case "backspace", "b":
+		if len(m.history) == 0 {
+			return m, nil
+		}
+		prev := m.history[len(m.history)-1]
+		m.history = m.history[:len(m.history)-1]
+		m.root = &browseNode{mod: prev}
+		m.cursor = 0
+		return m, m.expand(m.root)
+	case "tab", "d":
+		if m.dir == perseusapi.DependencyDirection_dependencies {
+			m.dir = perseusapi.DependencyDirection_dependents
+		} else {
+			m.dir = perseusapi.DependencyDirection_dependencies
+		}
+		m.root = &browseNode{mod: m.root.mod}
+		m.cursor = 0
+		return m, m.expand(m.root)
+	}
+	return m, nil
+}
+
+// moveCursor moves the selection by delta, clamping it to the range [0, n)
+func (m *browseModel) moveCursor(delta, n int) {
+	m.cursor += delta
+	if m.cursor >= n {
+		m.cursor = n - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+// View satisfies the [tea.Model] interface and renders the current screen.
+func (m *browseModel) View() string {
+	var sb strings.Builder
+	switch m.mode {
+	case browseModeSearch:
+		sb.WriteString("Search for modules (glob patterns are supported)\n\n")
+		sb.WriteString("> " + m.query + "█\n\n")
+		sb.WriteString("enter: search • esc: back • ctrl+c: quit\n")
+
+	case browseModeResults:
+		sb.WriteString(fmt.Sprintf("Modules matching %q\n\n", m.resultsFilter))
+		lines := make([]string, 0, len(m.results))
+		for _, mod := range m.results {
+			lines = append(lines, mod.String())
+		}
+		if len(lines) == 0 && !m.searching {
+			lines = append(lines, "(no matching modules)")
+		}
+		m.writeWindow(&sb, lines)
+		if m.searching {
+			sb.WriteString("\nloading...\n")
+		}
+		sb.WriteString("\n↑/↓: move • enter: open • /: search • q: quit\n")
+
+	default:
+		label := "Dependencies of"
+		if m.dir == perseusapi.DependencyDirection_dependents {
+			label = "Dependents of"
+		}
+		sb.WriteString(fmt.Sprintf("%s %s\n\n", label, m.root.mod))
+		visible := m.visibleNodes()
+		lines := make([]string, 0, len(visible))
+		for _, n := range visible {
+			marker := "▸ "
+			switch {
+			case n.loading:
+				marker = "… "
+			case n.expanded && len(n.children) == 0:
+				marker = "· "
+			case n.expanded:
+				marker = "▾ "
+			}
+			lines = append(lines, strings.Repeat("  ", n.depth)+marker+n.mod.String())
+		}
+		m.writeWindow(&sb, lines)
+		sb.WriteString("\n↑/↓: move • →/←: expand/collapse • enter: jump to module • b: back • tab: toggle direction • /: search • q: quit\n")
+	}
+	if m.err != nil {
+		sb.WriteString("\nerror: " + formatError(m.err) + "\n")
+	}
+	return sb.String()
+}
+
+// writeWindow writes the subset of lines that fits on the screen, scrolled such that the selected line
+// is visible.
+func (m *browseModel) writeWindow(sb *strings.Builder, lines []string) {
+	// leave room for the header and footer
+	rows := m.height - 6
+	if rows < 1 {
+		rows = 1
+	}
+	start := 0
+	if m.cursor >= rows {
+		start = m.cursor - rows + 1
+	}
+	for i := start; i < len(lines) && i < start+rows; i++ {
+		prefix := "  "
+		if i == m.cursor {
+			prefix = "> "
+		}
+		sb.WriteString(prefix + lines[i] + "\n")
+	}
+}
+
+// visibleNodes returns the nodes of the tree that are currently displayed, in display order.
+func (m *browseModel) visibleNodes() []*browseNode {
+	if m.root == nil {
+		return nil
+	}
+	var (
+		result []*browseNode
+		walk   func(*browseNode)
+	)
+	walk = func(n *browseNode) {
+		result = append(result, n)
+		if !n.expanded {
+			return
+		}
+		for _, c := range n.children {
+			walk(c)
+		}
+	}
+	walk(m.root)
+	return result
+}
+
+// openRoot expands the root of the tree, resolving the latest version of the module first if no version
+// was specified.
+func (m *browseModel) openRoot() tea.Cmd {
+	if m.root.mod.Version != "" {
+		return m.expand(m.root)
+	}
+	path := m.root.mod.Path
+	return func() tea.Msg {
+		ctx, cancel := m.conf.newContext()
+		defer cancel()
+		v, err := m.client.LatestVersion(ctx, path, asOf)
+		return rootResolvedMsg{version: v, err: err}
+	}
+}
+
+// expand displays the children of node, retrieving them from the server if they have not already been
+// loaded.
+func (m *browseModel) expand(node *browseNode) tea.Cmd {
+	if node.loaded {
+		node.expanded = true
+		return nil
+	}
+	if node.loading {
+		return nil
+	}
+	node.loading = true
+	dir := m.dir
+	return func() tea.Msg {
+		ctx, cancel := m.conf.newContext()
+		defer cancel()
+		mods, err := queryDirectDependencies(ctx, m.client, node.mod, dir)
+		return childrenLoadedMsg{node: node, dir: dir, mods: mods, err: err}
+	}
+}
+
+// search returns a command that retrieves the page of modules matching filter that is identified by
+// pageToken.
+func (m *browseModel) search(filter, pageToken string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := m.conf.newContext()
+		defer cancel()
+		req := connect.NewRequest(&perseusapi.ListModulesRequest{
+			Filter:    filter,
+			PageToken: pageToken,
+			PageSize:  browsePageSize,
+		})
+		resp, err := m.client.API().ListModules(ctx, req)
+		if err != nil {
+			return searchResultsMsg{err: fmt.Errorf("Unable to list modules matching the provided filter: %w", err)}
+		}
+		msg := searchResultsMsg{
+			filter:    filter,
+			pageToken: resp.Msg.GetNextPageToken(),
+			more:      pageToken != "",
+		}
+		for _, mod := range resp.Msg.GetModules() {
+			mv := module.Version{Path: mod.GetName()}
+			if vers := mod.GetVersions(); len(vers) > 0 {
+				mv.Version = vers[0]
+			}
+			msg.mods = append(msg.mods, mv)
+		}
+		return msg
+	}
+}
+
+// queryDirectDependencies invokes the Perseus API to retrieve the direct dependencies, or dependents, of
+// mod, reading all pages of results.
+func queryDirectDependencies(ctx context.Context, ps *client.Client, mod module.Version, dir perseusapi.DependencyDirection) ([]module.Version, error) {
+	var results []module.Version
+	deps := ps.QueryDependencies(ctx, client.DependencyQuery{
+		Module:    mod,
+		Direction: dir,
+		PageSize:  browsePageSize,
+	})
+	for dep, err := range deps.All() {
+		if err != nil {
+			return nil, fmt.Errorf("Unable to query the dependencies of %s: %w", mod, err)
+		}
+		results = append(results, module.Version{Path: dep.GetName(), Version: dep.GetVersions()[0]})
+	}
+	return results, nil
+}
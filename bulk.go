@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+)
+
+// defaultUpdateConcurrency is the default number of modules that are processed at the same time by the
+// bulk update operations
+const defaultUpdateConcurrency = 4
+
+// forEachConcurrently calls fn for each of the provided items, processing up to --concurrency items at
+// the same time, and returns once all of the calls have completed.
+func forEachConcurrently[T any](items []T, fn func(T)) {
+	var eg errgroup.Group
+	eg.SetLimit(max(updateConcurrency, 1))
+	for _, item := range items {
+		item := item
+		eg.Go(func() error {
+			fn(item)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+}
+
+// newProxyLimiter returns a rate limiter that restricts requests to the Go module proxy to --qps
+// requests per second, or a limiter that never waits if no limit was specified.
+func newProxyLimiter() *rate.Limiter {
+	if updateQPS <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(updateQPS), 1)
+}
+
+// updateFromFile updates the Perseus graph with each module listed in the file at path, or stdin if
+// path is "-", processing multiple modules concurrently and reporting progress as it goes.
+//
+// Each line of the file is either a module path, in which case the current version is processed, or
+// a module path and version in the form 'module@version'.  Blank lines and lines beginning with '#' are
+// ignored.
+func updateFromFile(conf clientConfig, path string) error {
+	key := "from-file:-"
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		if abs, err := filepath.Abs(path); err == nil {
+			key = "from-file:" + abs
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("Unable to open the module list: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+	mods, err := parseModuleList(r)
+	if err != nil {
+		return err
+	}
+	if len(mods) == 0 {
+		infof("no modules to process\n")
+		return nil
+	}
+
+	cp, err := openBulkCheckpoint(key)
+	if err != nil {
+		return err
+	}
+	// entries are recorded in the checkpoint as they appear in the file since the version of an entry
+	// without one is only resolved when it is processed
+	var pending []module.Version
+	for _, m := range mods {
+		if !cp.Done(m.String()) {
+			pending = append(pending, m)
+		}
+	}
+
+	progress := newBulkProgress(len(pending))
+	limiter := newProxyLimiter()
+	forEachConcurrently(pending, func(m module.Version) {
+		mod, err := updateModuleFromProxy(conf, limiter, m)
+		if err != nil {
+			progress.Failed(mod.String(), err)
+			return
+		}
+		if err := cp.Record(m.String()); err != nil {
+			logger.Error(err, "unable to record the completed module", "module", m)
+		}
+		progress.Succeeded(mod.String())
+	})
+	return finishBulkCheckpoint(cp, progress.Finish())
+}
+
+// updateModuleFromProxy reads the go.mod file for mod from the Go module proxy and updates the Perseus
+// graph with its direct dependencies, returning the module version that was processed.  If mod has no
+// version the current version is used.  Each request to the module proxy waits for limiter.
+func updateModuleFromProxy(conf clientConfig, limiter *rate.Limiter, mod module.Version) (module.Version, error) {
+	if mod.Version == "" {
+		_ = limiter.Wait(context.Background())
+		v, err := moduleProxy().GetCurrentVersion(mod.Path, includePrerelease)
+		if err != nil {
+			return mod, fmt.Errorf("unable to determine @latest: %w", err)
+		}
+		mod.Version = v
+	}
+	_ = limiter.Wait(context.Background())
+	info, err := parseModulePath(mod.Path, mod.Version)
+	if err != nil {
+		return mod, err
+	}
+	if err := applyUpdates(conf, mod, info.Deps); err != nil {
+		return mod, fmt.Errorf("Unable to update the Perseus graph: %w", err)
+	}
+	return mod, nil
+}
+
+// parseModuleList reads a list of modules, one 'module[@version]' per line, from r.  Blank lines and
+// lines beginning with '#' are ignored.
+func parseModuleList(r io.Reader) ([]module.Version, error) {
+	var (
+		mods   []module.Version
+		lineNo int
+	)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p, v, _ := strings.Cut(line, "@")
+		p, v, err := unescapeModule(p, v)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid module on line %d of the module list: %w", lineNo, err)
+		}
+		if err := module.CheckPath(p); err != nil {
+			return nil, fmt.Errorf("Invalid module on line %d of the module list: %w", lineNo, err)
+		}
+		if v != "" && !semver.IsValid(v) {
+			return nil, fmt.Errorf("Invalid version %q on line %d of the module list", v, lineNo)
+		}
+		mods = append(mods, module.Version{Path: p, Version: v})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Unable to read the module list: %w", err)
+	}
+	return mods, nil
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+// cachedResponseDecoder decodes a serialized response message into a [connect.AnyResponse] of the concrete
+// type that the generated client for an RPC expects
+type cachedResponseDecoder func(data []byte) (connect.AnyResponse, error)
+
+// cacheableProcedures lists the read-only RPCs whose responses may be cached, along with the decoder for
+// each one's response message.  RPCs that change the graph, or that return administrative data, are never
+// cached.
+var cacheableProcedures = map[string]cachedResponseDecoder{
+	perseusapiconnect.PerseusServiceListModulesProcedure:          decodeCachedResponse[perseusapi.ListModulesResponse],
+	perseusapiconnect.PerseusServiceListModuleVersionsProcedure:   decodeCachedResponse[perseusapi.ListModuleVersionsResponse],
+	perseusapiconnect.PerseusServiceQueryDependenciesProcedure:    decodeCachedResponse[perseusapi.QueryDependenciesResponse],
+	perseusapiconnect.PerseusServiceGetDependencyHistoryProcedure: decodeCachedResponse[perseusapi.GetDependencyHistoryResponse],
+	perseusapiconnect.PerseusServiceDiffGraphProcedure:            decodeCachedResponse[perseusapi.DiffGraphResponse],
+	perseusapiconnect.PerseusServiceListTopModulesProcedure:       decodeCachedResponse[perseusapi.ListTopModulesResponse],
+	perseusapiconnect.PerseusServiceGetModuleMetricsProcedure:     decodeCachedResponse[perseusapi.GetModuleMetricsResponse],
+	perseusapiconnect.PerseusServiceListModuleConsumersProcedure:  decodeCachedResponse[perseusapi.ListModuleConsumersResponse],
+	perseusapiconnect.PerseusServiceListModuleStalenessProcedure:  decodeCachedResponse[perseusapi.ListModuleStalenessResponse],
+	perseusapiconnect.PerseusServiceListAbandonedModulesProcedure: decodeCachedResponse[perseusapi.ListAbandonedModulesResponse],
+	perseusapiconnect.PerseusServiceListGhostVersionsProcedure:    decodeCachedResponse[perseusapi.ListGhostVersionsResponse],
+	perseusapiconnect.PerseusServiceListVulnerabilitiesProcedure:  decodeCachedResponse[perseusapi.ListVulnerabilitiesResponse],
+	perseusapiconnect.PerseusServiceResolveModuleSetProcedure:     decodeCachedResponse[perseusapi.ResolveModuleSetResponse],
+}
+
+// decodeCachedResponse is a [cachedResponseDecoder] for responses of type T
+func decodeCachedResponse[T any, PT interface {
+	*T
+	proto.Message
+}](data []byte) (connect.AnyResponse, error) {
+	msg := PT(new(T))
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse((*T)(msg)), nil
+}
+
+// responseCache is an on-disk cache of API responses, keyed by the server, the RPC, and the request
+// message, that is used to avoid re-fetching identical results from a slow or remote server when the
+// same queries are repeated during an investigation.
+type responseCache struct {
+	// the folder containing the cached responses
+	dir string
+	// the TCP host/port of the Perseus server, which is included in each key so that responses from
+	// different servers are kept separate
+	serverAddr string
+	// how long a cached response is used before it is re-fetched
+	ttl time.Duration
+	// ensures that expired entries are only pruned once per command
+	pruneOnce sync.Once
+}
+
+// responseCacheDir returns the location of the on-disk response cache, which is the perseus/responses/
+// folder under the user's cache directory, ex: $XDG_CACHE_HOME or ~/.cache on Linux.
+func responseCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine the user's cache directory: %w", err)
+	}
+	return filepath.Join(dir, "perseus", "responses"), nil
+}
+
+// newResponseCache returns a cache of the responses from the specified server that expire after ttl
+func newResponseCache(serverAddr string, ttl time.Duration) (*responseCache, error) {
+	dir, err := responseCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	return &responseCache{dir: dir, serverAddr: serverAddr, ttl: ttl}, nil
+}
+
+// interceptor returns a Connect interceptor that serves the responses to cacheable RPCs from the cache
+// when possible and stores successful responses for later use.  Failures to read or write the cache are
+// logged and otherwise ignored so that they never break a command.
+func (c *responseCache) interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			decode, ok := cacheableProcedures[req.Spec().Procedure]
+			if !ok {
+				return next(ctx, req)
+			}
+			msg, ok := req.Any().(proto.Message)
+			if !ok {
+				return next(ctx, req)
+			}
+			key, err := c.key(req.Spec().Procedure, msg)
+			if err != nil {
+				logger.Debug("unable to compute the response cache key", "procedure", req.Spec().Procedure, "err", err)
+				return next(ctx, req)
+			}
+
+			if data, ok := c.get(key); ok {
+				resp, err := decode(data)
+				if err == nil {
+					logger.Debug("using cached response", "procedure", req.Spec().Procedure, "key", key)
+					return resp, nil
+				}
+				logger.Debug("ignoring invalid cached response", "procedure", req.Spec().Procedure, "key", key, "err", err)
+			}
+
+			resp, err := next(ctx, req)
+			if err != nil {
+				return resp, err
+			}
+			if m, ok := resp.Any().(proto.Message); ok {
+				if err := c.put(key, m); err != nil {
+					logger.Debug("unable to cache the response", "procedure", req.Spec().Procedure, "key", key, "err", err)
+				}
+			}
+			return resp, nil
+		}
+	}
+}
+
+// key returns the cache key for a call to procedure with the specified request message
+func (c *responseCache) key(procedure string, msg proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(c.serverAddr))
+	h.Write([]byte{0})
+	h.Write([]byte(procedure))
+	h.Write([]byte{0})
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// get returns the serialized response stored under key, if it exists and has not expired
+func (c *responseCache) get(key string) ([]byte, bool) {
+	path := filepath.Join(c.dir, key)
+	fi, err := os.Stat(path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			logger.Debug("unable to read the response cache", "path", path, "err", err)
+		}
+		return nil, false
+	}
+	if time.Since(fi.ModTime()) > c.ttl {
+		_ = os.Remove(path)
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logger.Debug("unable to read the response cache", "path", path, "err", err)
+		return nil, false
+	}
+	return data, true
+}
+
+// put serializes msg and stores it under key, replacing any existing entry atomically so that concurrent
+// commands never read a partial response
+func (c *responseCache) put(key string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(c.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.Name(), filepath.Join(c.dir, key)); err != nil {
+		return err
+	}
+	c.pruneOnce.Do(c.prune)
+	return nil
+}
+
+// prune removes the entries, including the temporary files of interrupted writes, that have expired so
+// that responses which are never requested again don't accumulate.  Failures are logged and otherwise
+// ignored.
+func (c *responseCache) prune() {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		logger.Debug("unable to prune the response cache", "path", c.dir, "err", err)
+		return
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		fi, err := e.Info()
+		if err != nil || time.Since(fi.ModTime()) <= c.ttl {
+			continue
+		}
+		path := filepath.Join(c.dir, e.Name())
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			logger.Debug("unable to prune the response cache", "path", path, "err", err)
+		}
+	}
+}
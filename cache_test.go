@@ -0,0 +1,91 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+func TestResponseCache(t *testing.T) {
+	const procedure = perseusapiconnect.PerseusServiceListModulesProcedure
+	req := &perseusapi.ListModulesRequest{Filter: "github.com/example/*"}
+	resp := &perseusapi.ListModulesResponse{Modules: []*perseusapi.Module{{Name: "github.com/example/foo"}}}
+
+	// cacheEntry stores resp in c and returns its key
+	cacheEntry := func(t *testing.T, c *responseCache) string {
+		t.Helper()
+		key, err := c.key(procedure, req)
+		require.NoError(t, err)
+		require.NoError(t, c.put(key, resp))
+		return key
+	}
+
+	t.Run("hit", func(t *testing.T) {
+		c := &responseCache{dir: t.TempDir(), serverAddr: "perseus.example.com:31138", ttl: time.Hour}
+		key := cacheEntry(t, c)
+
+		data, ok := c.get(key)
+		require.True(t, ok, "the response should be cached")
+		got, err := cacheableProcedures[procedure](data)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(resp, got.Any().(proto.Message)), "unexpected cached response %v", got.Any())
+	})
+	t.Run("expired", func(t *testing.T) {
+		c := &responseCache{dir: t.TempDir(), serverAddr: "perseus.example.com:31138", ttl: time.Minute}
+		key := cacheEntry(t, c)
+		path := filepath.Join(c.dir, key)
+		old := time.Now().Add(-2 * time.Minute)
+		require.NoError(t, os.Chtimes(path, old, old))
+
+		_, ok := c.get(key)
+		assert.False(t, ok, "an expired response should not be used")
+		assert.NoFileExists(t, path, "an expired response should be removed")
+	})
+	t.Run("isolated by server", func(t *testing.T) {
+		dir := t.TempDir()
+		c1 := &responseCache{dir: dir, serverAddr: "perseus-1.example.com:31138", ttl: time.Hour}
+		c2 := &responseCache{dir: dir, serverAddr: "perseus-2.example.com:31138", ttl: time.Hour}
+		key1 := cacheEntry(t, c1)
+		key2, err := c2.key(procedure, req)
+		require.NoError(t, err)
+		assert.NotEqual(t, key1, key2, "the same request to different servers should have different keys")
+
+		_, ok := c2.get(key2)
+		assert.False(t, ok, "a response from another server should not be used")
+		_, ok = c1.get(key1)
+		assert.True(t, ok)
+	})
+	t.Run("isolated by request", func(t *testing.T) {
+		c := &responseCache{dir: t.TempDir(), serverAddr: "perseus.example.com:31138", ttl: time.Hour}
+		cacheEntry(t, c)
+		key, err := c.key(procedure, &perseusapi.ListModulesRequest{Filter: "github.com/other/*"})
+		require.NoError(t, err)
+		_, ok := c.get(key)
+		assert.False(t, ok, "a response to a different request should not be used")
+	})
+	t.Run("prunes expired entries on write", func(t *testing.T) {
+		c := &responseCache{dir: t.TempDir(), serverAddr: "perseus.example.com:31138", ttl: time.Minute}
+		old := time.Now().Add(-2 * time.Minute)
+		for _, name := range []string{"stale", "interrupted.123.tmp", "fresh"} {
+			path := filepath.Join(c.dir, name)
+			require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+			if name != "fresh" {
+				require.NoError(t, os.Chtimes(path, old, old))
+			}
+		}
+
+		key := cacheEntry(t, c)
+		assert.NoFileExists(t, filepath.Join(c.dir, "stale"))
+		assert.NoFileExists(t, filepath.Join(c.dir, "interrupted.123.tmp"))
+		assert.FileExists(t, filepath.Join(c.dir, "fresh"))
+		assert.FileExists(t, filepath.Join(c.dir, key))
+	})
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// checkpoint records the items of a bulk operation that have been processed successfully so that an
+// interrupted run can be resumed without repeating that work.
+//
+// The checkpoint file contains one completed item per line and is appended to as each item completes so
+// that the file is always up to date, even if the process is killed.  All methods are safe for
+// concurrent use.
+type checkpoint struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+	done map[string]struct{}
+}
+
+// openCheckpoint opens the checkpoint file at path, creating it if necessary.  If resume is true, the
+// items recorded by a previous run are loaded and the file is appended to.  Otherwise, any existing
+// checkpoint is discarded.
+func openCheckpoint(path string, resume bool) (*checkpoint, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("Unable to create the checkpoint folder: %w", err)
+	}
+	cp := checkpoint{
+		path: path,
+		done: make(map[string]struct{}),
+	}
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if resume {
+		if err := cp.load(); err != nil {
+			return nil, err
+		}
+	} else {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open the checkpoint file: %w", err)
+	}
+	cp.f = f
+	return &cp, nil
+}
+
+// load reads the items recorded in an existing checkpoint file.  A missing file is not an error so that
+// --resume can be specified unconditionally.
+func (c *checkpoint) load() error {
+	f, err := os.Open(c.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("Unable to read the checkpoint file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if item := strings.TrimSpace(scanner.Text()); item != "" {
+			c.done[item] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("Unable to read the checkpoint file: %w", err)
+	}
+	return nil
+}
+
+// Len returns the number of completed items that were loaded from a previous run.
+func (c *checkpoint) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.done)
+}
+
+// Done returns true if item was processed successfully by a previous run.
+func (c *checkpoint) Done(item string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.done[item]
+	return ok
+}
+
+// Record adds item to the checkpoint file.
+func (c *checkpoint) Record(item string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.done[item] = struct{}{}
+	if _, err := fmt.Fprintln(c.f, item); err != nil {
+		return fmt.Errorf("unable to update the checkpoint file: %w", err)
+	}
+	return nil
+}
+
+// Close closes the checkpoint file.  If completed is true, the run finished without failures so the
+// checkpoint is no longer needed and the file is removed.
+func (c *checkpoint) Close(completed bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.f.Close(); err != nil {
+		return fmt.Errorf("unable to close the checkpoint file: %w", err)
+	}
+	if completed {
+		if err := os.Remove(c.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("unable to remove the checkpoint file: %w", err)
+		}
+	}
+	return nil
+}
+
+// defaultCheckpointPath returns the path of the checkpoint file for the bulk operation identified by key
+// within the user's cache folder.
+func defaultCheckpointPath(key string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("Unable to determine the default checkpoint location, specify one using --checkpoint: %w", err)
+	}
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dir, "perseus", "checkpoints", hex.EncodeToString(sum[:8])+".txt"), nil
+}
+
+// openBulkCheckpoint opens the checkpoint for the bulk operation identified by key, using the file
+// specified by --checkpoint if provided, and resuming the previous run if --resume was specified.
+func openBulkCheckpoint(key string) (*checkpoint, error) {
+	// a dry run never makes progress, so it must not affect the checkpoint of a real run
+	if dryRun {
+		key = "dry-run:" + key
+	}
+	path := checkpointPath
+	if path == "" {
+		var err error
+		if path, err = defaultCheckpointPath(key); err != nil {
+			return nil, err
+		}
+	}
+	cp, err := openCheckpoint(path, resumeBulk)
+	if err != nil {
+		return nil, err
+	}
+	if n := cp.Len(); n > 0 {
+		infof("resuming from %s, skipping %d item(s) that were already processed\n", path, n)
+	}
+	return cp, nil
+}
+
+// finishBulkCheckpoint closes cp once a bulk operation ends, removing the checkpoint file if the
+// operation completed without errors.  The result of the operation, runErr, is returned.
+func finishBulkCheckpoint(cp *checkpoint, runErr error) error {
+	if err := cp.Close(runErr == nil); err != nil {
+		logger.Error(err, "unable to clean up the checkpoint", "path", cp.path)
+	}
+	if runErr != nil {
+		infof("re-run the command with --resume to retry only the items that did not complete\n")
+	}
+	return runErr
+}
@@ -0,0 +1,363 @@
+// Package client provides a Go client for the Perseus API that handles connecting to the server, retrying
+// transient failures according to a configurable [RetryPolicy], and paging through results, so that other
+// tools can query the Perseus graph without re-implementing the logic used by the perseus CLI.
+//
+//	c, err := client.New("https://perseus.example.com")
+//	if err != nil {
+//		return err
+//	}
+//	defer c.Close()
+//	for m, err := range c.ListModules(ctx, "github.com/example/*").All() {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(m.GetName())
+//	}
+//
+// The underlying Connect client is available from [Client.API] for the RPCs that have no helper.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/httplb"
+	"github.com/bufbuild/httplb/health"
+	"github.com/bufbuild/httplb/resolver"
+
+	"github.com/CrowdStrike/perseus/internal/compress"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+// Client is a client for the Perseus API.  A Client is safe for concurrent use.
+type Client struct {
+	api perseusapiconnect.PerseusServiceClient
+	// the load-balancing HTTP client created by New, which is closed by Close, or nil if the caller
+	// provided their own HTTP client
+	lb *httplb.Client
+
+	// guards info
+	infoMu sync.Mutex
+	// the server's version and features, which are retrieved by the first call to ServerInfo
+	info *ServerInfo
+}
+
+// config holds the settings applied by the Option values passed to New
+type config struct {
+	insecure    bool
+	tlsConfig   *tls.Config
+	apiKey      string
+	httpClient  connect.HTTPClient
+	retry       *RetryPolicy
+	connectOpts []connect.ClientOption
+
+	// connection tuning, zero for the defaults
+	idleTimeout    time.Duration
+	pingInterval   time.Duration
+	maxStreams     int
+	connectTimeout time.Duration
+
+	// the compression algorithm used for requests and requested for responses, "" for the default
+	compression string
+}
+
+// tuned returns true if any of the connection tuning options were specified
+func (c *config) tuned() bool {
+	return c.idleTimeout > 0 || c.pingInterval > 0 || c.maxStreams > 0 || c.connectTimeout > 0
+}
+
+// Option configures a Client
+type Option func(*config) error
+
+// WithInsecure disables TLS so that the client can connect to a server at an http:// address, which is
+// usually only appropriate for local development.
+func WithInsecure() Option {
+	return func(c *config) error {
+		c.insecure = true
+		return nil
+	}
+}
+
+// WithTLSConfig specifies the TLS settings used to connect to the server, ex: to trust the certificate
+// authority that issued a private server's certificate or to present a client certificate.  TLS 1.3 is
+// always required, regardless of cfg.MinVersion.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *config) error {
+		if cfg == nil {
+			return fmt.Errorf("the TLS configuration must not be nil")
+		}
+		c.tlsConfig = cfg
+		return nil
+	}
+}
+
+// WithAPIKey assigns the API key that is sent as a bearer token with each request, which is required
+// for administrative operations if the server has authentication enabled.
+func WithAPIKey(key string) Option {
+	return func(c *config) error {
+		if key == "" {
+			return fmt.Errorf("the API key must not be empty")
+		}
+		c.apiKey = key
+		return nil
+	}
+}
+
+// WithHTTPClient specifies the HTTP client used to call the server instead of the default, which
+// balances requests across the addresses the server's host name resolves to.  The HTTP client must
+// support HTTP/2.
+func WithHTTPClient(hc connect.HTTPClient) Option {
+	return func(c *config) error {
+		if hc == nil {
+			return fmt.Errorf("the HTTP client must not be nil")
+		}
+		c.httpClient = hc
+		return nil
+	}
+}
+
+// WithRetryPolicy specifies how RPCs that fail with a transient error are retried instead of
+// [DefaultRetryPolicy].  Use a policy with MaxAttempts set to 1 to disable retries.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *config) error {
+		if err := p.validate(); err != nil {
+			return err
+		}
+		p.RetryableCodes = slices.Clone(p.RetryableCodes)
+		c.retry = &p
+		return nil
+	}
+}
+
+// WithIdleTimeout closes connections to the server that have been idle for longer than d, which should be
+// less than the idle timeout of any load balancers or proxies in between so that the client never sends a
+// request on a connection that is being closed.  By default, idle connections are kept open.
+func WithIdleTimeout(d time.Duration) Option {
+	return func(c *config) error {
+		if d < 0 {
+			return fmt.Errorf("the idle timeout must not be negative, got %s", d)
+		}
+		c.idleTimeout = d
+		return nil
+	}
+}
+
+// WithPingInterval sends an HTTP/2 PING frame on any connection that has not received data from the server
+// for d, which keeps long-running calls from being dropped by load balancers as idle and detects
+// connections that were silently dropped.  By default, no pings are sent.
+func WithPingInterval(d time.Duration) Option {
+	return func(c *config) error {
+		if d < 0 {
+			return fmt.Errorf("the ping interval must not be negative, got %s", d)
+		}
+		c.pingInterval = d
+		return nil
+	}
+}
+
+// WithMaxConcurrentStreams limits the number of requests that may be in flight at once on each connection
+// to the server, and additional requests wait for one to finish.  By default, the limit is the one
+// advertised by the server.
+func WithMaxConcurrentStreams(n int) Option {
+	return func(c *config) error {
+		if n < 0 {
+			return fmt.Errorf("the maximum number of concurrent streams must not be negative, got %d", n)
+		}
+		c.maxStreams = n
+		return nil
+	}
+}
+
+// WithConnectTimeout limits how long the client waits to establish a connection to the server, including
+// the TLS handshake.  The defaults are 30 seconds to connect and 10 seconds for the handshake.
+func WithConnectTimeout(d time.Duration) Option {
+	return func(c *config) error {
+		if d < 0 {
+			return fmt.Errorf("the connect timeout must not be negative, got %s", d)
+		}
+		c.connectTimeout = d
+		return nil
+	}
+}
+
+// the compression algorithms supported by [WithCompression]
+const (
+	// gzip, which every Perseus server supports
+	CompressionGzip = compress.Gzip
+	// Zstandard, which is faster than gzip and compresses better, but requests fail if the server doesn't
+	// support it, ex: an older version or one started with --compression gzip
+	CompressionZstd = compress.Zstd
+	// no compression
+	CompressionNone = compress.None
+)
+
+// WithCompression specifies the algorithm used to compress requests, and requested for responses, that are
+// larger than 1KB.  Large pages of query results are highly compressible, so compression significantly
+// reduces the amount of data sent over slow links.  By default, requests are not compressed and responses
+// are compressed with gzip.
+func WithCompression(name string) Option {
+	return func(c *config) error {
+		switch name {
+		case CompressionGzip, CompressionZstd, CompressionNone:
+			c.compression = name
+			return nil
+		default:
+			return fmt.Errorf("unsupported compression algorithm %q, must be %s, %s, or %s", name, CompressionGzip, CompressionZstd, CompressionNone)
+		}
+	}
+}
+
+// WithClientOptions adds Connect client options, such as interceptors, which are applied after the
+// defaults.
+func WithClientOptions(opts ...connect.ClientOption) Option {
+	return func(c *config) error {
+		c.connectOpts = append(c.connectOpts, opts...)
+		return nil
+	}
+}
+
+// New returns a Client for the Perseus server at addr, which is a URL such as https://perseus.example.com.
+//
+// To balance requests across, and fail over between, the replicas of a replicated deployment, addr can
+// instead be a comma-separated list of URLs, ex: https://perseus-1.example.com,https://perseus-2.example.com,
+// or a srv:// URL that names a DNS SRV record listing the replicas, ex: srv://_perseus._tcp.example.com.
+// The replicas must all present a TLS certificate that is valid for the host name of the first URL or, for
+// an SRV record, its domain, ex: example.com.
+func New(addr string, opts ...Option) (*Client, error) {
+	if addr == "" {
+		return nil, fmt.Errorf("the Perseus server address must be specified")
+	}
+	var conf config
+	for _, fn := range opts {
+		if err := fn(&conf); err != nil {
+			return nil, fmt.Errorf("invalid client option: %w", err)
+		}
+	}
+
+	addr, replicas, err := parseServerAddress(addr, conf.insecure)
+	if err != nil {
+		return nil, err
+	}
+	if replicas != nil && conf.httpClient != nil {
+		return nil, fmt.Errorf("multiple Perseus server addresses can't be used with a custom HTTP client")
+	}
+	if conf.tuned() && conf.httpClient != nil {
+		return nil, fmt.Errorf("the connection tuning options can't be used with a custom HTTP client")
+	}
+	if conf.tlsConfig != nil && (conf.insecure || conf.httpClient != nil) {
+		return nil, fmt.Errorf("the TLS configuration can't be used with an insecure connection or a custom HTTP client")
+	}
+
+	c := Client{}
+	hc := conf.httpClient
+	if hc == nil {
+		var lbOpts []httplb.ClientOption
+		if replicas != nil {
+			// spread requests across the replicas and stop sending them to any that fail health checks
+			lbOpts = append(lbOpts,
+				httplb.WithResolver(resolver.NewPollingResolver(replicas, replicaResolveInterval)),
+				httplb.WithHealthChecks(health.NewPollingChecker(health.PollingCheckerConfig{}, health.NewSimpleProber(healthCheckPath))),
+			)
+		}
+		if !conf.insecure {
+			tlsc := &tls.Config{}
+			if conf.tlsConfig != nil {
+				tlsc = conf.tlsConfig.Clone()
+			}
+			tlsc.MinVersion = tls.VersionTLS13
+			lbOpts = append(lbOpts, httplb.WithTLSConfig(tlsc, conf.connectTimeout))
+		} else if strings.HasPrefix(addr, "http:") {
+			// switch to H2C if TLS is disabled since we're using gRPC over Connect
+			addr = "h2c" + addr[4:]
+		}
+		if conf.idleTimeout > 0 {
+			lbOpts = append(lbOpts, httplb.WithIdleConnectionTimeout(conf.idleTimeout))
+		}
+		if conf.connectTimeout > 0 {
+			dialer := net.Dialer{Timeout: conf.connectTimeout, KeepAlive: 30 * time.Second}
+			lbOpts = append(lbOpts, httplb.WithDialer(dialer.DialContext))
+		}
+		if conf.pingInterval > 0 || conf.maxStreams > 0 {
+			t := tunedTransport{pingInterval: conf.pingInterval, maxStreams: conf.maxStreams}
+			lbOpts = append(lbOpts, httplb.WithTransport("https", t), httplb.WithTransport("h2c", t))
+		}
+		c.lb = httplb.NewClient(lbOpts...)
+		hc = c.lb
+	}
+
+	// we include WithGRPC() so that the client can hit an existing gRPC-based server instance
+	// - this may be removed at some point in the future
+	copts := []connect.ClientOption{connect.WithGRPC()}
+	if conf.apiKey != "" {
+		copts = append(copts, connect.WithInterceptors(apiKeyInterceptor(conf.apiKey)))
+	}
+	switch conf.compression {
+	case CompressionGzip:
+		copts = append(copts, connect.WithSendGzip(), connect.WithCompressMinBytes(compress.MinBytes))
+	case CompressionZstd:
+		copts = append(copts,
+			connect.WithAcceptCompression(compress.Zstd, compress.NewZstdDecompressor, compress.NewZstdCompressor),
+			connect.WithSendCompression(compress.Zstd),
+			connect.WithCompressMinBytes(compress.MinBytes),
+		)
+	case CompressionNone:
+		// Connect clients accept gzip responses by default
+		copts = append(copts, connect.WithAcceptCompression(compress.Gzip, nil, nil))
+	}
+	retry := DefaultRetryPolicy
+	if conf.retry != nil {
+		retry = *conf.retry
+	}
+	copts = append(copts, connect.WithInterceptors(retryInterceptor(retry)))
+	copts = append(copts, conf.connectOpts...)
+	c.api = perseusapiconnect.NewPerseusServiceClient(hc, addr, copts...)
+	return &c, nil
+}
+
+// API returns the underlying Connect client, which can be used to call any RPC.  Unary calls are retried
+// according to the client's retry policy.
+func (c *Client) API() perseusapiconnect.PerseusServiceClient {
+	return c.api
+}
+
+// Close releases the connections held by the client, unless it was created using [WithHTTPClient].
+func (c *Client) Close() error {
+	if c.lb == nil {
+		return nil
+	}
+	return c.lb.Close()
+}
+
+// apiKeyInterceptor is a [connect.Interceptor] that adds the API key to the Authorization header of
+// every request
+type apiKeyInterceptor string
+
+// WrapUnary satisfies the [connect.Interceptor] interface and handles unary RPCs.
+func (key apiKeyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		req.Header().Set("Authorization", "Bearer "+string(key))
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient satisfies the [connect.Interceptor] interface and handles streaming RPCs.
+func (key apiKeyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set("Authorization", "Bearer "+string(key))
+		return conn
+	}
+}
+
+// WrapStreamingHandler satisfies the [connect.Interceptor] interface.  This is a no-op because the
+// interceptor is only used client-side.
+func (apiKeyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
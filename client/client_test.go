@@ -0,0 +1,459 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+// fakeServer is a Perseus API handler that serves a fixed graph, 2 results per page
+type fakeServer struct {
+	perseusapiconnect.UnimplementedPerseusServiceHandler
+
+	// the dependencies of each module version
+	deps map[string][]string
+	// the number of times that the first page of the dependencies of each module version was requested
+	queries sync.Map
+	// the number of ListModules calls that fail with CodeUnavailable before one succeeds
+	unavailable atomic.Int32
+	// the Authorization header of the most recent request
+	auth atomic.Value
+	// the response to GetServerInfo, which is unimplemented if nil
+	info *perseusapi.GetServerInfoResponse
+	// the number of GetServerInfo calls
+	infoCalls atomic.Int32
+	// the idempotency key of each UpdateDependencies request, the first len(keys)-1 of which fail with
+	// CodeUnavailable
+	keysMu sync.Mutex
+	keys   []string
+}
+
+func (f *fakeServer) ListModules(_ context.Context, req *connect.Request[perseusapi.ListModulesRequest]) (*connect.Response[perseusapi.ListModulesResponse], error) {
+	f.auth.Store(req.Header().Get("Authorization"))
+	if f.unavailable.Add(-1) >= 0 {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("try again"))
+	}
+	names := []string{"example.com/a", "example.com/b", "example.com/c"}
+	start, _ := strconv.Atoi(req.Msg.GetPageToken())
+	end := min(start+2, len(names))
+	resp := perseusapi.ListModulesResponse{}
+	for _, n := range names[start:end] {
+		resp.Modules = append(resp.Modules, &perseusapi.Module{Name: n, Versions: []string{"v1.0.0"}})
+	}
+	if end < len(names) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+func (f *fakeServer) QueryDependencies(_ context.Context, req *connect.Request[perseusapi.QueryDependenciesRequest]) (*connect.Response[perseusapi.QueryDependenciesResponse], error) {
+	key := req.Msg.GetModuleName() + "@" + req.Msg.GetVersion()
+	deps := f.deps[key]
+	if req.Msg.GetPageToken() == "" {
+		n, _ := f.queries.LoadOrStore(key, new(atomic.Int32))
+		n.(*atomic.Int32).Add(1)
+	}
+	start, _ := strconv.Atoi(req.Msg.GetPageToken())
+	end := min(start+2, len(deps))
+	resp := perseusapi.QueryDependenciesResponse{}
+	for _, d := range deps[start:end] {
+		path, version, _ := strings.Cut(d, "@")
+		resp.Modules = append(resp.Modules, &perseusapi.Module{Name: path, Versions: []string{version}})
+	}
+	if end < len(deps) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+func (f *fakeServer) ListModuleVersions(_ context.Context, req *connect.Request[perseusapi.ListModuleVersionsRequest]) (*connect.Response[perseusapi.ListModuleVersionsResponse], error) {
+	// v1.0.0 of each module was added on January 1st, 2024 and v1.1.0 on June 1st, 2024
+	added := map[string]time.Time{
+		"v1.1.0": time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		"v1.0.0": time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	names := []string{"example.com/a", "example.com/b", "example.com/c"}
+	start, _ := strconv.Atoi(req.Msg.GetPageToken())
+	end := min(start+2, len(names))
+	resp := perseusapi.ListModuleVersionsResponse{}
+	for _, n := range names[start:end] {
+		mod := &perseusapi.Module{Name: n}
+		for _, v := range []string{"v1.1.0", "v1.0.0"} {
+			if since := req.Msg.GetSince(); since != nil && added[v].Before(since.AsTime()) {
+				continue
+			}
+			mod.Versions = append(mod.Versions, v)
+			mod.VersionTimes = append(mod.VersionTimes, timestamppb.New(added[v]))
+		}
+		resp.Modules = append(resp.Modules, mod)
+	}
+	if end < len(names) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+func (f *fakeServer) GetServerInfo(ctx context.Context, req *connect.Request[perseusapi.GetServerInfoRequest]) (*connect.Response[perseusapi.GetServerInfoResponse], error) {
+	f.infoCalls.Add(1)
+	if f.info == nil {
+		return f.UnimplementedPerseusServiceHandler.GetServerInfo(ctx, req)
+	}
+	return connect.NewResponse(f.info), nil
+}
+
+func (f *fakeServer) UpdateDependencies(_ context.Context, req *connect.Request[perseusapi.UpdateDependenciesRequest]) (*connect.Response[perseusapi.UpdateDependenciesResponse], error) {
+	f.keysMu.Lock()
+	defer f.keysMu.Unlock()
+	f.keys = append(f.keys, req.Header().Get(IdempotencyKeyHeader))
+	if f.unavailable.Add(-1) >= 0 {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("try again"))
+	}
+	return connect.NewResponse(&perseusapi.UpdateDependenciesResponse{}), nil
+}
+
+// newTestClient starts an HTTP/2 server for f and returns a Client that calls it
+func newTestClient(t *testing.T, f *fakeServer, opts ...Option) *Client {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(perseusapiconnect.NewPerseusServiceHandler(f))
+	srv := httptest.NewUnstartedServer(mux)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	c, err := New(srv.URL, append([]Option{WithHTTPClient(srv.Client())}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestNew(t *testing.T) {
+	_, err := New("")
+	assert.Error(t, err, "the address is required")
+	_, err = New("https://perseus.example.com", WithAPIKey(""))
+	assert.Error(t, err, "the API key must not be empty")
+
+	c, err := New("http://localhost:31138", WithInsecure())
+	if assert.NoError(t, err) {
+		assert.NotNil(t, c.API())
+		assert.NoError(t, c.Close())
+	}
+}
+
+func TestTLSConfig(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(perseusapiconnect.NewPerseusServiceHandler(&fakeServer{}))
+	srv := httptest.NewUnstartedServer(mux)
+	srv.EnableHTTP2 = true
+	srv.TLS = &tls.Config{MinVersion: tls.VersionTLS13}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	list := func(opts ...Option) error {
+		c, err := New(srv.URL, append([]Option{WithRetryPolicy(RetryPolicy{MaxAttempts: 1})}, opts...)...)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = c.Close() }()
+		_, err = c.ListModules(context.Background(), "*").Collect()
+		return err
+	}
+	assert.Error(t, list(), "the test server's certificate should not be trusted by default")
+	roots := x509.NewCertPool()
+	roots.AddCert(srv.Certificate())
+	assert.NoError(t, list(WithTLSConfig(&tls.Config{RootCAs: roots})), "the test server's certificate should be trusted")
+
+	_, err := New(srv.URL, WithInsecure(), WithTLSConfig(&tls.Config{RootCAs: roots}))
+	assert.Error(t, err, "TLS settings can't be used with an insecure connection")
+}
+
+func TestListModules(t *testing.T) {
+	f := &fakeServer{}
+	f.unavailable.Store(2)
+	c := newTestClient(t, f, WithAPIKey("secret"))
+
+	mods, err := c.ListModules(context.Background(), "*").Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, m := range mods {
+		names = append(names, m.GetName())
+	}
+	assert.Equal(t, []string{"example.com/a", "example.com/b", "example.com/c"}, names, "should read every page, retrying Unavailable errors")
+	assert.Equal(t, "Bearer secret", f.auth.Load())
+}
+
+func TestListVersionTimes(t *testing.T) {
+	c := newTestClient(t, &fakeServer{})
+
+	since := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	got, err := c.ListVersionTimes(context.Background(), ModuleVersionQuery{ModuleFilter: "*", Since: since}).Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	june := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	want := []VersionTime{
+		{Version: module.Version{Path: "example.com/a", Version: "v1.1.0"}, Added: june},
+		{Version: module.Version{Path: "example.com/b", Version: "v1.1.0"}, Added: june},
+		{Version: module.Version{Path: "example.com/c", Version: "v1.1.0"}, Added: june},
+	}
+	assert.Equal(t, want, got, "should read every page and only return the versions added since March")
+
+	vers, err := c.ListModuleVersions(context.Background(), ModuleVersionQuery{ModuleFilter: "*"}).Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, vers, 6)
+}
+
+func TestWalkDependencies(t *testing.T) {
+	f := &fakeServer{
+		deps: map[string][]string{
+			"example.com/a@v1.0.0": {"example.com/b@v1.1.0", "example.com/c@v1.2.0", "example.com/d@v1.3.0"},
+			"example.com/b@v1.1.0": {"example.com/d@v1.3.0"},
+			"example.com/d@v1.3.0": {"example.com/e@v0.1.0"},
+		},
+	}
+	c := newTestClient(t, f)
+
+	var steps int
+	tree, err := c.WalkDependencies(context.Background(), module.Version{Path: "example.com/a", Version: "v1.0.0"}, WalkOptions{
+		Direction: perseusapi.DependencyDirection_dependencies,
+		MaxDepth:  2,
+		Status:    func(string) { steps++ },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "example.com/a@v1.0.0", tree.Module.String())
+	assert.Nil(t, tree.Info)
+	if assert.Len(t, tree.Deps, 3, "should read every page of direct dependencies") {
+		assert.Equal(t, "example.com/b@v1.1.0", tree.Deps[0].Module.String())
+		assert.Equal(t, "example.com/b", tree.Deps[0].Info.GetName())
+		if assert.Len(t, tree.Deps[0].Deps, 1) {
+			assert.Equal(t, "example.com/d@v1.3.0", tree.Deps[0].Deps[0].Module.String())
+			assert.Empty(t, tree.Deps[0].Deps[0].Deps, "should stop at the maximum depth")
+		}
+	}
+	assert.Equal(t, 4, steps)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.WalkDependencies(ctx, module.Version{Path: "example.com/a", Version: "v1.0.0"}, WalkOptions{MaxDepth: 2})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWalkDependenciesDeduplicates(t *testing.T) {
+	// d, and therefore e, are reachable from a, b, and c
+	f := &fakeServer{
+		deps: map[string][]string{
+			"example.com/a@v1.0.0": {"example.com/b@v1.1.0", "example.com/c@v1.2.0", "example.com/d@v1.3.0"},
+			"example.com/b@v1.1.0": {"example.com/d@v1.3.0"},
+			"example.com/c@v1.2.0": {"example.com/b@v1.1.0", "example.com/d@v1.3.0"},
+			"example.com/d@v1.3.0": {"example.com/e@v0.1.0"},
+		},
+	}
+	c := newTestClient(t, f)
+
+	tree, err := c.WalkDependencies(context.Background(), module.Version{Path: "example.com/a", Version: "v1.0.0"}, WalkOptions{
+		Direction:   perseusapi.DependencyDirection_dependencies,
+		MaxDepth:    5,
+		Concurrency: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	var visit func(prefix string, n DependencyNode)
+	visit = func(prefix string, n DependencyNode) {
+		prefix += "/" + strings.TrimPrefix(n.Module.Path, "example.com/")
+		paths = append(paths, prefix)
+		for _, d := range n.Deps {
+			visit(prefix, d)
+		}
+	}
+	visit("", tree)
+	assert.Equal(t, []string{
+		"/a", "/a/b", "/a/b/d", "/a/b/d/e",
+		"/a/c", "/a/c/b", "/a/c/b/d", "/a/c/b/d/e", "/a/c/d", "/a/c/d/e",
+		"/a/d", "/a/d/e",
+	}, paths, "each module should appear once per path, in order")
+
+	f.queries.Range(func(key, n any) bool {
+		assert.Equal(t, int32(1), n.(*atomic.Int32).Load(), "the dependencies of %s should only be queried once", key)
+		return true
+	})
+}
+
+func TestWalkDependenciesUnlimited(t *testing.T) {
+	// a -> b -> c -> a is a cycle
+	f := &fakeServer{
+		deps: map[string][]string{
+			"example.com/a@v1.0.0": {"example.com/b@v1.0.0"},
+			"example.com/b@v1.0.0": {"example.com/c@v1.0.0"},
+			"example.com/c@v1.0.0": {"example.com/a@v1.0.0", "example.com/d@v1.0.0"},
+		},
+	}
+	c := newTestClient(t, f)
+	root := module.Version{Path: "example.com/a", Version: "v1.0.0"}
+
+	tree, err := c.WalkDependencies(context.Background(), root, WalkOptions{MaxDepth: UnlimitedDepth})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var leaves []string
+	for n := tree; len(n.Deps) > 0; n = n.Deps[0] {
+		if len(n.Deps) > 1 {
+			leaves = append(leaves, n.Deps[1].Module.String())
+		}
+		if len(n.Deps[0].Deps) == 0 {
+			leaves = append(leaves, n.Deps[0].Module.String())
+		}
+	}
+	assert.Equal(t, []string{"example.com/d@v1.0.0", "example.com/a@v1.0.0"}, leaves, "the cycle back to a should end the walk")
+
+	_, err = c.WalkDependencies(context.Background(), root, WalkOptions{MaxDepth: UnlimitedDepth, MaxNodes: 3})
+	assert.ErrorIs(t, err, ErrTreeTooLarge)
+}
+
+func TestRetry(t *testing.T) {
+	var calls int
+	_, err := Retry(context.Background(), func() (struct{}, error) {
+		calls++
+		return struct{}{}, connect.NewError(connect.CodeInvalidArgument, errors.New("bad request"))
+	})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Equal(t, 1, calls, "should not retry other errors")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls = 0
+	_, err = Retry(ctx, func() (struct{}, error) {
+		calls++
+		cancel()
+		return struct{}{}, connect.NewError(connect.CodeUnavailable, errors.New("down"))
+	})
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	assert.Equal(t, 1, calls, "should stop retrying once the context is canceled")
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	f := &fakeServer{}
+	f.unavailable.Store(2)
+	c := newTestClient(t, f)
+
+	req := connect.NewRequest(&perseusapi.UpdateDependenciesRequest{ModuleName: "example.com/a", Version: "v1.0.0"})
+	if _, err := c.API().UpdateDependencies(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, f.keys, 3) {
+		assert.NotEmpty(t, f.keys[0])
+		assert.Equal(t, []string{f.keys[0], f.keys[0], f.keys[0]}, f.keys, "every attempt should send the same key")
+	}
+
+	f.keys = nil
+	req = connect.NewRequest(&perseusapi.UpdateDependenciesRequest{ModuleName: "example.com/a", Version: "v1.0.0"})
+	req.Header().Set(IdempotencyKeyHeader, "ci-build-42")
+	if _, err := c.API().UpdateDependencies(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"ci-build-42"}, f.keys, "should keep the caller's key")
+}
+
+func TestRetryPolicy(t *testing.T) {
+	_, err := New("https://perseus.example.com", WithRetryPolicy(RetryPolicy{MaxAttempts: 0}))
+	assert.Error(t, err, "at least 1 attempt is required")
+	_, err = New("https://perseus.example.com", WithRetryPolicy(RetryPolicy{MaxAttempts: 1, BaseDelay: -time.Second}))
+	assert.Error(t, err, "the delay must not be negative")
+
+	f := &fakeServer{}
+	f.unavailable.Store(2)
+	c := newTestClient(t, f, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, RetryableCodes: []connect.Code{connect.CodeUnavailable}}))
+	_, err = c.ListModules(context.Background(), "*").Collect()
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err), "should stop after the maximum number of attempts")
+
+	f.unavailable.Store(1)
+	c = newTestClient(t, f, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, RetryableCodes: []connect.Code{connect.CodeInternal}}))
+	_, err = c.ListModules(context.Background(), "*").Collect()
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err), "should not retry codes that aren't in the policy")
+
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond}
+	for retry, want := range []time.Duration{100, 200, 300, 500, 800} {
+		got := p.delay(retry + 1)
+		want *= time.Millisecond
+		assert.True(t, got >= want && got <= want+want/5, "retry %d should wait %s plus up to 20%% jitter, got %s", retry+1, want, got)
+	}
+}
+
+func TestIteratorAll(t *testing.T) {
+	f := &fakeServer{}
+	c := newTestClient(t, f)
+
+	var names []string
+	for m, err := range c.ListModules(context.Background(), "*").All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, m.GetName())
+		if len(names) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []string{"example.com/a", "example.com/b"}, names, "should stop when the loop exits")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var errs []error
+	for m, err := range c.ListModules(ctx, "*").All() {
+		assert.Nil(t, m)
+		errs = append(errs, err)
+	}
+	if assert.Len(t, errs, 1, "should yield the error once") {
+		assert.ErrorIs(t, errs[0], context.Canceled)
+	}
+}
+
+func TestServerInfo(t *testing.T) {
+	f := &fakeServer{}
+	c := newTestClient(t, f)
+	_, err := c.ServerInfo(context.Background())
+	assert.ErrorIs(t, err, ErrNoServerInfo, "should report that an older server has no version info")
+
+	f = &fakeServer{info: &perseusapi.GetServerInfoResponse{
+		Version:       "v1.2.3",
+		SchemaVersion: 11,
+		Features:      []string{FeatureStreamEdges, FeatureRenderGraph},
+	}}
+	c = newTestClient(t, f)
+	for range 2 {
+		info, err := c.ServerInfo(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "v1.2.3", info.Version)
+		assert.Equal(t, 11, info.SchemaVersion)
+		assert.True(t, info.Supports(FeatureRenderGraph))
+		assert.False(t, info.Supports(FeatureCompressionZstd))
+	}
+	assert.Equal(t, int32(1), f.infoCalls.Load(), "the server info should only be retrieved once")
+}
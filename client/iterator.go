@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+// pageFunc retrieves the page of results identified by pageToken, which is empty for the first page, and
+// returns the results along with the token for the next page, which is empty if there are no more.
+type pageFunc[T any] func(ctx context.Context, pageToken string) ([]T, string, error)
+
+// Iterator steps through the results of a paged API, retrieving each page from the server as it is needed.
+// An Iterator is not safe for concurrent use.
+//
+// The results can be read using a range loop over [Iterator.All]:
+//
+//	for v, err := range it.All() {
+//		if err != nil {
+//			...
+//		}
+//		...
+//	}
+//
+// or by calling Next until it returns false:
+//
+//	for it.Next() {
+//		v := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx   context.Context
+	fetch pageFunc[T]
+
+	page      []T
+	cur       T
+	pageToken string
+	fetched   bool
+	err       error
+}
+
+// newIterator returns an Iterator that retrieves each page of results using fetch
+func newIterator[T any](ctx context.Context, fetch pageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{ctx: ctx, fetch: fetch}
+}
+
+// Next advances the iterator to the next result, which is then available from Value, retrieving the next
+// page of results if necessary.  It returns false when there are no more results or an error occurs.
+func (it *Iterator[T]) Next() bool {
+	for len(it.page) == 0 {
+		// stop after the last page, or if the server returns an empty page so that a server that always
+		// returns a page token can't cause an infinite loop
+		if it.err != nil || (it.fetched && it.pageToken == "") {
+			return false
+		}
+		page, token, err := it.fetch(it.ctx, it.pageToken)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.pageToken, it.fetched = page, token, true
+		if len(page) == 0 {
+			it.pageToken = ""
+		}
+	}
+	it.cur, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Value returns the current result
+func (it *Iterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the error, if any, that stopped the iteration
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All returns an iterator over the remaining results for use with a range loop.  If retrieving a page
+// fails, the error is yielded along with the zero value of T as the final pair.  Breaking out of the loop
+// stops the iteration without retrieving any more pages.
+func (it *Iterator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next() {
+			if !yield(it.Value(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// Collect reads all of the remaining results into a slice
+func (it *Iterator[T]) Collect() ([]T, error) {
+	var results []T
+	for v, err := range it.All() {
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, v)
+	}
+	return results, nil
+}
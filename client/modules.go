@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/mod/module"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// ErrNoVersions is returned, possibly wrapped, when a module has no known versions
+var ErrNoVersions = errors.New("no version found")
+
+// ListModules returns an iterator over the modules whose names match filter, which is a glob pattern if it
+// contains any wildcards and a substring otherwise.  Each module includes its highest known version, if any.
+func (c *Client) ListModules(ctx context.Context, filter string) *Iterator[*perseusapi.Module] {
+	return newIterator(ctx, func(ctx context.Context, pageToken string) ([]*perseusapi.Module, string, error) {
+		req := connect.NewRequest(&perseusapi.ListModulesRequest{
+			Filter:    filter,
+			PageToken: pageToken,
+		})
+		resp, err := c.api.ListModules(ctx, req)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Msg.GetModules(), resp.Msg.GetNextPageToken(), nil
+	})
+}
+
+// ModuleVersionQuery specifies which module versions are returned by [Client.ListModuleVersions].
+type ModuleVersionQuery struct {
+	// a glob pattern specifying which module(s) should be returned
+	ModuleFilter string
+	// if true, the versions of every major version of the matching module(s) are returned, ex:
+	// example.com/foo/v2 for example.com/foo
+	AllMajors bool
+	// an optional glob pattern, ex: v1.*, or range of semantic versions, ex: ">=v1.4.0 <v2.0.0", ~v1.4, or ^v2,
+	// specifying which version(s) should be returned
+	VersionFilter string
+	// if true, pre-release versions are also returned
+	IncludePrerelease bool
+	// if true, pseudo-versions are not returned or considered when LatestOnly is also true
+	ExcludePseudo bool
+	// if true, only the highest matching version of each module is returned
+	LatestOnly bool
+	// if not the zero time, only the versions that were known at this time are returned
+	AsOf time.Time
+	// if not the zero time, only the versions that were added at or after this time are returned
+	Since time.Time
+	// if true, the versions of each module are returned from most to least recently added rather than from
+	// highest to lowest semantic version
+	SortByDate bool
+	// if non-zero, only the first Limit versions of each module are returned, ex: the 5 most recent
+	// releases when SortByDate is also true.  It is ignored when LatestOnly is true.
+	Limit int
+}
+
+// ListModuleVersions returns an iterator over the module versions that match query, with 1 result per
+// module/version pair.
+func (c *Client) ListModuleVersions(ctx context.Context, query ModuleVersionQuery) *Iterator[module.Version] {
+	return listModuleVersions(ctx, c, query, func(mod *perseusapi.Module, i int) module.Version {
+		return module.Version{Path: mod.GetName(), Version: mod.GetVersions()[i]}
+	})
+}
+
+// A VersionTime is a module version along with the time that it was added to the graph
+type VersionTime struct {
+	module.Version
+	// the time that the version was added, which is the zero time when only the latest versions are queried
+	Added time.Time
+}
+
+// ListVersionTimes is the same as [Client.ListModuleVersions] but each result also includes the time that
+// the version was added to the graph, ex: to report the versions added in the last week by setting
+// query.Since.
+func (c *Client) ListVersionTimes(ctx context.Context, query ModuleVersionQuery) *Iterator[VersionTime] {
+	return listModuleVersions(ctx, c, query, func(mod *perseusapi.Module, i int) VersionTime {
+		vt := VersionTime{Version: module.Version{Path: mod.GetName(), Version: mod.GetVersions()[i]}}
+		if times := mod.GetVersionTimes(); i < len(times) {
+			vt.Added = times[i].AsTime()
+		}
+		return vt
+	})
+}
+
+// listModuleVersions returns an iterator over the module versions that match query, using result to
+// convert the i-th version of each module in the API response.
+func listModuleVersions[T any](ctx context.Context, c *Client, query ModuleVersionQuery, result func(mod *perseusapi.Module, i int) T) *Iterator[T] {
+	return newIterator(ctx, func(ctx context.Context, pageToken string) ([]T, string, error) {
+		req := connect.NewRequest(&perseusapi.ListModuleVersionsRequest{
+			ModuleFilter:      query.ModuleFilter,
+			AllMajors:         query.AllMajors,
+			VersionFilter:     query.VersionFilter,
+			IncludePrerelease: query.IncludePrerelease,
+			ExcludePseudo:     query.ExcludePseudo,
+			VersionOption:     perseusapi.ModuleVersionOption_all,
+			AsOf:              timestampOrNil(query.AsOf),
+			Since:             timestampOrNil(query.Since),
+			Limit:             int32(query.Limit), //nolint: gosec // the server rejects limits above 1000
+			PageToken:         pageToken,
+		})
+		if query.SortByDate {
+			req.Msg.Sort = perseusapi.ModuleVersionSort_date
+		}
+		if query.LatestOnly {
+			req.Msg.VersionOption = perseusapi.ModuleVersionOption_latest
+			req.Msg.Limit = 0
+		}
+		resp, err := c.api.ListModuleVersions(ctx, req)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// API response is 1 result per module with a list of versions
+		// - flatten to 1 result per module/version pair
+		var results []T
+		for _, mod := range resp.Msg.GetModules() {
+			for i := range mod.GetVersions() {
+				results = append(results, result(mod, i))
+			}
+		}
+		nextPageToken := resp.Msg.GetNextPageToken()
+		if query.LatestOnly {
+			// the API only supports paging through all versions
+			nextPageToken = ""
+		}
+		return results, nextPageToken, nil
+	})
+}
+
+// LatestVersion returns the highest known semantic version of the module at path.  If asOf is not the zero
+// time, the result is the highest version that was known at that time.
+func (c *Client) LatestVersion(ctx context.Context, path string, asOf time.Time) (string, error) {
+	req := connect.NewRequest(&perseusapi.ListModuleVersionsRequest{
+		ModuleName:    path,
+		VersionOption: perseusapi.ModuleVersionOption_latest,
+		AsOf:          timestampOrNil(asOf),
+	})
+	resp, err := c.api.ListModuleVersions(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	mods := resp.Msg.GetModules()
+	if len(mods) == 0 || len(mods[0].GetVersions()) == 0 {
+		return "", fmt.Errorf("%w for module %s", ErrNoVersions, path)
+	}
+	return mods[0].GetVersions()[0], nil
+}
+
+// LatestFamilyVersions returns the highest known semantic version of every module in the same family as the
+// module at path, which are the other major versions of the same project, ordered by module path.  If asOf
+// is not the zero time, the results are the highest versions that were known at that time.
+func (c *Client) LatestFamilyVersions(ctx context.Context, path string, asOf time.Time) ([]module.Version, error) {
+	req := connect.NewRequest(&perseusapi.ListModuleVersionsRequest{
+		ModuleName:    path,
+		AllMajors:     true,
+		VersionOption: perseusapi.ModuleVersionOption_latest,
+		AsOf:          timestampOrNil(asOf),
+	})
+	resp, err := c.api.ListModuleVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var mods []module.Version
+	for _, m := range resp.Msg.GetModules() {
+		if len(m.GetVersions()) > 0 {
+			mods = append(mods, module.Version{Path: m.GetName(), Version: m.GetVersions()[0]})
+		}
+	}
+	if len(mods) == 0 {
+		return nil, fmt.Errorf("%w for module %s", ErrNoVersions, path)
+	}
+	return mods, nil
+}
+
+// timestampOrNil returns t as a Protobuf timestamp, or nil if t is the zero time so that the server
+// returns the current state of the graph
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
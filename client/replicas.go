@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/httplb/resolver"
+)
+
+const (
+	// the scheme of a server address that names a DNS SRV record listing the server's replicas,
+	// ex: srv://_perseus._tcp.example.com
+	srvScheme = "srv"
+	// how often the addresses of the replicas are resolved again so that the client follows changes to
+	// the deployment
+	replicaResolveInterval = time.Minute
+	// the path of the server's health check endpoint, which is polled to stop sending requests to
+	// replicas that are down
+	healthCheckPath = "/healthz"
+)
+
+// parseServerAddress parses addr, which is either the URL of a single server, a comma-separated list of
+// URLs of replicas of the server, or a srv:// URL that names a DNS SRV record, and returns the base URL for
+// the Connect client.  For multiple replicas, it also returns a prober that resolves the replicas'
+// addresses so that requests can be balanced across them.  All of the replicas must present a TLS
+// certificate that is valid for the host name in the returned URL.
+func parseServerAddress(addr string, insecure bool) (string, resolver.ResolveProber, error) {
+	if strings.HasPrefix(addr, srvScheme+"://") {
+		name := strings.TrimPrefix(addr, srvScheme+"://")
+		if name == "" || strings.ContainsAny(name, "/:") {
+			return "", nil, fmt.Errorf("invalid SRV server address %q, must be of the form srv://_service._proto.name", addr)
+		}
+		scheme := "https"
+		if insecure {
+			scheme = "http"
+		}
+		return scheme + "://" + srvDomain(name), replicaProber{srvName: name}, nil
+	}
+
+	addrs := strings.Split(addr, ",")
+	if len(addrs) == 1 {
+		return addr, nil, nil
+	}
+	var (
+		scheme    string
+		hostPorts []string
+	)
+	for i, a := range addrs {
+		a = strings.TrimSpace(a)
+		u, err := url.Parse(a)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "", nil, fmt.Errorf("invalid server address %q, each address in a list must be a URL, ex: https://perseus-1.example.com", a)
+		}
+		if i == 0 {
+			scheme = u.Scheme
+		} else if u.Scheme != scheme {
+			return "", nil, fmt.Errorf("invalid server address %q, all addresses in a list must use the same scheme", a)
+		}
+		port := u.Port()
+		if port == "" {
+			port = "443"
+			if u.Scheme == "http" {
+				port = "80"
+			}
+		}
+		hostPorts = append(hostPorts, net.JoinHostPort(u.Hostname(), port))
+	}
+	return strings.TrimSpace(addrs[0]), replicaProber{hostPorts: hostPorts}, nil
+}
+
+// srvDomain returns the domain that a DNS SRV record belongs to, which is name without the leading
+// service and protocol labels, ex: example.com for _perseus._tcp.example.com
+func srvDomain(name string) string {
+	for strings.HasPrefix(name, "_") {
+		_, rest, ok := strings.Cut(name, ".")
+		if !ok {
+			break
+		}
+		name = rest
+	}
+	return strings.TrimSuffix(name, ".")
+}
+
+// replicaProber is a [resolver.ResolveProber] that resolves the IP addresses of each replica of the
+// Perseus server, which are either a fixed list of host names or the targets of a DNS SRV record.
+type replicaProber struct {
+	// the host:port of each replica
+	hostPorts []string
+	// the name of the DNS SRV record that lists the replicas, which takes precedence over hostPorts
+	srvName string
+}
+
+// ResolveOnce satisfies the [resolver.ResolveProber] interface and returns the IP address and port of each
+// replica.  Replicas that can't be resolved are skipped, and an error is only returned if none can be.
+func (p replicaProber) ResolveOnce(ctx context.Context, _, _ string) ([]resolver.Address, time.Duration, error) {
+	hostPorts := p.hostPorts
+	if p.srvName != "" {
+		_, srvs, err := net.DefaultResolver.LookupSRV(ctx, "", "", p.srvName)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to look up the SRV record %q: %w", p.srvName, err)
+		}
+		hostPorts = nil
+		for _, srv := range srvs {
+			hostPorts = append(hostPorts, net.JoinHostPort(strings.TrimSuffix(srv.Target, "."), strconv.Itoa(int(srv.Port))))
+		}
+	}
+
+	var (
+		results []resolver.Address
+		errs    []error
+	)
+	for _, hp := range hostPorts {
+		host, port, err := net.SplitHostPort(hp)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ips, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, ip := range ips {
+			results = append(results, resolver.Address{HostPort: net.JoinHostPort(ip.Unmap().String(), port)})
+		}
+	}
+	if len(results) == 0 {
+		if len(errs) == 0 {
+			return nil, 0, fmt.Errorf("no Perseus server replicas were found")
+		}
+		return nil, 0, errors.Join(errs...)
+	}
+	return results, 0, nil
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseServerAddress(t *testing.T) {
+	testCases := []struct {
+		name      string
+		addr      string
+		insecure  bool
+		target    string
+		hostPorts []string
+		srvName   string
+		expectErr bool
+	}{
+		{
+			name:   "single address",
+			addr:   "https://perseus.example.com",
+			target: "https://perseus.example.com",
+		},
+		{
+			name:      "list of replicas",
+			addr:      "https://perseus-1.example.com, https://perseus-2.example.com:8443",
+			target:    "https://perseus-1.example.com",
+			hostPorts: []string{"perseus-1.example.com:443", "perseus-2.example.com:8443"},
+		},
+		{
+			name:      "insecure list of replicas",
+			addr:      "http://10.0.0.1,http://10.0.0.2:31138",
+			insecure:  true,
+			target:    "http://10.0.0.1",
+			hostPorts: []string{"10.0.0.1:80", "10.0.0.2:31138"},
+		},
+		{
+			name:      "mixed schemes",
+			addr:      "https://perseus-1.example.com,http://perseus-2.example.com",
+			expectErr: true,
+		},
+		{
+			name:      "missing scheme",
+			addr:      "https://perseus-1.example.com,perseus-2.example.com",
+			expectErr: true,
+		},
+		{
+			name:    "SRV record",
+			addr:    "srv://_perseus._tcp.example.com",
+			target:  "https://example.com",
+			srvName: "_perseus._tcp.example.com",
+		},
+		{
+			name:     "insecure SRV record",
+			addr:     "srv://_perseus._tcp.example.com",
+			insecure: true,
+			target:   "http://example.com",
+			srvName:  "_perseus._tcp.example.com",
+		},
+		{
+			name:      "invalid SRV record",
+			addr:      "srv://example.com:443",
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			target, prober, err := parseServerAddress(tc.addr, tc.insecure)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.target, target)
+			if tc.hostPorts == nil && tc.srvName == "" {
+				assert.Nil(t, prober)
+				return
+			}
+			assert.Equal(t, replicaProber{hostPorts: tc.hostPorts, srvName: tc.srvName}, prober)
+		})
+	}
+}
+
+func TestReplicaProber(t *testing.T) {
+	p := replicaProber{hostPorts: []string{"10.0.0.1:443", "[::1]:8443", "invalid"}}
+	addrs, _, err := p.ResolveOnce(context.Background(), "https", "perseus.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hostPorts []string
+	for _, a := range addrs {
+		hostPorts = append(hostPorts, a.HostPort)
+	}
+	assert.Equal(t, []string{"10.0.0.1:443", "[::1]:8443"}, hostPorts, "should skip replicas that can't be resolved")
+
+	p = replicaProber{hostPorts: []string{"invalid"}}
+	_, _, err = p.ResolveOnce(context.Background(), "https", "perseus.example.com")
+	assert.Error(t, err, "should fail if no replicas can be resolved")
+}
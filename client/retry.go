@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/CrowdStrike/perseus/internal/headers"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+// IdempotencyKeyHeader is the HTTP header that carries the idempotency key of a write request.  Servers
+// that report [FeatureIdempotencyKeys] return the original response to retries of a request with the same
+// key rather than processing it again.
+const IdempotencyKeyHeader = headers.IdempotencyKey
+
+// idempotentProcedures are the write RPCs that accept an idempotency key
+var idempotentProcedures = []string{
+	perseusapiconnect.PerseusServiceCreateModuleProcedure,
+	perseusapiconnect.PerseusServiceUpdateDependenciesProcedure,
+	perseusapiconnect.PerseusServiceBatchUpdateDependenciesProcedure,
+}
+
+// RetryPolicy controls how requests that fail with a transient error are retried
+type RetryPolicy struct {
+	// the maximum number of times a request is sent, including the first attempt, so 1 disables retries
+	MaxAttempts int
+	// the delay before the first retry.  Subsequent delays are multiples of BaseDelay that follow the
+	// Fibonacci sequence, ex: 1x, 2x, 3x, 5x, 8x, for semi-exponential growth, and each delay includes up
+	// to 20% jitter.
+	BaseDelay time.Duration
+	// the status codes of the errors that are retried, ex: [connect.CodeUnavailable]
+	RetryableCodes []connect.Code
+}
+
+// DefaultRetryPolicy is the policy used if none is specified, which retries requests that fail with an
+// Unavailable status up to 5 times to provide resiliency for transient failures due to LB flakiness
+// (especially within K8S).
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    6,
+	BaseDelay:      100 * time.Millisecond,
+	RetryableCodes: []connect.Code{connect.CodeUnavailable},
+}
+
+// validate returns an error if the policy settings are out of range
+func (p RetryPolicy) validate() error {
+	if p.MaxAttempts < 1 {
+		return fmt.Errorf("the maximum number of attempts must be at least 1, got %d", p.MaxAttempts)
+	}
+	if p.BaseDelay < 0 {
+		return fmt.Errorf("the base retry delay must not be negative, got %s", p.BaseDelay)
+	}
+	return nil
+}
+
+// retryable returns true if err has one of the status codes that the policy retries
+func (p RetryPolicy) retryable(err error) bool {
+	return slices.Contains(p.RetryableCodes, connect.CodeOf(err))
+}
+
+// delay returns the amount of time to wait before the specified retry, where 1 is the first retry,
+// including the jitter
+func (p RetryPolicy) delay(retry int) time.Duration {
+	// the multiples are 1, 2, 3, 5, 8, ...
+	a, b := time.Duration(1), time.Duration(2)
+	for range retry - 1 {
+		a, b = b, a+b
+	}
+	wait := p.BaseDelay * a
+	// inject up to 20% jitter
+	if maxJitter := int64(float64(wait) * 0.2); maxJitter > 0 {
+		jitter, _ := rand.Int(rand.Reader, big.NewInt(maxJitter))
+		wait += time.Duration(jitter.Int64())
+	}
+	return wait
+}
+
+// Retry performs the specified operation using [DefaultRetryPolicy].  RPCs made by a [Client] are already
+// retried according to its policy, so this is only needed for other operations.
+func Retry[T any](ctx context.Context, op func() (T, error)) (T, error) {
+	return RetryWithPolicy(ctx, DefaultRetryPolicy, op)
+}
+
+// RetryWithPolicy performs the specified operation, retrying it as specified by p if it fails with one of
+// the policy's retryable status codes.  Retries stop early if ctx is canceled or its deadline expires.
+func RetryWithPolicy[T any](ctx context.Context, p RetryPolicy, op func() (T, error)) (result T, err error) {
+	var zero T
+	for attempt := 1; ; attempt++ {
+		result, err = op()
+		switch {
+		case err == nil:
+			return result, nil
+		case attempt >= p.MaxAttempts || !p.retryable(err) || ctx.Err() != nil:
+			// a DeadlineExceeded error caused by ctx itself is not retried since every later attempt
+			// would fail the same way
+			return zero, err
+		}
+		select {
+		case <-ctx.Done():
+			return zero, err
+		case <-time.After(p.delay(attempt)):
+		}
+	}
+}
+
+// retryInterceptor is a [connect.Interceptor] that retries unary RPCs according to a [RetryPolicy].
+// Streaming RPCs are not retried since the messages that were already received can't be replayed.
+//
+// Write requests that accept an idempotency key are assigned a random one, unless the caller already set
+// the [IdempotencyKeyHeader] header, so that a retry of a request that the server did process, but whose
+// response was lost, is not processed twice.
+type retryInterceptor RetryPolicy
+
+// WrapUnary satisfies the [connect.Interceptor] interface and handles unary RPCs.
+func (ri retryInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if slices.Contains(idempotentProcedures, req.Spec().Procedure) && req.Header().Get(IdempotencyKeyHeader) == "" {
+			req.Header().Set(IdempotencyKeyHeader, uuid.NewString())
+		}
+		return RetryWithPolicy(ctx, RetryPolicy(ri), func() (connect.AnyResponse, error) {
+			return next(ctx, req)
+		})
+	}
+}
+
+// WrapStreamingClient satisfies the [connect.Interceptor] interface.  This is a no-op because streaming
+// RPCs are not retried.
+func (retryInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler satisfies the [connect.Interceptor] interface.  This is a no-op because the
+// interceptor is only used client-side.
+func (retryInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	"github.com/CrowdStrike/perseus/internal/features"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// the optional server features reported by [Client.ServerInfo]
+const (
+	// the StreamEdges RPC
+	FeatureStreamEdges = features.StreamEdges
+	// the RenderGraph RPC
+	FeatureRenderGraph = features.RenderGraph
+	// the SubmitIngestionJobs, GetIngestionJob, and ListIngestionJobs RPCs
+	FeatureIngestionJobs = features.IngestionJobs
+	// zstd compression, see [WithCompression]
+	FeatureCompressionZstd = features.CompressionZstd
+	// deps.dev enrichment of query results
+	FeatureEnrichment = features.Enrichment
+	// the ListVulnerabilities RPC
+	FeatureVulnerabilities = features.Vulnerabilities
+	// querying dependents by version range, see [DependencyQuery]
+	FeatureDependentsInRange = features.DependentsInRange
+	// the ResolveModuleSet RPC
+	FeatureResolveModuleSet = features.ResolveModuleSet
+	// the upgrades field of ResolveModuleSet requests
+	FeatureResolveUpgrades = features.ResolveUpgrades
+	// the ListGhostVersions RPC
+	FeatureGhostVersions = features.GhostVersions
+	// de-duplication of retried writes, see [IdempotencyKeyHeader]
+	FeatureIdempotencyKeys = features.IdempotencyKeys
+	// the validate_only field of UpdateDependencies requests
+	FeatureUpdateValidateOnly = features.UpdateValidateOnly
+	// the BatchUpdateDependencies RPC
+	FeatureBatchUpdates = features.BatchUpdates
+	// the GetStoreStats RPC
+	FeatureStoreStats = features.StoreStats
+)
+
+// ErrNoServerInfo is returned, possibly wrapped, by [Client.ServerInfo] when the server is a version that
+// predates the GetServerInfo RPC, so its version and features are unknown
+var ErrNoServerInfo = errors.New("the server does not report its version")
+
+// ServerInfo describes the version and capabilities of a Perseus server
+type ServerInfo struct {
+	// the version of the server, ex: v1.2.3
+	Version string
+	// the version of the database schema that the server requires
+	SchemaVersion int
+	// the optional features that the server supports, ex: [FeatureStreamEdges]
+	Features []string
+}
+
+// Supports returns true if the server supports the specified feature
+func (i ServerInfo) Supports(feature string) bool {
+	return slices.Contains(i.Features, feature)
+}
+
+// ServerInfo returns the version and optional features of the server.  The result is retrieved once and
+// reused by later calls.
+func (c *Client) ServerInfo(ctx context.Context) (ServerInfo, error) {
+	c.infoMu.Lock()
+	defer c.infoMu.Unlock()
+	if c.info != nil {
+		return *c.info, nil
+	}
+
+	resp, err := c.api.GetServerInfo(ctx, connect.NewRequest(&perseusapi.GetServerInfoRequest{}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeUnimplemented {
+			return ServerInfo{}, fmt.Errorf("%w: %w", ErrNoServerInfo, err)
+		}
+		return ServerInfo{}, err
+	}
+	c.info = &ServerInfo{
+		Version:       resp.Msg.GetVersion(),
+		SchemaVersion: int(resp.Msg.GetSchemaVersion()),
+		Features:      resp.Msg.GetFeatures(),
+	}
+	return *c.info, nil
+}
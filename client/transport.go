@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/bufbuild/httplb"
+	"golang.org/x/net/http2"
+)
+
+// tunedTransport is an [httplb.Transport] that creates the connections to the server with the HTTP/2
+// keepalive and concurrency settings specified by [WithPingInterval] and [WithMaxConcurrentStreams].
+// It is only used if one of those options is specified, otherwise the httplb defaults apply.
+type tunedTransport struct {
+	// how long a connection may go without receiving a frame before a PING frame is sent to check it,
+	// zero to disable health check pings
+	pingInterval time.Duration
+	// the maximum number of requests that may be in flight on each connection, zero for no limit
+	maxStreams int
+}
+
+// NewRoundTripper satisfies the [httplb.Transport] interface and returns a round tripper for requests to
+// the specified target.
+func (t tunedTransport) NewRoundTripper(scheme, _ string, opts httplb.TransportConfig) httplb.RoundTripperResult {
+	var result httplb.RoundTripperResult
+	switch scheme {
+	case "h2c":
+		tr := &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return opts.DialFunc(ctx, network, addr)
+			},
+			MaxHeaderListSize: uint32(opts.MaxResponseHeaderBytes), //nolint: gosec // limited to 1MB by httplb
+			IdleConnTimeout:   opts.IdleConnTimeout,
+			ReadIdleTimeout:   t.pingInterval,
+		}
+		result = httplb.RoundTripperResult{RoundTripper: tr, Scheme: "http", Close: tr.CloseIdleConnections}
+	default:
+		tr := &http.Transport{
+			Proxy:                  opts.ProxyFunc,
+			GetProxyConnectHeader:  opts.ProxyConnectHeadersFunc,
+			DialContext:            opts.DialFunc,
+			ForceAttemptHTTP2:      true,
+			MaxIdleConns:           1,
+			MaxIdleConnsPerHost:    1,
+			IdleConnTimeout:        opts.IdleConnTimeout,
+			TLSHandshakeTimeout:    opts.TLSHandshakeTimeout,
+			TLSClientConfig:        opts.TLSClientConfig,
+			MaxResponseHeaderBytes: opts.MaxResponseHeaderBytes,
+			ExpectContinueTimeout:  1 * time.Second,
+		}
+		// the HTTP/2 settings are only exposed by the x/net implementation that backs http.Transport
+		if h2, err := http2.ConfigureTransports(tr); err == nil {
+			h2.ReadIdleTimeout = t.pingInterval
+		}
+		result = httplb.RoundTripperResult{RoundTripper: tr, Close: tr.CloseIdleConnections}
+	}
+	if t.maxStreams > 0 {
+		result.RoundTripper = &streamLimiter{next: result.RoundTripper, slots: make(chan struct{}, t.maxStreams)}
+	}
+	return result
+}
+
+// streamLimiter is an [http.RoundTripper] that limits the number of requests in flight at once, where a
+// request is in flight until its response body is closed, which is when its HTTP/2 stream ends.
+// Additional requests wait for a slot to free up.
+type streamLimiter struct {
+	next  http.RoundTripper
+	slots chan struct{}
+}
+
+// RoundTrip satisfies the [http.RoundTripper] interface
+func (l *streamLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case l.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	resp, err := l.next.RoundTrip(req)
+	if err != nil {
+		<-l.slots
+		return nil, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: func() { <-l.slots }}
+	return resp, nil
+}
+
+// releasingBody wraps a response body to free its stream slot when it is closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	closed  bool
+}
+
+// Close satisfies the [io.Closer] interface and frees the stream slot the first time it is called
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.closed {
+		b.closed = true
+		b.release()
+	}
+	return err
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/CrowdStrike/perseus/internal/compress"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+func TestTunedConnections(t *testing.T) {
+	_, err := New("https://perseus.example.com", WithPingInterval(time.Second), WithHTTPClient(http.DefaultClient))
+	assert.Error(t, err, "tuning requires the default HTTP client")
+	_, err = New("https://perseus.example.com", WithMaxConcurrentStreams(-1))
+	assert.Error(t, err, "the maximum number of streams must not be negative")
+
+	mux := http.NewServeMux()
+	mux.Handle(perseusapiconnect.NewPerseusServiceHandler(&fakeServer{}))
+	srv := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(srv.URL, WithInsecure(),
+		WithIdleTimeout(time.Minute),
+		WithPingInterval(time.Second),
+		WithMaxConcurrentStreams(1),
+		WithConnectTimeout(5*time.Second),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	mods, err := c.ListModules(context.Background(), "*").Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, mods, 3)
+}
+
+// stubRoundTripper returns a successful response without contacting a server
+type stubRoundTripper struct{}
+
+func (stubRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}, nil
+}
+
+func TestStreamLimiter(t *testing.T) {
+	l := &streamLimiter{next: stubRoundTripper{}, slots: make(chan struct{}, 1)}
+	req := httptest.NewRequest(http.MethodPost, "https://perseus.example.com", http.NoBody)
+	resp, err := l.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = l.RoundTrip(req.WithContext(ctx))
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "should wait while the only stream is in use")
+
+	assert.NoError(t, resp.Body.Close())
+	assert.NoError(t, resp.Body.Close(), "closing twice should only free 1 slot")
+	resp, err = l.RoundTrip(req)
+	if assert.NoError(t, err, "should proceed once the stream is closed") {
+		assert.NoError(t, resp.Body.Close())
+	}
+	assert.Empty(t, l.slots)
+}
+
+func TestCompression(t *testing.T) {
+	_, err := New("https://perseus.example.com", WithCompression("brotli"))
+	assert.Error(t, err, "unsupported algorithm")
+
+	// compress every response, regardless of its size
+	f := &fakeServer{}
+	mux := http.NewServeMux()
+	mux.Handle(perseusapiconnect.NewPerseusServiceHandler(f,
+		connect.WithCompression(compress.Zstd, compress.NewZstdDecompressor, compress.NewZstdCompressor),
+		connect.WithCompressMinBytes(1),
+	))
+	var encoding atomic.Value
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.ServeHTTP(w, r)
+		encoding.Store(w.Header().Get("Grpc-Encoding"))
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	for alg, want := range map[string]string{CompressionGzip: "gzip", CompressionZstd: "zstd", CompressionNone: ""} {
+		t.Run(alg, func(t *testing.T) {
+			c, err := New(srv.URL, WithHTTPClient(srv.Client()), WithCompression(alg))
+			if err != nil {
+				t.Fatal(err)
+			}
+			mods, err := c.ListModules(context.Background(), "*").Collect()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, mods, 3)
+			assert.Equal(t, want, encoding.Load(), "the response should be compressed with the requested algorithm")
+		})
+	}
+}
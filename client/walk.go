@@ -0,0 +1,266 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/mod/module"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// DependencyQuery specifies the direct dependencies, or dependents, that are returned by
+// [Client.QueryDependencies].
+type DependencyQuery struct {
+	// the module version whose dependencies or dependents are returned
+	Module module.Version
+	// whether the results are the modules that Module depends on or the modules that depend on it
+	Direction perseusapi.DependencyDirection
+	// if not empty, the results are the modules that depend on any version of Module.Path in this range of
+	// semantic versions, ex: "<v1.56.3", rather than on Module.Version, which must be empty.  The
+	// DependencyVersion of each result is the version that it depends on.  Only supported for dependents.
+	VersionRange string
+	// if true, the results also include those of every module that Module was renamed from or to
+	FollowRenames bool
+	// if not the zero time, the results are the dependencies that were declared at this time
+	AsOf time.Time
+	// the deps.dev data to include with each result, any of "scorecard", "licenses", or "advisories"
+	Enrich []string
+	// the number of results to request per page, zero for the server's default
+	PageSize int32
+}
+
+// QueryDependencies returns an iterator over the direct dependencies, or dependents, of a module version.
+// Each result has exactly 1 version.
+func (c *Client) QueryDependencies(ctx context.Context, query DependencyQuery) *Iterator[*perseusapi.Module] {
+	return newIterator(ctx, func(ctx context.Context, pageToken string) ([]*perseusapi.Module, string, error) {
+		req := connect.NewRequest(&perseusapi.QueryDependenciesRequest{
+			ModuleName:    query.Module.Path,
+			Version:       query.Module.Version,
+			VersionRange:  query.VersionRange,
+			Direction:     query.Direction,
+			FollowRenames: query.FollowRenames,
+			AsOf:          timestampOrNil(query.AsOf),
+			Enrich:        query.Enrich,
+			PageToken:     pageToken,
+			PageSize:      query.PageSize,
+		})
+		resp, err := c.api.QueryDependencies(ctx, req)
+		if err != nil {
+			return nil, "", err
+		}
+		var results []*perseusapi.Module
+		for _, m := range resp.Msg.GetModules() {
+			if len(m.GetVersions()) > 0 {
+				results = append(results, m)
+			}
+		}
+		nextPageToken := resp.Msg.GetNextPageToken()
+		if query.FollowRenames {
+			// results that follow module renames are never paged
+			nextPageToken = ""
+		}
+		return results, nextPageToken, nil
+	})
+}
+
+// defaultWalkConcurrency is the maximum number of requests that [Client.WalkDependencies] makes at the
+// same time if [WalkOptions] does not specify a limit
+const defaultWalkConcurrency = 8
+
+// UnlimitedDepth is the [WalkOptions] MaxDepth that walks every level of the graph
+const UnlimitedDepth = -1
+
+// DefaultMaxWalkNodes is the maximum number of nodes in the tree returned by [Client.WalkDependencies] if
+// [WalkOptions] does not specify a limit
+const DefaultMaxWalkNodes = 100000
+
+// ErrTreeTooLarge is returned, possibly wrapped, by [Client.WalkDependencies] when the tree would have more
+// than the maximum number of nodes
+var ErrTreeTooLarge = errors.New("the dependency tree is too large")
+
+// WalkOptions controls how [Client.WalkDependencies] traverses the graph
+type WalkOptions struct {
+	// whether to walk the modules that the root depends on or the modules that depend on it
+	Direction perseusapi.DependencyDirection
+	// the maximum number of levels to walk, [UnlimitedDepth] to walk every level, or 1 if it is zero
+	MaxDepth int
+	// the maximum number of nodes in the tree, [DefaultMaxWalkNodes] if it is not positive, which
+	// protects against walks that would take too long or use too much memory, especially when the depth
+	// is unlimited
+	MaxNodes int
+	// if true, the results for each module also include those of every module that it was renamed from
+	// or to
+	FollowRenames bool
+	// if not the zero time, the graph is walked as it was at this time
+	AsOf time.Time
+	// the deps.dev data to include with each module, any of "scorecard", "licenses", or "advisories"
+	Enrich []string
+	// the maximum number of requests made at the same time, 8 if it is not positive
+	Concurrency int
+	// an optional callback that is invoked with a description of each step, ex: to update a progress
+	// indicator.  It is never called concurrently.
+	Status func(string)
+}
+
+// DependencyNode is a module version in the tree returned by [Client.WalkDependencies]
+type DependencyNode struct {
+	// the module path and version
+	Module module.Version
+	// the API representation of the module, which holds any deps.dev data that was requested, or nil
+	// for the root
+	Info *perseusapi.Module
+	// the direct dependencies, or dependents, of the module
+	Deps []DependencyNode
+}
+
+// WalkDependencies retrieves the dependencies, or dependents, of root and recursively those of each result,
+// to the maximum depth in opts, and returns them as a tree.  A module version that is reachable by more than
+// 1 path appears in the tree once for each path, but its direct dependencies are only retrieved once.  The
+// branches of the tree are walked concurrently.
+//
+// If the graph contains a cycle, a module version that is already one of the ancestors of a node is
+// included as a leaf so that the walk ends.  An error wrapping [ErrTreeTooLarge] is returned if the tree
+// would exceed the maximum number of nodes.
+func (c *Client) WalkDependencies(ctx context.Context, root module.Version, opts WalkOptions) (DependencyNode, error) {
+	switch {
+	case opts.MaxDepth < 0:
+		opts.MaxDepth = math.MaxInt
+	case opts.MaxDepth == 0:
+		opts.MaxDepth = 1
+	}
+	if opts.MaxNodes <= 0 {
+		opts.MaxNodes = DefaultMaxWalkNodes
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultWalkConcurrency
+	}
+	w := walker{
+		c:    c,
+		opts: opts,
+		sem:  make(chan struct{}, opts.Concurrency),
+		deps: make(map[module.Version]*directDeps),
+	}
+	w.nodes.Store(1)
+	return w.walk(ctx, DependencyNode{Module: root}, nil)
+}
+
+// walker holds the state of a single call to [Client.WalkDependencies]
+type walker struct {
+	c    *Client
+	opts WalkOptions
+	// limits the number of requests in flight
+	sem chan struct{}
+	// the number of nodes in the tree so far
+	nodes atomic.Int64
+
+	// guards deps and calls to opts.Status
+	mu sync.Mutex
+	// the direct dependencies of each module version that has been visited, which are shared by every
+	// branch of the tree that contains it
+	deps map[module.Version]*directDeps
+}
+
+// directDeps holds the direct dependencies, or dependents, of a module version, which are available once
+// done is closed
+type directDeps struct {
+	done chan struct{}
+	mods []*perseusapi.Module
+	err  error
+}
+
+// walk populates the dependencies of node, whose ancestors are the module versions on the path from the
+// root, and returns it
+func (w *walker) walk(ctx context.Context, node DependencyNode, ancestors []module.Version) (DependencyNode, error) {
+	if err := ctx.Err(); err != nil {
+		return DependencyNode{}, err
+	}
+	if len(ancestors) >= w.opts.MaxDepth || slices.Contains(ancestors, node.Module) {
+		return node, nil
+	}
+
+	deps, err := w.directDeps(ctx, node.Module)
+	if err != nil {
+		return DependencyNode{}, err
+	}
+	if len(deps) == 0 {
+		return node, nil
+	}
+	if n := w.nodes.Add(int64(len(deps))); n > int64(w.opts.MaxNodes) {
+		return DependencyNode{}, fmt.Errorf("%w, it has more than %d nodes", ErrTreeTooLarge, w.opts.MaxNodes)
+	}
+	// the children share the path, which is clipped so that appending to it always makes a copy
+	path := append(slices.Clip(ancestors), node.Module)
+	node.Deps = make([]DependencyNode, len(deps))
+	eg, ctx := errgroup.WithContext(ctx)
+	for i, dep := range deps {
+		eg.Go(func() error {
+			child, err := w.walk(ctx, DependencyNode{
+				Module: module.Version{Path: dep.GetName(), Version: dep.GetVersions()[0]},
+				Info:   dep,
+			}, path)
+			node.Deps[i] = child
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return DependencyNode{}, err
+	}
+	return node, nil
+}
+
+// directDeps returns the direct dependencies, or dependents, of mod, which are only retrieved from the
+// server the first time they're needed.  Later callers wait for that request to complete.
+func (w *walker) directDeps(ctx context.Context, mod module.Version) ([]*perseusapi.Module, error) {
+	w.mu.Lock()
+	d, found := w.deps[mod]
+	if !found {
+		d = &directDeps{done: make(chan struct{})}
+		w.deps[mod] = d
+	}
+	w.mu.Unlock()
+	if found {
+		select {
+		case <-d.done:
+			return d.mods, d.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	defer close(d.done)
+	select {
+	case w.sem <- struct{}{}:
+		defer func() { <-w.sem }()
+	case <-ctx.Done():
+		d.err = ctx.Err()
+		return nil, d.err
+	}
+	w.status("processing " + mod.String())
+	d.mods, d.err = w.c.QueryDependencies(ctx, DependencyQuery{
+		Module:        mod,
+		Direction:     w.opts.Direction,
+		FollowRenames: w.opts.FollowRenames,
+		AsOf:          w.opts.AsOf,
+		Enrich:        w.opts.Enrich,
+	}).Collect()
+	return d.mods, d.err
+}
+
+// status reports a step of the walk to the caller's callback, if any
+func (w *walker) status(msg string) {
+	if w.opts.Status == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.opts.Status(msg)
+}
@@ -1,24 +1,40 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
-	"github.com/bufbuild/httplb"
 	"github.com/spf13/pflag"
 
-	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+	"github.com/CrowdStrike/perseus/client"
 )
 
 // package variables to hold CLI flag values
 var (
-	formatAsJSON, formatAsList, formatAsDotGraph bool
-	formatTemplate                               string
-	maxDepth                                     int
-	disableTLS                                   bool
+	outputFmt      outputFormat
+	outputPath     string
+	maxDepth       int
+	disableTLS     bool
+	quietMode      bool
+	noSpinner      bool
+	followRenames  bool
+	withScorecard  bool
+	withLicenses   bool
+	withAdvisories bool
+	showLinks      bool
+	renderFormat   string
+	dotStyleOpts   dotStyle
+	asOf           time.Time
+	since          time.Time
+	diffFrom       time.Time
+	diffTo         time.Time
 )
 
 // clientConfig defines the runtime options for the "client" CLI commands
@@ -27,6 +43,40 @@ type clientConfig struct {
 	serverAddr string
 	// do not use TLS when connecting if true
 	disableTLS bool
+	// the path to a PEM file with the certificate authorities that are trusted to issue the server's
+	// certificate, the system's trusted authorities are used if empty
+	caFile string
+	// the paths to the PEM files with the client certificate and private key that are presented to the
+	// server, if any
+	certFile, keyFile string
+	// the default output format to use if none is specified on the command line
+	outputFormat string
+	// the maximum amount of time a command may spend calling the server, zero for no limit
+	timeout time.Duration
+	// the API key sent with each request, which is required for administrative operations if the server
+	// has authentication enabled
+	apiKey string
+	// how long responses to read-only queries are cached on disk, zero to disable the cache
+	cacheTTL time.Duration
+	// how requests that fail with a transient error are retried
+	retry client.RetryPolicy
+	// how long an idle connection to the server is kept open, zero to keep it open indefinitely
+	idleTimeout time.Duration
+	// how long a connection may go without receiving data before an HTTP/2 ping is sent, zero for no pings
+	pingInterval time.Duration
+	// the maximum number of requests in flight on each connection, zero for the server's limit
+	maxStreams int
+	// the maximum amount of time to establish a connection, zero for the default
+	connectTimeout time.Duration
+	// the algorithm used to compress requests and responses, empty for the default
+	compression string
+}
+
+// newClientConfig returns a clientConfig with the default settings
+func newClientConfig() clientConfig {
+	return clientConfig{
+		retry: client.DefaultRetryPolicy,
+	}
 }
 
 // clientOption defines a functional option that configures a particular "client" CLI runtime option
@@ -48,6 +98,196 @@ func withInsecureDial() clientOption {
 	}
 }
 
+// withCAFile assigns the PEM file with the certificate authorities that are trusted to issue the
+// server's certificate
+func withCAFile(path string) clientOption {
+	return func(conf *clientConfig) error {
+		conf.caFile = path
+		return nil
+	}
+}
+
+// withCertFile assigns the PEM file with the client certificate that is presented to the server
+func withCertFile(path string) clientOption {
+	return func(conf *clientConfig) error {
+		conf.certFile = path
+		return nil
+	}
+}
+
+// withKeyFile assigns the PEM file with the private key of the client certificate
+func withKeyFile(path string) clientOption {
+	return func(conf *clientConfig) error {
+		conf.keyFile = path
+		return nil
+	}
+}
+
+// withOutputFormat assigns the default output format
+func withOutputFormat(format string) clientOption {
+	return func(conf *clientConfig) error {
+		switch format {
+		case outputJSON, outputYAML, outputTable, outputTree, outputDot:
+			conf.outputFormat = format
+			return nil
+		case "list":
+			conf.outputFormat = outputTable
+			return nil
+		default:
+			return fmt.Errorf("invalid output format %q, must be one of 'json', 'yaml', 'table', 'tree', or 'dot'", format)
+		}
+	}
+}
+
+// withTimeout assigns the maximum amount of time a command may spend calling the Perseus server
+func withTimeout(d time.Duration) clientOption {
+	return func(conf *clientConfig) error {
+		if d < 0 {
+			return fmt.Errorf("invalid timeout %s, must not be negative", d)
+		}
+		conf.timeout = d
+		return nil
+	}
+}
+
+// withAPIKey assigns the API key that is sent with each request to the Perseus server
+func withAPIKey(key string) clientOption {
+	return func(conf *clientConfig) error {
+		conf.apiKey = key
+		return nil
+	}
+}
+
+// withCacheTTL assigns how long responses to read-only queries are cached on disk
+func withCacheTTL(d time.Duration) clientOption {
+	return func(conf *clientConfig) error {
+		if d < 0 {
+			return fmt.Errorf("invalid cache TTL %s, must not be negative", d)
+		}
+		conf.cacheTTL = d
+		return nil
+	}
+}
+
+// withRetryAttempts assigns the maximum number of times each request is sent to the Perseus server
+func withRetryAttempts(n int) clientOption {
+	return func(conf *clientConfig) error {
+		if n < 1 {
+			return fmt.Errorf("invalid number of retry attempts %d, must be at least 1", n)
+		}
+		conf.retry.MaxAttempts = n
+		return nil
+	}
+}
+
+// withRetryDelay assigns the delay before the first retry of a failed request
+func withRetryDelay(d time.Duration) clientOption {
+	return func(conf *clientConfig) error {
+		if d < 0 {
+			return fmt.Errorf("invalid retry delay %s, must not be negative", d)
+		}
+		conf.retry.BaseDelay = d
+		return nil
+	}
+}
+
+// withRetryCodes assigns the status codes, ex: unavailable or deadline_exceeded, of the failed requests
+// that are retried
+func withRetryCodes(names []string) clientOption {
+	return func(conf *clientConfig) error {
+		codes := make([]connect.Code, 0, len(names))
+		for _, name := range names {
+			var code connect.Code
+			if err := code.UnmarshalText([]byte(strings.ToLower(strings.TrimSpace(name)))); err != nil {
+				return fmt.Errorf("invalid retry status code %q, must be a gRPC status such as unavailable, deadline_exceeded, or internal", name)
+			}
+			codes = append(codes, code)
+		}
+		conf.retry.RetryableCodes = codes
+		return nil
+	}
+}
+
+// withIdleTimeout assigns how long an idle connection to the Perseus server is kept open
+func withIdleTimeout(d time.Duration) clientOption {
+	return func(conf *clientConfig) error {
+		if d < 0 {
+			return fmt.Errorf("invalid idle timeout %s, must not be negative", d)
+		}
+		conf.idleTimeout = d
+		return nil
+	}
+}
+
+// withPingInterval assigns how long a connection may go without receiving data before an HTTP/2 ping is
+// sent to keep it alive
+func withPingInterval(d time.Duration) clientOption {
+	return func(conf *clientConfig) error {
+		if d < 0 {
+			return fmt.Errorf("invalid ping interval %s, must not be negative", d)
+		}
+		conf.pingInterval = d
+		return nil
+	}
+}
+
+// withMaxStreams assigns the maximum number of requests in flight on each connection to the Perseus server
+func withMaxStreams(n int) clientOption {
+	return func(conf *clientConfig) error {
+		if n < 0 {
+			return fmt.Errorf("invalid maximum number of concurrent streams %d, must not be negative", n)
+		}
+		conf.maxStreams = n
+		return nil
+	}
+}
+
+// withConnectTimeout assigns the maximum amount of time to establish a connection to the Perseus server
+func withConnectTimeout(d time.Duration) clientOption {
+	return func(conf *clientConfig) error {
+		if d < 0 {
+			return fmt.Errorf("invalid connect timeout %s, must not be negative", d)
+		}
+		conf.connectTimeout = d
+		return nil
+	}
+}
+
+// withCompression assigns the algorithm, one of gzip, zstd, or none, used to compress requests to, and
+// responses from, the Perseus server
+func withCompression(name string) clientOption {
+	return func(conf *clientConfig) error {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case client.CompressionGzip, client.CompressionZstd, client.CompressionNone:
+			conf.compression = name
+			return nil
+		default:
+			return fmt.Errorf("invalid compression algorithm %q, must be gzip, zstd, or none", name)
+		}
+	}
+}
+
+// addRetryFlags adds the CLI flags that control how failed requests to the Perseus server are retried
+func addRetryFlags(fset *pflag.FlagSet) {
+	fset.Int("retry-attempts", client.DefaultRetryPolicy.MaxAttempts, "the maximum number of times each request is sent to the Perseus server, 1 to disable retries (default is $PERSEUS_RETRY_ATTEMPTS environment variable)")
+	fset.Duration("retry-delay", client.DefaultRetryPolicy.BaseDelay, "the delay before the first retry of a failed request, which grows with each later retry (default is $PERSEUS_RETRY_DELAY environment variable)")
+	fset.StringSlice("retry-codes", []string{"unavailable"}, "the comma-separated status codes of the failed requests that are retried, ex: unavailable,deadline_exceeded,internal (default is $PERSEUS_RETRY_CODES environment variable)")
+}
+
+// addConnectionFlags adds the CLI flags that tune the connections to the Perseus server, ex: to keep
+// long-running jobs from being reset by load balancers
+func addConnectionFlags(fset *pflag.FlagSet) {
+	fset.Duration("idle-timeout", 0, "close connections to the Perseus server that are idle for longer than this, which should be less than any load balancer's idle timeout (default is $PERSEUS_IDLE_TIMEOUT environment variable or no limit)")
+	fset.Duration("ping-interval", 0, "send an HTTP/2 ping on connections that have not received data for this long to keep them alive (default is $PERSEUS_PING_INTERVAL environment variable or no pings)")
+	fset.Int("max-streams", 0, "the maximum number of requests in flight on each connection to the Perseus server (default is $PERSEUS_MAX_STREAMS environment variable or the server's limit)")
+	fset.Duration("connect-timeout", 0, "the maximum amount of time to establish a connection to the Perseus server (default is $PERSEUS_CONNECT_TIMEOUT environment variable or 30s)")
+	fset.String("compression", "", "the algorithm used to compress requests to and responses from the Perseus server, one of gzip, zstd, or none (default is $PERSEUS_COMPRESSION environment variable or gzip responses only)")
+	fset.String("ca-file", "", "the path to a PEM file with the certificate authorities that issue the Perseus server's certificate (default is $PERSEUS_CA_FILE environment variable or the system's trusted authorities)")
+	fset.String("cert-file", "", "the path to a PEM file with the client certificate presented to the Perseus server, requires --key-file (default is $PERSEUS_CERT_FILE environment variable)")
+	fset.String("key-file", "", "the path to a PEM file with the private key of the client certificate (default is $PERSEUS_KEY_FILE environment variable)")
+}
+
 // readClientConfig scans the process environment vars and returns a list of 0 or more config options
 func readClientConfigEnv() []clientOption {
 	var opts []clientOption
@@ -61,6 +301,78 @@ func readClientConfigEnv() []clientOption {
 			opts = append(opts, withInsecureDial())
 		}
 	}
+	if key := os.Getenv("PERSEUS_API_KEY"); key != "" {
+		opts = append(opts, withAPIKey(key))
+	}
+	if s := os.Getenv("PERSEUS_TIMEOUT"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			logger.Error(err, "ignoring invalid value for $PERSEUS_TIMEOUT", "value", s)
+		} else {
+			opts = append(opts, withTimeout(d))
+		}
+	}
+	if s := os.Getenv("PERSEUS_CACHE_TTL"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			logger.Error(err, "ignoring invalid value for $PERSEUS_CACHE_TTL", "value", s)
+		} else {
+			opts = append(opts, withCacheTTL(d))
+		}
+	}
+	if s := os.Getenv("PERSEUS_RETRY_ATTEMPTS"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			logger.Error(err, "ignoring invalid value for $PERSEUS_RETRY_ATTEMPTS", "value", s)
+		} else {
+			opts = append(opts, withRetryAttempts(n))
+		}
+	}
+	if s := os.Getenv("PERSEUS_RETRY_DELAY"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			logger.Error(err, "ignoring invalid value for $PERSEUS_RETRY_DELAY", "value", s)
+		} else {
+			opts = append(opts, withRetryDelay(d))
+		}
+	}
+	if s := os.Getenv("PERSEUS_RETRY_CODES"); s != "" {
+		opts = append(opts, withRetryCodes(strings.Split(s, ",")))
+	}
+	for name, fn := range map[string]func(time.Duration) clientOption{
+		"PERSEUS_IDLE_TIMEOUT":    withIdleTimeout,
+		"PERSEUS_PING_INTERVAL":   withPingInterval,
+		"PERSEUS_CONNECT_TIMEOUT": withConnectTimeout,
+	} {
+		if s := os.Getenv(name); s != "" {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				logger.Error(err, "ignoring invalid value for $"+name, "value", s)
+			} else {
+				opts = append(opts, fn(d))
+			}
+		}
+	}
+	if s := os.Getenv("PERSEUS_MAX_STREAMS"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			logger.Error(err, "ignoring invalid value for $PERSEUS_MAX_STREAMS", "value", s)
+		} else {
+			opts = append(opts, withMaxStreams(n))
+		}
+	}
+	if s := os.Getenv("PERSEUS_COMPRESSION"); s != "" {
+		opts = append(opts, withCompression(s))
+	}
+	if path := os.Getenv("PERSEUS_CA_FILE"); path != "" {
+		opts = append(opts, withCAFile(path))
+	}
+	if path := os.Getenv("PERSEUS_CERT_FILE"); path != "" {
+		opts = append(opts, withCertFile(path))
+	}
+	if path := os.Getenv("PERSEUS_KEY_FILE"); path != "" {
+		opts = append(opts, withKeyFile(path))
+	}
 
 	return opts
 }
@@ -76,27 +388,163 @@ func readClientConfigFlags(fset *pflag.FlagSet) []clientOption {
 	if v, err := fset.GetBool("insecure"); err == nil && v {
 		opts = append(opts, withInsecureDial())
 	}
+	if fset.Changed("timeout") {
+		if d, err := fset.GetDuration("timeout"); err == nil {
+			opts = append(opts, withTimeout(d))
+		}
+	}
+	if fset.Changed("cache-ttl") {
+		if d, err := fset.GetDuration("cache-ttl"); err == nil {
+			opts = append(opts, withCacheTTL(d))
+		}
+	}
+	if fset.Changed("retry-attempts") {
+		if n, err := fset.GetInt("retry-attempts"); err == nil {
+			opts = append(opts, withRetryAttempts(n))
+		}
+	}
+	if fset.Changed("retry-delay") {
+		if d, err := fset.GetDuration("retry-delay"); err == nil {
+			opts = append(opts, withRetryDelay(d))
+		}
+	}
+	if fset.Changed("retry-codes") {
+		if names, err := fset.GetStringSlice("retry-codes"); err == nil {
+			opts = append(opts, withRetryCodes(names))
+		}
+	}
+	for name, fn := range map[string]func(time.Duration) clientOption{
+		"idle-timeout":    withIdleTimeout,
+		"ping-interval":   withPingInterval,
+		"connect-timeout": withConnectTimeout,
+	} {
+		if fset.Changed(name) {
+			if d, err := fset.GetDuration(name); err == nil {
+				opts = append(opts, fn(d))
+			}
+		}
+	}
+	if fset.Changed("max-streams") {
+		if n, err := fset.GetInt("max-streams"); err == nil {
+			opts = append(opts, withMaxStreams(n))
+		}
+	}
+	if fset.Changed("compression") {
+		if name, err := fset.GetString("compression"); err == nil {
+			opts = append(opts, withCompression(name))
+		}
+	}
+	if path, err := fset.GetString("ca-file"); err == nil && path != "" {
+		opts = append(opts, withCAFile(path))
+	}
+	if path, err := fset.GetString("cert-file"); err == nil && path != "" {
+		opts = append(opts, withCertFile(path))
+	}
+	if path, err := fset.GetString("key-file"); err == nil && path != "" {
+		opts = append(opts, withKeyFile(path))
+	}
 
 	return opts
 }
 
-func (conf *clientConfig) getClient() (client perseusapiconnect.PerseusServiceClient) {
-	opts := []httplb.ClientOption{}
-	if !conf.disableTLS {
-		tlsc := tls.Config{
-			MinVersion: tls.VersionTLS13,
+// readClientConfigOptions returns the combined list of config options from the selected profile, if any,
+// the process environment, and the CLI flags in fset, in order of increasing precedence.
+func readClientConfigOptions(fset *pflag.FlagSet) ([]clientOption, error) {
+	var opts []clientOption
+	profileOpts, err := readClientConfigProfile(fset)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, profileOpts...)
+	opts = append(opts, readClientConfigEnv()...)
+	opts = append(opts, readClientConfigFlags(fset)...)
+	return opts, nil
+}
+
+// newContext returns a context for calls to the Perseus server that is canceled once the configured
+// timeout, if any, has elapsed.
+func (conf *clientConfig) newContext() (context.Context, context.CancelFunc) {
+	if conf.timeout > 0 {
+		return context.WithTimeout(context.Background(), conf.timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// getClient returns a Perseus API client for the configured server
+func (conf *clientConfig) getClient() (*client.Client, error) {
+	var opts []client.Option
+	if conf.disableTLS {
+		opts = append(opts, client.WithInsecure())
+	}
+	tlsc, err := conf.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsc != nil {
+		opts = append(opts, client.WithTLSConfig(tlsc))
+	}
+	if conf.apiKey != "" {
+		opts = append(opts, client.WithAPIKey(conf.apiKey))
+	}
+	opts = append(opts,
+		client.WithRetryPolicy(conf.retry),
+		client.WithIdleTimeout(conf.idleTimeout),
+		client.WithPingInterval(conf.pingInterval),
+		client.WithMaxConcurrentStreams(conf.maxStreams),
+		client.WithConnectTimeout(conf.connectTimeout),
+	)
+	if conf.compression != "" {
+		opts = append(opts, client.WithCompression(conf.compression))
+	}
+	if conf.cacheTTL > 0 {
+		cache, err := newResponseCache(conf.serverAddr, conf.cacheTTL)
+		if err != nil {
+			return nil, err
 		}
-		opts = append(opts, httplb.WithTLSConfig(&tlsc, 0))
-	} else if strings.HasPrefix(conf.serverAddr, "http:") {
-		// switch to H2C if TLS is disabled since we're using gRPC over Connect
-		conf.serverAddr = "h2c" + conf.serverAddr[4:]
+		opts = append(opts, client.WithClientOptions(connect.WithInterceptors(cache.interceptor())))
+	}
+	// the version check is added after the cache so that it's skipped for cached responses, and the
+	// interceptor refers to ps, which is assigned before any RPCs are made
+	var ps *client.Client
+	opts = append(opts, client.WithClientOptions(connect.WithInterceptors(serverCheckInterceptor(func(ctx context.Context) {
+		conf.checkServer(ctx, ps)
+	}))))
+	ps, err = client.New(conf.serverAddr, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return ps, nil
+}
 
-	// we include WithGRPC() so that the CLI can hit an existing gRPC-based server instance
-	// - this may be removed at some point in the future
-	cc := perseusapiconnect.NewPerseusServiceClient(
-		httplb.NewClient(opts...),
-		conf.serverAddr,
-		connect.WithGRPC())
-	return cc
+// tlsConfig returns the TLS settings for the configured CA bundle and client certificate, or nil if
+// neither is configured
+func (conf *clientConfig) tlsConfig() (*tls.Config, error) {
+	if conf.caFile == "" && conf.certFile == "" && conf.keyFile == "" {
+		return nil, nil
+	}
+	if conf.disableTLS {
+		return nil, fmt.Errorf("the CA and client certificate settings can't be used when TLS is disabled")
+	}
+	if (conf.certFile == "") != (conf.keyFile == "") {
+		return nil, fmt.Errorf("both a client certificate and its private key must be specified")
+	}
+	var tlsc tls.Config
+	if conf.caFile != "" {
+		pem, err := os.ReadFile(conf.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the CA bundle: %w", err)
+		}
+		tlsc.RootCAs = x509.NewCertPool()
+		if !tlsc.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("the CA bundle %q does not contain any PEM-encoded certificates", conf.caFile)
+		}
+	}
+	if conf.certFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.certFile, conf.keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the client certificate: %w", err)
+		}
+		tlsc.Certificates = []tls.Certificate{cert}
+	}
+	return &tlsc, nil
 }
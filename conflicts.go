@@ -0,0 +1,234 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryConflictsExampleUsage = `  # check the latest version of a module for conflicting dependency versions
+  perseus query conflicts github.com/example/foo -o table
+
+  # check the full transitive graph and only flag versions that are more than 10 minor versions apart
+  perseus query conflicts github.com/example/foo@v1.4.0 --max-depth 20 --max-minor-drift 10`
+
+// the kinds of conflicts reported by the 'query conflicts' CLI sub-command
+const (
+	// multiple major versions of the same module family, ex: example.com/foo and example.com/foo/v2
+	conflictMultipleMajors = "multiple-majors"
+	// versions of the same module with different major versions or whose minor versions are far apart
+	conflictDivergentVersions = "divergent-versions"
+)
+
+// dependencyConflict defines the information returned by the 'query conflicts' CLI sub-command for each
+// potential conflict in the transitive dependencies of the root module
+type dependencyConflict struct {
+	// the kind of conflict, either "multiple-majors" or "divergent-versions"
+	Kind string `yaml:"kind"`
+	// the module family for multiple major versions or the module path for divergent versions
+	Module string `yaml:"module"`
+	// the conflicting module versions and the modules that depend on each
+	Versions []conflictingVersion `yaml:"versions"`
+}
+
+// conflictingVersion is a single module version involved in a [dependencyConflict]
+type conflictingVersion struct {
+	Path    string `yaml:"path"`
+	Version string `yaml:"version"`
+	// the module versions in the graph that depend on this version
+	RequiredBy []string `yaml:"requiredBy"`
+}
+
+// runQueryConflictsCmd implements the logic behind the 'query conflicts' CLI sub-command
+func runQueryConflictsCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	rootMod := module.Version{}
+	rootMod.Path, rootMod.Version, _ = strings.Cut(args[0], "@")
+	if err := module.CheckPath(rootMod.Path); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", rootMod.Path, err)
+	}
+	if rootMod.Version != "" && rootMod.Version != "latest" && !semver.IsValid(rootMod.Version) {
+		return fmt.Errorf("%s is not a valid Go module semantic version string", rootMod.Version)
+	}
+	drift, _ := cmd.Flags().GetInt("max-minor-drift")
+	if drift < 0 {
+		return fmt.Errorf("The --max-minor-drift flag must not be negative")
+	}
+	if maxDepth < 0 {
+		return fmt.Errorf("The --max-depth flag must be 0, for no limit, or greater")
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if rootMod.Version == "" || rootMod.Version == "latest" {
+		if rootMod.Version, err = ps.LatestVersion(ctx, rootMod.Path, asOf); err != nil {
+			return err
+		}
+	}
+	updateSpinner, stopSpinner := startSpinner()
+	tree, err := walkDependencies(ctx, ps, rootMod, perseusapi.DependencyDirection_dependencies, maxDepth, updateSpinner)
+	stopSpinner()
+	if err != nil {
+		return err
+	}
+	requiredBy := make(map[module.Version]map[string]struct{})
+	collectRequirements(tree, requiredBy)
+	conflicts := findConflicts(requiredBy, drift)
+	if len(conflicts) == 0 {
+		infof("no conflicting dependency versions found for %s\n", rootMod)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeConflicts(out, format, conflicts); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// collectRequirements records each module version in the dependency tree rooted at node along with the
+// module versions that depend on it
+func collectRequirements(node dependencyTreeNode, requiredBy map[module.Version]map[string]struct{}) {
+	for _, dep := range node.Deps {
+		if requiredBy[dep.Module] == nil {
+			requiredBy[dep.Module] = make(map[string]struct{})
+		}
+		requiredBy[dep.Module][node.Module.String()] = struct{}{}
+		collectRequirements(dep, requiredBy)
+	}
+}
+
+// findConflicts returns the module families with more than 1 major version in requiredBy and the modules
+// with versions that have different major versions or whose minor versions differ by more than maxDrift,
+// ordered by module.
+func findConflicts(requiredBy map[module.Version]map[string]struct{}, maxDrift int) []dependencyConflict {
+	byFamily := make(map[string][]module.Version)
+	byPath := make(map[string][]module.Version)
+	for mv := range requiredBy {
+		fam := modver.Family(mv.Path)
+		byFamily[fam] = append(byFamily[fam], mv)
+		byPath[mv.Path] = append(byPath[mv.Path], mv)
+	}
+
+	var conflicts []dependencyConflict
+	for fam, mvs := range byFamily {
+		paths := make(map[string]struct{})
+		for _, mv := range mvs {
+			paths[mv.Path] = struct{}{}
+		}
+		if len(paths) > 1 {
+			conflicts = append(conflicts, newDependencyConflict(conflictMultipleMajors, fam, mvs, requiredBy))
+		}
+	}
+	for path, mvs := range byPath {
+		if len(mvs) > 1 && versionsDiverge(mvs, maxDrift) {
+			conflicts = append(conflicts, newDependencyConflict(conflictDivergentVersions, path, mvs, requiredBy))
+		}
+	}
+	slices.SortFunc(conflicts, func(a, b dependencyConflict) int {
+		if c := strings.Compare(a.Module, b.Module); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Kind, b.Kind)
+	})
+	return conflicts
+}
+
+// versionsDiverge returns true if mvs, which are versions of the same module, have different major
+// versions or if their minor versions differ by more than maxDrift
+func versionsDiverge(mvs []module.Version, maxDrift int) bool {
+	minMinor, maxMinor := -1, -1
+	for _, mv := range mvs {
+		if semver.Major(mv.Version) != semver.Major(mvs[0].Version) {
+			return true
+		}
+		_, minor, _ := strings.Cut(semver.MajorMinor(mv.Version), ".")
+		n, err := strconv.Atoi(minor)
+		if err != nil {
+			continue
+		}
+		if minMinor < 0 || n < minMinor {
+			minMinor = n
+		}
+		if n > maxMinor {
+			maxMinor = n
+		}
+	}
+	return maxMinor-minMinor > maxDrift
+}
+
+// newDependencyConflict constructs a conflict of the specified kind between mvs, sorted by module path
+// then by descending version
+func newDependencyConflict(kind, mod string, mvs []module.Version, requiredBy map[module.Version]map[string]struct{}) dependencyConflict {
+	slices.SortFunc(mvs, func(a, b module.Version) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return semver.Compare(b.Version, a.Version)
+	})
+	c := dependencyConflict{Kind: kind, Module: mod}
+	for _, mv := range mvs {
+		cv := conflictingVersion{Path: mv.Path, Version: mv.Version}
+		for dep := range requiredBy[mv] {
+			cv.RequiredBy = append(cv.RequiredBy, dep)
+		}
+		slices.Sort(cv.RequiredBy)
+		c.Versions = append(c.Versions, cv)
+	}
+	return c
+}
+
+// writeConflicts writes the contents of conflicts to the provided io.Writer in the specified format
+func writeConflicts(w io.Writer, format string, conflicts []dependencyConflict) error {
+	switch format {
+	case outputTable:
+		for i, c := range conflicts {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "%s: %s\n", c.Kind, c.Module)
+			for _, v := range c.Versions {
+				fmt.Fprintf(w, "  %s@%s required by %s\n", v.Path, v.Version, strings.Join(v.RequiredBy, ", "))
+			}
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(conflicts)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(conflicts)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+)
+
+func TestVersionsDiverge(t *testing.T) {
+	testCases := []struct {
+		name     string
+		versions []string
+		maxDrift int
+		expected bool
+	}{
+		{name: "same minor", versions: []string{"v1.2.0", "v1.2.5"}, maxDrift: 0, expected: false},
+		{name: "within drift", versions: []string{"v1.2.0", "v1.5.0", "v1.4.1"}, maxDrift: 3, expected: false},
+		{name: "beyond drift", versions: []string{"v1.2.0", "v1.6.0", "v1.4.1"}, maxDrift: 3, expected: true},
+		{name: "different majors", versions: []string{"v2.0.0+incompatible", "v1.9.0"}, maxDrift: 100, expected: true},
+		{name: "pseudo-versions", versions: []string{"v0.0.0-20240101000000-abcdefabcdef", "v0.3.0"}, maxDrift: 2, expected: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mvs []module.Version
+			for _, v := range tc.versions {
+				mvs = append(mvs, module.Version{Path: "example.com/foo", Version: v})
+			}
+			assert.Equal(t, tc.expected, versionsDiverge(mvs, tc.maxDrift))
+		})
+	}
+}
+
+func TestFindConflicts(t *testing.T) {
+	requiredBy := func(deps ...string) map[string]struct{} {
+		m := make(map[string]struct{}, len(deps))
+		for _, d := range deps {
+			m[d] = struct{}{}
+		}
+		return m
+	}
+	testCases := []struct {
+		name       string
+		requiredBy map[module.Version]map[string]struct{}
+		maxDrift   int
+		expected   []dependencyConflict
+	}{
+		{
+			name: "no conflicts",
+			requiredBy: map[module.Version]map[string]struct{}{
+				{Path: "example.com/foo", Version: "v1.2.0"}: requiredBy("example.com/app@v1.0.0"),
+				{Path: "example.com/foo", Version: "v1.3.0"}: requiredBy("example.com/bar@v0.1.0"),
+				{Path: "example.com/bar", Version: "v0.1.0"}: requiredBy("example.com/app@v1.0.0"),
+			},
+			maxDrift: 5,
+		},
+		{
+			name: "multiple majors",
+			requiredBy: map[module.Version]map[string]struct{}{
+				{Path: "example.com/foo", Version: "v1.2.0"}:    requiredBy("example.com/app@v1.0.0"),
+				{Path: "example.com/foo/v2", Version: "v2.1.0"}: requiredBy("example.com/bar@v0.1.0", "example.com/app@v1.0.0"),
+			},
+			maxDrift: 5,
+			expected: []dependencyConflict{
+				{
+					Kind:   conflictMultipleMajors,
+					Module: "example.com/foo",
+					Versions: []conflictingVersion{
+						{Path: "example.com/foo", Version: "v1.2.0", RequiredBy: []string{"example.com/app@v1.0.0"}},
+						{Path: "example.com/foo/v2", Version: "v2.1.0", RequiredBy: []string{"example.com/app@v1.0.0", "example.com/bar@v0.1.0"}},
+					},
+				},
+			},
+		},
+		{
+			name: "divergent versions",
+			requiredBy: map[module.Version]map[string]struct{}{
+				{Path: "example.com/foo", Version: "v1.2.0"}:  requiredBy("example.com/app@v1.0.0"),
+				{Path: "example.com/foo", Version: "v1.12.0"}: requiredBy("example.com/bar@v0.1.0"),
+				{Path: "example.com/bar", Version: "v0.1.0"}:  requiredBy("example.com/app@v1.0.0"),
+			},
+			maxDrift: 5,
+			expected: []dependencyConflict{
+				{
+					Kind:   conflictDivergentVersions,
+					Module: "example.com/foo",
+					Versions: []conflictingVersion{
+						{Path: "example.com/foo", Version: "v1.12.0", RequiredBy: []string{"example.com/bar@v0.1.0"}},
+						{Path: "example.com/foo", Version: "v1.2.0", RequiredBy: []string{"example.com/app@v1.0.0"}},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple majors and divergent versions",
+			requiredBy: map[module.Version]map[string]struct{}{
+				{Path: "example.com/foo", Version: "v1.2.0"}:    requiredBy("example.com/app@v1.0.0"),
+				{Path: "example.com/foo/v2", Version: "v2.0.0"}: requiredBy("example.com/app@v1.0.0"),
+				{Path: "example.com/baz", Version: "v0.1.0"}:    requiredBy("example.com/app@v1.0.0"),
+				{Path: "example.com/baz", Version: "v0.9.0"}:    requiredBy("example.com/foo@v1.2.0"),
+			},
+			maxDrift: 2,
+			expected: []dependencyConflict{
+				{
+					Kind:   conflictDivergentVersions,
+					Module: "example.com/baz",
+					Versions: []conflictingVersion{
+						{Path: "example.com/baz", Version: "v0.9.0", RequiredBy: []string{"example.com/foo@v1.2.0"}},
+						{Path: "example.com/baz", Version: "v0.1.0", RequiredBy: []string{"example.com/app@v1.0.0"}},
+					},
+				},
+				{
+					Kind:   conflictMultipleMajors,
+					Module: "example.com/foo",
+					Versions: []conflictingVersion{
+						{Path: "example.com/foo", Version: "v1.2.0", RequiredBy: []string{"example.com/app@v1.0.0"}},
+						{Path: "example.com/foo/v2", Version: "v2.0.0", RequiredBy: []string{"example.com/app@v1.0.0"}},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, findConflicts(tc.requiredBy, tc.maxDrift))
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryConsumersExampleUsage = `  # list the modules that depend on a version of gRPC that is affected by CVE-2023-44487
+  perseus query consumers google.golang.org/grpc '<v1.56.3' -o table
+
+  # list the modules that depended on any v1.x version of a module at the start of the year
+  perseus query consumers github.com/example/foo '^v1' --as-of 2024-01-01`
+
+// consumerItem defines the information returned by the 'query consumers' CLI sub-command for each module
+// version that depends on a version of the queried module in the requested range
+type consumerItem struct {
+	// the dependent module path, ex: github.com/CrowdStrike/perseus
+	Path string `yaml:"path"`
+	// the dependent module version, ex: v1.11.38
+	Version string `yaml:"version"`
+	// the version of the queried module that the dependent depends on
+	Consumes string `yaml:"consumes"`
+}
+
+// runQueryConsumersCmd implements the logic behind the 'query consumers' CLI sub-command
+func runQueryConsumersCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 2 {
+		return fmt.Errorf("The module name and a version range must be provided")
+	}
+	modPath, versionRange := args[0], args[1]
+	if err := module.CheckPath(modPath); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", modPath, err)
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner(fmt.Sprintf("querying the modules that depend on %s %s", modPath, versionRange))
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if err := requireFeature(ctx, ps, client.FeatureDependentsInRange, "querying dependents by version range"); err != nil {
+		return err
+	}
+	dependents := ps.QueryDependencies(ctx, client.DependencyQuery{
+		Module:       module.Version{Path: modPath},
+		VersionRange: versionRange,
+		Direction:    perseusapi.DependencyDirection_dependents,
+		AsOf:         asOf,
+	})
+	results := make([]consumerItem, 0)
+	for m, err := range dependents.All() {
+		if err != nil {
+			stopSpinner()
+			return fmt.Errorf("Unable to query the modules that depend on %s %s: %w", modPath, versionRange, err)
+		}
+		results = append(results, consumerItem{
+			Path:     m.GetName(),
+			Version:  m.GetVersions()[0],
+			Consumes: m.GetDependencyVersion(),
+		})
+	}
+	stopSpinner()
+	if len(results) == 0 {
+		infof("no modules depend on %s %s\n", modPath, versionRange)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeConsumers(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// writeConsumers writes the contents of results to the provided io.Writer in the specified format
+func writeConsumers(w io.Writer, format string, results []consumerItem) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Dependent\tConsumes\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, c := range results {
+			if _, err := fmt.Fprintf(tw, "%s@%s\t%s\n", c.Path, c.Version, c.Consumes); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
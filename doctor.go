@@ -0,0 +1,352 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	"github.com/CrowdStrike/perseus/internal/modproxy"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// doctorCheckTimeout is the default time limit for each individual diagnostic check
+const doctorCheckTimeout = 10 * time.Second
+
+// doctorProbeModule is the module used to verify that the configured Go module proxies are reachable
+const doctorProbeModule = "golang.org/x/mod"
+
+// createDoctorCommand initializes and returns a *cobra.Command that implements the 'doctor' CLI sub-command
+func createDoctorCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:          "doctor",
+		Short:        "Diagnoses problems with the CLI configuration and connectivity to the Perseus server and Go module proxies",
+		RunE:         runDoctorCmd,
+		SilenceUsage: true,
+	}
+	fset := cmd.Flags()
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
+	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.Duration("timeout", doctorCheckTimeout, "the maximum amount of time to wait for each check")
+
+	return &cmd
+}
+
+// doctorResult is the outcome of a single diagnostic check
+type doctorResult struct {
+	// the check passed
+	ok bool
+	// the check was not applicable and was not run
+	skipped bool
+	// a short description of the outcome
+	detail string
+	// an actionable suggestion for resolving a failure
+	hint string
+}
+
+// runDoctorCmd implements the logic behind the 'doctor' CLI sub-command
+func runDoctorCmd(cmd *cobra.Command, _ []string) error {
+	conf := newClientConfig()
+	opts, err := readClientConfigOptions(cmd.Flags())
+	if err != nil {
+		return err
+	}
+	for _, fn := range opts {
+		if err := fn(&conf); err != nil {
+			return fmt.Errorf("Could not apply client config option: %w", err)
+		}
+	}
+	if conf.timeout == 0 {
+		conf.timeout = doctorCheckTimeout
+	}
+
+	d := doctor{conf: conf}
+	d.check("configuration", d.checkConfig)
+	d.check("server reachability", d.checkReachability)
+	d.check("TLS handshake", d.checkTLS)
+	d.check("authentication", d.checkAuth)
+	d.check("API compatibility", d.checkAPI)
+	d.check("database health", d.checkReadiness)
+	d.check("Go module proxies", d.checkProxies)
+
+	if d.failed > 0 {
+		return fmt.Errorf("%d check(s) failed", d.failed)
+	}
+	return nil
+}
+
+// doctor runs a sequence of diagnostic checks.  Once a check fails, any later checks that depend on the
+// Perseus server are skipped.
+type doctor struct {
+	conf   clientConfig
+	addr   *url.URL
+	failed int
+}
+
+// check runs fn and prints the result
+func (d *doctor) check(name string, fn func(context.Context) doctorResult) {
+	ctx, cancel := d.conf.newContext()
+	defer cancel()
+	res := fn(ctx)
+	switch {
+	case res.skipped:
+		fmt.Printf("[SKIP] %s: %s\n", name, res.detail)
+	case res.ok:
+		fmt.Printf("[ OK ] %s: %s\n", name, res.detail)
+	default:
+		d.failed++
+		fmt.Printf("[FAIL] %s: %s\n", name, res.detail)
+		if res.hint != "" {
+			fmt.Printf("       hint: %s\n", res.hint)
+		}
+	}
+}
+
+// serverUnavailable returns a skipped result if a prior check against the Perseus server failed
+func (d *doctor) serverUnavailable() (doctorResult, bool) {
+	if d.addr == nil || d.failed > 0 {
+		return doctorResult{skipped: true, detail: "a prior check failed"}, true
+	}
+	return doctorResult{}, false
+}
+
+// checkConfig verifies that a valid server address has been configured.  If the address lists multiple
+// replicas, the remaining checks are run against the first one.
+func (d *doctor) checkConfig(ctx context.Context) doctorResult {
+	if d.conf.serverAddr == "" {
+		return doctorResult{
+			detail: "no Perseus server address is configured",
+			hint:   "pass --server-addr, set $PERSEUS_SERVER_ADDR, or add 'server-addr' to a profile in " + cliConfigFileHint(),
+		}
+	}
+	addr, _, multiple := strings.Cut(d.conf.serverAddr, ",")
+	addr = strings.TrimSpace(addr)
+	if name, ok := strings.CutPrefix(addr, "srv://"); ok {
+		_, srvs, err := net.DefaultResolver.LookupSRV(ctx, "", "", name)
+		if err != nil || len(srvs) == 0 {
+			return doctorResult{
+				detail: fmt.Sprintf("unable to look up the SRV record %q: %v", name, err),
+				hint:   "verify the SRV record name, ex: srv://_perseus._tcp.example.com, and your DNS/VPN settings",
+			}
+		}
+		scheme := "https"
+		if d.conf.disableTLS {
+			scheme = "http"
+		}
+		addr = scheme + "://" + net.JoinHostPort(strings.TrimSuffix(srvs[0].Target, "."), strconv.Itoa(int(srvs[0].Port)))
+		multiple = len(srvs) > 1
+	}
+	u, err := url.Parse(addr)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return doctorResult{
+			detail: fmt.Sprintf("the server address %q is not a valid URL", addr),
+			hint:   "the address must include the scheme, ex: https://perseus.example.com or http://localhost:31138",
+		}
+	}
+	if u.Scheme == "http" && !d.conf.disableTLS {
+		return doctorResult{
+			detail: fmt.Sprintf("the server address %q does not use TLS but --insecure was not specified", addr),
+			hint:   "use an https:// address or pass --insecure",
+		}
+	}
+	d.addr = u
+	if multiple {
+		return doctorResult{ok: true, detail: fmt.Sprintf("using server %s, the first of multiple replicas", addr)}
+	}
+	return doctorResult{ok: true, detail: "using server " + addr}
+}
+
+// checkReachability verifies that a TCP connection can be established to the server
+func (d *doctor) checkReachability(ctx context.Context) doctorResult {
+	if res, skip := d.serverUnavailable(); skip {
+		return res
+	}
+	hostPort := serverHostPort(d.addr)
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", hostPort)
+	if err != nil {
+		res := doctorResult{detail: fmt.Sprintf("unable to connect to %s: %v", hostPort, err)}
+		var dnsErr *net.DNSError
+		switch {
+		case errors.As(err, &dnsErr):
+			res.hint = "the host name could not be resolved, verify the server address and your DNS/VPN settings"
+		case errors.Is(err, context.DeadlineExceeded):
+			res.hint = "the connection timed out, verify that the server is running and that no firewall is blocking the port"
+		default:
+			res.hint = "verify that the server is running and listening on the configured port"
+		}
+		return res
+	}
+	_ = conn.Close()
+	return doctorResult{ok: true, detail: "connected to " + hostPort}
+}
+
+// checkTLS verifies that a TLS session can be established with the server
+func (d *doctor) checkTLS(ctx context.Context) doctorResult {
+	if res, skip := d.serverUnavailable(); skip {
+		return res
+	}
+	if d.addr.Scheme != "https" {
+		return doctorResult{skipped: true, detail: "TLS is disabled"}
+	}
+	dialer := tls.Dialer{Config: &tls.Config{MinVersion: tls.VersionTLS13, ServerName: d.addr.Hostname()}}
+	conn, err := dialer.DialContext(ctx, "tcp", serverHostPort(d.addr))
+	if err != nil {
+		res := doctorResult{detail: fmt.Sprintf("TLS handshake failed: %v", err)}
+		var certErr *tls.CertificateVerificationError
+		switch {
+		case errors.As(err, &certErr):
+			res.hint = "the server certificate is not trusted, verify the server address or install the issuing CA certificate"
+		default:
+			res.hint = "verify that the server has TLS enabled, supports TLS 1.3, or use an http:// address with --insecure if it does not use TLS"
+		}
+		return res
+	}
+	defer func() { _ = conn.Close() }()
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return doctorResult{ok: true, detail: "handshake succeeded"}
+	}
+	leaf := certs[0]
+	if until := time.Until(leaf.NotAfter); until < 14*24*time.Hour {
+		return doctorResult{ok: true, detail: fmt.Sprintf("handshake succeeded, but the certificate for %s expires on %s", leaf.Subject.CommonName, leaf.NotAfter.Format(time.DateOnly))}
+	}
+	return doctorResult{ok: true, detail: fmt.Sprintf("handshake succeeded, certificate for %s is valid until %s", leaf.Subject.CommonName, leaf.NotAfter.Format(time.DateOnly))}
+}
+
+// checkAuth verifies the configured client credentials.  The Perseus API does not currently require
+// authentication so there is nothing to verify.
+func (d *doctor) checkAuth(_ context.Context) doctorResult {
+	return doctorResult{skipped: true, detail: "the Perseus API does not require authentication"}
+}
+
+// checkAPI verifies that the server implements the Perseus API expected by this version of the CLI
+func (d *doctor) checkAPI(ctx context.Context) doctorResult {
+	if res, skip := d.serverUnavailable(); skip {
+		return res
+	}
+	ps, err := d.conf.getClient()
+	if err != nil {
+		return doctorResult{detail: err.Error()}
+	}
+	_, err = ps.API().ListModules(ctx, connect.NewRequest(&perseusapi.ListModulesRequest{Filter: doctorProbeModule, PageSize: 1}))
+	if err != nil {
+		res := doctorResult{detail: fmt.Sprintf("the API call failed: %v", err)}
+		switch connect.CodeOf(err) {
+		case connect.CodeUnimplemented, connect.CodeNotFound:
+			res.hint = "the server does not implement the Perseus API, verify the server address or upgrade the server"
+		case connect.CodeUnavailable:
+			res.hint = "the server is unable to process requests, check the server logs for errors"
+		default:
+			res.hint = "check the server logs for errors, using the request ID above if one was returned"
+		}
+		return res
+	}
+	return doctorResult{ok: true, detail: "the server responded to a ListModules request"}
+}
+
+// checkReadiness verifies that the server is able to connect to its database by calling the /readyz
+// endpoint
+func (d *doctor) checkReadiness(ctx context.Context) doctorResult {
+	if res, skip := d.serverUnavailable(); skip {
+		return res
+	}
+	hc := http.Client{}
+	if d.addr.Scheme == "https" {
+		hc.Transport = &http.Transport{TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS13}}
+	}
+	status, err := httpGetStatus(ctx, &hc, d.addr.JoinPath("/readyz").String())
+	if err != nil {
+		return doctorResult{detail: fmt.Sprintf("unable to call /readyz: %v", err)}
+	}
+	switch status {
+	case http.StatusOK:
+		return doctorResult{ok: true, detail: "the server is connected to its database"}
+	case http.StatusNotFound:
+		return doctorResult{
+			detail: "the server does not expose a /readyz endpoint",
+			hint:   "the server may be an older version or the address may be routed through a proxy that does not forward /readyz",
+		}
+	default:
+		return doctorResult{
+			detail: fmt.Sprintf("/readyz returned %d %s", status, http.StatusText(status)),
+			hint:   "the server cannot reach its database, check the server's database settings and logs",
+		}
+	}
+}
+
+// checkProxies verifies that each configured Go module proxy is reachable
+func (d *doctor) checkProxies(ctx context.Context) doctorResult {
+	hc := http.Client{}
+	urls := modproxy.NewFromEnv(&hc).URLs()
+	if len(urls) == 0 {
+		return doctorResult{
+			detail: "no Go module proxies are configured",
+			hint:   "set $GOPROXY to one or more proxy URLs, ex: https://proxy.golang.org",
+		}
+	}
+	for _, u := range urls {
+		status, err := httpGetStatus(ctx, &hc, u+"/"+doctorProbeModule+"/@v/list")
+		if err != nil {
+			return doctorResult{
+				detail: fmt.Sprintf("unable to reach %s: %v", u, err),
+				hint:   "verify $GOPROXY and any HTTP proxy settings ($HTTPS_PROXY, $NO_PROXY)",
+			}
+		}
+		if status != http.StatusOK {
+			return doctorResult{
+				detail: fmt.Sprintf("%s returned %d %s for %s", u, status, http.StatusText(status), doctorProbeModule),
+				hint:   "verify that $GOPROXY points to a Go module proxy and that any required credentials are configured in ~/.netrc",
+			}
+		}
+	}
+	return doctorResult{ok: true, detail: fmt.Sprintf("%d proxy(s) reachable: %v", len(urls), urls)}
+}
+
+// httpGetStatus issues an HTTP GET request for url and returns the response status code
+func httpGetStatus(ctx context.Context, hc *http.Client, url string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
+// serverHostPort returns the host and port of the server, applying the default port for the scheme if
+// none is specified.
+func serverHostPort(u *url.URL) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	if u.Scheme == "https" {
+		return net.JoinHostPort(u.Hostname(), "443")
+	}
+	return net.JoinHostPort(u.Hostname(), "80")
+}
+
+// cliConfigFileHint returns the location of the CLI configuration file for use in remediation hints
+func cliConfigFileHint() string {
+	path, err := cliConfigFilePath()
+	if err != nil {
+		return "the CLI configuration file"
+	}
+	return path
+}
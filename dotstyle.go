@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/module"
+)
+
+// dotTheme defines the colors used when generating DOT graphs
+type dotTheme struct {
+	// the background color of the graph
+	background string
+	// the background color of the rounded box that contains the nodes
+	cluster string
+	// the fill and font colors of the nodes
+	nodeFill, nodeFont string
+	// the color of the edges
+	edge string
+	// the fill and font colors of the root node when --dot-style highlight-root is specified
+	rootFill, rootFont string
+	// the outline color of vulnerable nodes when --dot-style mark-vulnerable is specified
+	vulnerable string
+	// the fill color of deprecated nodes when --dot-style mark-deprecated is specified
+	deprecated string
+}
+
+// the built-in DOT themes, keyed by name
+var dotThemes = map[string]dotTheme{
+	// the CrowdStrike palette, which is the default
+	"crowdstrike": {
+		background: "#414142",
+		cluster:    "#58595B",
+		nodeFill:   "#F3F3F4",
+		nodeFont:   "#58595B",
+		edge:       "#EC3525",
+		rootFill:   "#EC3525",
+		rootFont:   "#FFFFFF",
+		vulnerable: "#FFB81C",
+		deprecated: "#A7A9AC",
+	},
+	"light": {
+		background: "#FFFFFF",
+		cluster:    "#F5F5F5",
+		nodeFill:   "#FFFFFF",
+		nodeFont:   "#212121",
+		edge:       "#757575",
+		rootFill:   "#1E88E5",
+		rootFont:   "#FFFFFF",
+		vulnerable: "#E53935",
+		deprecated: "#E0E0E0",
+	},
+	"dark": {
+		background: "#121212",
+		cluster:    "#1E1E1E",
+		nodeFill:   "#2D2D2D",
+		nodeFont:   "#E0E0E0",
+		edge:       "#90CAF9",
+		rootFill:   "#90CAF9",
+		rootFont:   "#121212",
+		vulnerable: "#EF5350",
+		deprecated: "#4A4A4A",
+	},
+	// black and white, for printing
+	"mono": {
+		background: "#FFFFFF",
+		cluster:    "#FFFFFF",
+		nodeFill:   "#FFFFFF",
+		nodeFont:   "#000000",
+		edge:       "#000000",
+		rootFill:   "#000000",
+		rootFont:   "#FFFFFF",
+		vulnerable: "#000000",
+		deprecated: "#D9D9D9",
+	},
+}
+
+// the name of the theme that is used if none is specified
+const defaultDotTheme = "crowdstrike"
+
+// dotStyle is a [pflag.Value] that holds the options for the --dot-style CLI flag, which controls how
+// DOT graphs are drawn.  The value is a comma-separated list of options, and the flag can be repeated:
+//
+//   - theme=[name]: the color scheme, one of crowdstrike (the default), light, dark, or mono
+//   - hide-versions: label each node with only the module path
+//   - show-owners: add the owner of each module, ex: github.com/CrowdStrike, to its label
+//   - highlight-root: fill the node(s) for the queried module with the theme's accent color
+//   - mark-vulnerable: outline the module versions that are affected by security advisories
+//   - mark-deprecated: shade the modules whose source repositories are archived or abandoned
+type dotStyle struct {
+	// the name of the selected theme, "" for the default
+	theme string
+	// the options that were specified, in the order they were given
+	opts []string
+
+	hideVersions   bool
+	showOwners     bool
+	highlightRoot  bool
+	markVulnerable bool
+	markDeprecated bool
+}
+
+// String returns the string representation of the DOT style options
+func (s *dotStyle) String() string {
+	return strings.Join(s.opts, ",")
+}
+
+// Set parses the comma-separated options in v and adds them to the DOT style
+func (s *dotStyle) Set(v string) error {
+	for _, opt := range strings.Split(v, ",") {
+		opt = strings.TrimSpace(opt)
+		name, value, hasValue := strings.Cut(opt, "=")
+		switch name {
+		case "":
+			continue
+		case "theme":
+			if _, ok := dotThemes[value]; !ok {
+				return fmt.Errorf("invalid DOT theme %q, must be one of %s", value, strings.Join(sortedKeys(dotThemes), ", "))
+			}
+			s.theme = value
+		case "hide-versions", "show-owners", "highlight-root", "mark-vulnerable", "mark-deprecated":
+			if hasValue {
+				return fmt.Errorf("unexpected '=' in DOT style option %q", opt)
+			}
+			s.enable(name)
+		default:
+			return fmt.Errorf("invalid DOT style option %q, must be one of theme=..., hide-versions, show-owners, highlight-root, mark-vulnerable, or mark-deprecated", opt)
+		}
+		s.opts = append(s.opts, opt)
+	}
+	return nil
+}
+
+// enable turns on the boolean option with the specified name
+func (s *dotStyle) enable(name string) {
+	switch name {
+	case "hide-versions":
+		s.hideVersions = true
+	case "show-owners":
+		s.showOwners = true
+	case "highlight-root":
+		s.highlightRoot = true
+	case "mark-vulnerable":
+		s.markVulnerable = true
+	case "mark-deprecated":
+		s.markDeprecated = true
+	}
+}
+
+// Type returns a string description of the value type
+func (s *dotStyle) Type() string {
+	return "options"
+}
+
+// colors returns the selected theme
+func (s *dotStyle) colors() dotTheme {
+	if s.theme == "" {
+		return dotThemes[defaultDotTheme]
+	}
+	return dotThemes[s.theme]
+}
+
+// customizesNodes returns true if any of the options require the nodes to be declared individually rather
+// than implicitly by the edges
+func (s *dotStyle) customizesNodes() bool {
+	return s.hideVersions || s.showOwners || s.highlightRoot || s.markVulnerable || s.markDeprecated
+}
+
+// nodeAttrs returns the DOT attributes, with a leading space, for the node representing the specified module
+// version, or an empty string if the defaults apply.  isRoot indicates whether the node is one of the queried
+// modules, vulnerable whether it is affected by security advisories, and deprecated whether its source
+// repository is archived or abandoned.
+func (s *dotStyle) nodeAttrs(mod module.Version, isRoot, vulnerable, deprecated bool) string {
+	theme := s.colors()
+	var attrs []string
+	label := mod.String()
+	if s.hideVersions {
+		label = mod.Path
+	}
+	if s.showOwners {
+		label += "\n" + moduleOwner(mod.Path)
+	}
+	if label != mod.String() {
+		attrs = append(attrs, fmt.Sprintf("label=%q", label))
+	}
+
+	var styles []string
+	switch {
+	case s.highlightRoot && isRoot:
+		attrs = append(attrs, fmt.Sprintf("fillcolor=%q fontcolor=%q", theme.rootFill, theme.rootFont))
+	case s.markDeprecated && deprecated:
+		attrs = append(attrs, fmt.Sprintf("fillcolor=%q", theme.deprecated))
+		styles = append(styles, "dashed")
+	}
+	if s.markVulnerable && vulnerable {
+		attrs = append(attrs, fmt.Sprintf("color=%q penwidth=3", theme.vulnerable))
+		styles = append(styles, "bold")
+	}
+	if len(styles) > 0 {
+		attrs = append(attrs, fmt.Sprintf("style=%q", strings.Join(slices.Concat([]string{"rounded", "filled"}, styles), ",")))
+	}
+	if len(attrs) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(attrs, " ") + "]"
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/CrowdStrike/perseus/internal/headers"
+)
+
+// formatError renders err for display on the console.  If err is, or wraps, a [connect.Error] returned
+// by the Perseus server, any structured error details are included in the output.
+func formatError(err error) string {
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return err.Error() + "\nThe operation did not complete in time. Use --timeout to allow more time."
+		}
+		return err.Error()
+	}
+
+	var sb strings.Builder
+	sb.WriteString(err.Error())
+	for _, d := range cerr.Details() {
+		detail, derr := d.Value()
+		if derr != nil {
+			logger.Debug("unable to decode error detail", "type", d.Type(), "err", derr)
+			continue
+		}
+		switch detail := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range detail.GetFieldViolations() {
+				field := v.GetField()
+				if field == "" {
+					field = "(request)"
+				}
+				sb.WriteString(fmt.Sprintf("\n  - %s: %s", field, v.GetDescription()))
+			}
+		case *errdetails.RetryInfo:
+			sb.WriteString(fmt.Sprintf("\nThis is likely a transient failure on the server. Please retry after %s.", detail.GetRetryDelay().AsDuration()))
+		case *errdetails.ErrorInfo:
+			logger.Debug("server error info", "reason", detail.GetReason(), "domain", detail.GetDomain(), "metadata", detail.GetMetadata())
+		default:
+			logger.Debug("ignoring unsupported error detail", "type", d.Type())
+		}
+	}
+	if cerr.Code() == connect.CodeDeadlineExceeded {
+		sb.WriteString("\nThe operation did not complete in time. Use --timeout to allow more time.")
+	}
+	// include the request ID so that the failure can be correlated with the server logs
+	if id := cerr.Meta().Get(headers.RequestID); id != "" {
+		sb.WriteString("\n(request ID: " + id + ")")
+	}
+	return sb.String()
+}
@@ -0,0 +1,503 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const exportExampleUsage = `  # export the CrowdStrike modules as Backstage catalog entities
+  perseus export 'github.com/CrowdStrike/*' --format backstage -O catalog-info.yaml
+
+  # assign all of the exported components to a specific group and system
+  perseus export 'github.com/CrowdStrike/*' --owner group:default/platform --system go-libraries
+
+  # export the full graph as Cypher statements and load it into Neo4j
+  perseus export '*' --format cypher -O perseus.cypher
+  cypher-shell -u neo4j -f perseus.cypher
+
+  # stream every dependency edge in the graph as JSON Lines
+  perseus export '*' --format jsonl -O perseus-edges.jsonl`
+
+// the supported output formats of the 'export' CLI sub-command
+const (
+	exportFormatBackstage = "backstage"
+	exportFormatCypher    = "cypher"
+	exportFormatJSONL     = "jsonl"
+)
+
+// exportConcurrency is the number of module versions whose dependencies are retrieved at the same time when
+// exporting the graph
+const exportConcurrency = 4
+
+const (
+	// backstageAPIVersion is the Backstage catalog API version of the exported entities
+	backstageAPIVersion = "backstage.io/v1alpha1"
+	// backstageModuleAnnotation is the annotation that records the Go module path of an exported component
+	backstageModuleAnnotation = "perseus.crowdstrike.com/module-path"
+	// backstageVersionAnnotation is the annotation that records the latest known version of an exported
+	// component
+	backstageVersionAnnotation = "perseus.crowdstrike.com/latest-version"
+	// backstageMaxNameLen is the maximum length of a Backstage entity name
+	backstageMaxNameLen = 63
+)
+
+// createExportCommand initializes and returns a *cobra.Command that implements the 'export' CLI sub-command
+func createExportCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:          "export (module glob)",
+		Example:      exportExampleUsage,
+		Short:        "Exports the matching modules and their dependencies in a format understood by other tools",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runExportCmd,
+		SilenceUsage: true,
+	}
+	fset := cmd.Flags()
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
+	fset.StringVarP(&outputPath, "output", "O", "", "write the results to the specified file, which is replaced atomically, instead of stdout")
+	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.Duration("timeout", 0, "the maximum amount of time to wait for the Perseus server, ex: 30s or 2m (default is $PERSEUS_TIMEOUT environment variable or no limit)")
+	addRetryFlags(fset)
+	addConnectionFlags(fset)
+	fset.String("format", exportFormatBackstage, "the output format, either 'backstage' for Backstage catalog entities, 'cypher' for Neo4j Cypher statements, or 'jsonl' for a JSON object per dependency edge")
+	fset.Bool("latest-only", false, "only export the latest version of each module and its dependencies (cypher format only)")
+	fset.String("dependencies", "", "only export the edges to dependencies that match the specified glob pattern (jsonl format only)")
+	fset.Var(asOfValue{&asOf}, "as-of", "export the edges that were declared at the specified date or RFC 3339 timestamp, ex: 2024-06-01 (jsonl format only)")
+	fset.String("owner", "", "the Backstage entity reference of the owner of every exported component (default is derived from each module path, ex: CrowdStrike for github.com/CrowdStrike/perseus)")
+	fset.String("lifecycle", "production", "the Backstage lifecycle of every exported component")
+	fset.String("system", "", "the Backstage system that every exported component belongs to, if any")
+
+	return &cmd
+}
+
+// backstageEntity is the subset of a Backstage catalog entity generated by the 'export --format backstage'
+// CLI sub-command
+type backstageEntity struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   backstageMetadata `yaml:"metadata"`
+	Spec       backstageSpec     `yaml:"spec"`
+}
+
+// backstageMetadata is the metadata of a [backstageEntity]
+type backstageMetadata struct {
+	Name        string            `yaml:"name"`
+	Title       string            `yaml:"title,omitempty"`
+	Description string            `yaml:"description,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+	Tags        []string          `yaml:"tags,omitempty"`
+	Links       []backstageLink   `yaml:"links,omitempty"`
+}
+
+// backstageLink is an external link in the [backstageMetadata] of an entity
+type backstageLink struct {
+	URL   string `yaml:"url"`
+	Title string `yaml:"title,omitempty"`
+}
+
+// backstageSpec is the spec of a Component [backstageEntity]
+type backstageSpec struct {
+	Type      string   `yaml:"type"`
+	Lifecycle string   `yaml:"lifecycle"`
+	Owner     string   `yaml:"owner"`
+	System    string   `yaml:"system,omitempty"`
+	DependsOn []string `yaml:"dependsOn,omitempty"`
+}
+
+// runExportCmd implements the logic behind the 'export' CLI sub-command
+func runExportCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	format, _ := cmd.Flags().GetString("format")
+	switch format {
+	case exportFormatBackstage:
+		return runExportBackstage(cmd, conf, args[0])
+	case exportFormatCypher:
+		return runExportCypher(cmd, conf, args[0])
+	case exportFormatJSONL:
+		return runExportJSONL(cmd, conf, args[0])
+	default:
+		return fmt.Errorf("Invalid export format %q, must be one of %s, %s, %s", format, exportFormatBackstage, exportFormatCypher, exportFormatJSONL)
+	}
+}
+
+// runExportBackstage writes the modules matching filter as Backstage catalog entities, with dependsOn
+// relations between them
+func runExportBackstage(cmd *cobra.Command, conf clientConfig, filter string) error {
+	owner, _ := cmd.Flags().GetString("owner")
+	lifecycle, _ := cmd.Flags().GetString("lifecycle")
+	system, _ := cmd.Flags().GetString("system")
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	mods, err := listModules(ctx, ps, filter, updateSpinner)
+	if err != nil {
+		return err
+	}
+	if len(mods) == 0 {
+		return fmt.Errorf("No modules match %q", filter)
+	}
+	paths := make([]string, len(mods))
+	for i, m := range mods {
+		paths[i] = m.Path
+	}
+	names := backstageEntityNames(paths)
+
+	entities := make([]backstageEntity, 0, len(mods))
+	for _, m := range mods {
+		entity := newBackstageComponent(m.Path, names[m.Path])
+		entity.Spec.Lifecycle = lifecycle
+		entity.Spec.System = system
+		if owner != "" {
+			entity.Spec.Owner = owner
+		}
+		// modules without a known version have no dependencies to report
+		if m.Version != "-" {
+			entity.Metadata.Annotations[backstageVersionAnnotation] = m.Version
+			node, err := walkDependencies(ctx, ps, module.Version{Path: m.Path, Version: m.Version}, perseusapi.DependencyDirection_dependencies, 1, updateSpinner)
+			if err != nil {
+				return fmt.Errorf("Unable to retrieve the dependencies of %s: %w", m.Path, err)
+			}
+			// only dependencies on other exported modules are included since the others have no entity
+			for _, dep := range node.Deps {
+				if name, ok := names[dep.Module.Path]; ok && dep.Module.Path != m.Path {
+					entity.Spec.DependsOn = append(entity.Spec.DependsOn, "component:"+name)
+				}
+			}
+			slices.Sort(entity.Spec.DependsOn)
+			entity.Spec.DependsOn = slices.Compact(entity.Spec.DependsOn)
+		}
+		entities = append(entities, entity)
+	}
+	slices.SortFunc(entities, func(a, b backstageEntity) int {
+		return strings.Compare(a.Metadata.Name, b.Metadata.Name)
+	})
+	stopSpinner()
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(2)
+	for _, entity := range entities {
+		if err := enc.Encode(entity); err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("Error generating YAML output: %w", err)
+	}
+	return out.Commit()
+}
+
+// newBackstageComponent returns a Backstage Component entity for the Go module at path with the specified
+// entity name, owned by the module's owner as determined by [moduleOwner]
+func newBackstageComponent(path, name string) backstageEntity {
+	entity := backstageEntity{
+		APIVersion: backstageAPIVersion,
+		Kind:       "Component",
+		Metadata: backstageMetadata{
+			Name:        name,
+			Title:       path,
+			Description: "Go module " + path,
+			Annotations: map[string]string{backstageModuleAnnotation: path},
+			Tags:        []string{"go"},
+		},
+		Spec: backstageSpec{
+			Type:  "library",
+			Owner: backstageEntityName(modulePathBase(moduleOwner(path))),
+		},
+	}
+	if src := sourceURL(path); src != "" {
+		entity.Metadata.Annotations["backstage.io/source-location"] = "url:" + src + "/"
+		entity.Metadata.Links = append(entity.Metadata.Links, backstageLink{URL: src, Title: "Source"})
+		if slug, ok := strings.CutPrefix(src, "https://github.com/"); ok {
+			entity.Metadata.Annotations["github.com/project-slug"] = slug
+		}
+	}
+	if docs := docsURL(module.Version{Path: path}); docs != "" {
+		entity.Metadata.Links = append(entity.Metadata.Links, backstageLink{URL: docs, Title: "Documentation"})
+	}
+	return entity
+}
+
+// backstageEntityNames returns a unique Backstage entity name for each of the specified module paths, keyed
+// by path.  Names are derived from the paths without the leading host, ex: CrowdStrike-perseus-v2 for
+// github.com/CrowdStrike/perseus/v2, unless that would make 2 or more names the same, in which case the
+// full paths are used for those modules.
+func backstageEntityNames(paths []string) map[string]string {
+	names := make(map[string]string, len(paths))
+	counts := make(map[string]int, len(paths))
+	for _, p := range paths {
+		short := p
+		if _, rest, ok := strings.Cut(p, "/"); ok {
+			short = rest
+		}
+		names[p] = backstageEntityName(short)
+		counts[names[p]]++
+	}
+	for p, name := range names {
+		if counts[name] > 1 {
+			names[p] = backstageEntityName(p)
+		}
+	}
+	return names
+}
+
+// backstageEntityName converts s into a valid Backstage entity name, which is at most 63 letters, digits,
+// and single separators, by replacing each run of other characters with a '-'.  Longer names are truncated
+// and suffixed with a hash of s so that they remain unique.
+func backstageEntityName(s string) string {
+	var sb strings.Builder
+	sep := false
+	for _, r := range s {
+		switch {
+		case (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'):
+			if sep && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(r)
+			sep = false
+		default:
+			sep = true
+		}
+	}
+	name := sb.String()
+	if len(name) > backstageMaxNameLen {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(s))
+		name = strings.TrimRight(name[:backstageMaxNameLen-9], "-") + fmt.Sprintf("-%08x", h.Sum32())
+	}
+	return name
+}
+
+// modulePathBase returns the last element of the slash-separated path
+func modulePathBase(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// cypherGraph holds the module versions and dependency edges written by the 'export --format cypher' CLI
+// sub-command
+type cypherGraph struct {
+	mu       sync.Mutex
+	versions map[module.Version]struct{}
+	edges    map[[2]module.Version]struct{}
+}
+
+// runExportCypher writes the versions of the modules matching filter, their dependencies, and the
+// dependency edges between them as Cypher statements that can be run against a Neo4j database, ex: with
+// cypher-shell.  The statements use MERGE so that running them again, or running the output of overlapping
+// exports, does not create duplicate nodes or relationships.
+func runExportCypher(cmd *cobra.Command, conf clientConfig, filter string) error {
+	latestOnly, _ := cmd.Flags().GetBool("latest-only")
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	mods, err := listModuleVersions(ctx, ps, listModuleVersionsRequest{
+		modulePattern:     filter,
+		latestOnly:        latestOnly,
+		includePrerelease: true,
+		updateStatus:      updateSpinner,
+	})
+	if err != nil {
+		return err
+	}
+	if len(mods) == 0 {
+		return fmt.Errorf("No modules match %q", filter)
+	}
+
+	graph := cypherGraph{
+		versions: make(map[module.Version]struct{}, len(mods)),
+		edges:    make(map[[2]module.Version]struct{}),
+	}
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(exportConcurrency)
+	for _, m := range mods {
+		graph.versions[module.Version{Path: m.Path, Version: m.Version}] = struct{}{}
+	}
+	for _, m := range mods {
+		mod := module.Version{Path: m.Path, Version: m.Version}
+		eg.Go(func() error {
+			node, err := walkDependencies(ctx, ps, mod, perseusapi.DependencyDirection_dependencies, 1, updateSpinner)
+			if err != nil {
+				return fmt.Errorf("Unable to retrieve the dependencies of %s: %w", mod, err)
+			}
+			graph.mu.Lock()
+			defer graph.mu.Unlock()
+			for _, dep := range node.Deps {
+				graph.versions[dep.Module] = struct{}{}
+				graph.edges[[2]module.Version{mod, dep.Module}] = struct{}{}
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	stopSpinner()
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	writeCypher(out, &graph)
+	return out.Commit()
+}
+
+// writeCypher writes the Cypher statements that create the uniqueness constraints, a :Module node for each
+// module, a :ModuleVersion node linked to its module by a :HAS_VERSION relationship for each version, and a
+// :DEPENDS_ON relationship for each dependency edge in graph, in a stable order.
+func writeCypher(w io.Writer, graph *cypherGraph) {
+	fmt.Fprintln(w, "CREATE CONSTRAINT perseus_module_path IF NOT EXISTS FOR (m:Module) REQUIRE m.path IS UNIQUE;")
+	fmt.Fprintln(w, "CREATE CONSTRAINT perseus_module_version_id IF NOT EXISTS FOR (v:ModuleVersion) REQUIRE v.id IS UNIQUE;")
+
+	versions := make([]module.Version, 0, len(graph.versions))
+	for v := range graph.versions {
+		versions = append(versions, v)
+	}
+	slices.SortFunc(versions, compareModuleVersions)
+	for _, v := range versions {
+		fmt.Fprintf(w, "MERGE (m:Module {path: %s}) MERGE (v:ModuleVersion {id: %s}) SET v.path = %s, v.version = %s MERGE (m)-[:HAS_VERSION]->(v);\n",
+			cypherString(v.Path), cypherString(v.String()), cypherString(v.Path), cypherString(v.Version))
+	}
+
+	edges := make([][2]module.Version, 0, len(graph.edges))
+	for e := range graph.edges {
+		edges = append(edges, e)
+	}
+	slices.SortFunc(edges, func(a, b [2]module.Version) int {
+		if c := compareModuleVersions(a[0], b[0]); c != 0 {
+			return c
+		}
+		return compareModuleVersions(a[1], b[1])
+	})
+	for _, e := range edges {
+		fmt.Fprintf(w, "MATCH (a:ModuleVersion {id: %s}), (b:ModuleVersion {id: %s}) MERGE (a)-[:DEPENDS_ON]->(b);\n",
+			cypherString(e[0].String()), cypherString(e[1].String()))
+	}
+}
+
+// compareModuleVersions orders module versions by path and then by semantic version
+func compareModuleVersions(a, b module.Version) int {
+	if c := strings.Compare(a.Path, b.Path); c != 0 {
+		return c
+	}
+	return semver.Compare(a.Version, b.Version)
+}
+
+// cypherString returns s as a single-quoted Cypher string literal
+func cypherString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// edgeRecord is a single line written by the 'export --format jsonl' CLI sub-command
+type edgeRecord struct {
+	Module            string `json:"module"`
+	Version           string `json:"version"`
+	Dependency        string `json:"dependency"`
+	DependencyVersion string `json:"dependencyVersion"`
+}
+
+// runExportJSONL streams the dependency edges of the modules matching filter from the server and writes
+// each one as a JSON object on its own line, so the size of the graph is not limited by client memory
+func runExportJSONL(cmd *cobra.Command, conf clientConfig, filter string) error {
+	depFilter, _ := cmd.Flags().GetString("dependencies")
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("streaming dependency edges")
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if err := requireFeature(ctx, ps, client.FeatureStreamEdges, "streaming the dependency edges"); err != nil {
+		return err
+	}
+	req := perseusapi.StreamEdgesRequest{
+		ModuleFilter:     filter,
+		DependencyFilter: depFilter,
+	}
+	if !asOf.IsZero() {
+		req.AsOf = timestamppb.New(asOf)
+	}
+	stream, err := ps.API().StreamEdges(ctx, connect.NewRequest(&req))
+	if err != nil {
+		return fmt.Errorf("Unable to stream the dependency edges: %w", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	enc := json.NewEncoder(out)
+	count := 0
+	for stream.Receive() {
+		msg := stream.Msg()
+		rec := edgeRecord{
+			Module:     msg.GetModule().GetName(),
+			Dependency: msg.GetDependency().GetName(),
+		}
+		if vers := msg.GetModule().GetVersions(); len(vers) > 0 {
+			rec.Version = vers[0]
+		}
+		if vers := msg.GetDependency().GetVersions(); len(vers) > 0 {
+			rec.DependencyVersion = vers[0]
+		}
+		if err := enc.Encode(rec); err != nil {
+			return fmt.Errorf("Error writing JSON output: %w", err)
+		}
+		count++
+		if count%1000 == 0 {
+			updateSpinner(fmt.Sprintf("streamed %d dependency edges", count))
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("Unable to stream the dependency edges: %w", err)
+	}
+	stopSpinner()
+	if count == 0 {
+		infof("no dependency edges found matching %q\n", filter)
+	}
+	return out.Commit()
+}
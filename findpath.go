@@ -11,8 +11,9 @@ import (
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	"golang.org/x/mod/module"
+	"gopkg.in/yaml.v3"
 
-	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+	"github.com/CrowdStrike/perseus/client"
 )
 
 const findPathsExampleUsage = `# find any path between the latest version of github.com/example/foo and any version of gRPC
@@ -20,7 +21,7 @@ const findPathsExampleUsage = `# find any path between the latest version of git
 perseus find-paths github.com/example/foo google.golang.org/grpc
 
 # same, but output JSON
-perseus find-paths github.com/example/foo google.golang.org/grpc --json
+perseus find-paths github.com/example/foo google.golang.org/grpc -o json
 
 # find all paths between the latest version of github.com/example/foo and v1.43.0 of gRPC
 # and output the result as a tree
@@ -28,7 +29,11 @@ perseus find-paths github.com/example/foo google.golang.org/grpc@v1.43.0 --all
 
 # find all paths between v1.0.0 of github.com/example/foo and any version of gRPC
 # and output the results as line-delimited JSON
-perseus find-paths github.com/example/foo@v1.0.0 google.golang.org/grpc --all --json`
+perseus find-paths github.com/example/foo@v1.0.0 google.golang.org/grpc --all -o json
+
+# find all paths between the latest version of github.com/example/foo and any version of gRPC
+# and output the results as YAML
+perseus find-paths github.com/example/foo google.golang.org/grpc --all -o yaml`
 
 // createFindPathsCommand creates and returns a *cobra.Command that implements the 'find-paths' CLI command
 func createFindPathsCommand() *cobra.Command {
@@ -41,11 +46,16 @@ func createFindPathsCommand() *cobra.Command {
 		SilenceUsage: true,
 	}
 	fset := cmd.Flags()
-	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the TCP host and port of the Perseus server (default is $PERSEUS_SERVER_ADDR environment variable)")
-	fset.BoolVar(&formatAsJSON, "json", false, "specifies that the output should be formatted as line-delimited JSON")
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
+	addOutputFormatFlags(fset, "specifies the output format, one of 'table' (a textual tree, the default), 'json' (line-delimited JSON), or 'yaml'", "json")
+	fset.StringVarP(&outputPath, "output", "O", "", "write the results to the specified file, which is replaced atomically, instead of stdout")
 	fset.Bool("all", false, "Return all paths between the two modules")
-	fset.IntVar(&maxDepth, "max-depth", 4, "specifies the maximum number of levels to be returned")
+	fset.IntVar(&maxDepth, "max-depth", 4, "specifies the maximum number of levels to be searched, 0 for no limit")
 	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.Duration("timeout", 0, "the maximum amount of time to wait for the Perseus server, ex: 30s or 2m (default is $PERSEUS_TIMEOUT environment variable or no limit)")
+	addRetryFlags(fset)
+	addConnectionFlags(fset)
 
 	return &cmd
 }
@@ -64,15 +74,25 @@ func runFindPathsCommand(cmd *cobra.Command, args []string) (err error) {
 	default:
 		return fmt.Errorf("Only 2 positional arguments, the 'from' and 'to' modules, are supported")
 	}
+	format, err := outputFmt.resolve(conf.outputFormat, outputTable, outputJSON, outputYAML)
+	if err != nil {
+		return err
+	}
+	if maxDepth < 0 {
+		return fmt.Errorf("The --max-depth flag must be 0, for no limit, or greater")
+	}
 
 	updateSpinner, stopSpinner := startSpinner()
 	defer stopSpinner()
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := conf.newContext()
 	defer cancel()
 
 	updateSpinner("connecting to the server at " + conf.serverAddr)
-	ps := conf.getClient()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
 
 	// validate the 'from' and 'to' modules, defaulting to the highest known release for 'from'
 	// if no version is specified
@@ -97,16 +117,28 @@ func runFindPathsCommand(cmd *cobra.Command, args []string) (err error) {
 		if err != nil {
 			return
 		}
-		if formatAsJSON {
-			printJSONLinesTo(os.Stdout, paths)
-		} else {
-			printTreeTo(os.Stdout, paths)
+		out, oerr := newResultWriter(outputPath)
+		if oerr != nil {
+			err = oerr
+			return
+		}
+		defer out.Abort()
+		switch format {
+		case outputJSON:
+			printJSONLinesTo(out, paths)
+		case outputYAML:
+			err = printYAMLTo(out, paths)
+		default:
+			printTreeTo(out, paths)
+		}
+		if err == nil {
+			err = out.Commit()
 		}
 	}()
 	for p := range pf.findPathsBetween(ctx, from, to) {
 		if p.err != nil {
 			// context cancellation is not a failure
-			if errors.Is(p.err, context.Canceled) || connect.CodeOf(err) == connect.CodeCanceled {
+			if errors.Is(p.err, context.Canceled) || connect.CodeOf(p.err) == connect.CodeCanceled {
 				return nil
 			}
 			return p.err
@@ -148,9 +180,27 @@ func printJSONLinesTo(w io.Writer, paths [][]module.Version) {
 	}
 }
 
+// printYAMLTo writes the provided list of dependency paths to w as a YAML sequence.  Each path is a
+// sequence of "[name]@[version]" strings, starting with the 'from' module.
+func printYAMLTo(w io.Writer, paths [][]module.Version) error {
+	out := make([][]string, 0, len(paths))
+	for _, p := range paths {
+		names := make([]string, 0, len(p))
+		for _, pp := range p {
+			names = append(names, pp.String())
+		}
+		out = append(out, names)
+	}
+	enc := yaml.NewEncoder(w)
+	if err := enc.Encode(out); err != nil {
+		return fmt.Errorf("Error generating YAML output: %w", err)
+	}
+	return enc.Close()
+}
+
 // parseModuleArg parses the provided string as a Go module path, optionally with a version, and returns
 // the parsed result.  If no version is specified, the highest known version is used.
-func parseModuleArg(ctx context.Context, arg string, client perseusapiconnect.PerseusServiceClient, findLatest bool, status func(string)) (module.Version, error) {
+func parseModuleArg(ctx context.Context, arg string, ps *client.Client, findLatest bool, status func(string)) (module.Version, error) {
 	defer status("")
 	var m module.Version
 	toks := strings.Split(arg, "@")
@@ -168,7 +218,7 @@ func parseModuleArg(ctx context.Context, arg string, client perseusapiconnect.Pe
 	}
 	if m.Version == "" && findLatest {
 		status("determining current version for " + m.String())
-		v, err := lookupLatestModuleVersion(ctx, client, m.Path)
+		v, err := ps.LatestVersion(ctx, m.Path, asOf)
 		if err != nil {
 			return module.Version{}, fmt.Errorf("Unable to determine the current version for %q: %w", m.Path, err)
 		}
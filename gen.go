@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const genRenovateExampleUsage = `  # generate a Renovate config that groups the CrowdStrike modules and prioritizes critical libraries
+  perseus gen renovate 'github.com/CrowdStrike/*' -O renovate.json
+
+  # only prioritize the 5 most important external modules
+  perseus gen renovate 'github.com/CrowdStrike/*' --top 5`
+
+const genDependabotExampleUsage = `  # generate a Dependabot config that groups the CrowdStrike modules by owner
+  perseus gen dependabot 'github.com/CrowdStrike/*' -O .github/dependabot.yml`
+
+// the Renovate PR priorities assigned to internal modules and to the most important external modules
+const (
+	renovateInternalPriority = 10
+	renovateCriticalPriority = 5
+)
+
+// hosts whose module paths start with host/owner, which is used to group internal modules by owner
+var ownerHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// createGenCommand initializes and returns a *cobra.Command that implements the 'gen' CLI sub-command
+func createGenCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:          "gen ...",
+		Short:        "Generates configuration for other tools from the Perseus graph",
+		SilenceUsage: true,
+	}
+	fset := cmd.PersistentFlags()
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
+	fset.StringVarP(&outputPath, "output", "O", "", "write the results to the specified file, which is replaced atomically, instead of stdout")
+	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.Duration("timeout", 0, "the maximum amount of time to wait for the Perseus server, ex: 30s or 2m (default is $PERSEUS_TIMEOUT environment variable or no limit)")
+	addRetryFlags(fset)
+	addConnectionFlags(fset)
+
+	renovateCmd := cobra.Command{
+		Use:          "renovate (internal module glob)",
+		Example:      genRenovateExampleUsage,
+		Short:        "Outputs a Renovate configuration that groups internal modules by owner and prioritizes the most important modules",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runGenRenovateCmd,
+		SilenceUsage: true,
+	}
+	renovateCmd.Flags().Int("top", 20, "the number of the most important external modules, by importance score, whose updates should be prioritized")
+	cmd.AddCommand(&renovateCmd)
+
+	dependabotCmd := cobra.Command{
+		Use:          "dependabot (internal module glob)",
+		Example:      genDependabotExampleUsage,
+		Short:        "Outputs a Dependabot configuration that groups internal modules by owner",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runGenDependabotCmd,
+		SilenceUsage: true,
+	}
+	dependabotCmd.Flags().String("directory", "/", "the location of the go.mod file within the repository")
+	dependabotCmd.Flags().String("interval", "weekly", "how often Dependabot should check for updates, either 'daily', 'weekly' or 'monthly'")
+	cmd.AddCommand(&dependabotCmd)
+
+	return &cmd
+}
+
+// renovateConfig is the subset of the Renovate configuration generated by the 'gen renovate' CLI sub-command
+type renovateConfig struct {
+	Schema       string                `json:"$schema"`
+	Extends      []string              `json:"extends"`
+	PackageRules []renovatePackageRule `json:"packageRules"`
+}
+
+// renovatePackageRule is a single entry in the packageRules of a [renovateConfig]
+type renovatePackageRule struct {
+	Description       string   `json:"description"`
+	MatchDatasources  []string `json:"matchDatasources"`
+	MatchPackageNames []string `json:"matchPackageNames"`
+	GroupName         string   `json:"groupName,omitempty"`
+	PRPriority        int      `json:"prPriority"`
+}
+
+// dependabotConfig is the subset of the Dependabot configuration generated by the 'gen dependabot' CLI
+// sub-command
+type dependabotConfig struct {
+	Version int                `yaml:"version"`
+	Updates []dependabotUpdate `yaml:"updates"`
+}
+
+// dependabotUpdate is a single entry in the updates of a [dependabotConfig]
+type dependabotUpdate struct {
+	PackageEcosystem string                     `yaml:"package-ecosystem"`
+	Directory        string                     `yaml:"directory"`
+	Schedule         map[string]string          `yaml:"schedule"`
+	Groups           map[string]dependabotGroup `yaml:"groups,omitempty"`
+}
+
+// dependabotGroup is a named group of dependencies in a [dependabotUpdate]
+type dependabotGroup struct {
+	Patterns []string `yaml:"patterns"`
+}
+
+// runGenRenovateCmd implements the logic behind the 'gen renovate' CLI sub-command
+func runGenRenovateCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	top, _ := cmd.Flags().GetInt("top")
+	if top < 0 || top > 1000 {
+		return fmt.Errorf("The --top flag must be between 0 and 1000")
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	owners, err := listInternalModulesByOwner(ctx, ps, args[0], updateSpinner)
+	if err != nil {
+		return err
+	}
+	config := renovateConfig{
+		Schema:  "https://docs.renovatebot.com/renovate-schema.json",
+		Extends: []string{"config:recommended"},
+	}
+	for _, owner := range sortedKeys(owners) {
+		config.PackageRules = append(config.PackageRules, renovatePackageRule{
+			Description:       "update the modules owned by " + owner + " together, before anything else",
+			MatchDatasources:  []string{"go"},
+			MatchPackageNames: owners[owner],
+			GroupName:         owner + " modules",
+			PRPriority:        renovateInternalPriority,
+		})
+	}
+	if top > 0 {
+		updateSpinner("ranking modules")
+		resp, err := ps.API().ListTopModules(ctx, connect.NewRequest(&perseusapi.ListTopModulesRequest{
+			By:    "importance",
+			Limit: int32(top), //nolint: gosec // validated above
+		}))
+		if err != nil {
+			return fmt.Errorf("Unable to rank the modules: %w", err)
+		}
+		var critical []string
+		for _, m := range resp.Msg.GetModules() {
+			if !slices.Contains(owners[moduleOwner(m.GetName())], m.GetName()) {
+				critical = append(critical, m.GetName())
+			}
+		}
+		if len(critical) > 0 {
+			config.PackageRules = append(config.PackageRules, renovatePackageRule{
+				Description:       "prioritize updates to the most important external modules in the Perseus graph",
+				MatchDatasources:  []string{"go"},
+				MatchPackageNames: critical,
+				PRPriority:        renovateCriticalPriority,
+			})
+		}
+	}
+	stopSpinner()
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	output, _ := json.MarshalIndent(config, "", "  ")
+	_, _ = out.Write(output)
+	fmt.Fprintln(out)
+	return out.Commit()
+}
+
+// runGenDependabotCmd implements the logic behind the 'gen dependabot' CLI sub-command
+func runGenDependabotCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	directory, _ := cmd.Flags().GetString("directory")
+	interval, _ := cmd.Flags().GetString("interval")
+	if !slices.Contains([]string{"daily", "weekly", "monthly"}, interval) {
+		return fmt.Errorf("Invalid interval %q, must be one of daily, weekly, monthly", interval)
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	owners, err := listInternalModulesByOwner(ctx, ps, args[0], updateSpinner)
+	if err != nil {
+		return err
+	}
+	stopSpinner()
+	update := dependabotUpdate{
+		PackageEcosystem: "gomod",
+		Directory:        directory,
+		Schedule:         map[string]string{"interval": interval},
+		Groups:           make(map[string]dependabotGroup, len(owners)),
+	}
+	for owner, mods := range owners {
+		// Dependabot group names may only contain letters, digits, '-' and '_'
+		name := strings.NewReplacer(".", "-", "/", "-").Replace(owner)
+		update.Groups[name] = dependabotGroup{Patterns: mods}
+	}
+	config := dependabotConfig{Version: 2, Updates: []dependabotUpdate{update}}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	output, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("Error generating YAML output: %w", err)
+	}
+	_, _ = out.Write(output)
+	return out.Commit()
+}
+
+// listInternalModulesByOwner returns the modules matching filter grouped by their owner, as determined by
+// [moduleOwner], with each group sorted by module path
+func listInternalModulesByOwner(ctx context.Context, ps *client.Client, filter string, status func(string)) (map[string][]string, error) {
+	mods, err := listModules(ctx, ps, filter, status)
+	if err != nil {
+		return nil, err
+	}
+	if len(mods) == 0 {
+		return nil, fmt.Errorf("No modules match %q", filter)
+	}
+	owners := make(map[string][]string)
+	for _, m := range mods {
+		owner := moduleOwner(m.Path)
+		owners[owner] = append(owners[owner], m.Path)
+	}
+	for _, paths := range owners {
+		slices.Sort(paths)
+	}
+	return owners, nil
+}
+
+// moduleOwner returns the part of the module path that identifies who owns the module, which is the
+// host and organization for well-known code hosts, ex: github.com/CrowdStrike, and the host otherwise
+func moduleOwner(path string) string {
+	parts := strings.SplitN(path, "/", 3)
+	if len(parts) > 1 && slices.Contains(ownerHosts, parts[0]) {
+		return parts[0] + "/" + parts[1]
+	}
+	return parts[0]
+}
+
+// sortedKeys returns the keys of m in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
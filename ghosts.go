@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryGhostsExampleUsage = `  # list the module versions whose tags were deleted or that have been retracted
+  perseus query ghosts -o table
+
+  # only consider modules from a single organization and only the versions that are still depended on
+  perseus query ghosts 'github.com/example/*' --in-use`
+
+// ghostVersion defines the information returned by the 'query ghosts' CLI sub-command for each module
+// version that can no longer be used
+type ghostVersion struct {
+	// the module path, ex: github.com/CrowdStrike/perseus
+	Path string `yaml:"path"`
+	// the module version, ex: v1.11.38
+	Version string `yaml:"version"`
+	// why the version is unavailable, either "missing" or "retracted"
+	Reason string `yaml:"reason"`
+	// when the server first found that the version is unavailable
+	Since time.Time `yaml:"since"`
+	// the number of module versions that currently depend directly on the version
+	Dependents int `yaml:"dependents"`
+}
+
+// runQueryGhostsCmd implements the logic behind the 'query ghosts' CLI sub-command
+func runQueryGhostsCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	filter := "*"
+	if len(args) > 0 {
+		filter = args[0]
+	}
+	inUse, _ := cmd.Flags().GetBool("in-use")
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("querying unavailable module versions")
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if err := requireFeature(ctx, ps, client.FeatureGhostVersions, "querying unavailable module versions"); err != nil {
+		return err
+	}
+	resp, err := ps.API().ListGhostVersions(ctx, connect.NewRequest(&perseusapi.ListGhostVersionsRequest{
+		ModuleFilter: filter,
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to query the unavailable module versions: %w", err)
+	}
+	results := make([]ghostVersion, 0, len(resp.Msg.GetVersions()))
+	for _, v := range resp.Msg.GetVersions() {
+		if inUse && v.GetDependents() == 0 {
+			continue
+		}
+		results = append(results, ghostVersion{
+			Path:       v.GetModule().GetName(),
+			Version:    v.GetModule().GetVersions()[0],
+			Reason:     v.GetReason(),
+			Since:      v.GetSince().AsTime(),
+			Dependents: int(v.GetDependents()),
+		})
+	}
+	if len(results) == 0 {
+		infof("no unavailable versions found for modules matching %q\n", filter)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeGhosts(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// writeGhosts writes the contents of results to the provided io.Writer in the specified format
+func writeGhosts(w io.Writer, format string, results []ghostVersion) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Module\tVersion\tReason\tSince\tDependents\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, v := range results {
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\n", v.Path, v.Version, v.Reason, v.Since.Local().Format(time.DateOnly), v.Dependents); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryGraphDiffExampleUsage = `  # summarize the dependency churn of CrowdStrike modules in the first half of 2024
+  perseus query graph-diff --from 2024-01-01 --to 2024-07-01 'github.com/CrowdStrike/*' -o table
+
+  # report every change to the graph since the start of the year as JSON
+  perseus query graph-diff --from 2024-01-01`
+
+// graphDiffResult defines the information returned by the 'query graph-diff' CLI sub-command
+type graphDiffResult struct {
+	From time.Time `yaml:"from"`
+	To   time.Time `yaml:"to"`
+	// the modules that were and were no longer part of at least 1 dependency edge
+	AddedModules   []string `yaml:"addedModules"`
+	RemovedModules []string `yaml:"removedModules"`
+	// the dependency edges that were added and removed
+	AddedEdges   []graphDiffEdge `yaml:"addedEdges"`
+	RemovedEdges []graphDiffEdge `yaml:"removedEdges"`
+}
+
+// graphDiffEdge is a direct dependency of one module version on another
+type graphDiffEdge struct {
+	Module            string `yaml:"module"`
+	Version           string `yaml:"version"`
+	Dependency        string `yaml:"dependency"`
+	DependencyVersion string `yaml:"dependencyVersion"`
+}
+
+// runQueryGraphDiffCmd implements the logic behind the 'query graph-diff' CLI sub-command
+func runQueryGraphDiffCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	var filter string
+	if len(args) > 0 {
+		filter = args[0]
+	}
+	if diffFrom.IsZero() {
+		return fmt.Errorf("The start of the window must be specified with --from")
+	}
+	to := diffTo
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if !diffFrom.Before(to) {
+		return fmt.Errorf("The --from date must be before the --to date")
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("comparing the graph")
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().DiffGraph(ctx, connect.NewRequest(&perseusapi.DiffGraphRequest{
+		ModuleFilter: filter,
+		FromTime:     timestamppb.New(diffFrom),
+		ToTime:       timestamppb.New(to),
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to compare the graph: %w", err)
+	}
+	result := graphDiffResult{
+		From:           diffFrom,
+		To:             to,
+		AddedModules:   resp.Msg.GetAddedModules(),
+		RemovedModules: resp.Msg.GetRemovedModules(),
+		AddedEdges:     fromAPIEdges(resp.Msg.GetAddedEdges()),
+		RemovedEdges:   fromAPIEdges(resp.Msg.GetRemovedEdges()),
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeGraphDiff(out, format, result); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// fromAPIEdges converts the dependency edges returned by the Perseus API to CLI results
+func fromAPIEdges(edges []*perseusapi.DiffGraphResponse_Edge) []graphDiffEdge {
+	res := make([]graphDiffEdge, 0, len(edges))
+	for _, e := range edges {
+		edge := graphDiffEdge{
+			Module:     e.GetModule().GetName(),
+			Dependency: e.GetDependency().GetName(),
+		}
+		if vers := e.GetModule().GetVersions(); len(vers) > 0 {
+			edge.Version = vers[0]
+		}
+		if vers := e.GetDependency().GetVersions(); len(vers) > 0 {
+			edge.DependencyVersion = vers[0]
+		}
+		res = append(res, edge)
+	}
+	return res
+}
+
+// writeGraphDiff writes result to the provided io.Writer in the specified format.  The table format is a
+// human-readable summary followed by the individual changes.
+func writeGraphDiff(w io.Writer, format string, result graphDiffResult) error {
+	switch format {
+	case outputTable:
+		fmt.Fprintf(w, "Changes from %s to %s\n", result.From.Format(time.RFC3339), result.To.Format(time.RFC3339))
+		fmt.Fprintf(w, "  modules:      %d added, %d removed\n", len(result.AddedModules), len(result.RemovedModules))
+		fmt.Fprintf(w, "  dependencies: %d added, %d removed\n", len(result.AddedEdges), len(result.RemovedEdges))
+		for _, section := range []struct {
+			title, marker string
+			names         []string
+		}{
+			{"Added modules", "+", result.AddedModules},
+			{"Removed modules", "-", result.RemovedModules},
+		} {
+			if len(section.names) == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "\n%s:\n", section.title)
+			for _, n := range section.names {
+				fmt.Fprintf(w, "  %s %s\n", section.marker, n)
+			}
+		}
+		for _, section := range []struct {
+			title, marker string
+			edges         []graphDiffEdge
+		}{
+			{"Added dependencies", "+", result.AddedEdges},
+			{"Removed dependencies", "-", result.RemovedEdges},
+		} {
+			if len(section.edges) == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "\n%s:\n", section.title)
+			for _, e := range section.edges {
+				fmt.Fprintf(w, "  %s %s@%s -> %s@%s\n", section.marker, e.Module, e.Version, e.Dependency, e.DependencyVersion)
+			}
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(result)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
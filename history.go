@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryHistoryExampleUsage = `  # show when each dependency of a Perseus release was added and, if it was dropped, removed
+  perseus query history github.com/CrowdStrike/perseus@v0.22.0 -f table`
+
+// dependencyHistoryItem defines the information returned by the 'query history' CLI sub-command for
+// each direct dependency
+type dependencyHistoryItem struct {
+	// the module path, ex: github.com/CrowdStrike/perseus
+	Path string `yaml:"path"`
+	// the module version, ex: v1.11.38
+	Version string `yaml:"version"`
+	// when the dependency was first and most recently declared
+	FirstSeen time.Time `yaml:"firstSeen"`
+	LastSeen  time.Time `yaml:"lastSeen"`
+	// when the dependency was removed, nil if it is still declared
+	RemovedAt *time.Time `json:",omitempty" yaml:"removedAt,omitempty"`
+}
+
+// runQueryHistoryCmd implements the logic behind the 'query history' CLI sub-command
+func runQueryHistoryCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 1 {
+		return fmt.Errorf("The module name and version must be provided")
+	}
+	modPath, modVer, _ := strings.Cut(args[0], "@")
+	if err := module.CheckPath(modPath); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", modPath, err)
+	}
+	if !semver.IsValid(modVer) {
+		return fmt.Errorf("A valid module version must be specified, ex: %s@v1.2.3", modPath)
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().GetDependencyHistory(ctx, connect.NewRequest(&perseusapi.GetDependencyHistoryRequest{
+		ModuleName: modPath,
+		Version:    modVer,
+	}))
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve the dependency history of %s: %w", args[0], err)
+	}
+	results := make([]dependencyHistoryItem, 0, len(resp.Msg.GetDependencies()))
+	for _, d := range resp.Msg.GetDependencies() {
+		item := dependencyHistoryItem{
+			Path:      d.GetModule().GetName(),
+			FirstSeen: d.GetFirstSeenTime().AsTime(),
+			LastSeen:  d.GetLastSeenTime().AsTime(),
+		}
+		if vers := d.GetModule().GetVersions(); len(vers) > 0 {
+			item.Version = vers[0]
+		}
+		if d.RemoveTime != nil {
+			removed := d.GetRemoveTime().AsTime()
+			item.RemovedAt = &removed
+		}
+		results = append(results, item)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeHistoryResults(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// writeHistoryResults writes the contents of results to the provided io.Writer in the specified format
+func writeHistoryResults(w io.Writer, format string, results []dependencyHistoryItem) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Module\tVersion\tFirst Seen\tLast Seen\tRemoved\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, e := range results {
+			removed := "-"
+			if e.RemovedAt != nil {
+				removed = e.RemovedAt.Local().Format(time.DateTime)
+			}
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", e.Path, e.Version,
+				e.FirstSeen.Local().Format(time.DateTime), e.LastSeen.Local().Format(time.DateTime), removed); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
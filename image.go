@@ -0,0 +1,105 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"debug/buildinfo"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"golang.org/x/mod/module"
+)
+
+// maxImageBinarySize is the size of the largest file in a container image that will be inspected for
+// Go build info.  Larger files are skipped to bound memory usage.
+const maxImageBinarySize = 512 << 20
+
+// getModuleInfosFromImage locates the Go binaries in the specified container image and returns the
+// module info for each, as described by [getModuleInfoFromBinary].  The image can be either the path to
+// a tarball created by 'docker save' or a reference to an image in a registry, ex: ghcr.io/example/app:v1.2.3.
+// Registry credentials are read from the Docker config file, just as the docker CLI does.
+//
+// Binaries without a valid module version are skipped unless a version was specified using --version.
+// Each module version is returned once, even if it is contained in multiple binaries.
+func getModuleInfosFromImage(image string) ([]moduleInfo, error) {
+	img, err := loadImage(image)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := mutate.Extract(img)
+	defer func() { _ = rc.Close() }()
+
+	var (
+		infos []moduleInfo
+		seen  = map[module.Version]struct{}{}
+		tr    = tar.NewReader(rc)
+		buf   bytes.Buffer
+	)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read the file system of image %s: %w", image, err)
+		}
+		// only regular, executable files can be Go binaries
+		if hdr.Typeflag != tar.TypeReg || hdr.Mode&0o111 == 0 || hdr.Size == 0 {
+			continue
+		}
+		if hdr.Size > maxImageBinarySize {
+			logger.Debug("skipping large file in container image", "path", hdr.Name, "size", hdr.Size)
+			continue
+		}
+		buf.Reset()
+		if _, err := io.Copy(&buf, tr); err != nil {
+			return nil, fmt.Errorf("Unable to read %s from image %s: %w", hdr.Name, image, err)
+		}
+		bi, err := buildinfo.Read(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			// not a Go binary
+			continue
+		}
+		info, err := moduleInfoFromBuildInfo(bi, hdr.Name)
+		if err != nil {
+			infof("skipping %s: %v\n", hdr.Name, err)
+			continue
+		}
+		if info.Name == "" {
+			continue
+		}
+		mod := module.Version{Path: info.Name, Version: info.Version}
+		if _, found := seen[mod]; found {
+			continue
+		}
+		seen[mod] = struct{}{}
+		logger.Debug("found Go binary in container image", "path", hdr.Name, "module", mod.String())
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// loadImage reads the container image from a local tarball, if image is the path to an existing file,
+// or pulls it from the registry.
+func loadImage(image string) (v1.Image, error) {
+	if fi, err := os.Stat(image); err == nil && !fi.IsDir() {
+		img, err := tarball.ImageFromPath(image, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read the container image tarball %s: %w", image, err)
+		}
+		return img, nil
+	}
+	img, err := crane.Pull(image, crane.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to pull the container image %s: %w", image, err)
+	}
+	return img, nil
+}
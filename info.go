@@ -0,0 +1,346 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const infoExampleUsage = `  # show a summary of the configured Perseus server
+  perseus info
+
+  # same, but as JSON for a support ticket or a monitoring script
+  perseus info -o json`
+
+// createInfoCommand initializes and returns a *cobra.Command that implements the 'info' CLI sub-command
+func createInfoCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:          "info",
+		Example:      infoExampleUsage,
+		Aliases:      []string{"server-info"},
+		Short:        "Shows the version, uptime, database health, graph and storage statistics, and features of the Perseus server",
+		RunE:         runInfoCmd,
+		SilenceUsage: true,
+	}
+	fset := cmd.Flags()
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
+	addOutputFormatFlags(fset, "specifies the output format, one of 'table' (the default), 'json', or 'yaml'")
+	fset.StringVarP(&outputPath, "output", "O", "", "write the results to the specified file, which is replaced atomically, instead of stdout")
+	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.Duration("timeout", 0, "the maximum amount of time to wait for the Perseus server, ex: 30s or 2m (default is $PERSEUS_TIMEOUT environment variable or no limit)")
+	addRetryFlags(fset)
+	addConnectionFlags(fset)
+
+	return &cmd
+}
+
+// serverInfoItem defines the information returned by the 'info' CLI sub-command
+type serverInfoItem struct {
+	// the URL of the server, as configured
+	Address string `yaml:"address"`
+	// the version of the server, ex: v1.2.3
+	Version string `yaml:"version"`
+	// the version of this CLI
+	CLIVersion string `yaml:"cliVersion"`
+	// the version of the database schema that the server requires
+	SchemaVersion int `yaml:"schemaVersion"`
+	// when the server process started and how long it has been running
+	StartTime time.Time     `yaml:"startTime"`
+	Uptime    time.Duration `yaml:"uptime"`
+	// the outcome of the server's database health check
+	Database databaseHealthItem `yaml:"database"`
+	// the size of the graph, nil if the database is unhealthy
+	Graph *graphStatsItem `yaml:"graph,omitempty"`
+	// the storage used by the database, nil if the server doesn't report it or the caller isn't an admin
+	Storage *storageStatsItem `json:",omitempty" yaml:"storage,omitempty"`
+	// the optional features that the server supports
+	Features []string `yaml:"features"`
+}
+
+// databaseHealthItem is the outcome of the server's database health check
+type databaseHealthItem struct {
+	Healthy bool          `yaml:"healthy"`
+	Latency time.Duration `yaml:"latency"`
+	Error   string        `yaml:"error,omitempty"`
+}
+
+// graphStatsItem describes the size of the graph
+type graphStatsItem struct {
+	Modules  int64 `yaml:"modules"`
+	Versions int64 `yaml:"versions"`
+	Edges    int64 `yaml:"edges"`
+	// the most recent time that a module version or dependency edge was added, nil if the graph is empty
+	LastIngest *time.Time `json:",omitempty" yaml:"lastIngest,omitempty"`
+}
+
+// storageStatsItem describes the storage used by the server's database
+type storageStatsItem struct {
+	DatabaseBytes int64            `yaml:"databaseBytes"`
+	Tables        []tableStatsItem `yaml:"tables"`
+	Indexes       []indexStatsItem `yaml:"indexes"`
+}
+
+// tableStatsItem describes the storage used by a database table
+type tableStatsItem struct {
+	Name        string     `yaml:"name"`
+	Rows        int64      `yaml:"rows"`
+	DeadRows    int64      `yaml:"deadRows"`
+	TotalBytes  int64      `yaml:"totalBytes"`
+	IndexBytes  int64      `yaml:"indexBytes"`
+	LastVacuum  *time.Time `json:",omitempty" yaml:"lastVacuum,omitempty"`
+	LastAnalyze *time.Time `json:",omitempty" yaml:"lastAnalyze,omitempty"`
+	Hint        string     `json:",omitempty" yaml:"hint,omitempty"`
+}
+
+// indexStatsItem describes the storage used by a database index
+type indexStatsItem struct {
+	Name  string `yaml:"name"`
+	Table string `yaml:"table"`
+	Bytes int64  `yaml:"bytes"`
+	Scans int64  `yaml:"scans"`
+	Hint  string `json:",omitempty" yaml:"hint,omitempty"`
+}
+
+// runInfoCmd implements the logic behind the 'info' CLI sub-command
+func runInfoCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	format, err := outputFmt.resolve(conf.outputFormat, outputTable, outputJSON, outputYAML)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("querying the server at " + conf.serverAddr)
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().GetServerInfo(ctx, connect.NewRequest(&perseusapi.GetServerInfoRequest{IncludeStats: true}))
+	stopSpinner()
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeUnimplemented {
+			return fmt.Errorf("The Perseus server does not report its version, it is older than the CLI and should be upgraded: %w", err)
+		}
+		return fmt.Errorf("Unable to retrieve the server info: %w", err)
+	}
+
+	msg := resp.Msg
+	info := serverInfoItem{
+		Address:       conf.serverAddr,
+		Version:       msg.GetVersion(),
+		CLIVersion:    BuildVersion,
+		SchemaVersion: int(msg.GetSchemaVersion()),
+		StartTime:     msg.GetStartTime().AsTime(),
+		Uptime:        time.Since(msg.GetStartTime().AsTime()).Round(time.Second),
+		Database: databaseHealthItem{
+			Healthy: msg.GetDatabase().GetHealthy(),
+			Latency: msg.GetDatabase().GetLatency().AsDuration(),
+			Error:   msg.GetDatabase().GetError(),
+		},
+		Features: msg.GetFeatures(),
+	}
+	if g := msg.GetGraph(); g != nil {
+		info.Graph = &graphStatsItem{
+			Modules:  g.GetModules(),
+			Versions: g.GetVersions(),
+			Edges:    g.GetEdges(),
+		}
+		if g.GetLastIngestTime() != nil {
+			t := g.GetLastIngestTime().AsTime()
+			info.Graph.LastIngest = &t
+		}
+	}
+	if slices.Contains(info.Features, client.FeatureStoreStats) {
+		info.Storage = getStorageStats(ctx, ps)
+	}
+	if skew := versionSkew(BuildVersion, info.Version); skew != "" {
+		logger.Info(skew, "cli", BuildVersion, "server", info.Version)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeServerInfo(out, format, info); err != nil {
+		return err
+	}
+	if err := out.Commit(); err != nil {
+		return err
+	}
+	if !info.Database.Healthy {
+		return fmt.Errorf("The Perseus server is unable to reach its database")
+	}
+	return nil
+}
+
+// getStorageStats returns the storage used by the server's database, or nil if it can't be retrieved.  The
+// GetStoreStats RPC requires an admin token or API key, so a failure only omits the statistics from the
+// output.
+func getStorageStats(ctx context.Context, ps *client.Client) *storageStatsItem {
+	resp, err := ps.API().GetStoreStats(ctx, connect.NewRequest(&perseusapi.GetStoreStatsRequest{}))
+	if err != nil {
+		logger.Debug("unable to retrieve the database storage statistics", "err", err)
+		return nil
+	}
+	stats := &storageStatsItem{DatabaseBytes: resp.Msg.GetDatabaseBytes()}
+	for _, t := range resp.Msg.GetTables() {
+		item := tableStatsItem{
+			Name:       t.GetName(),
+			Rows:       t.GetRows(),
+			DeadRows:   t.GetDeadRows(),
+			TotalBytes: t.GetTotalBytes(),
+			IndexBytes: t.GetIndexBytes(),
+			Hint:       t.GetHint(),
+		}
+		if t.GetLastVacuumTime() != nil {
+			ts := t.GetLastVacuumTime().AsTime()
+			item.LastVacuum = &ts
+		}
+		if t.GetLastAnalyzeTime() != nil {
+			ts := t.GetLastAnalyzeTime().AsTime()
+			item.LastAnalyze = &ts
+		}
+		stats.Tables = append(stats.Tables, item)
+	}
+	for _, i := range resp.Msg.GetIndexes() {
+		stats.Indexes = append(stats.Indexes, indexStatsItem{
+			Name:  i.GetName(),
+			Table: i.GetTable(),
+			Bytes: i.GetBytes(),
+			Scans: i.GetScans(),
+			Hint:  i.GetHint(),
+		})
+	}
+	return stats
+}
+
+// writeServerInfo writes info to the provided io.Writer in the specified format
+func writeServerInfo(w io.Writer, format string, info serverInfoItem) error {
+	switch format {
+	case outputTable:
+		database := fmt.Sprintf("healthy (%s)", info.Database.Latency.Round(time.Microsecond))
+		if !info.Database.Healthy {
+			database = "unhealthy: " + info.Database.Error
+		}
+		rows := [][2]string{
+			{"Server", info.Address},
+			{"Version", info.Version},
+			{"CLI Version", info.CLIVersion},
+			{"Schema Version", fmt.Sprint(info.SchemaVersion)},
+			{"Started", info.StartTime.Local().Format(time.DateTime)},
+			{"Uptime", info.Uptime.String()},
+			{"Database", database},
+		}
+		if g := info.Graph; g != nil {
+			lastIngest := "never"
+			if g.LastIngest != nil {
+				lastIngest = g.LastIngest.Local().Format(time.DateTime)
+			}
+			rows = append(rows,
+				[2]string{"Modules", fmt.Sprint(g.Modules)},
+				[2]string{"Versions", fmt.Sprint(g.Versions)},
+				[2]string{"Dependencies", fmt.Sprint(g.Edges)},
+				[2]string{"Last Ingested", lastIngest},
+			)
+		}
+		if info.Storage != nil {
+			rows = append(rows, [2]string{"Database Size", formatBytes(info.Storage.DatabaseBytes)})
+		}
+		rows = append(rows, [2]string{"Features", strings.Join(info.Features, ", ")})
+
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		for _, r := range rows {
+			if _, err := fmt.Fprintf(tw, "%s:\t%s\n", r[0], r[1]); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		if info.Storage != nil {
+			return writeStorageStats(w, *info.Storage)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(info)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(info)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// writeStorageStats writes a table of the storage used by each database table to w, followed by any
+// indexes that may need maintenance.  Indexes without a hint are only included in the JSON and YAML
+// output.
+func writeStorageStats(w io.Writer, stats storageStatsItem) error {
+	tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "Table\tRows\tDead Rows\tSize\tIndexes\tLast Vacuum\tHint")
+	for _, t := range stats.Tables {
+		lastVacuum := "never"
+		if t.LastVacuum != nil {
+			lastVacuum = t.LastVacuum.Local().Format(time.DateTime)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\t%s\n", t.Name, t.Rows, t.DeadRows, formatBytes(t.TotalBytes), formatBytes(t.IndexBytes), lastVacuum, t.Hint)
+	}
+	var hinted []indexStatsItem
+	for _, i := range stats.Indexes {
+		if i.Hint != "" {
+			hinted = append(hinted, i)
+		}
+	}
+	if len(hinted) > 0 {
+		fmt.Fprintln(tw)
+		fmt.Fprintln(tw, "Index\tTable\tSize\tScans\tHint")
+		for _, i := range hinted {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\n", i.Name, i.Table, formatBytes(i.Bytes), i.Scans, i.Hint)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("Error writing tabular output: %w", err)
+	}
+	return nil
+}
+
+// formatBytes returns n as a human-readable size using binary units, ex: 1.5 MiB
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,85 @@
+// Package compress provides the compression algorithms that the Perseus server and clients can use to
+// compress API messages, which adds zstd to the gzip algorithm that Connect supports natively.
+package compress
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/klauspost/compress/zstd"
+)
+
+// the names of the supported compression algorithms
+const (
+	// gzip, which every Connect and gRPC implementation supports
+	Gzip = "gzip"
+	// Zstandard, which is faster than gzip and compresses better, but may not be supported by other clients
+	Zstd = "zstd"
+	// no compression
+	None = "none"
+)
+
+// MinBytes is the size below which messages are not compressed since the savings would not be worth the
+// overhead
+const MinBytes = 1024
+
+// ParseNames validates a list of compression algorithm names, which may be separated by commas, and returns
+// them in lower case without duplicates.  None is only valid by itself and results in an empty list.
+func ParseNames(names []string) ([]string, error) {
+	var result []string
+	for _, n := range names {
+		for _, name := range strings.Split(n, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			switch name {
+			case "":
+				continue
+			case Gzip, Zstd:
+				if !slices.Contains(result, name) {
+					result = append(result, name)
+				}
+			case None:
+				if len(names) > 1 || strings.Contains(n, ",") {
+					return nil, fmt.Errorf("the %q compression option can't be combined with others", None)
+				}
+				return nil, nil
+			default:
+				return nil, fmt.Errorf("unsupported compression algorithm %q, must be %s, %s, or %s", name, Gzip, Zstd, None)
+			}
+		}
+	}
+	return result, nil
+}
+
+// NewZstdCompressor returns a [connect.Compressor] that uses the zstd algorithm
+func NewZstdCompressor() connect.Compressor {
+	// the encoder can only fail to initialize if an option is invalid
+	enc, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	return enc
+}
+
+// NewZstdDecompressor returns a [connect.Decompressor] that uses the zstd algorithm
+func NewZstdDecompressor() connect.Decompressor {
+	// the decoder can only fail to initialize if an option is invalid
+	dec, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	return zstdDecompressor{dec}
+}
+
+// zstdDecompressor adapts a [zstd.Decoder] to the [connect.Decompressor] interface
+type zstdDecompressor struct {
+	*zstd.Decoder
+}
+
+// Reset satisfies the [connect.Decompressor] interface and prepares the decoder to read from r
+func (d zstdDecompressor) Reset(r io.Reader) error {
+	return d.Decoder.Reset(r)
+}
+
+// Close satisfies the [connect.Decompressor] interface.  This is a no-op because Connect pools and reuses
+// decompressors after closing them, but a closed [zstd.Decoder] can't be reused.  A decoder that is
+// limited to 1 goroutine does not start any background work, so there is nothing to release.
+func (d zstdDecompressor) Close() error {
+	return nil
+}
@@ -0,0 +1,51 @@
+package compress
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNames(t *testing.T) {
+	names, err := ParseNames([]string{"GZIP, zstd", "gzip"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{Gzip, Zstd}, names)
+	}
+	names, err = ParseNames([]string{"none"})
+	if assert.NoError(t, err) {
+		assert.Empty(t, names)
+	}
+	_, err = ParseNames([]string{"gzip,none"})
+	assert.Error(t, err, "none can't be combined with other algorithms")
+	_, err = ParseNames([]string{"brotli"})
+	assert.Error(t, err, "unsupported algorithm")
+}
+
+func TestZstd(t *testing.T) {
+	c := NewZstdCompressor()
+	d := NewZstdDecompressor()
+	for _, msg := range []string{"github.com/CrowdStrike/perseus", strings.Repeat("golang.org/x/mod ", 100)} {
+		var buf bytes.Buffer
+		c.Reset(&buf)
+		_, err := c.Write([]byte(msg))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := d.Reset(&buf); err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, msg, string(got))
+		assert.NoError(t, d.Close(), "the decompressor should be reusable after Close")
+	}
+}
@@ -0,0 +1,184 @@
+// Package depsdev retrieves license, security advisory, and OpenSSF Scorecard data for Go modules from
+// the deps.dev API, https://docs.deps.dev/api/v3/.
+package depsdev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultURL is the base URL of the public deps.dev API
+const DefaultURL = "https://api.deps.dev"
+
+// DefaultCacheTTL is a reasonable amount of time to cache deps.dev responses.  Advisories and Scorecard
+// results change over time, but not often enough to justify requesting them for every query.
+const DefaultCacheTTL = 6 * time.Hour
+
+// maxCacheEntries bounds the memory used by the response cache
+const maxCacheEntries = 50000
+
+// ErrNotFound is returned, possibly wrapped, when deps.dev has no data for the requested module version
+// or project
+var ErrNotFound = errors.New("not found")
+
+// Version is the deps.dev data for a single module version
+type Version struct {
+	// the SPDX identifiers of the module version's licenses
+	Licenses []string
+	// the IDs of the security advisories that affect the module version, ex: GHSA-xxxx-xxxx-xxxx
+	Advisories []string
+	// the deps.dev project key of the module's source repository, ex: github.com/CrowdStrike/perseus,
+	// which is empty if the repository is not known
+	SourceRepo string
+}
+
+// Client retrieves data from the deps.dev API, caching the responses in memory
+type Client struct {
+	c       *http.Client
+	baseURL string
+	ttl     time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// cacheEntry is a cached deps.dev response body, or a nil body if the API responded with a 404
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// New returns a Client that uses c to send requests to the deps.dev API at baseURL, ex: [DefaultURL],
+// and caches the responses for ttl.
+func New(c *http.Client, baseURL string, ttl time.Duration) *Client {
+	return &Client{
+		c:       c,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		ttl:     ttl,
+		cache:   make(map[string]cacheEntry),
+	}
+}
+
+// GetVersion returns the deps.dev data for version of mod.  If deps.dev does not know the module version,
+// the returned error wraps [ErrNotFound].
+func (c *Client) GetVersion(ctx context.Context, mod, version string) (Version, error) {
+	var resp struct {
+		Licenses     []string `json:"licenses"`
+		AdvisoryKeys []struct {
+			ID string `json:"id"`
+		} `json:"advisoryKeys"`
+		RelatedProjects []struct {
+			ProjectKey struct {
+				ID string `json:"id"`
+			} `json:"projectKey"`
+			RelationType string `json:"relationType"`
+		} `json:"relatedProjects"`
+	}
+	u := c.baseURL + "/v3/systems/go/packages/" + url.PathEscape(mod) + "/versions/" + url.PathEscape(version)
+	if err := c.get(ctx, u, &resp); err != nil {
+		return Version{}, fmt.Errorf("unable to retrieve %s@%s from deps.dev: %w", mod, version, err)
+	}
+	v := Version{Licenses: resp.Licenses}
+	for _, a := range resp.AdvisoryKeys {
+		v.Advisories = append(v.Advisories, a.ID)
+	}
+	for _, p := range resp.RelatedProjects {
+		if p.RelationType == "SOURCE_REPO" {
+			v.SourceRepo = p.ProjectKey.ID
+			break
+		}
+	}
+	return v, nil
+}
+
+// GetScorecard returns the overall OpenSSF Scorecard score, from 0 to 10, of the project identified by
+// the deps.dev project key.  The boolean result is false if the project has not been scored.  If deps.dev
+// does not know the project, the returned error wraps [ErrNotFound].
+func (c *Client) GetScorecard(ctx context.Context, project string) (float64, bool, error) {
+	var resp struct {
+		Scorecard *struct {
+			OverallScore float64 `json:"overallScore"`
+		} `json:"scorecard"`
+	}
+	if err := c.get(ctx, c.baseURL+"/v3/projects/"+url.PathEscape(project), &resp); err != nil {
+		return 0, false, fmt.Errorf("unable to retrieve the project %s from deps.dev: %w", project, err)
+	}
+	if resp.Scorecard == nil {
+		return 0, false, nil
+	}
+	return resp.Scorecard.OverallScore, true, nil
+}
+
+// get decodes the JSON response to a GET request for u into dest, using the cached response if there is
+// one that has not expired
+func (c *Client) get(ctx context.Context, u string, dest any) error {
+	c.mu.Lock()
+	e, ok := c.cache[u]
+	c.mu.Unlock()
+	if !ok || time.Now().After(e.expires) {
+		body, err := c.fetch(ctx, u)
+		if err != nil {
+			return err
+		}
+		e = cacheEntry{body: body, expires: time.Now().Add(c.ttl)}
+		c.mu.Lock()
+		if len(c.cache) >= maxCacheEntries {
+			c.evictExpired()
+		}
+		c.cache[u] = e
+		c.mu.Unlock()
+	}
+	if e.body == nil {
+		return ErrNotFound
+	}
+	if err := json.Unmarshal(e.body, dest); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	return nil
+}
+
+// evictExpired removes the expired responses from the cache or, if none have expired, empties it.  The
+// caller must hold c.mu.
+func (c *Client) evictExpired() {
+	now := time.Now()
+	for u, e := range c.cache {
+		if now.After(e.expires) {
+			delete(c.cache, u)
+		}
+	}
+	if len(c.cache) >= maxCacheEntries {
+		clear(c.cache)
+	}
+}
+
+// fetch sends a GET request for u and returns the response body, or a nil body if the API responded with
+// a 404 so that missing data is also cached
+func (c *Client) fetch(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("unexpected HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+}
@@ -0,0 +1,79 @@
+package depsdev
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient(t *testing.T) {
+	var requests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/systems/go/packages/", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.EscapedPath() {
+		case "/v3/systems/go/packages/github.com%2Fexample%2Ffoo/versions/v1.2.3":
+			_, _ = w.Write([]byte(`{
+				"licenses": ["Apache-2.0"],
+				"advisoryKeys": [{"id": "GHSA-aaaa-bbbb-cccc"}, {"id": "GO-2024-0001"}],
+				"relatedProjects": [
+					{"projectKey": {"id": "github.com/example/docs"}, "relationType": "ISSUE_TRACKER"},
+					{"projectKey": {"id": "github.com/example/foo"}, "relationType": "SOURCE_REPO"}
+				]
+			}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	mux.HandleFunc("/v3/projects/", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.EscapedPath() {
+		case "/v3/projects/github.com%2Fexample%2Ffoo":
+			_, _ = w.Write([]byte(`{"scorecard": {"overallScore": 7.4}}`))
+		case "/v3/projects/github.com%2Fexample%2Funscored":
+			_, _ = w.Write([]byte(`{"projectKey": {"id": "github.com/example/unscored"}}`))
+		case "/v3/projects/github.com%2Fexample%2Fbroken":
+			http.Error(w, "oops", http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	svr := httptest.NewServer(mux)
+	t.Cleanup(svr.Close)
+
+	ctx := context.Background()
+	c := New(svr.Client(), svr.URL+"/", time.Hour)
+
+	v, err := c.GetVersion(ctx, "github.com/example/foo", "v1.2.3")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"Apache-2.0"}, v.Licenses)
+		assert.Equal(t, []string{"GHSA-aaaa-bbbb-cccc", "GO-2024-0001"}, v.Advisories)
+		assert.Equal(t, "github.com/example/foo", v.SourceRepo)
+	}
+	// the 2nd lookup should be served from the cache
+	_, err = c.GetVersion(ctx, "github.com/example/foo", "v1.2.3")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+
+	_, err = c.GetVersion(ctx, "github.com/example/missing", "v0.1.0")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	score, ok, err := c.GetScorecard(ctx, "github.com/example/foo")
+	if assert.NoError(t, err) {
+		assert.True(t, ok)
+		assert.InDelta(t, 7.4, score, 0.001)
+	}
+	_, ok, err = c.GetScorecard(ctx, "github.com/example/unscored")
+	if assert.NoError(t, err) {
+		assert.False(t, ok)
+	}
+	_, _, err = c.GetScorecard(ctx, "github.com/example/broken")
+	if assert.Error(t, err) {
+		assert.NotErrorIs(t, err, ErrNotFound)
+	}
+}
@@ -0,0 +1,37 @@
+// Package features defines the names of the optional features that the Perseus server reports in its
+// GetServerInfo response, which clients use to detect whether a server supports an operation before
+// starting it rather than failing part way through.
+package features
+
+// the names of the optional server features
+const (
+	// the StreamEdges RPC
+	StreamEdges = "stream-edges"
+	// the RenderGraph RPC
+	RenderGraph = "render-graph"
+	// the SubmitIngestionJobs, GetIngestionJob, and ListIngestionJobs RPCs
+	IngestionJobs = "ingestion-jobs"
+	// zstd compression of requests and responses, which can be disabled by the server's configuration
+	CompressionZstd = "compression-zstd"
+	// deps.dev enrichment of query results, which is only available if the server has a deps.dev URL
+	Enrichment = "enrichment"
+	// the ListVulnerabilities RPC, which is only available if the server has an OSV URL
+	Vulnerabilities = "vulnerabilities"
+	// querying the dependents of a range of versions using the version_range field of QueryDependencies
+	DependentsInRange = "dependents-in-range"
+	// the ResolveModuleSet RPC
+	ResolveModuleSet = "resolve-module-set"
+	// the upgrades field of ResolveModuleSet requests
+	ResolveUpgrades = "resolve-upgrades"
+	// the ListGhostVersions RPC
+	GhostVersions = "ghost-versions"
+	// de-duplication of retried CreateModule, UpdateDependencies, and BatchUpdateDependencies requests
+	// that have an Idempotency-Key header
+	IdempotencyKeys = "idempotency-keys"
+	// previewing the changes made by UpdateDependencies using its validate_only field
+	UpdateValidateOnly = "update-validate-only"
+	// the BatchUpdateDependencies RPC, which applies the updates of several modules atomically
+	BatchUpdates = "batch-updates"
+	// the GetStoreStats RPC
+	StoreStats = "store-stats"
+)
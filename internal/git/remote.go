@@ -0,0 +1,82 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"golang.org/x/mod/semver"
+)
+
+// ErrFileNotFound is returned by [ReadRemoteFile] if the requested file does not exist at the specified tag.
+var ErrFileNotFound = object.ErrFileNotFound
+
+// RemoteVersionTags returns the versions from the tags in the remote Git repository at url that consist
+// of prefix followed by a SemVer version, with the prefix removed.  See [Repo.PrefixedVersionTags] for
+// details about prefix.
+func RemoteVersionTags(url, prefix string) ([]string, error) {
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{url},
+	})
+	refs, err := remote.List(&git.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the tags in %s: %w", url, err)
+	}
+	var tags []string
+	for _, ref := range refs {
+		if !ref.Name().IsTag() {
+			continue
+		}
+		if v, ok := strings.CutPrefix(ref.Name().Short(), prefix); ok && semver.IsValid(v) {
+			tags = append(tags, v)
+		}
+	}
+	return tags, nil
+}
+
+// ReadRemoteFile returns the contents of the first of the provided paths, relative to the root of the
+// repository, that exists as of the specified tag in the remote Git repository at url.  If none of them
+// exist the returned error wraps [ErrFileNotFound].
+//
+// Only the tagged commit is fetched, and it is held in memory, so this is much cheaper than a full clone.
+func ReadRemoteFile(url, tag string, paths ...string) ([]byte, error) {
+	repo, err := git.Clone(memory.NewStorage(), nil, &git.CloneOptions{
+		URL:           url,
+		ReferenceName: plumbing.NewTagReferenceName(tag),
+		SingleBranch:  true,
+		Depth:         1,
+		Tags:          git.NoTags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch tag %s from %s: %w", tag, url, err)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("error inspecting Git repository: %w", err)
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("error inspecting Git repository: %w", err)
+	}
+	for _, p := range paths {
+		f, err := commit.File(p)
+		if err != nil {
+			if errors.Is(err, object.ErrFileNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("unable to read %s at tag %s: %w", p, tag, err)
+		}
+		contents, err := f.Contents()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s at tag %s: %w", p, tag, err)
+		}
+		return []byte(contents), nil
+	}
+	return nil, fmt.Errorf("none of %v exist at tag %s: %w", paths, tag, ErrFileNotFound)
+}
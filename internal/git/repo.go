@@ -17,9 +17,10 @@ type Repo struct {
 	repo *git.Repository
 }
 
-// Open opens a Git repository at the specified path.
+// Open opens the Git repository that contains the specified path, which may be the root of the repository
+// or any folder within it.
 func Open(dir string) (*Repo, error) {
-	repo, err := git.PlainOpen(dir)
+	repo, err := git.PlainOpenWithOptions(dir, &git.PlainOpenOptions{DetectDotGit: true})
 	if err != nil {
 		return nil, fmt.Errorf("unable to open Git repository at %q: %w", dir, err)
 	}
@@ -28,8 +29,26 @@ func Open(dir string) (*Repo, error) {
 	}, nil
 }
 
+// Root returns the path to the root folder of the repository's working tree.
+func (r *Repo) Root() (string, error) {
+	wt, err := r.repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("error inspecting Git repository: %w", err)
+	}
+	return wt.Filesystem.Root(), nil
+}
+
 // VersionTags returns the SemVer tags associated with the current HEAD revision on the repo.
 func (r *Repo) VersionTags() (tags []string, err error) {
+	return r.PrefixedVersionTags("")
+}
+
+// PrefixedVersionTags returns the versions from the tags associated with the current HEAD revision on the
+// repo that consist of prefix followed by a SemVer version, with the prefix removed.
+//
+// A Go module in a sub-folder of a repository is tagged with the path of that folder as a prefix, so the
+// tag for v1.2.3 of a module in the 'foo/bar' folder is 'foo/bar/v1.2.3' and prefix should be 'foo/bar/'.
+func (r *Repo) PrefixedVersionTags(prefix string) (tags []string, err error) {
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("error inspecting Git repository: %w", err)
@@ -60,8 +79,8 @@ func (r *Repo) VersionTags() (tags []string, err error) {
 		}
 		_ = it.ForEach(func(t *object.Tag) error {
 			if t.Target == hh {
-				if isSemverTag := semver.IsValid(t.Name); isSemverTag {
-					rc <- tagResult{Tag: t.Name}
+				if v, ok := strings.CutPrefix(t.Name, prefix); ok && semver.IsValid(v) {
+					rc <- tagResult{Tag: v}
 				}
 			}
 			return nil
@@ -77,8 +96,8 @@ func (r *Repo) VersionTags() (tags []string, err error) {
 		_ = it2.ForEach(func(ref *plumbing.Reference) error {
 			if ref.Hash() == hh {
 				tag := strings.TrimPrefix(ref.Name().String(), "refs/tags/")
-				if isSemverTag := semver.IsValid(tag); isSemverTag {
-					rc <- tagResult{Tag: tag}
+				if v, ok := strings.CutPrefix(tag, prefix); ok && semver.IsValid(v) {
+					rc <- tagResult{Tag: v}
 				}
 			}
 			return nil
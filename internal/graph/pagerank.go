@@ -0,0 +1,99 @@
+// Package graph provides algorithms that operate on the module-level dependency graph.
+package graph
+
+import (
+	"math"
+)
+
+const (
+	// damping is the probability that the "random surfer" follows a dependency rather than jumping to
+	// a random module, the value from the original PageRank paper
+	damping = 0.85
+	// maxIterations and tolerance bound the power iteration
+	maxIterations = 100
+	tolerance     = 1e-9
+)
+
+// Edge is a directed edge from a dependent module to one of its dependencies
+type Edge struct {
+	From, To int32
+}
+
+// PageRank computes the PageRank of each node in the graph described by edges, where each dependent
+// "votes" for its dependencies so that modules relied on by other important modules score highly.
+//
+// The scores are scaled so that the average is 1, which makes them comparable across graphs of different
+// sizes: a module with a score of 10 is 10 times as important as the average module.  Nodes with no
+// outgoing edges spread their score evenly across all nodes, and self-loops are ignored.
+func PageRank(edges []Edge) map[int32]float64 {
+	// assign each node a dense index so the iteration works on slices
+	index := make(map[int32]int)
+	var ids []int32
+	node := func(id int32) int {
+		i, ok := index[id]
+		if !ok {
+			i = len(ids)
+			index[id] = i
+			ids = append(ids, id)
+		}
+		return i
+	}
+	type link struct{ from, to int }
+	links := make([]link, 0, len(edges))
+	seen := make(map[Edge]struct{}, len(edges))
+	for _, e := range edges {
+		from, to := node(e.From), node(e.To)
+		if from == to {
+			continue
+		}
+		if _, dup := seen[e]; dup {
+			continue
+		}
+		seen[e] = struct{}{}
+		links = append(links, link{from, to})
+	}
+	n := len(ids)
+	if n == 0 {
+		return map[int32]float64{}
+	}
+
+	outDegree := make([]int, n)
+	for _, l := range links {
+		outDegree[l.from]++
+	}
+	rank := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for iter := 0; iter < maxIterations; iter++ {
+		// the score of nodes without dependencies is shared by every node
+		dangling := 0.0
+		for i, r := range rank {
+			if outDegree[i] == 0 {
+				dangling += r
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		for i := range next {
+			next[i] = base
+		}
+		for _, l := range links {
+			next[l.to] += damping * rank[l.from] / float64(outDegree[l.from])
+		}
+		delta := 0.0
+		for i := range rank {
+			delta += math.Abs(next[i] - rank[i])
+		}
+		rank, next = next, rank
+		if delta < tolerance {
+			break
+		}
+	}
+
+	scores := make(map[int32]float64, n)
+	for i, id := range ids {
+		scores[id] = rank[i] * float64(n)
+	}
+	return scores
+}
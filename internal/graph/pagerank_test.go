@@ -0,0 +1,45 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageRank(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty graph", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, PageRank(nil))
+	})
+
+	t.Run("shared dependency", func(t *testing.T) {
+		t.Parallel()
+		// 1, 2, and 3 all depend on 4, which depends on 5
+		scores := PageRank([]Edge{{1, 4}, {2, 4}, {3, 4}, {4, 5}})
+		assert.Len(t, scores, 5)
+		assert.Greater(t, scores[4], scores[1])
+		assert.Greater(t, scores[5], scores[4], "a module's only dependency inherits its importance")
+		assert.InDelta(t, scores[1], scores[2], 1e-9)
+		assert.InDelta(t, scores[1], scores[3], 1e-9)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		t.Parallel()
+		scores := PageRank([]Edge{{1, 2}, {2, 3}, {3, 1}})
+		for id, s := range scores {
+			assert.InDelta(t, 1.0, s, 1e-6, "module %d", id)
+		}
+	})
+
+	t.Run("average is 1", func(t *testing.T) {
+		t.Parallel()
+		scores := PageRank([]Edge{{1, 2}, {1, 3}, {2, 3}, {4, 3}, {5, 1}, {5, 5}, {1, 2}})
+		total := 0.0
+		for _, s := range scores {
+			total += s
+		}
+		assert.InDelta(t, 1.0, total/float64(len(scores)), 1e-6)
+	})
+}
@@ -0,0 +1,17 @@
+// Package headers defines the HTTP headers that are shared by the Perseus server and its clients, so that
+// clients can use them without depending on the server implementation.
+package headers
+
+const (
+	// RequestID is the HTTP header used to propagate the unique identifier for each API request.  If the
+	// caller provides a valid value it is used as-is, otherwise the server generates a new one.  In both
+	// cases, the ID is returned to the caller in the response headers.
+	RequestID = "X-Request-Id"
+	// IdempotencyKey is the HTTP header that carries the caller-provided idempotency key of a write
+	// request.  Retries of a request with the same key return the original response rather than being
+	// processed again.
+	IdempotencyKey = "Idempotency-Key"
+	// IdempotentReplayed is set to "true" in the response to a request whose result was replayed from an
+	// earlier request with the same idempotency key
+	IdempotentReplayed = "Idempotent-Replayed"
+)
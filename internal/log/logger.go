@@ -4,58 +4,114 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// Format is the output format of a [Logger]
+type Format string
+
+const (
+	// FormatJSON writes each log entry as a JSON object
+	FormatJSON Format = "json"
+	// FormatLogfmt writes each log entry as logfmt-style key=value pairs
+	FormatLogfmt Format = "logfmt"
+	// FormatText writes each log entry as a human-readable line, which is intended for interactive use
+	FormatText Format = "text"
+)
+
+// ParseFormat returns the [Format] named by s or, if s is empty, the default format, which is JSON when
+// running in Kubernetes and logfmt otherwise.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatJSON, FormatLogfmt, FormatText:
+		return f, nil
+	case "":
+		if inK8S() {
+			return FormatJSON, nil
+		}
+		return FormatLogfmt, nil
+	default:
+		return "", fmt.Errorf("unsupported log format %q, must be one of json, logfmt, or text", s)
+	}
+}
+
 // New initializes and returns a new [Logger] using [level] to dynamically determine the active
-// verbosity level.
+// verbosity level.  Entries are written to [os.Stdout] in the default format until [Logger.SetOutput]
+// is called.
 func New(level slog.Leveler) *Logger {
+	l := &Logger{level: level}
+	format, _ := ParseFormat("")
+	l.SetOutput(format, os.Stdout)
+	return l
+}
+
+// Logger wraps a [slog.Logger] to provide a streamlined API and consistent behavior for the Perseus
+// application.
+type Logger struct {
+	out   atomic.Pointer[output]
+	level slog.Leveler
+}
+
+// output is the destination and format of a [Logger]'s entries
+type output struct {
+	handler slog.Handler
+	format  Format
+}
+
+// SetOutput changes the format of the log entries and where they are written.
+func (l *Logger) SetOutput(format Format, w io.Writer) {
 	opts := slog.HandlerOptions{
 		AddSource:   true,
-		Level:       level,
+		Level:       l.level,
 		ReplaceAttr: replaceRecordAttributes,
 	}
-
-	var logger *slog.Logger
-	if inK8S() {
-		logger = slog.New(slog.NewJSONHandler(os.Stdout, &opts))
-	} else {
-		logger = slog.New(slog.NewTextHandler(os.Stdout, &opts))
-	}
-	return &Logger{
-		logger: logger,
+	var h slog.Handler
+	switch format {
+	case FormatJSON:
+		h = slog.NewJSONHandler(w, &opts)
+	case FormatText:
+		h = newTextHandler(w, &opts)
+	default:
+		h = slog.NewTextHandler(w, &opts)
 	}
+	l.out.Store(&output{handler: h, format: format})
 }
 
-// Logger wraps a [slog.Logger] to provide a streamlined API and consistent behavior for the Perseus
-// application.
-type Logger struct {
-	logger *slog.Logger
+// SetDebug enables or disables DEBUG level output at runtime.  This is a no-op unless the [slog.Leveler]
+// passed to [New] also provides a SetDebug(bool) method.
+func (l *Logger) SetDebug(enabled bool) {
+	if ds, ok := l.level.(interface{ SetDebug(bool) }); ok {
+		ds.SetDebug(enabled)
+	}
 }
 
 // Info logs a message at INFO level with the specified message and attributes.
 func (l *Logger) Info(msg string, kvs ...any) {
-	ctx, h := context.Background(), l.logger.Handler()
+	ctx, out := context.Background(), l.out.Load()
+	h := out.handler
 	if h.Enabled(ctx, slog.LevelInfo) {
 		rec := getLogRecord(slog.LevelInfo, msg, kvs...)
 		if err := h.Handle(ctx, rec); err != nil {
-			dumpLogHandlerError(err, rec)
+			dumpLogHandlerError(out.format, err, rec)
 		}
 	}
 }
 
 // Debug logs a message at DEBUG level with the specified message and attributes.
 func (l *Logger) Debug(msg string, kvs ...any) {
-	ctx, h := context.Background(), l.logger.Handler()
+	ctx, out := context.Background(), l.out.Load()
+	h := out.handler
 	if h.Enabled(ctx, slog.LevelDebug) {
 		rec := getLogRecord(slog.LevelDebug, msg, kvs...)
 		if err := h.Handle(ctx, rec); err != nil {
-			dumpLogHandlerError(err, rec)
+			dumpLogHandlerError(out.format, err, rec)
 		}
 	}
 }
@@ -63,14 +119,15 @@ func (l *Logger) Debug(msg string, kvs ...any) {
 // Error logs a message at ERROR level with the specified message and attributes.  If [err] is not nil,
 // it will be logged in an additional attribute called "err".
 func (l *Logger) Error(err error, msg string, kvs ...any) {
-	ctx, h := context.Background(), l.logger.Handler()
+	ctx, out := context.Background(), l.out.Load()
+	h := out.handler
 	if h.Enabled(ctx, slog.LevelError) {
 		rec := getLogRecord(slog.LevelError, msg, kvs...)
 		if err != nil {
 			rec.Add(slog.String("err", err.Error()))
 		}
 		if err := h.Handle(ctx, rec); err != nil {
-			dumpLogHandlerError(err, rec)
+			dumpLogHandlerError(out.format, err, rec)
 		}
 	}
 }
@@ -98,7 +155,8 @@ func getLogRecord(lvl slog.Level, msg string, kvs ...any) slog.Record {
 	return rec
 }
 
-// replaceRecordAttributes massages the log record attributes before output
+// replaceRecordAttributes massages the log record attributes before output, including masking any secrets
+// in the message and attribute values
 func replaceRecordAttributes(_ []string, a slog.Attr) slog.Attr {
 	switch a.Key {
 	case slog.SourceKey:
@@ -107,7 +165,10 @@ func replaceRecordAttributes(_ []string, a slog.Attr) slog.Attr {
 		if idx := strings.Index(val.File, "github.com/CrowdStrike/perseus/"); idx != -1 {
 			val.File = val.File[idx+31:]
 		}
+	case slog.TimeKey, slog.LevelKey:
+		// these never contain secrets
 	default:
+		a = redactAttr(a)
 	}
 	return a
 }
@@ -128,8 +189,8 @@ func inK8S() bool {
 
 // dumpLogHandlerError is called when invoking the underlying [slog.Handler] returns an error to write
 // the error and additional details to [os.Stderr] in the appropriate format (JSON vs key/value) based
-// on the environment.
-func dumpLogHandlerError(err error, rec slog.Record) {
+// on the logger's format.
+func dumpLogHandlerError(format Format, err error, rec slog.Record) {
 	var source string
 	rec.Attrs(func(a slog.Attr) bool {
 		switch a.Key {
@@ -146,10 +207,10 @@ func dumpLogHandlerError(err error, rec slog.Record) {
 		"level":       "ERROR",
 		"source":      source,
 		"msg":         "failure invoking slog.Handler.Handle()",
-		"originalMsg": rec.Message,
-		"error":       err.Error(),
+		"originalMsg": redactString(rec.Message),
+		"error":       redactString(err.Error()),
 	}
-	if inK8S() {
+	if format == FormatJSON {
 		output, _ := json.Marshal(logData)
 		_, _ = os.Stderr.Write(output)
 	} else {
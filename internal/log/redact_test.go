@@ -0,0 +1,49 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedaction(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: replaceRecordAttributes}))
+	dbURL, _ := url.Parse("postgres://perseus:hunter2@db:5432/perseus")
+	logger.Info("connecting to postgres://perseus:hunter2@db:5432/perseus",
+		"db-pass", "hunter2",
+		"adminToken", "hunter2",
+		"OIDC_CLIENT_SECRET", "hunter2",
+		"url", dbURL,
+		"dsn", "host=db user=perseus password=hunter2 dbname=perseus",
+		"err", errors.New("authentication failed for Bearer hunter2"),
+		"headers", http.Header{"Authorization": {"Bearer hunter2"}, "Cookie": {"session=hunter2"}, "Accept": {"application/json"}},
+		"apiKey", "perseus_"+strings.Repeat("a", 43),
+		"msg2", "created key perseus_"+strings.Repeat("b", 43),
+		"pageToken", "abc",
+		"module", "github.com/example/foo@v1.2.3",
+		"proxy", "https://proxy.golang.org:443/github.com/example/foo/@v/list",
+	)
+	out := buf.String()
+	assert.NotContains(t, out, "hunter2")
+	assert.NotContains(t, out, strings.Repeat("a", 43))
+	assert.NotContains(t, out, strings.Repeat("b", 43))
+	for _, want := range []string{
+		"postgres://perseus:[REDACTED]@db:5432/perseus",
+		"db-pass=[REDACTED]",
+		"password=[REDACTED] dbname=perseus",
+		"Bearer [REDACTED]",
+		"Accept:[application/json]",
+		"pageToken=abc",
+		"module=github.com/example/foo@v1.2.3",
+		"proxy=https://proxy.golang.org:443/github.com/example/foo/@v/list",
+	} {
+		assert.Contains(t, out, want)
+	}
+}
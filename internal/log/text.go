@@ -0,0 +1,147 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// textHandler is a [slog.Handler] that writes each entry as a human-readable line, ex:
+//
+//	15:04:05.000 INFO  Server listening addr=:31138
+//
+// The source location is omitted and [slog.HandlerOptions.ReplaceAttr] is applied to the message and
+// every attribute so that secrets are masked.
+type textHandler struct {
+	opts slog.HandlerOptions
+	// guards writes to w, which is shared by the handlers returned by WithAttrs and WithGroup
+	mu *sync.Mutex
+	w  io.Writer
+	// the pre-formatted attributes added by WithAttrs
+	attrs []byte
+	// the prefix, ex: "grp.", for the keys of subsequent attributes
+	prefix string
+	groups []string
+}
+
+// ensure the handler satisfies the slog interface
+var _ slog.Handler = (*textHandler)(nil)
+
+// newTextHandler returns a [textHandler] that writes to w
+func newTextHandler(w io.Writer, opts *slog.HandlerOptions) *textHandler {
+	h := &textHandler{mu: &sync.Mutex{}, w: w}
+	if opts != nil {
+		h.opts = *opts
+	}
+	return h
+}
+
+// Enabled satisfies the [slog.Handler] interface
+func (h *textHandler) Enabled(_ context.Context, lvl slog.Level) bool {
+	minLevel := slog.LevelInfo
+	if h.opts.Level != nil {
+		minLevel = h.opts.Level.Level()
+	}
+	return lvl >= minLevel
+}
+
+// Handle satisfies the [slog.Handler] interface
+func (h *textHandler) Handle(_ context.Context, rec slog.Record) error {
+	var buf bytes.Buffer
+	if !rec.Time.IsZero() {
+		buf.WriteString(rec.Time.Local().Format("15:04:05.000") + " ")
+	}
+	fmt.Fprintf(&buf, "%-5s ", rec.Level.String())
+	msg := slog.String(slog.MessageKey, rec.Message)
+	if h.opts.ReplaceAttr != nil {
+		msg = h.opts.ReplaceAttr(nil, msg)
+	}
+	buf.WriteString(msg.Value.String())
+	buf.Write(h.attrs)
+	rec.Attrs(func(a slog.Attr) bool {
+		h.appendAttr(&buf, h.prefix, h.groups, a)
+		return true
+	})
+	buf.WriteByte('\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := h.w.Write(buf.Bytes())
+	return err
+}
+
+// WithAttrs satisfies the [slog.Handler] interface
+func (h *textHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := *h
+	buf := bytes.NewBuffer(append([]byte(nil), h.attrs...))
+	for _, a := range attrs {
+		h.appendAttr(buf, h.prefix, h.groups, a)
+	}
+	h2.attrs = buf.Bytes()
+	return &h2
+}
+
+// WithGroup satisfies the [slog.Handler] interface
+func (h *textHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.prefix = h.prefix + name + "."
+	h2.groups = append(append([]string(nil), h.groups...), name)
+	return &h2
+}
+
+// appendAttr writes a as " key=value" to buf, flattening groups into dotted keys
+func (h *textHandler) appendAttr(buf *bytes.Buffer, prefix string, groups []string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Value.Kind() != slog.KindGroup && h.opts.ReplaceAttr != nil {
+		a = h.opts.ReplaceAttr(groups, a)
+		a.Value = a.Value.Resolve()
+	}
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		attrs := a.Value.Group()
+		if len(attrs) == 0 {
+			return
+		}
+		if a.Key != "" {
+			prefix, groups = prefix+a.Key+".", append(append([]string(nil), groups...), a.Key)
+		}
+		for _, ga := range attrs {
+			h.appendAttr(buf, prefix, groups, ga)
+		}
+		return
+	}
+	buf.WriteString(" " + prefix + a.Key + "=" + formatTextValue(a.Value))
+}
+
+// formatTextValue returns v as a string, which is quoted if it is empty or contains spaces, quotes, or
+// control characters
+func formatTextValue(v slog.Value) string {
+	var s string
+	switch v.Kind() {
+	case slog.KindTime:
+		s = v.Time().Format(time.RFC3339)
+	case slog.KindAny:
+		if err, ok := v.Any().(error); ok {
+			s = err.Error()
+		} else {
+			s = fmt.Sprintf("%+v", v.Any())
+		}
+	default:
+		s = v.String()
+	}
+	if s == "" || strings.ContainsFunc(s, func(r rune) bool { return r <= ' ' || r == '"' || r == '=' || r == 0x7f }) {
+		return strconv.Quote(s)
+	}
+	return s
+}
@@ -0,0 +1,54 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOutput(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(slog.LevelInfo)
+
+	l.SetOutput(FormatText, &buf)
+	l.Info("Server listening", "addr", ":31138", "db-pass", "hunter2", "note", "two words")
+	l.Debug("not logged")
+	l.Error(errors.New("boom"), "request failed")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if assert.Len(t, lines, 2) {
+		assert.Regexp(t, `^\d\d:\d\d:\d\d\.\d{3} INFO  Server listening addr=:31138 db-pass=\[REDACTED\] note="two words"$`, lines[0])
+		assert.Regexp(t, `ERROR request failed err=boom$`, lines[1])
+	}
+
+	buf.Reset()
+	l.SetOutput(FormatJSON, &buf)
+	l.Info("Server listening", "addr", ":31138")
+	var entry map[string]any
+	if assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry)) {
+		assert.Equal(t, "Server listening", entry["msg"])
+		assert.Equal(t, ":31138", entry["addr"])
+	}
+
+	buf.Reset()
+	l.SetOutput(FormatLogfmt, &buf)
+	l.Info("Server listening", "addr", ":31138")
+	assert.Contains(t, buf.String(), `msg="Server listening" addr=:31138`)
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, s := range []string{"json", "logfmt", "TEXT"} {
+		f, err := ParseFormat(s)
+		assert.NoError(t, err)
+		assert.Equal(t, Format(strings.ToLower(s)), f)
+	}
+	f, err := ParseFormat("")
+	assert.NoError(t, err)
+	assert.Contains(t, []Format{FormatJSON, FormatLogfmt}, f)
+	_, err = ParseFormat("xml")
+	assert.Error(t, err)
+}
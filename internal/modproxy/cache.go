@@ -0,0 +1,150 @@
+package modproxy
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/module"
+)
+
+// DefaultListCacheTTL is a reasonable amount of time to cache the list of versions of a module, which
+// changes whenever a new version is published.
+const DefaultListCacheTTL = time.Hour
+
+// diskCache stores module proxy responses in a local folder using the same layout as the module
+// download cache of the go command, $GOMODCACHE/cache/download.
+//
+// go.mod files never change for a given module version so they are cached indefinitely.  Version lists
+// are considered stale after listTTL.
+type diskCache struct {
+	dir     string
+	listTTL time.Duration
+}
+
+// WithCache returns a copy of p that caches version lists and go.mod files in dir so that repeated
+// lookups don't hit the module proxies.  Cached version lists expire after listTTL.  An empty dir
+// disables caching.
+func (p Proxy) WithCache(dir string, listTTL time.Duration) Proxy {
+	if dir == "" {
+		p.cache = nil
+		return p
+	}
+	p.cache = &diskCache{dir: dir, listTTL: listTTL}
+	return p
+}
+
+// WithListCacheTTL returns a copy of p whose cached version lists expire after ttl.  A ttl of 0 always
+// reads the current list from the module proxies while still updating the cache with the result.
+func (p Proxy) WithListCacheTTL(ttl time.Duration) Proxy {
+	if p.cache != nil {
+		c := *p.cache
+		c.listTTL = ttl
+		p.cache = &c
+	}
+	return p
+}
+
+// getVersions returns the cached list of versions of mod, if present and not stale.
+func (c *diskCache) getVersions(mod string) ([]string, bool) {
+	if c == nil {
+		return nil, false
+	}
+	fp, err := c.path(mod, "list")
+	if err != nil {
+		return nil, false
+	}
+	if fi, err := os.Stat(fp); err != nil || time.Since(fi.ModTime()) >= c.listTTL {
+		return nil, false
+	}
+	data, err := os.ReadFile(fp)
+	if err != nil || len(data) == 0 {
+		return nil, false
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n"), true
+}
+
+// putVersions caches the list of versions of mod.
+func (c *diskCache) putVersions(mod string, versions []string) error {
+	if c == nil {
+		return nil
+	}
+	fp, err := c.path(mod, "list")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(fp, []byte(strings.Join(versions, "\n")+"\n"))
+}
+
+// getModFile returns the cached go.mod file for mod@version, if present.
+func (c *diskCache) getModFile(mod, version string) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	ev, err := module.EscapeVersion(version)
+	if err != nil {
+		return nil, false
+	}
+	fp, err := c.path(mod, ev+".mod")
+	if err != nil {
+		return nil, false
+	}
+	data, err := os.ReadFile(fp)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// putModFile caches the go.mod file for mod@version.
+func (c *diskCache) putModFile(mod, version string, data []byte) error {
+	if c == nil {
+		return nil
+	}
+	ev, err := module.EscapeVersion(version)
+	if err != nil {
+		return err
+	}
+	fp, err := c.path(mod, ev+".mod")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(fp, data)
+}
+
+// path returns the path of the cache file for mod with the specified name
+func (c *diskCache) path(mod, name string) (string, error) {
+	ep, err := module.EscapePath(mod)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(c.dir, filepath.FromSlash(ep), "@v", name), nil
+}
+
+// writeFileAtomic writes data to a temporary file then renames it to fp so that concurrent readers never
+// see a partially written file.
+func writeFileAtomic(fp string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(fp), 0o755); err != nil && !errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("unable to create the module cache folder: %w", err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(fp), filepath.Base(fp)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("unable to write to the module cache: %w", err)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("unable to write to the module cache: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("unable to write to the module cache: %w", err)
+	}
+	if err := os.Rename(f.Name(), fp); err != nil {
+		return fmt.Errorf("unable to write to the module cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package modproxy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/net/html"
+
+	"github.com/CrowdStrike/perseus/internal/git"
+)
+
+// knownHosts lists the code hosting sites whose repository layout is known so that the repository for
+// a module can be determined without a ?go-get=1 request.  The first 3 elements of a module path on
+// these hosts are the repository root.
+var knownHosts = map[string]bool{
+	"github.com":    true,
+	"gitlab.com":    true,
+	"bitbucket.org": true,
+}
+
+// repoRoot identifies the version control repository that contains a module
+type repoRoot struct {
+	// the import path that corresponds to the root of the repository
+	root string
+	// the version control system, only "git" and "mod" (a module proxy) are supported
+	vcs string
+	// the URL of the repository or, if vcs is "mod", the base URL of a module proxy that serves the module
+	url string
+}
+
+// directModuleVersions retrieves the list of versions of mod from the tags in its version control
+// repository, like the go command does for the "direct" GOPROXY entry.
+func (p Proxy) directModuleVersions(mod string) ([]string, error) {
+	rr, err := p.resolveRepoRoot(mod)
+	if err != nil {
+		return nil, err
+	}
+	if rr.vcs == "mod" {
+		return p.proxyModuleVersions(rr.url, mod)
+	}
+
+	prefix, pathMajor := tagPrefix(rr, mod)
+	tags, err := git.RemoteVersionTags(rr.url, prefix)
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, v := range tags {
+		// only tags for the module's major version belong to this module
+		if module.CheckPathMajor(v, pathMajor) == nil {
+			versions = append(versions, v)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, errNotFound
+	}
+	return versions, nil
+}
+
+// directModFile retrieves the go.mod file for mod@version from the tagged commit in the module's version
+// control repository, like the go command does for the "direct" GOPROXY entry.
+func (p Proxy) directModFile(mod, version string) ([]byte, error) {
+	rr, err := p.resolveRepoRoot(mod)
+	if err != nil {
+		return nil, err
+	}
+	if rr.vcs == "mod" {
+		return p.proxyModFile(rr.url, mod, version)
+	}
+	if module.IsPseudoVersion(version) {
+		return nil, fmt.Errorf("unable to fetch %s@%s directly: pseudo-versions are only supported by module proxies", mod, version)
+	}
+
+	prefix, pathMajor := tagPrefix(rr, mod)
+	dir := strings.TrimSuffix(prefix, "/")
+	// a module with a major version suffix may live in a vN sub-folder or in the folder for the module
+	// prefix itself
+	var paths []string
+	if pathMajor != "" && !strings.HasPrefix(pathMajor, ".") {
+		paths = append(paths, strings.TrimPrefix(dir+pathMajor+"/go.mod", "/"))
+	}
+	paths = append(paths, strings.TrimPrefix(dir+"/go.mod", "/"))
+
+	data, err := git.ReadRemoteFile(rr.url, prefix+version, paths...)
+	if err != nil {
+		if errors.Is(err, git.ErrFileNotFound) {
+			// like the go command, synthesize a go.mod for code that pre-dates modules
+			return []byte("module " + mod + "\n"), nil
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// tagPrefix returns the prefix of the version tags for mod within the repository described by rr, which
+// is the module's folder within the repository, along with the major version suffix of the module path,
+// if any.
+func tagPrefix(rr repoRoot, mod string) (prefix, pathMajor string) {
+	modPrefix, pathMajor, _ := module.SplitPathVersion(mod)
+	if dir, ok := strings.CutPrefix(modPrefix, rr.root+"/"); ok {
+		prefix = dir + "/"
+	}
+	return prefix, pathMajor
+}
+
+// resolveRepoRoot determines the version control repository that contains mod.  Modules on well known
+// code hosting sites are resolved based on the module path, otherwise the go-import <meta> tag served for
+// the module path with ?go-get=1 is used, which is how vanity import paths are resolved.
+//
+// See https://go.dev/ref/mod#vcs-find
+func (p Proxy) resolveRepoRoot(mod string) (repoRoot, error) {
+	parts := strings.Split(mod, "/")
+	if knownHosts[parts[0]] {
+		if len(parts) < 3 {
+			return repoRoot{}, fmt.Errorf("invalid module path %q for %s: %w", mod, parts[0], errNotFound)
+		}
+		root := strings.Join(parts[:3], "/")
+		return repoRoot{root: root, vcs: "git", url: "https://" + root}, nil
+	}
+
+	u := "https://" + mod + "?go-get=1"
+	resp, err := p.get(u)
+	if err != nil {
+		return repoRoot{}, fmt.Errorf("error resolving the repository for %s: %w", mod, err)
+	}
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return repoRoot{}, fmt.Errorf("unexpected response code from %s: %s", u, resp.Status)
+	}
+	roots, err := parseGoImports(resp.Body)
+	if err != nil {
+		return repoRoot{}, fmt.Errorf("error parsing the response from %s: %w", u, err)
+	}
+
+	var match repoRoot
+	for _, rr := range roots {
+		if rr.root != mod && !strings.HasPrefix(mod, rr.root+"/") {
+			continue
+		}
+		if len(rr.root) > len(match.root) {
+			match = rr
+		}
+	}
+	switch match.vcs {
+	case "":
+		return repoRoot{}, fmt.Errorf("no go-import meta tag for %s at %s: %w", mod, u, errNotFound)
+	case "git", "mod":
+		return match, nil
+	default:
+		return repoRoot{}, fmt.Errorf("unable to fetch %s directly: the %q version control system is not supported", mod, match.vcs)
+	}
+}
+
+// parseGoImports returns the repositories declared by the go-import <meta> tags in the HTML document
+// read from r.  Like the go command, only the <head> element is considered.
+func parseGoImports(r io.Reader) ([]repoRoot, error) {
+	var roots []repoRoot
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if err := z.Err(); !errors.Is(err, io.EOF) {
+				return nil, err
+			}
+			return roots, nil
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			if tok.Data == "body" {
+				return roots, nil
+			}
+			if tok.Data != "meta" {
+				continue
+			}
+			var name, content string
+			for _, a := range tok.Attr {
+				switch strings.ToLower(a.Key) {
+				case "name":
+					name = a.Val
+				case "content":
+					content = a.Val
+				}
+			}
+			if f := strings.Fields(content); name == "go-import" && len(f) == 3 {
+				roots = append(roots, repoRoot{root: f[0], vcs: f[1], url: strings.TrimSuffix(f[2], "/")})
+			}
+		case html.EndTagToken:
+			if z.Token().Data == "head" {
+				return roots, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package modproxy
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGoImports(t *testing.T) {
+	const doc = `<!DOCTYPE html>
+<html>
+<head>
+<meta name="go-import" content="example.com/foo git https://git.example.com/foo/">
+<meta name="go-source" content="example.com/foo https://git.example.com/foo">
+<meta name="go-import" content="example.com/bar mod https://proxy.example.com">
+</head>
+<body>
+<meta name="go-import" content="example.com/ignored git https://git.example.com/ignored">
+</body>
+</html>`
+	got, err := parseGoImports(strings.NewReader(doc))
+	assert.NoError(t, err)
+	assert.Equal(t, []repoRoot{
+		{root: "example.com/foo", vcs: "git", url: "https://git.example.com/foo"},
+		{root: "example.com/bar", vcs: "mod", url: "https://proxy.example.com"},
+	}, got)
+}
+
+func TestTagPrefix(t *testing.T) {
+	type testCase struct {
+		name              string
+		root, mod         string
+		prefix, pathMajor string
+	}
+	cases := []testCase{
+		{name: "repo root", root: "github.com/foo/bar", mod: "github.com/foo/bar"},
+		{name: "major version", root: "github.com/foo/bar", mod: "github.com/foo/bar/v2", pathMajor: "/v2"},
+		{name: "sub-folder", root: "github.com/foo/bar", mod: "github.com/foo/bar/baz", prefix: "baz/"},
+		{name: "sub-folder with major version", root: "github.com/foo/bar", mod: "github.com/foo/bar/baz/v3", prefix: "baz/", pathMajor: "/v3"},
+		{name: "gopkg.in", root: "gopkg.in/yaml.v3", mod: "gopkg.in/yaml.v3", pathMajor: ".v3"},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			prefix, pathMajor := tagPrefix(repoRoot{root: tc.root}, tc.mod)
+			assert.Equal(t, tc.prefix, prefix)
+			assert.Equal(t, tc.pathMajor, pathMajor)
+		})
+	}
+}
+
+func TestResolveVanityPath(t *testing.T) {
+	const doc = `<html><head>
+<meta name="go-import" content="go.uber.org/zap git https://github.com/uber-go/zap.git">
+<meta name="go-import" content="k8s.io/client-go git https://github.com/kubernetes/client-go">
+<meta name="go-import" content="example.com/self git https://git.example.com/self">
+</head></html>`
+	p := New(getterFunc(func(string) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(doc))}, nil
+	}), "https://proxy.example.com")
+
+	cases := map[string]string{
+		"github.com/foo/bar":         "",
+		"go.uber.org/zap":            "github.com/uber-go/zap",
+		"go.uber.org/zap/v2":         "github.com/uber-go/zap/v2",
+		"k8s.io/client-go":           "github.com/kubernetes/client-go",
+		"example.com/self":           "",
+		"gopkg.in/yaml.v2":           "github.com/go-yaml/yaml/v2",
+		"gopkg.in/yaml.v1":           "github.com/go-yaml/yaml",
+		"gopkg.in/src-d/go-git.v4":   "github.com/src-d/go-git/v4",
+		"gopkg.in/check.v1-unstable": "github.com/go-check/check",
+	}
+	for mod, expected := range cases {
+		got, err := p.ResolveVanityPath(mod)
+		assert.NoError(t, err, mod)
+		assert.Equal(t, expected, got, mod)
+	}
+}
@@ -0,0 +1,61 @@
+package modproxy
+
+import (
+	"net/url"
+	"os"
+
+	"golang.org/x/mod/module"
+)
+
+// publicProxyHosts lists the hosts of well-known public module proxies, which cannot serve private modules
+// and should not be sent the paths of private modules.
+var publicProxyHosts = map[string]bool{
+	"proxy.golang.org":   true,
+	"goproxy.io":         true,
+	"goproxy.cn":         true,
+	"mirrors.aliyun.com": true,
+}
+
+// privateModules returns the comma-separated list of glob patterns matching module paths that should not
+// be fetched through public module proxies, read from $GONOPROXY or, if that is not set, $GOPRIVATE.
+//
+// See https://go.dev/ref/mod#private-modules
+func privateModules() string {
+	if s := os.Getenv("GONOPROXY"); s != "" {
+		return s
+	}
+	return os.Getenv("GOPRIVATE")
+}
+
+// WithPrivateDirect returns a copy of p that, if enabled is true, fetches private modules, those matching
+// $GOPRIVATE or $GONOPROXY, directly from their version control repositories when none of the configured
+// private proxies have them.
+func (p Proxy) WithPrivateDirect(enabled bool) Proxy {
+	p.privateDirect = enabled
+	return p
+}
+
+// sourcesFor returns the sources to be used for mod.  Private modules are never requested from a public
+// proxy and are only fetched directly if that was enabled using [Proxy.WithPrivateDirect].
+func (p Proxy) sourcesFor(mod string) []source {
+	if p.private == "" || !module.MatchPrefixPatterns(p.private, mod) {
+		return p.sources
+	}
+	var sources []source
+	for _, src := range p.sources {
+		if src.url == sourceDirect || isPublicProxy(src.url) {
+			continue
+		}
+		sources = append(sources, src)
+	}
+	if p.privateDirect {
+		sources = append(sources, source{url: sourceDirect})
+	}
+	return sources
+}
+
+// isPublicProxy returns true if u is the URL of a well-known public module proxy
+func isPublicProxy(u string) bool {
+	parsed, err := url.Parse(u)
+	return err == nil && publicProxyHosts[parsed.Hostname()]
+}
@@ -1,33 +1,102 @@
 package modproxy
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	"golang.org/x/mod/modfile"
-	"golang.org/x/mod/semver"
+	"golang.org/x/mod/module"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
+)
+
+// the special GOPROXY list entries
+const (
+	// fetch modules directly from their version control repositories
+	sourceDirect = "direct"
+	// disallow module lookups
+	sourceOff = "off"
 )
 
+// errNotFound is returned when a source does not have the requested module or version, which always
+// allows the next source in the list to be tried.
+var errNotFound = errors.New("not found")
+
+// ErrNoVersions is returned, possibly wrapped, by [Proxy.GetModuleVersions] when none of the sources have
+// any versions of the requested module.
+var ErrNoVersions = errors.New("no versions found")
+
+// ErrVersionNotFound is returned, possibly wrapped, by [Proxy.GetModFile] when none of the sources have
+// the requested module version.
+var ErrVersionNotFound = errors.New("module version not found")
+
+// ErrPrivateModule is returned, possibly wrapped, when the requested module matches $GONOPROXY or
+// $GOPRIVATE and there are no private proxies or direct fetching configured to retrieve it from.
+var ErrPrivateModule = errors.New("private module")
+
 // Proxy wraps a Getter and a list of proxy URLs to provide the required module proxy operations
 type Proxy struct {
 	g       Getter
 	proxies []string
+	sources []source
+
+	retryAttempts int
+	retryDelay    time.Duration
+	cache         *diskCache
+
+	// glob patterns matching private modules and whether or not they may be fetched directly
+	private       string
+	privateDirect bool
+}
+
+// source is a single entry in a GOPROXY list
+type source struct {
+	// the proxy URL or one of the special values "direct" or "off"
+	url string
+	// true if the entry was followed by '|', meaning that the next entry should be tried after any error
+	// rather than only when the module or version was not found
+	fallbackOnError bool
 }
 
 // New returns a Proxy instance that will use g to execute HTTP requests against the module proxies
-// in urls.
+// in urls.  Each URL is tried in order, moving to the next one only if the module was not found, and
+// "direct" may be included to fetch modules from their version control repositories.
+//
+// If no URLs are provided, the proxies are read from $GOPROXY, including the '|' separator which falls
+// back to the next proxy after any error, and modules matching $GONOPROXY or $GOPRIVATE are never sent to
+// public proxies.
 func New(g Getter, urls ...string) Proxy {
+	var (
+		sources []source
+		private string
+	)
 	if len(urls) == 0 {
-		urls = getModProxies()
+		sources = parseProxyList(os.Getenv("GOPROXY"))
+		private = privateModules()
+	} else {
+		for _, u := range urls {
+			sources = append(sources, source{url: strings.TrimSuffix(u, "/")})
+		}
 	}
-	return Proxy{
-		g:       g,
-		proxies: urls,
+	p := Proxy{
+		g:             g,
+		sources:       sources,
+		retryAttempts: defaultRetryAttempts,
+		retryDelay:    defaultRetryDelay,
+		private:       private,
 	}
+	for _, src := range sources {
+		if src.url != sourceDirect && src.url != sourceOff {
+			p.proxies = append(p.proxies, src.url)
+		}
+	}
+	return p
 }
 
 // NewFromEnv returns a Proxy instance that will use g to execute HTTP requests against the module proxies
@@ -36,95 +105,177 @@ func NewFromEnv(g Getter) Proxy {
 	return New(g)
 }
 
+// URLs returns the list of module proxy URLs configured on p.
+func (p Proxy) URLs() []string {
+	return p.proxies
+}
+
 // GetCurrentVersion returns the highest known version of the specified module, as returned by list of
-// module proxies configured on p.
+// module proxies configured on p.  Like the go command, "+incompatible" versions are only considered if
+// the module has no compatible versions.
 func (p Proxy) GetCurrentVersion(mod string, includePrerelease bool) (string, error) {
 	versions, err := p.GetModuleVersions(mod)
 	if err != nil {
 		return "", err
 	}
-	latest := versions[0]
-	for _, v := range versions {
-		if semver.Compare(v, latest) > 0 && (semver.Prerelease(v) == "" || includePrerelease) {
-			latest = v
-		}
-	}
-	return latest, nil
+	return modver.Latest(versions, includePrerelease), nil
 }
 
 // GetModuleVersions retrieve a list of module versions for the specified module by querying the list
 // of module proxies configured on p.
 func (p Proxy) GetModuleVersions(mod string) ([]string, error) {
-	for _, proxy := range p.proxies {
-		url := proxy + "/" + path.Join(mod, "@v/list")
-		resp, err := p.g.Get(url)
-		if err != nil {
-			return nil, fmt.Errorf("error fetching module versions from %s: %w", proxy, err)
+	if versions, ok := p.cache.getVersions(mod); ok {
+		return versions, nil
+	}
+	var versions []string
+	err := p.lookup(mod, func(src source) (err error) {
+		if src.url == sourceDirect {
+			versions, err = p.directModuleVersions(mod)
+		} else {
+			versions, err = p.proxyModuleVersions(src.url, mod)
 		}
-		defer func() {
-			if resp.Body != nil {
-				_ = resp.Body.Close()
-			}
-		}()
-		switch resp.StatusCode {
-		case http.StatusOK:
-			// no error check here b/c we've already checked the error from Get() and the response status code
-			data, _ := io.ReadAll(resp.Body)
-			// the response is a plain text list of module versions delimited by newlines
-			// - see https://go.dev/ref/mod#goproxy-protocol
-			if len(data) == 0 {
-				// proceed to the next proxy, if present, if we got no data
-				continue
-			}
-			ss := strings.Split(string(data), "\n")
-			res := make([]string, len(ss))
-			for i, s := range ss {
-				res[i] = strings.TrimSpace(s)
-			}
-			return res, nil
-		case http.StatusNotFound, http.StatusGone:
-			// try the next proxy
-			continue
-		default:
-			return nil, fmt.Errorf("unexpected response code (%s) from %s", resp.Status, proxy)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, fmt.Errorf("%w for %s", ErrNoVersions, mod)
 		}
+		return nil, err
 	}
-	return nil, fmt.Errorf("no versions found for %s", mod)
+	// a failure to update the cache only costs a future request so it is not reported
+	_ = p.cache.putVersions(mod, versions)
+	return versions, nil
 }
 
 // GetModFile retrieves the go.mod file for the specified module by querying the list of module proxies
 // configured on p.
 func (p Proxy) GetModFile(mod, version string) (*modfile.File, error) {
-	for _, proxy := range p.proxies {
-		u := proxy + "/" + path.Join(mod, "@v", semver.Canonical(version)+".mod")
-		resp, err := p.g.Get(u)
-		if err != nil {
-			return nil, fmt.Errorf("error fetching module versions from %s: %w", u, err)
-		}
-		defer func() {
-			if resp.Body != nil {
-				_ = resp.Body.Close()
+	data, cached := p.cache.getModFile(mod, version)
+	if !cached {
+		err := p.lookup(mod, func(src source) (err error) {
+			if src.url == sourceDirect {
+				data, err = p.directModFile(mod, version)
+			} else {
+				data, err = p.proxyModFile(src.url, mod, version)
 			}
-		}()
-		switch resp.StatusCode {
-		case http.StatusOK:
-			data, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return nil, fmt.Errorf("error reading the module proxy respons from %s: %w", u, err)
+			return err
+		})
+		if err != nil {
+			if errors.Is(err, errNotFound) {
+				return nil, fmt.Errorf("%w: %s@%s", ErrVersionNotFound, mod, version)
 			}
-			f, err := modfile.ParseLax(mod+"@"+version+"/go.mod", data, nil)
-			if err != nil {
-				return nil, fmt.Errorf("error parsing go.mod from %s: %w", u, err)
+			return nil, err
+		}
+	}
+	f, err := modfile.ParseLax(mod+"@"+version+"/go.mod", data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing go.mod for %s@%s: %w", mod, version, err)
+	}
+	if !cached {
+		// a failure to update the cache only costs a future request so it is not reported
+		_ = p.cache.putModFile(mod, version, data)
+	}
+	return f, nil
+}
+
+// lookup calls fetch for each of the sources to be used for mod, in order, until one succeeds.  Like the
+// go command, the next source is tried if the module or version was not found or, if the current source
+// was followed by '|' in $GOPROXY, after any error.
+func (p Proxy) lookup(mod string, fetch func(source) error) error {
+	sources := p.sourcesFor(mod)
+	if len(sources) == 0 {
+		return fmt.Errorf("%w: no private proxy or direct fetching is configured for %s", ErrPrivateModule, mod)
+	}
+	err := errNotFound
+	for _, src := range sources {
+		if src.url == sourceOff {
+			return fmt.Errorf("module lookup disabled by GOPROXY=off")
+		}
+		if err = fetch(src); err == nil {
+			return nil
+		}
+		if !errors.Is(err, errNotFound) && !src.fallbackOnError {
+			return err
+		}
+	}
+	return err
+}
+
+// proxyModuleVersions retrieves the list of versions of mod from the module proxy at baseURL.  Like all
+// proxy requests, the module path in the URL is case-encoded so that paths containing upper-case letters
+// resolve on case-insensitive file systems.
+func (p Proxy) proxyModuleVersions(baseURL, mod string) ([]string, error) {
+	escaped, err := module.EscapePath(mod)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module path %q: %w", mod, err)
+	}
+	url := baseURL + "/" + path.Join(escaped, "@v/list")
+	resp, err := p.get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching module versions from %s: %w", baseURL, err)
+	}
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// no error check here b/c we've already checked the error from Get() and the response status code
+		data, _ := io.ReadAll(resp.Body)
+		// the response is a plain text list of module versions delimited by newlines
+		// - see https://go.dev/ref/mod#goproxy-protocol
+		var res []string
+		for _, s := range strings.Split(string(data), "\n") {
+			if s = strings.TrimSpace(s); s != "" {
+				res = append(res, s)
 			}
-			return f, nil
-		case http.StatusNotFound, http.StatusGone:
-			// try the next proxy
-			continue
-		default:
-			return nil, fmt.Errorf("unexpected response code from %s: %s", u, resp.Status)
 		}
+		if len(res) == 0 {
+			// proceed to the next proxy, if present, if we got no data
+			return nil, errNotFound
+		}
+		return res, nil
+	case http.StatusNotFound, http.StatusGone:
+		return nil, errNotFound
+	default:
+		return nil, fmt.Errorf("unexpected response code (%s) from %s", resp.Status, baseURL)
+	}
+}
+
+// proxyModFile retrieves the contents of the go.mod file for mod@version from the module proxy at baseURL
+func (p Proxy) proxyModFile(baseURL, mod, version string) ([]byte, error) {
+	escapedPath, err := module.EscapePath(mod)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module path %q: %w", mod, err)
+	}
+	// module.CanonicalVersion() is used because, unlike semver.Canonical(), it keeps "+incompatible"
+	escapedVersion, err := module.EscapeVersion(module.CanonicalVersion(version))
+	if err != nil {
+		return nil, fmt.Errorf("invalid module version %q: %w", version, err)
+	}
+	u := baseURL + "/" + path.Join(escapedPath, "@v", escapedVersion+".mod")
+	resp, err := p.get(u)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching module versions from %s: %w", u, err)
+	}
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the module proxy respons from %s: %w", u, err)
+		}
+		return data, nil
+	case http.StatusNotFound, http.StatusGone:
+		return nil, errNotFound
+	default:
+		return nil, fmt.Errorf("unexpected response code from %s: %s", u, resp.Status)
 	}
-	return nil, fmt.Errorf("the specified module was not found")
 }
 
 // Getter defines a type, such as http.Client, that can perform an HTTP GET request and return
@@ -160,24 +311,38 @@ func GetModFile(g Getter, mod, version string) (*modfile.File, error) {
 
 // getModProxies returns a list of Go module proxies by parsing the GOPROXY environment variable.  If
 // no proxy is set ($GOPROXY is unset or "") this function returns a single result containing the
-// Google public proxy.
+// Google public proxy.  The special "direct" and "off" entries are not included.
 func getModProxies() []string {
-	// $GOPROXY is expected to be a string containing 0 or more URLs or the string "direct" separated
-	// by ',' or '|'
-	// - see https://go.dev/ref/mod#environment-variables
-	ev := os.Getenv("GOPROXY")
-	if ev == "" {
-		return []string{"https://proxy.golang.org"}
-	}
-	// convert '|' to ',' so we only need 1 call to strings.Split() below
-	ev = strings.ReplaceAll(ev, "|", ",")
-	var results []string
-	for _, s := range strings.Split(ev, ",") {
-		// not trying to deal with pulling go.mod directly from various VCSs for now
-		if s != "direct" {
-			// remove any trailing slash so that the paths can be treated homogeneously
-			results = append(results, strings.TrimSuffix(s, "/"))
+	return New(nil).URLs()
+}
+
+// parseProxyList parses a $GOPROXY value into a list of sources.  If the value is empty the result
+// contains only the Google public proxy.
+//
+// The value is expected to be a string containing 1 or more URLs or the special values "direct" and
+// "off" separated by ',' or '|'
+// - see https://go.dev/ref/mod#goproxy-protocol
+func parseProxyList(ev string) []source {
+	if strings.TrimSpace(ev) == "" {
+		return []source{{url: "https://proxy.golang.org"}}
+	}
+	var sources []source
+	for ev != "" {
+		entry, sep := ev, byte(0)
+		if i := strings.IndexAny(ev, ",|"); i >= 0 {
+			entry, sep, ev = ev[:i], ev[i], ev[i+1:]
+		} else {
+			ev = ""
+		}
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
 		}
+		sources = append(sources, source{
+			// remove any trailing slash so that the paths can be treated homogeneously
+			url:             strings.TrimSuffix(entry, "/"),
+			fallbackOnError: sep == '|',
+		})
 	}
-	return results
+	return sources
 }
@@ -7,7 +7,9 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/mod/modfile"
@@ -332,3 +334,230 @@ func TestGetModProxies(t *testing.T) {
 		})
 	}
 }
+
+func TestParseProxyList(t *testing.T) {
+	type testCase struct {
+		name     string
+		env      string
+		expected []source
+	}
+	cases := []testCase{
+		{
+			name:     "no value",
+			env:      "",
+			expected: []source{{url: "https://proxy.golang.org"}},
+		},
+		{
+			name: "comma separated with direct",
+			env:  "https://one/,https://two,direct",
+			expected: []source{
+				{url: "https://one"},
+				{url: "https://two"},
+				{url: "direct"},
+			},
+		},
+		{
+			name: "mixed separators",
+			env:  "https://one|https://two,off",
+			expected: []source{
+				{url: "https://one", fallbackOnError: true},
+				{url: "https://two"},
+				{url: "off"},
+			},
+		},
+		{
+			name: "empty entries are ignored",
+			env:  " https://one ,,|https://two",
+			expected: []source{
+				{url: "https://one"},
+				{url: "https://two"},
+			},
+		},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, parseProxyList(tc.env))
+		})
+	}
+}
+
+func TestProxyFallback(t *testing.T) {
+	testErr := fmt.Errorf("oh no")
+	// the first proxy always fails and the second always succeeds
+	g := getterFunc(func(url string) (*http.Response, error) {
+		if strings.HasPrefix(url, "https://one/") {
+			return nil, testErr
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBuffer([]byte("v0.1.0\nv0.2.0\n"))),
+		}, nil
+	})
+	type testCase struct {
+		name     string
+		sources  []source
+		expected []string
+		checkErr func(*testing.T, error)
+	}
+	cases := []testCase{
+		{
+			name:    "comma stops on error",
+			sources: parseProxyList("https://one,https://two"),
+			checkErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, testErr)
+			},
+		},
+		{
+			name:     "pipe falls back on error",
+			sources:  parseProxyList("https://one|https://two"),
+			expected: []string{"v0.1.0", "v0.2.0"},
+			checkErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "off disables lookups",
+			sources: parseProxyList("off"),
+			checkErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "GOPROXY=off")
+			},
+		},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := Proxy{g: g, sources: tc.sources}
+			got, err := p.GetModuleVersions("github.com/foo/bar")
+			tc.checkErr(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestProxyURLsAreCaseEncoded(t *testing.T) {
+	var urls []string
+	p := New(getterFunc(func(url string) (*http.Response, error) {
+		urls = append(urls, url)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBuffer([]byte("module github.com/Azure/go-autorest\n"))),
+		}, nil
+	}), "https://proxy.example.com")
+
+	_, err := p.GetModuleVersions("github.com/Azure/go-autorest")
+	assert.NoError(t, err)
+	_, err = p.GetModFile("github.com/Azure/go-autorest", "v14.2.0-RC1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://proxy.example.com/github.com/!azure/go-autorest/@v/list",
+		"https://proxy.example.com/github.com/!azure/go-autorest/@v/v14.2.0-!r!c1.mod",
+	}, urls)
+}
+
+func TestProxyRetries(t *testing.T) {
+	calls := 0
+	p := New(getterFunc(func(string) (*http.Response, error) {
+		calls++
+		if calls < 3 {
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Status:     "503 Service Unavailable",
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBuffer([]byte("v0.1.0"))),
+		}, nil
+	}), "https://proxy.example.com")
+	p.retryDelay = time.Millisecond
+
+	got, err := p.GetModuleVersions("github.com/foo/bar")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v0.1.0"}, got)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	_, err = p.WithRetries(2).GetModuleVersions("github.com/foo/bar")
+	assert.ErrorContains(t, err, "503")
+	assert.Equal(t, 2, calls)
+}
+
+func TestProxyCache(t *testing.T) {
+	const modContents = "module github.com/Foo/bar\n\ngo 1.18\n"
+	calls := 0
+	p := New(getterFunc(func(url string) (*http.Response, error) {
+		calls++
+		body := modContents
+		if strings.HasSuffix(url, "/list") {
+			body = "v0.1.0\nv0.2.0\n"
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBuffer([]byte(body))),
+		}, nil
+	}), "https://proxy.example.com").WithCache(t.TempDir(), time.Hour)
+
+	for i := 0; i < 2; i++ {
+		versions, err := p.GetModuleVersions("github.com/Foo/bar")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"v0.1.0", "v0.2.0"}, versions)
+
+		mf, err := p.GetModFile("github.com/Foo/bar", "v0.2.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "github.com/Foo/bar", mf.Module.Mod.Path)
+	}
+	assert.Equal(t, 2, calls, "the second round of lookups should be served from the cache")
+}
+
+func TestPrivateModuleSources(t *testing.T) {
+	type testCase struct {
+		name          string
+		goproxy       string
+		mod           string
+		privateDirect bool
+		expected      []source
+	}
+	cases := []testCase{
+		{
+			name:     "public module",
+			goproxy:  "https://athens.example.com,https://proxy.golang.org,direct",
+			mod:      "github.com/foo/bar",
+			expected: parseProxyList("https://athens.example.com,https://proxy.golang.org,direct"),
+		},
+		{
+			name:     "private module skips public proxies and direct",
+			goproxy:  "https://athens.example.com|https://proxy.golang.org,direct",
+			mod:      "git.example.com/team/svc",
+			expected: []source{{url: "https://athens.example.com", fallbackOnError: true}},
+		},
+		{
+			name:          "private module with direct fallback",
+			goproxy:       "https://proxy.golang.org",
+			mod:           "git.example.com/team/svc",
+			privateDirect: true,
+			expected:      []source{{url: "direct"}},
+		},
+		{
+			name:    "private module with only public proxies",
+			goproxy: "https://proxy.golang.org,direct",
+			mod:     "git.example.com/team/svc",
+		},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := Proxy{
+				sources: parseProxyList(tc.goproxy),
+				private: "git.example.com,*.corp.example.com",
+			}
+			assert.Equal(t, tc.expected, p.WithPrivateDirect(tc.privateDirect).sourcesFor(tc.mod))
+		})
+	}
+}
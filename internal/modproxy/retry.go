@@ -0,0 +1,77 @@
+package modproxy
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultRetryAttempts is the default number of times a request to a module proxy is attempted before
+	// giving up on a transient failure
+	defaultRetryAttempts = 3
+	// defaultRetryDelay is the delay before the first retry, which doubles for each subsequent retry
+	defaultRetryDelay = 250 * time.Millisecond
+	// maxRetryDelay caps the delay between retries, including any delay requested by the proxy
+	maxRetryDelay = 10 * time.Second
+)
+
+// WithRetries returns a copy of p that makes up to attempts tries for each request to a module proxy
+// that fails with a network error or a transient HTTP status (429, 500, 502, 503, or 504), waiting
+// longer after each failure.  An attempts value less than 2 disables retries.
+func (p Proxy) WithRetries(attempts int) Proxy {
+	p.retryAttempts = max(attempts, 1)
+	return p
+}
+
+// get executes an HTTP GET request for url, retrying transient failures with exponential backoff and
+// jitter.  The response or error from the final attempt is returned.
+func (p Proxy) get(url string) (resp *http.Response, err error) {
+	delay := p.retryDelay
+	for attempt := 1; ; attempt++ {
+		resp, err = p.g.Get(url)
+		if attempt >= p.retryAttempts || !isTransient(resp, err) {
+			return resp, err
+		}
+		wait := delay
+		if resp != nil {
+			if ra := retryAfter(resp); ra > wait {
+				wait = ra
+			}
+			if resp.Body != nil {
+				_ = resp.Body.Close()
+			}
+		}
+		// inject up to 20% jitter so that concurrent callers don't retry in lock-step
+		wait += time.Duration(rand.Int64N(int64(wait)/5 + 1))
+		time.Sleep(min(wait, maxRetryDelay))
+		delay *= 2
+	}
+}
+
+// isTransient returns true if the result of a request indicates a failure that may succeed if retried
+func isTransient(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, if it specifies a number of
+// seconds, or 0.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp.Header == nil {
+		return 0
+	}
+	d, err := time.ParseDuration(resp.Header.Get("Retry-After") + "s")
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,72 @@
+package modproxy
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/mod/module"
+)
+
+// ResolveVanityPath returns the path that mod would have if it were named after the code hosting site
+// repository that contains it, such as github.com/uber-go/zap for go.uber.org/zap, or an empty string if
+// mod is not a vanity import path or its repository is not hosted on a well-known site.
+//
+// The repository is located using the go-import <meta> tag served for the module path with ?go-get=1,
+// except for gopkg.in, whose mapping to GitHub repositories is fixed.  The major version suffix of mod, if
+// any, is kept as a "/vN" suffix so that each major version has a distinct path.
+func (p Proxy) ResolveVanityPath(mod string) (string, error) {
+	host, _, _ := strings.Cut(mod, "/")
+	if knownHosts[host] {
+		return "", nil
+	}
+	if host == "gopkg.in" {
+		return gopkgInRepoPath(mod), nil
+	}
+
+	rr, err := p.resolveRepoRoot(mod)
+	if err != nil {
+		return "", err
+	}
+	if rr.vcs != "git" {
+		return "", nil
+	}
+	u, err := url.Parse(rr.url)
+	if err != nil || !knownHosts[u.Hostname()] {
+		return "", nil
+	}
+	parts := strings.Split(strings.Trim(strings.TrimSuffix(u.Path, ".git"), "/"), "/")
+	if len(parts) != 2 {
+		return "", nil
+	}
+	resolved := u.Hostname() + "/" + strings.Join(parts, "/") + strings.TrimPrefix(mod, rr.root)
+	if resolved == mod {
+		return "", nil
+	}
+	return resolved, nil
+}
+
+// gopkgInRepoPath returns the GitHub path of the gopkg.in module mod, which is github.com/go-pkg/pkg for
+// gopkg.in/pkg.vN and github.com/user/pkg for gopkg.in/user/pkg.vN.  An empty string is returned if mod
+// is not a valid gopkg.in path.
+//
+// See https://labix.org/gopkg.in
+func gopkgInRepoPath(mod string) string {
+	prefix, pathMajor, ok := module.SplitPathVersion(mod)
+	if !ok || pathMajor == "" {
+		return ""
+	}
+	parts := strings.Split(strings.TrimPrefix(prefix, "gopkg.in/"), "/")
+	var repo string
+	switch len(parts) {
+	case 1:
+		repo = "github.com/go-" + parts[0] + "/" + parts[0]
+	case 2:
+		repo = "github.com/" + parts[0] + "/" + parts[1]
+	default:
+		return ""
+	}
+	if major := strings.TrimSuffix(strings.TrimPrefix(pathMajor, ".v"), "-unstable"); major != "0" && major != "1" {
+		repo += "/v" + major
+	}
+	return repo
+}
@@ -0,0 +1,98 @@
+// Package modver provides helpers for normalizing and comparing Go module versions according to the rules
+// used by the go command.
+package modver
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// incompatibleSuffix is the build metadata that marks a v2+ version of a module that has no major version
+// suffix in its path, either because it pre-dates modules or does not follow semantic import versioning
+const incompatibleSuffix = "+incompatible"
+
+// Canonical returns the canonical form of version for the module at path, which is the form required by
+// [module.Check] and the form that is stored in the Perseus graph.
+//
+// Shorthand versions, such as v1.2, are expanded to v1.2.0, a missing "v" prefix is added, and build
+// metadata is removed.  Like the go command, v2+ versions of a module whose path does not end in a major
+// version suffix are marked "+incompatible" and the marker is removed from any other version.
+func Canonical(path, version string) (string, error) {
+	v := version
+	if v != "" && v[0] != 'v' {
+		v = "v" + v
+	}
+	if !semver.IsValid(v) {
+		return "", fmt.Errorf("%q is not a valid semantic version", version)
+	}
+	// semver.Canonical() removes all build metadata, including +incompatible, which is added back below
+	// if it applies
+	v = semver.Canonical(v)
+	if _, pathMajor, ok := module.SplitPathVersion(path); ok && pathMajor == "" {
+		if major := semver.Major(v); major != "v0" && major != "v1" {
+			v += incompatibleSuffix
+		}
+	}
+	if err := module.Check(path, v); err != nil {
+		return "", err
+	}
+	return v, nil
+}
+
+// Family returns the path that identifies the family of modules that path belongs to, which is path
+// without its major version suffix.  The major versions of a project, such as example.com/foo,
+// example.com/foo/v2, and gopkg.in/foo.v3, are separate modules that belong to the same family.
+//
+// If path is not a valid module path it is returned unchanged.
+func Family(path string) string {
+	prefix, _, ok := module.SplitPathVersion(path)
+	if !ok {
+		return path
+	}
+	return prefix
+}
+
+// IsIncompatible returns true if v is a "+incompatible" version.
+func IsIncompatible(v string) bool {
+	return strings.HasSuffix(v, incompatibleSuffix)
+}
+
+// IsPseudo returns true if v is a pseudo-version, such as v0.0.0-20240101120000-abcdef123456, that
+// identifies an untagged revision of a module.
+//
+// Pseudo-versions are pre-releases, so they sort after the tagged version they are based on and before
+// the next release.
+func IsPseudo(v string) bool {
+	return module.IsPseudoVersion(v)
+}
+
+// Latest returns the highest version in versions following the rules used by the go command to resolve
+// @latest: release versions are preferred over pre-releases, which are only considered if includePrerelease
+// is true or there are no releases, tagged versions are preferred over pseudo-versions, and "+incompatible"
+// versions are only considered if there are no compatible versions.  An empty string is returned if versions
+// is empty.
+func Latest(versions []string, includePrerelease bool) string {
+	candidates := []func(string) bool{
+		func(v string) bool {
+			return !IsIncompatible(v) && !IsPseudo(v) && (includePrerelease || semver.Prerelease(v) == "")
+		},
+		func(v string) bool { return !IsPseudo(v) && (includePrerelease || semver.Prerelease(v) == "") },
+		func(v string) bool { return includePrerelease || semver.Prerelease(v) == "" },
+		func(string) bool { return true },
+	}
+	for _, include := range candidates {
+		latest := ""
+		for _, v := range versions {
+			if semver.IsValid(v) && include(v) && (latest == "" || semver.Compare(v, latest) > 0) {
+				latest = v
+			}
+		}
+		if latest != "" {
+			return latest
+		}
+	}
+	return ""
+}
@@ -0,0 +1,103 @@
+package modver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonical(t *testing.T) {
+	type testCase struct {
+		name, path, version string
+		expected            string
+		wantErr             bool
+	}
+	cases := []testCase{
+		{name: "already canonical", path: "github.com/foo/bar", version: "v1.2.3", expected: "v1.2.3"},
+		{name: "shorthand", path: "github.com/foo/bar", version: "v1.2", expected: "v1.2.0"},
+		{name: "missing v prefix", path: "github.com/foo/bar", version: "1.2.3", expected: "v1.2.3"},
+		{name: "build metadata", path: "github.com/foo/bar", version: "v1.2.3+build.42", expected: "v1.2.3"},
+		{name: "pre-release", path: "github.com/foo/bar", version: "v1.2.3-rc.1", expected: "v1.2.3-rc.1"},
+		{name: "pseudo-version", path: "github.com/foo/bar", version: "v0.0.0-20240101120000-abcdef123456", expected: "v0.0.0-20240101120000-abcdef123456"},
+		{name: "incompatible pseudo-version", path: "github.com/docker/docker", version: "v20.10.28-0.20240101120000-abcdef123456", expected: "v20.10.28-0.20240101120000-abcdef123456+incompatible"},
+		{name: "incompatible", path: "github.com/docker/docker", version: "v20.10.27+incompatible", expected: "v20.10.27+incompatible"},
+		{name: "missing incompatible", path: "github.com/docker/docker", version: "v20.10.27", expected: "v20.10.27+incompatible"},
+		{name: "spurious incompatible", path: "github.com/foo/bar", version: "v1.0.0+incompatible", expected: "v1.0.0"},
+		{name: "major version suffix", path: "github.com/foo/bar/v2", version: "v2.1.0+incompatible", expected: "v2.1.0"},
+		{name: "gopkg.in", path: "gopkg.in/yaml.v3", version: "v3.0.1", expected: "v3.0.1"},
+		{name: "wrong major version", path: "github.com/foo/bar/v2", version: "v3.0.0", wantErr: true},
+		{name: "invalid", path: "github.com/foo/bar", version: "latest", wantErr: true},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Canonical(tc.path, tc.version)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestLatest(t *testing.T) {
+	type testCase struct {
+		name              string
+		versions          []string
+		includePrerelease bool
+		expected          string
+	}
+	cases := []testCase{
+		{name: "empty"},
+		{name: "releases", versions: []string{"v1.0.0", "v1.10.0", "v1.2.0"}, expected: "v1.10.0"},
+		{name: "skips pre-releases", versions: []string{"v1.0.0", "v1.1.0-rc.1"}, expected: "v1.0.0"},
+		{name: "includes pre-releases", versions: []string{"v1.0.0", "v1.1.0-rc.1"}, includePrerelease: true, expected: "v1.1.0-rc.1"},
+		{name: "only pre-releases", versions: []string{"v1.1.0-rc.1", "v1.1.0-rc.2"}, expected: "v1.1.0-rc.2"},
+		{name: "prefers compatible", versions: []string{"v1.5.0", "v2.0.0+incompatible", "v3.1.0+incompatible"}, expected: "v1.5.0"},
+		{name: "only incompatible", versions: []string{"v2.0.0+incompatible", "v3.1.0+incompatible"}, expected: "v3.1.0+incompatible"},
+		{name: "prefers tags over pseudo-versions", versions: []string{"v1.1.0-rc.1", "v1.1.0-rc.1.0.20240101120000-abcdef123456"}, includePrerelease: true, expected: "v1.1.0-rc.1"},
+		{name: "pseudo-version above release", versions: []string{"v1.0.0", "v1.0.1-0.20240101120000-abcdef123456"}, includePrerelease: true, expected: "v1.0.0"},
+		{name: "only pseudo-versions", versions: []string{"v0.0.0-20230101120000-abcdef123456", "v0.0.0-20240101120000-123456abcdef"}, expected: "v0.0.0-20240101120000-123456abcdef"},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, Latest(tc.versions, tc.includePrerelease))
+		})
+	}
+}
+
+func TestIsPseudo(t *testing.T) {
+	cases := map[string]bool{
+		"v0.0.0-20240101120000-abcdef123456":                true,
+		"v1.2.4-0.20240101120000-abcdef123456":              true,
+		"v1.2.3-rc.1.0.20240101120000-abcdef123456":         true,
+		"v2.0.1-0.20240101120000-abcdef123456+incompatible": true,
+		"v1.2.3":      false,
+		"v1.2.3-rc.1": false,
+		"v0.0.0-2024": false,
+	}
+	for v, expected := range cases {
+		assert.Equal(t, expected, IsPseudo(v), v)
+	}
+}
+
+func TestFamily(t *testing.T) {
+	cases := map[string]string{
+		"github.com/foo/bar":     "github.com/foo/bar",
+		"github.com/foo/bar/v2":  "github.com/foo/bar",
+		"github.com/foo/bar/v12": "github.com/foo/bar",
+		"github.com/foo/v2/bar":  "github.com/foo/v2/bar",
+		"gopkg.in/yaml.v3":       "gopkg.in/yaml",
+		"gopkg.in/foo/bar.v1":    "gopkg.in/foo/bar",
+	}
+	for path, expected := range cases {
+		assert.Equal(t, expected, Family(path), path)
+	}
+}
@@ -0,0 +1,152 @@
+package modver
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+// A Range is a set of semantic versions defined by 0 or more comparisons, such as ">=v1.2.0 <v1.5.0",
+// all of which must be satisfied.  The zero value contains every version.
+type Range struct {
+	comparisons []Comparison
+	text        string
+}
+
+// A Comparison is a single comparison within a [Range], ex: {Op: ">=", Version: "v1.2.0"}
+type Comparison struct {
+	// one of >, >=, <, <=, = or !=
+	Op string
+	// the semantic version being compared against, which may be a shorthand such as v1.2
+	Version string
+}
+
+// the comparison operators supported by [ParseRange], longest first so that "<=" is not parsed as "<"
+var rangeOperators = []string{">=", "<=", "!=", ">", "<", "=", "~", "^"}
+
+// IsRange returns true if s is a range expression, such as ">=v1.2.0 <v2.0.0" or "^v1.4", rather than a
+// single version or a glob pattern.
+func IsRange(s string) bool {
+	return strings.ContainsAny(strings.TrimSpace(s), "<>=!~^ ,")
+}
+
+// ParseRange parses s, which is a list of comparisons separated by spaces or commas, into a [Range].  Each
+// comparison is one of the operators >, >=, <, <=, = or != followed by a semantic version, ex: "<v1.5.0".
+// A version without an operator must match exactly.  An empty string results in a range that contains
+// every version.
+//
+// Like npm and Cargo, ~ and ^ are shorthands for a pair of comparisons.  ~ allows patch releases if a minor
+// version is specified and minor releases otherwise, ex: "~v1.4" is ">=v1.4.0 <v1.5.0-0".  ^ allows any
+// release that does not change the left-most non-zero component, ex: "^v1.4" is ">=v1.4.0 <v2.0.0-0" and
+// "^v0.4.2" is ">=v0.4.2 <v0.5.0-0".  The upper bounds exclude the pre-releases of the next version.
+func ParseRange(s string) (Range, error) {
+	r := Range{text: strings.TrimSpace(s)}
+	fields := strings.FieldsFunc(s, func(c rune) bool { return c == ' ' || c == ',' })
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		// allow a space between the operator and the version, ex: ">= v1.2.0"
+		if slices.Contains(rangeOperators, f) && i+1 < len(fields) {
+			i++
+			f += fields[i]
+		}
+		c := Comparison{Op: "=", Version: f}
+		for _, op := range rangeOperators {
+			if v, ok := strings.CutPrefix(f, op); ok {
+				c = Comparison{Op: op, Version: v}
+				break
+			}
+		}
+		if c.Version != "" && c.Version[0] != 'v' {
+			c.Version = "v" + c.Version
+		}
+		if !semver.IsValid(c.Version) {
+			return Range{}, fmt.Errorf("invalid version range %q: %q is not a valid semantic version", s, c.Version)
+		}
+		switch c.Op {
+		case "~", "^":
+			lower, upper, err := expandShorthand(c.Op, c.Version)
+			if err != nil {
+				return Range{}, fmt.Errorf("invalid version range %q: %w", s, err)
+			}
+			r.comparisons = append(r.comparisons, Comparison{Op: ">=", Version: lower}, Comparison{Op: "<", Version: upper})
+		default:
+			r.comparisons = append(r.comparisons, c)
+		}
+	}
+	return r, nil
+}
+
+// expandShorthand returns the inclusive lower bound and exclusive upper bound of the ~ or ^ comparison
+// against version v, which may be a shorthand such as v1.4
+func expandShorthand(op, v string) (lower, upper string, err error) {
+	if semver.Prerelease(v) != "" || semver.Build(v) != "" {
+		return "", "", fmt.Errorf("%s%s must not include a pre-release or build metadata", op, v)
+	}
+	// the number of components that were specified, ex: 2 for v1.4
+	specified := strings.Count(v, ".") + 1
+	lower = semver.Canonical(v)
+	var parts [3]int
+	for i, p := range strings.Split(strings.TrimPrefix(lower, "v"), ".") {
+		parts[i], _ = strconv.Atoi(p)
+	}
+	major, minor, patch := parts[0], parts[1], parts[2]
+	switch {
+	case op == "~" && specified == 1, op == "^" && (major > 0 || specified == 1):
+		upper = fmt.Sprintf("v%d.0.0", major+1)
+	case op == "~", minor > 0 || specified == 2:
+		upper = fmt.Sprintf("v%d.%d.0", major, minor+1)
+	default:
+		upper = fmt.Sprintf("v%d.%d.%d", major, minor, patch+1)
+	}
+	// exclude the pre-releases of the upper bound, which are lower than it
+	return lower, upper + "-0", nil
+}
+
+// Contains returns true if v satisfies every comparison in r.  Invalid versions are never contained in a
+// non-empty range.
+func (r Range) Contains(v string) bool {
+	if len(r.comparisons) > 0 && !semver.IsValid(v) {
+		return false
+	}
+	for _, c := range r.comparisons {
+		cmp := semver.Compare(v, c.Version)
+		var ok bool
+		switch c.Op {
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case "!=":
+			ok = cmp != 0
+		default:
+			ok = cmp == 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty returns true if r has no comparisons, meaning that it contains every version.
+func (r Range) IsEmpty() bool {
+	return len(r.comparisons) == 0
+}
+
+// Comparisons returns the comparisons that a version must satisfy to be in r, with any ~ or ^ shorthands
+// expanded.
+func (r Range) Comparisons() []Comparison {
+	return slices.Clone(r.comparisons)
+}
+
+// String returns the text that r was parsed from.
+func (r Range) String() string {
+	return r.text
+}
@@ -0,0 +1,73 @@
+package modver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	type testCase struct {
+		name     string
+		spec     string
+		in, out  []string
+		parseErr bool
+	}
+	cases := []testCase{
+		{name: "empty", spec: "", in: []string{"v0.1.0", "v1.2.3", "v2.0.0+incompatible"}},
+		{name: "upper bound", spec: "<v1.5.0", in: []string{"v1.0.0", "v1.4.9", "v1.5.0-rc.1"}, out: []string{"v1.5.0", "v2.0.0"}},
+		{name: "bounded", spec: ">=v1.2.0 <v1.5.0", in: []string{"v1.2.0", "v1.4.0"}, out: []string{"v1.1.9", "v1.5.0"}},
+		{name: "comma separated", spec: ">v1.2.0, <=v1.5.0", in: []string{"v1.2.1", "v1.5.0"}, out: []string{"v1.2.0", "v1.5.1"}},
+		{name: "exact", spec: "v1.2.3", in: []string{"v1.2.3"}, out: []string{"v1.2.4"}},
+		{name: "not equal", spec: "!=v1.2.3", in: []string{"v1.2.4"}, out: []string{"v1.2.3"}},
+		{name: "missing v prefix", spec: ">= 1.2", in: []string{"v1.2.0"}, out: []string{"v1.1.0"}},
+		{name: "invalid versions are excluded", spec: "<v2.0.0", out: []string{"latest", ""}},
+		{name: "tilde minor", spec: "~v1.4", in: []string{"v1.4.0", "v1.4.9"}, out: []string{"v1.3.9", "v1.5.0-rc.1", "v1.5.0"}},
+		{name: "tilde patch", spec: "~1.4.2", in: []string{"v1.4.2", "v1.4.3"}, out: []string{"v1.4.1", "v1.5.0"}},
+		{name: "tilde major", spec: "~v1", in: []string{"v1.0.0", "v1.9.0"}, out: []string{"v0.9.0", "v2.0.0-rc.1"}},
+		{name: "caret major", spec: "^2", in: []string{"v2.0.0", "v2.9.9", "v2.1.0+incompatible"}, out: []string{"v1.9.9", "v3.0.0-alpha"}},
+		{name: "caret minor", spec: "^v1.4", in: []string{"v1.4.0", "v1.9.0"}, out: []string{"v1.3.9", "v2.0.0"}},
+		{name: "caret zero major", spec: "^v0.4.2", in: []string{"v0.4.2", "v0.4.9"}, out: []string{"v0.4.1", "v0.5.0"}},
+		{name: "caret zero minor", spec: "^0.0.3", in: []string{"v0.0.3"}, out: []string{"v0.0.4"}},
+		{name: "above a minimum within a major", spec: ">=v1.4.0 <v2.0.0", in: []string{"v1.4.0", "v1.10.0"}, out: []string{"v1.3.0", "v2.0.0"}},
+		{name: "invalid", spec: ">=foo", parseErr: true},
+		{name: "caret with pre-release", spec: "^v1.2.0-rc.1", parseErr: true},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := ParseRange(tc.spec)
+			if tc.parseErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			for _, v := range tc.in {
+				assert.True(t, r.Contains(v), "expected %s to be in %q", v, tc.spec)
+			}
+			for _, v := range tc.out {
+				assert.False(t, r.Contains(v), "expected %s not to be in %q", v, tc.spec)
+			}
+		})
+	}
+}
+
+func TestIsRange(t *testing.T) {
+	for _, s := range []string{">=v1.2.0", "^2", "~v1.4", "v1.2.0 v1.3.0", "!=v1.0.0"} {
+		assert.True(t, IsRange(s), "expected %q to be a range", s)
+	}
+	for _, s := range []string{"", "v1.2.3", "v1.*", " v1.2.3 "} {
+		assert.False(t, IsRange(s), "expected %q not to be a range", s)
+	}
+}
+
+func TestRangeComparisons(t *testing.T) {
+	r, err := ParseRange("^v1.4 !=v1.5.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Comparison{{Op: ">=", Version: "v1.4.0"}, {Op: "<", Version: "v2.0.0-0"}, {Op: "!=", Version: "v1.5.0"}}
+	assert.Equal(t, want, r.Comparisons())
+}
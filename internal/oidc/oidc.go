@@ -0,0 +1,149 @@
+// Package oidc implements the subset of OpenID Connect that is needed to log users in to the web UI: provider
+// discovery, the authorization code flow with PKCE, and verification of the ID tokens that are returned.
+//
+// Discovery and token verification are delegated to github.com/coreos/go-oidc, and the code exchange to
+// golang.org/x/oauth2.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// ErrInvalidToken is returned, possibly wrapped, when an ID token is malformed, has an invalid signature,
+// or contains claims that are not valid for this client
+var ErrInvalidToken = errors.New("invalid ID token")
+
+// signingAlgs are the ID token signing algorithms that are accepted if the provider's discovery document
+// does not list the ones it uses
+var signingAlgs = []string{gooidc.RS256, gooidc.ES256}
+
+// Config defines the OIDC client registration
+type Config struct {
+	// the issuer URL of the provider, ex: https://accounts.google.com
+	Issuer string
+	// the client ID and secret assigned by the provider
+	ClientID, ClientSecret string
+	// the URL that the provider redirects to after the user logs in
+	RedirectURL string
+	// the scopes to request in addition to "openid", the default is "email" and "profile"
+	Scopes []string
+}
+
+// Claims are the claims of a verified ID token that identify the user
+type Claims struct {
+	Subject string `json:"sub"`
+	Email   string `json:"email"`
+	// whether the provider has verified that the user controls the email address
+	EmailVerified bool      `json:"email_verified"`
+	Name          string    `json:"name"`
+	Expiry        time.Time `json:"-"`
+}
+
+// Provider is an OIDC client for a single provider
+type Provider struct {
+	c        *http.Client
+	oauth    oauth2.Config
+	verifier *gooidc.IDTokenVerifier
+}
+
+// NewProvider retrieves the discovery document of the provider at conf.Issuer and returns a Provider that
+// uses c to send requests to it.
+func NewProvider(ctx context.Context, c *http.Client, conf Config) (*Provider, error) {
+	if conf.Issuer == "" || conf.ClientID == "" || conf.RedirectURL == "" {
+		return nil, fmt.Errorf("the OIDC issuer, client ID, and redirect URL must be specified")
+	}
+	if len(conf.Scopes) == 0 {
+		conf.Scopes = []string{"email", "profile"}
+	}
+	// the context is retained by the provider to retrieve its signing keys, so it must not be canceled
+	// when the caller returns
+	p, err := gooidc.NewProvider(gooidc.ClientContext(context.WithoutCancel(ctx), c), conf.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve the OIDC discovery document: %w", err)
+	}
+	var doc struct {
+		Algs []string `json:"id_token_signing_alg_values_supported"`
+	}
+	if err := p.Claims(&doc); err != nil {
+		return nil, fmt.Errorf("unable to decode the OIDC discovery document: %w", err)
+	}
+	vc := &gooidc.Config{ClientID: conf.ClientID}
+	if len(doc.Algs) == 0 {
+		vc.SupportedSigningAlgs = signingAlgs
+	}
+	endpoint := p.Endpoint()
+	endpoint.AuthStyle = oauth2.AuthStyleInHeader
+	return &Provider{
+		c: c,
+		oauth: oauth2.Config{
+			ClientID:     conf.ClientID,
+			ClientSecret: conf.ClientSecret,
+			Endpoint:     endpoint,
+			RedirectURL:  conf.RedirectURL,
+			Scopes:       append([]string{gooidc.ScopeOpenID}, conf.Scopes...),
+		},
+		verifier: p.Verifier(vc),
+	}, nil
+}
+
+// AuthCodeURL returns the URL of the provider's login page.  The state and nonce values must be random
+// and are verified after the provider redirects back.  The verifier is the PKCE code verifier that is
+// passed to [Provider.Exchange].
+func (p *Provider) AuthCodeURL(state, nonce, verifier string) string {
+	return p.oauth.AuthCodeURL(state, gooidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier))
+}
+
+// Exchange redeems the authorization code returned by the provider and returns the verified claims of the
+// resulting ID token, which must contain the specified nonce.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (Claims, error) {
+	tok, err := p.oauth.Exchange(gooidc.ClientContext(ctx, p.c), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return Claims{}, fmt.Errorf("unable to redeem the authorization code: %w", err)
+	}
+	rawToken, _ := tok.Extra("id_token").(string)
+	if rawToken == "" {
+		return Claims{}, fmt.Errorf("the token response does not contain an ID token")
+	}
+	return p.Verify(ctx, rawToken, nonce)
+}
+
+// Verify checks the signature and the claims of a raw ID token and returns the claims that identify the
+// user.
+func (p *Provider) Verify(ctx context.Context, rawToken, nonce string) (Claims, error) {
+	tok, err := p.verifier.Verify(gooidc.ClientContext(ctx, p.c), rawToken)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if subtle.ConstantTimeCompare([]byte(tok.Nonce), []byte(nonce)) != 1 {
+		return Claims{}, fmt.Errorf("%w: the nonce does not match", ErrInvalidToken)
+	}
+	if tok.Subject == "" {
+		return Claims{}, fmt.Errorf("%w: the token does not identify a user", ErrInvalidToken)
+	}
+	var claims Claims
+	if err := tok.Claims(&claims); err != nil {
+		return Claims{}, fmt.Errorf("%w: invalid claims: %w", ErrInvalidToken, err)
+	}
+	claims.Expiry = tok.Expiry
+	return claims, nil
+}
+
+// RandomString returns a random, URL-safe string that is suitable for the state, nonce, and PKCE verifier
+// values
+func RandomString() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("unable to generate random value: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,211 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testProvider is a fake OIDC provider that issues ID tokens signed with an RSA or an EC key
+type testProvider struct {
+	svr    *httptest.Server
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+	// the claims of the ID token returned by the token endpoint
+	claims map[string]any
+	// the PKCE challenge sent to the authorization endpoint
+	challenge string
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tp := &testProvider{rsaKey: rsaKey, ecKey: ecKey}
+
+	b64 := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 tp.svr.URL,
+			"authorization_endpoint": tp.svr.URL + "/authorize",
+			"token_endpoint":         tp.svr.URL + "/token",
+			"jwks_uri":               tp.svr.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{"kid": "rsa", "kty": "RSA", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+				{"kid": "ec", "kty": "EC", "crv": "P-256", "x": b64(ecKey.X.FillBytes(make([]byte, 32))), "y": b64(ecKey.Y.FillBytes(make([]byte, 32)))},
+			},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, _ := r.BasicAuth()
+		verifier := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if id != "perseus" || secret != "s3cr3t" || r.PostFormValue("code") != "abc" || b64(verifier[:]) != tp.challenge {
+			http.Error(w, `{"error": "invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "t0ken",
+			"token_type":   "Bearer",
+			"id_token":     tp.sign(t, "RS256", "rsa", tp.claims),
+		})
+	})
+	tp.svr = httptest.NewServer(mux)
+	t.Cleanup(tp.svr.Close)
+	return tp
+}
+
+// sign returns a JWT with the specified claims, signed with the test provider's key for alg
+func (tp *testProvider) sign(t *testing.T, alg, kid string, claims map[string]any) string {
+	t.Helper()
+
+	enc := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signed := enc(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"}) + "." + enc(claims)
+	digest := sha256.Sum256([]byte(signed))
+	var sig []byte
+	switch alg {
+	case "RS256":
+		var err error
+		sig, err = rsa.SignPKCS1v15(rand.Reader, tp.rsaKey, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+	case "ES256":
+		r, s, err := ecdsa.Sign(rand.Reader, tp.ecKey, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func (tp *testProvider) validClaims() map[string]any {
+	return map[string]any{
+		"iss":            tp.svr.URL,
+		"aud":            "perseus",
+		"sub":            "12345",
+		"email":          "jane@example.com",
+		"email_verified": true,
+		"name":           "Jane Doe",
+		"nonce":          "n0nce",
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestProvider(t *testing.T) {
+	tp := newTestProvider(t)
+	ctx := context.Background()
+
+	_, err := NewProvider(ctx, tp.svr.Client(), Config{Issuer: tp.svr.URL + "/other", ClientID: "perseus", RedirectURL: "https://perseus.example.com/ui/auth/callback"})
+	assert.Error(t, err, "a mismatched issuer should be rejected")
+
+	p, err := NewProvider(ctx, tp.svr.Client(), Config{
+		Issuer:       tp.svr.URL,
+		ClientID:     "perseus",
+		ClientSecret: "s3cr3t",
+		RedirectURL:  "https://perseus.example.com/ui/auth/callback",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authURL, err := url.Parse(p.AuthCodeURL("st4te", "n0nce", "v3rifier"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := authURL.Query()
+	assert.Equal(t, tp.svr.URL+"/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+	assert.Equal(t, "openid email profile", q.Get("scope"))
+	assert.Equal(t, "st4te", q.Get("state"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	tp.challenge = q.Get("code_challenge")
+
+	tp.claims = tp.validClaims()
+	claims, err := p.Exchange(ctx, "abc", "v3rifier", "n0nce")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "12345", claims.Subject)
+		assert.Equal(t, "jane@example.com", claims.Email)
+		assert.True(t, claims.EmailVerified)
+		assert.Equal(t, "Jane Doe", claims.Name)
+		assert.False(t, claims.Expiry.IsZero())
+	}
+	_, err = p.Exchange(ctx, "abc", "wrong", "n0nce")
+	assert.Error(t, err, "the PKCE verifier should be checked by the provider")
+
+	claims, err = p.Verify(ctx, tp.sign(t, "ES256", "ec", tp.validClaims()), "n0nce")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "12345", claims.Subject)
+	}
+
+	// an unverified email address is reported as such so that it is not trusted for authorization
+	unverified := tp.validClaims()
+	delete(unverified, "email_verified")
+	claims, err = p.Verify(ctx, tp.sign(t, "RS256", "rsa", unverified), "n0nce")
+	if assert.NoError(t, err) {
+		assert.False(t, claims.EmailVerified)
+	}
+
+	withClaim := func(k string, v any) map[string]any {
+		c := tp.validClaims()
+		if v == nil {
+			delete(c, k)
+		} else {
+			c[k] = v
+		}
+		return c
+	}
+	invalid := map[string]string{
+		"expired":             tp.sign(t, "RS256", "rsa", withClaim("exp", time.Now().Add(-time.Hour).Unix())),
+		"missing expiration":  tp.sign(t, "RS256", "rsa", withClaim("exp", nil)),
+		"wrong issuer":        tp.sign(t, "RS256", "rsa", withClaim("iss", "https://evil.example.com")),
+		"wrong audience":      tp.sign(t, "RS256", "rsa", withClaim("aud", []string{"other"})),
+		"wrong nonce":         tp.sign(t, "RS256", "rsa", withClaim("nonce", "other")),
+		"missing subject":     tp.sign(t, "RS256", "rsa", withClaim("sub", nil)),
+		"algorithm confusion": tp.sign(t, "ES256", "rsa", tp.validClaims()),
+		"unknown key":         tp.sign(t, "RS256", "other", tp.validClaims()),
+		"not a JWT":           "abc.def",
+	}
+	for name, tok := range invalid {
+		_, err := p.Verify(ctx, tok, "n0nce")
+		assert.True(t, errors.Is(err, ErrInvalidToken), "%s: got %v", name, err)
+	}
+
+	// a token with a list of audiences that includes the client is accepted
+	_, err = p.Verify(ctx, tp.sign(t, "RS256", "rsa", withClaim("aud", []string{"other", "perseus"})), "n0nce")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,270 @@
+// Package osv retrieves the security advisories that affect Go module versions from the OSV API,
+// https://google.github.io/osv.dev/api/.
+package osv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/mod/semver"
+)
+
+// DefaultURL is the base URL of the public OSV API
+const DefaultURL = "https://api.osv.dev"
+
+// DefaultCacheTTL is a reasonable amount of time to cache OSV responses.  New advisories should be
+// reported promptly, but the same module versions are requested repeatedly when walking a dependency graph.
+const DefaultCacheTTL = time.Hour
+
+// maxCacheEntries bounds the memory used by the response cache
+const maxCacheEntries = 50000
+
+// ecosystem is the OSV ecosystem of Go modules
+const ecosystem = "Go"
+
+// Entry is an OSV advisory
+type Entry struct {
+	// the advisory ID, ex: GO-2024-2687
+	ID string
+	// other IDs of the same vulnerability, ex: CVE-2023-45288 or GHSA-4v7x-pqxf-cx7m
+	Aliases []string
+	// a one-line description of the vulnerability
+	Summary string
+	// the complete OSV entry as JSON
+	Raw json.RawMessage
+
+	affected []affected
+}
+
+// affected is the subset of an OSV "affected" object that is needed to determine the fixed version
+type affected struct {
+	Package struct {
+		Name      string `json:"name"`
+		Ecosystem string `json:"ecosystem"`
+	} `json:"package"`
+	Ranges []struct {
+		Type   string `json:"type"`
+		Events []struct {
+			Introduced string `json:"introduced"`
+			Fixed      string `json:"fixed"`
+		} `json:"events"`
+	} `json:"ranges"`
+}
+
+// UnmarshalJSON populates e from an OSV entry, preserving the original JSON in e.Raw
+func (e *Entry) UnmarshalJSON(data []byte) error {
+	var v struct {
+		ID       string     `json:"id"`
+		Aliases  []string   `json:"aliases"`
+		Summary  string     `json:"summary"`
+		Affected []affected `json:"affected"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*e = Entry{
+		ID:       v.ID,
+		Aliases:  v.Aliases,
+		Summary:  v.Summary,
+		Raw:      append(json.RawMessage(nil), data...),
+		affected: v.Affected,
+	}
+	return nil
+}
+
+// FixedVersion returns the lowest version of mod that fixes the vulnerability described by e for the
+// specified affected version, ex: v0.23.0, or an empty string if no fix is available.
+func (e Entry) FixedVersion(mod, version string) string {
+	for _, a := range e.affected {
+		if a.Package.Ecosystem != ecosystem || a.Package.Name != mod {
+			continue
+		}
+		for _, r := range a.Ranges {
+			if r.Type != "SEMVER" {
+				continue
+			}
+			// each range is a sequence of introduced/fixed events in ascending version order
+			introduced := ""
+			for _, ev := range r.Events {
+				switch {
+				case ev.Introduced != "":
+					introduced = canonical(ev.Introduced)
+				case ev.Fixed != "" && introduced != "":
+					fixed := canonical(ev.Fixed)
+					if semver.Compare(version, introduced) >= 0 && semver.Compare(version, fixed) < 0 {
+						return fixed
+					}
+					introduced = ""
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// canonical converts an OSV SEMVER version, which has no "v" prefix, to a Go module version.  The special
+// introduced version "0" is treated as v0.0.0.
+func canonical(v string) string {
+	if v == "0" {
+		return "v0.0.0"
+	}
+	return "v" + strings.TrimPrefix(v, "v")
+}
+
+// Client retrieves advisories from the OSV API, caching the responses in memory
+type Client struct {
+	c       *http.Client
+	baseURL string
+	ttl     time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// cacheEntry is the cached list of advisories for a module version
+type cacheEntry struct {
+	entries []Entry
+	expires time.Time
+}
+
+// New returns a Client that uses c to send requests to the OSV API at baseURL, ex: [DefaultURL], and caches
+// the responses for ttl.
+func New(c *http.Client, baseURL string, ttl time.Duration) *Client {
+	return &Client{
+		c:       c,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		ttl:     ttl,
+		cache:   make(map[string]cacheEntry),
+	}
+}
+
+// URL returns the base URL of the OSV API
+func (c *Client) URL() string {
+	return c.baseURL
+}
+
+// Query returns the advisories that affect the specified version of mod.  Advisories from other databases,
+// ex: GHSA, that are aliases of an advisory from the Go vulnerability database are omitted so that each
+// vulnerability is only reported once.
+func (c *Client) Query(ctx context.Context, mod, version string) ([]Entry, error) {
+	key := mod + "@" + version
+	c.mu.Lock()
+	e, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && time.Now().Before(e.expires) {
+		return e.entries, nil
+	}
+
+	var entries []Entry
+	pageToken := ""
+	for {
+		var resp struct {
+			Vulns         []Entry `json:"vulns"`
+			NextPageToken string  `json:"next_page_token"`
+		}
+		if err := c.post(ctx, "/v1/query", queryRequest(mod, version, pageToken), &resp); err != nil {
+			return nil, fmt.Errorf("unable to query OSV for %s@%s: %w", mod, version, err)
+		}
+		entries = append(entries, resp.Vulns...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	entries = dedupe(entries)
+
+	c.mu.Lock()
+	if len(c.cache) >= maxCacheEntries {
+		c.evictExpired()
+	}
+	c.cache[key] = cacheEntry{entries: entries, expires: time.Now().Add(c.ttl)}
+	c.mu.Unlock()
+	return entries, nil
+}
+
+// queryRequest returns the body of an OSV query request for version of mod
+func queryRequest(mod, version, pageToken string) any {
+	type pkg struct {
+		Name      string `json:"name"`
+		Ecosystem string `json:"ecosystem"`
+	}
+	return struct {
+		Package   pkg    `json:"package"`
+		Version   string `json:"version"`
+		PageToken string `json:"page_token,omitempty"`
+	}{
+		Package: pkg{Name: mod, Ecosystem: ecosystem},
+		// OSV stores Go module versions without the "v" prefix
+		Version:   strings.TrimPrefix(version, "v"),
+		PageToken: pageToken,
+	}
+}
+
+// dedupe removes the entries that are aliases of an entry from the Go vulnerability database, whose IDs
+// start with "GO-"
+func dedupe(entries []Entry) []Entry {
+	aliased := make(map[string]struct{})
+	for _, e := range entries {
+		if strings.HasPrefix(e.ID, "GO-") {
+			for _, a := range e.Aliases {
+				aliased[a] = struct{}{}
+			}
+		}
+	}
+	result := make([]Entry, 0, len(entries))
+	for _, e := range entries {
+		if _, found := aliased[e.ID]; found && !strings.HasPrefix(e.ID, "GO-") {
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// evictExpired removes the expired responses from the cache or, if none have expired, empties it.  The
+// caller must hold c.mu.
+func (c *Client) evictExpired() {
+	now := time.Now()
+	for k, e := range c.cache {
+		if now.After(e.expires) {
+			delete(c.cache, k)
+		}
+	}
+	if len(c.cache) >= maxCacheEntries {
+		clear(c.cache)
+	}
+}
+
+// post sends body as JSON in a POST request for path and decodes the JSON response into dest
+func (c *Client) post(ctx context.Context, path string, body, dest any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	return nil
+}
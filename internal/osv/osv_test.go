@@ -0,0 +1,106 @@
+package osv
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testEntry = `{
+	"id": "GO-2024-2687",
+	"aliases": ["CVE-2023-45288", "GHSA-4v7x-pqxf-cx7m"],
+	"summary": "HTTP/2 CONTINUATION flood in net/http",
+	"affected": [{
+		"package": {"name": "golang.org/x/net", "ecosystem": "Go"},
+		"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "0.23.0"}]}]
+	}]
+}`
+
+func TestClient(t *testing.T) {
+	var requests atomic.Int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var req struct {
+			Package struct {
+				Name      string `json:"name"`
+				Ecosystem string `json:"ecosystem"`
+			} `json:"package"`
+			Version string `json:"version"`
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/query" || json.NewDecoder(r.Body).Decode(&req) != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		switch {
+		case req.Package.Ecosystem != "Go":
+			http.Error(w, "bad ecosystem", http.StatusBadRequest)
+		case req.Package.Name == "golang.org/x/net" && req.Version == "0.22.0":
+			_, _ = w.Write([]byte(`{"vulns": [` + testEntry + `, {"id": "GHSA-4v7x-pqxf-cx7m", "aliases": ["CVE-2023-45288", "GO-2024-2687"]}]}`))
+		case req.Package.Name == "example.com/broken":
+			http.Error(w, "oops", http.StatusInternalServerError)
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	ctx := context.Background()
+	c := New(svr.Client(), svr.URL+"/", time.Hour)
+
+	entries, err := c.Query(ctx, "golang.org/x/net", "v0.22.0")
+	if assert.NoError(t, err) && assert.Len(t, entries, 1, "the GHSA alias should be omitted") {
+		assert.Equal(t, "GO-2024-2687", entries[0].ID)
+		assert.Equal(t, "HTTP/2 CONTINUATION flood in net/http", entries[0].Summary)
+		assert.JSONEq(t, testEntry, string(entries[0].Raw))
+		assert.Equal(t, "v0.23.0", entries[0].FixedVersion("golang.org/x/net", "v0.22.0"))
+	}
+	// the 2nd lookup should be served from the cache
+	_, err = c.Query(ctx, "golang.org/x/net", "v0.22.0")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+
+	entries, err = c.Query(ctx, "golang.org/x/net", "v0.23.0")
+	if assert.NoError(t, err) {
+		assert.Empty(t, entries)
+	}
+	_, err = c.Query(ctx, "example.com/broken", "v1.0.0")
+	assert.Error(t, err)
+}
+
+func TestFixedVersion(t *testing.T) {
+	t.Parallel()
+
+	var e Entry
+	err := json.Unmarshal([]byte(`{
+		"id": "GO-2099-0001",
+		"affected": [{
+			"package": {"name": "example.com/foo", "ecosystem": "Go"},
+			"ranges": [{"type": "SEMVER", "events": [
+				{"introduced": "0"}, {"fixed": "1.2.3"},
+				{"introduced": "1.4.0"}, {"fixed": "1.4.2"},
+				{"introduced": "2.0.0"}
+			]}]
+		}]
+	}`), &e)
+	if !assert.NoError(t, err) {
+		return
+	}
+	testCases := []struct {
+		version, want string
+	}{
+		{"v0.1.0", "v1.2.3"},
+		{"v1.2.3", ""},
+		{"v1.4.1", "v1.4.2"},
+		{"v2.1.0", ""},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, e.FixedVersion("example.com/foo", tc.version), tc.version)
+	}
+	assert.Equal(t, "", e.FixedVersion("example.com/bar", "v0.1.0"))
+}
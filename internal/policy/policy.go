@@ -0,0 +1,250 @@
+// Package policy implements the dependency policies that operators declare to ban modules, ban ranges of
+// versions of a module, require that a module be replaced by another, or restrict the licenses of
+// dependencies.
+//
+// Policies are defined in a YAML file with a list of rules and optional license lists, ex:
+//
+//	rules:
+//	  - name: no-logrus
+//	    module: github.com/sirupsen/logrus
+//	    replacement: log/slog
+//	  - name: patched-x-net
+//	    module: golang.org/x/net
+//	    versions: "<v0.23.0"
+//	    message: versions before v0.23.0 are vulnerable to CVE-2023-45288
+//	    severity: warning
+//	licenses:
+//	  deny: [AGPL-3.0-only, SSPL-1.0]
+package policy
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
+)
+
+// the supported rule severities
+const (
+	// SeverityError marks a violation that should fail a CI gate
+	SeverityError = "error"
+	// SeverityWarning marks a violation that should be reported but not fail a CI gate
+	SeverityWarning = "warning"
+)
+
+// the kinds of violations
+const (
+	// KindModule is a violation of a [Rule]
+	KindModule = "module"
+	// KindLicense is a violation of the [LicenseRules]
+	KindLicense = "license"
+)
+
+// the names of the rules reported for license violations
+const (
+	licenseDenied     = "license-denied"
+	licenseNotAllowed = "license-not-allowed"
+	licenseUnknown    = "license-unknown"
+)
+
+// A Rule bans any version, or a range of versions, of the modules that match a glob pattern
+type Rule struct {
+	// a unique name that identifies the rule in reported violations
+	Name string `yaml:"name"`
+	// a glob pattern specifying which module(s) the rule applies to, where '*' matches any sequence of
+	// characters, including '/'
+	Module string `yaml:"module"`
+	// an optional range of banned versions, ex: ">=v1.2.0 <v1.5.0", see [modver.ParseRange].  Every
+	// version is banned if this is empty.
+	Versions string `yaml:"versions"`
+	// an optional module that should be used instead
+	Replacement string `yaml:"replacement"`
+	// an optional explanation that is included in reported violations
+	Message string `yaml:"message"`
+	// either "error" (the default) or "warning"
+	Severity string `yaml:"severity"`
+
+	pattern  *regexp.Regexp
+	versions modver.Range
+}
+
+// LicenseRules restricts the licenses of dependencies, which are identified by their SPDX identifiers, ex:
+// "Apache-2.0".  Identifiers are compared case-insensitively.
+type LicenseRules struct {
+	// if not empty, any license that is not in this list is banned
+	Allow []string `yaml:"allow"`
+	// the banned licenses
+	Deny []string `yaml:"deny"`
+	// if true, dependencies whose license is not known are reported
+	ReportUnknown bool `yaml:"report-unknown"`
+	// either "error" (the default) or "warning"
+	Severity string `yaml:"severity"`
+}
+
+// A Policy is a set of rules that the dependencies of a module must satisfy
+type Policy struct {
+	Rules    []Rule       `yaml:"rules"`
+	Licenses LicenseRules `yaml:"licenses"`
+}
+
+// A Violation is a module version that is banned by a [Rule] or that has a banned license
+type Violation struct {
+	// either [KindModule] or [KindLicense]
+	Kind        string
+	Rule        string
+	Severity    string
+	Message     string
+	Replacement string
+	// the license of the module for license violations, which is empty if it is not known
+	License string
+	Module  module.Version
+}
+
+// Load reads and validates the policy defined in the YAML file at path.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the policy file: %w", err)
+	}
+	p, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy file %q: %w", path, err)
+	}
+	return p, nil
+}
+
+// Parse parses and validates the YAML policy definition in data.
+func Parse(data []byte) (*Policy, error) {
+	var p Policy
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	names := make(map[string]struct{}, len(p.Rules))
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		if r.Name == "" {
+			return nil, fmt.Errorf("rule %d has no name", i+1)
+		}
+		if _, exists := names[r.Name]; exists {
+			return nil, fmt.Errorf("duplicate rule name %q", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		if r.Module == "" {
+			return nil, fmt.Errorf("rule %q has no module pattern", r.Name)
+		}
+		switch r.Severity {
+		case "":
+			r.Severity = SeverityError
+		case SeverityError, SeverityWarning:
+		default:
+			return nil, fmt.Errorf("rule %q has an invalid severity %q, must be %q or %q", r.Name, r.Severity, SeverityError, SeverityWarning)
+		}
+		var err error
+		if r.versions, err = modver.ParseRange(r.Versions); err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Name, err)
+		}
+		r.pattern = globToRegexp(r.Module)
+	}
+	switch p.Licenses.Severity {
+	case "":
+		p.Licenses.Severity = SeverityError
+	case SeverityError, SeverityWarning:
+	default:
+		return nil, fmt.Errorf("the license rules have an invalid severity %q, must be %q or %q", p.Licenses.Severity, SeverityError, SeverityWarning)
+	}
+	return &p, nil
+}
+
+// HasLicenseRules returns true if p restricts the licenses of dependencies.
+func (p *Policy) HasLicenseRules() bool {
+	return len(p.Licenses.Allow) > 0 || len(p.Licenses.Deny) > 0 || p.Licenses.ReportUnknown
+}
+
+// Check returns the violations of p for a single module version, in the order of the rules.
+func (p *Policy) Check(mod module.Version) []Violation {
+	var violations []Violation
+	for _, r := range p.Rules {
+		if !r.pattern.MatchString(mod.Path) || !r.versions.Contains(mod.Version) {
+			continue
+		}
+		violations = append(violations, Violation{
+			Kind:        KindModule,
+			Rule:        r.Name,
+			Severity:    r.Severity,
+			Message:     r.message(mod),
+			Replacement: r.Replacement,
+			Module:      mod,
+		})
+	}
+	return violations
+}
+
+// CheckLicense returns the violation of the license rules of p by a module version with the specified
+// license, which is empty if it is not known, or nil if the license is acceptable.
+func (p *Policy) CheckLicense(mod module.Version, license string) *Violation {
+	lr := p.Licenses
+	v := Violation{Kind: KindLicense, Severity: lr.Severity, License: license, Module: mod}
+	switch {
+	case license == "":
+		if !lr.ReportUnknown {
+			return nil
+		}
+		v.Rule, v.Message = licenseUnknown, "the license of "+mod.Path+" is not known"
+	case containsFold(lr.Deny, license):
+		v.Rule, v.Message = licenseDenied, fmt.Sprintf("%s is licensed under %s, which is banned", mod.Path, license)
+	case len(lr.Allow) > 0 && !containsFold(lr.Allow, license):
+		v.Rule, v.Message = licenseNotAllowed, fmt.Sprintf("%s is licensed under %s, which is not an allowed license", mod.Path, license)
+	default:
+		return nil
+	}
+	return &v
+}
+
+// containsFold returns true if list contains s, ignoring case
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// message returns the description of a violation of r by mod
+func (r Rule) message(mod module.Version) string {
+	msg := mod.Path + " is banned"
+	if !r.versions.IsEmpty() {
+		msg = fmt.Sprintf("%s is in the banned version range %q", mod, r.versions)
+	}
+	if r.Replacement != "" {
+		msg += ", use " + r.Replacement + " instead"
+	}
+	if r.Message != "" {
+		msg += ": " + r.Message
+	}
+	return msg
+}
+
+// globToRegexp converts a glob pattern, where '*' matches any sequence of characters and '?' matches any
+// single character, to an anchored regular expression
+func globToRegexp(glob string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, c := range glob {
+		switch c {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
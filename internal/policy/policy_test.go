@@ -0,0 +1,136 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+)
+
+const testPolicy = `
+rules:
+  - name: no-logrus
+    module: github.com/sirupsen/logrus
+    replacement: log/slog
+  - name: patched-x-net
+    module: golang.org/x/net
+    versions: "<v0.23.0"
+    message: vulnerable to CVE-2023-45288
+    severity: warning
+  - name: no-old-crowdstrike
+    module: github.com/CrowdStrike/*
+    versions: "<v1.0.0"
+`
+
+func TestParse(t *testing.T) {
+	type testCase struct {
+		name   string
+		policy string
+		errMsg string
+	}
+	cases := []testCase{
+		{name: "valid", policy: testPolicy},
+		{name: "empty", policy: ""},
+		{name: "missing name", policy: "rules: [{module: foo}]", errMsg: "rule 1 has no name"},
+		{name: "duplicate name", policy: "rules: [{name: a, module: foo}, {name: a, module: bar}]", errMsg: `duplicate rule name "a"`},
+		{name: "missing module", policy: "rules: [{name: a}]", errMsg: `rule "a" has no module pattern`},
+		{name: "invalid severity", policy: "rules: [{name: a, module: foo, severity: fatal}]", errMsg: `invalid severity "fatal"`},
+		{name: "invalid range", policy: "rules: [{name: a, module: foo, versions: '>=bar'}]", errMsg: "invalid version range"},
+		{name: "licenses", policy: "licenses: {allow: [MIT], deny: [AGPL-3.0-only], severity: warning}"},
+		{name: "invalid license severity", policy: "licenses: {deny: [MIT], severity: fatal}", errMsg: `invalid severity "fatal"`},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Parse([]byte(tc.policy))
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	p, err := Parse([]byte(testPolicy))
+	if !assert.NoError(t, err) {
+		return
+	}
+	type testCase struct {
+		mod      module.Version
+		expected []string
+	}
+	cases := []testCase{
+		{mod: module.Version{Path: "github.com/sirupsen/logrus", Version: "v1.9.3"}, expected: []string{"no-logrus"}},
+		{mod: module.Version{Path: "github.com/sirupsen/logrus-extra", Version: "v1.0.0"}},
+		{mod: module.Version{Path: "golang.org/x/net", Version: "v0.22.0"}, expected: []string{"patched-x-net"}},
+		{mod: module.Version{Path: "golang.org/x/net", Version: "v0.23.0"}},
+		{mod: module.Version{Path: "github.com/CrowdStrike/perseus", Version: "v0.9.0"}, expected: []string{"no-old-crowdstrike"}},
+		{mod: module.Version{Path: "github.com/CrowdStrike/perseus/v2", Version: "v2.0.0"}},
+	}
+	for _, tc := range cases {
+		var got []string
+		for _, v := range p.Check(tc.mod) {
+			got = append(got, v.Rule)
+			assert.Equal(t, tc.mod, v.Module)
+		}
+		assert.Equal(t, tc.expected, got, tc.mod.String())
+	}
+
+	v := p.Check(module.Version{Path: "golang.org/x/net", Version: "v0.22.0"})
+	if assert.Len(t, v, 1) {
+		assert.Equal(t, SeverityWarning, v[0].Severity)
+		assert.Equal(t, `golang.org/x/net@v0.22.0 is in the banned version range "<v0.23.0": vulnerable to CVE-2023-45288`, v[0].Message)
+	}
+	v = p.Check(module.Version{Path: "github.com/sirupsen/logrus", Version: "v1.9.3"})
+	if assert.Len(t, v, 1) {
+		assert.Equal(t, SeverityError, v[0].Severity)
+		assert.Equal(t, "github.com/sirupsen/logrus is banned, use log/slog instead", v[0].Message)
+	}
+}
+
+func TestCheckLicense(t *testing.T) {
+	type testCase struct {
+		name     string
+		policy   string
+		license  string
+		expected string
+	}
+	cases := []testCase{
+		{name: "no rules", policy: "", license: "AGPL-3.0-only"},
+		{name: "denied", policy: "licenses: {deny: [AGPL-3.0-only]}", license: "AGPL-3.0-only", expected: "license-denied"},
+		{name: "denied ignores case", policy: "licenses: {deny: [agpl-3.0-only]}", license: "AGPL-3.0-only", expected: "license-denied"},
+		{name: "not denied", policy: "licenses: {deny: [AGPL-3.0-only]}", license: "MIT"},
+		{name: "allowed", policy: "licenses: {allow: [MIT, Apache-2.0]}", license: "Apache-2.0"},
+		{name: "not allowed", policy: "licenses: {allow: [MIT, Apache-2.0]}", license: "GPL-2.0-only", expected: "license-not-allowed"},
+		{name: "unknown is ignored", policy: "licenses: {allow: [MIT]}", license: ""},
+		{name: "unknown is reported", policy: "licenses: {report-unknown: true}", license: "", expected: "license-unknown"},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := Parse([]byte(tc.policy))
+			if !assert.NoError(t, err) {
+				return
+			}
+			mod := module.Version{Path: "example.com/foo", Version: "v1.0.0"}
+			v := p.CheckLicense(mod, tc.license)
+			if tc.expected == "" {
+				assert.Nil(t, v)
+				return
+			}
+			if assert.NotNil(t, v) {
+				assert.Equal(t, tc.expected, v.Rule)
+				assert.Equal(t, KindLicense, v.Kind)
+				assert.Equal(t, SeverityError, v.Severity)
+				assert.Equal(t, tc.license, v.License)
+				assert.Equal(t, mod, v.Module)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Package render lays out graphs in the DOT language and renders them as images using Graphviz, which is
+// compiled to WebAssembly and embedded in the binary so that no local Graphviz installation or cgo is
+// required.
+package render
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/goccy/go-graphviz"
+)
+
+// the supported image formats
+const (
+	FormatSVG = "svg"
+	FormatPNG = "png"
+)
+
+// the largest graphs that can be rendered, which bound the time and memory used by the layout
+const (
+	MaxNodes = 2000
+	MaxEdges = 5000
+)
+
+// ErrInvalidGraph is returned, possibly wrapped, if the DOT source cannot be parsed
+var ErrInvalidGraph = errors.New("invalid DOT graph")
+
+// ErrGraphTooLarge is returned, possibly wrapped, if the graph has more than [MaxNodes] nodes or
+// [MaxEdges] edges
+var ErrGraphTooLarge = errors.New("the graph is too large to render")
+
+// ContentType returns the media type of images in the specified format
+func ContentType(format string) string {
+	switch format {
+	case FormatPNG:
+		return "image/png"
+	default:
+		return "image/svg+xml"
+	}
+}
+
+// Renderer renders DOT graphs as images.  The Graphviz runtime is initialized on first use and is not safe
+// for concurrent use, so graphs are rendered one at a time.  The zero value is ready to use.
+type Renderer struct {
+	init sync.Once
+	// a semaphore of size 1 that is held while the runtime is in use, which is a channel rather than a
+	// mutex so that waiting for it can be abandoned if the context is canceled
+	sem chan struct{}
+	gv  *graphviz.Graphviz
+}
+
+// Render lays out the graph defined by dot using the "dot" layout engine and writes the image, in the
+// specified format, to w.
+//
+// If ctx is canceled while waiting for another graph to be rendered, or while rendering, Render returns
+// the context's error.  Graphviz itself can't be interrupted, so a layout that has started runs to
+// completion in the background, which is bounded by the [MaxNodes] and [MaxEdges] limits.
+func (r *Renderer) Render(ctx context.Context, dot []byte, format string, w io.Writer) error {
+	var gvFormat graphviz.Format
+	switch format {
+	case FormatSVG:
+		gvFormat = graphviz.SVG
+	case FormatPNG:
+		gvFormat = graphviz.PNG
+	default:
+		return fmt.Errorf("unsupported image format %q", format)
+	}
+
+	if err := r.lock(ctx); err != nil {
+		return err
+	}
+	// the image is buffered so that nothing is written if the layout fails part way through, or if the
+	// caller gives up before it finishes
+	var buf bytes.Buffer
+	done := make(chan error, 1)
+	go func() {
+		defer r.unlock()
+		done <- r.render(context.WithoutCancel(ctx), dot, gvFormat, &buf)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+	case <-ctx.Done():
+		return fmt.Errorf("unable to render the graph: %w", ctx.Err())
+	}
+	if _, err := buf.WriteTo(w); err != nil {
+		return fmt.Errorf("unable to write the image: %w", err)
+	}
+	return nil
+}
+
+// render parses dot, checks its size, and renders it.  The caller must hold the lock.
+func (r *Renderer) render(ctx context.Context, dot []byte, format graphviz.Format, w io.Writer) error {
+	if r.gv == nil {
+		gv, err := graphviz.New(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to initialize Graphviz: %w", err)
+		}
+		r.gv = gv
+	}
+	graph, err := graphviz.ParseBytes(dot)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidGraph, err)
+	}
+	defer graph.Close()
+
+	nodes, err := graph.NodeNum()
+	if err != nil {
+		return fmt.Errorf("unable to count the nodes of the graph: %w", err)
+	}
+	edges, err := graph.EdgeNum()
+	if err != nil {
+		return fmt.Errorf("unable to count the edges of the graph: %w", err)
+	}
+	if nodes > MaxNodes || edges > MaxEdges {
+		return fmt.Errorf("%w: it has %d nodes and %d edges, the limits are %d and %d", ErrGraphTooLarge, nodes, edges, MaxNodes, MaxEdges)
+	}
+
+	if err := r.gv.Render(ctx, graph, format, w); err != nil {
+		return fmt.Errorf("unable to render the graph: %w", err)
+	}
+	return nil
+}
+
+// lock waits until no other graph is being rendered, or until ctx is canceled
+func (r *Renderer) lock(ctx context.Context) error {
+	r.init.Do(func() { r.sem = make(chan struct{}, 1) })
+	select {
+	case r.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("unable to render the graph: %w", ctx.Err())
+	}
+}
+
+func (r *Renderer) unlock() {
+	<-r.sem
+}
+
+// Close releases the Graphviz runtime, if it was initialized, after waiting for any graph that is being
+// rendered
+func (r *Renderer) Close() error {
+	_ = r.lock(context.Background())
+	defer r.unlock()
+
+	if r.gv == nil {
+		return nil
+	}
+	err := r.gv.Close()
+	r.gv = nil
+	return err
+}
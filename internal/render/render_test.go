@@ -0,0 +1,71 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderer(t *testing.T) {
+	var r Renderer
+	t.Cleanup(func() { _ = r.Close() })
+
+	ctx := context.Background()
+	dot := []byte(`digraph G {
+		"github.com/example/foo@v1.2.3" -> "github.com/example/bar@v0.4.0";
+		"github.com/example/foo@v1.2.3" -> "golang.org/x/mod@v0.21.0";
+	}`)
+
+	var svg bytes.Buffer
+	if assert.NoError(t, r.Render(ctx, dot, FormatSVG, &svg)) {
+		assert.Contains(t, svg.String(), "<svg")
+		assert.Contains(t, svg.String(), "github.com/example/bar@v0.4.0")
+	}
+
+	var png bytes.Buffer
+	if assert.NoError(t, r.Render(ctx, dot, FormatPNG, &png)) {
+		assert.True(t, bytes.HasPrefix(png.Bytes(), []byte("\x89PNG")), "should be a PNG image")
+	}
+
+	var out bytes.Buffer
+	err := r.Render(ctx, []byte(`digraph G { "a" -> `), FormatSVG, &out)
+	assert.True(t, errors.Is(err, ErrInvalidGraph), "got %v", err)
+	assert.Zero(t, out.Len())
+
+	assert.Error(t, r.Render(ctx, dot, "gif", &out))
+	assert.Equal(t, "image/png", ContentType(FormatPNG))
+}
+
+func TestRendererLimits(t *testing.T) {
+	var r Renderer
+	t.Cleanup(func() { _ = r.Close() })
+
+	var dot strings.Builder
+	dot.WriteString("digraph G {\n")
+	for i := 0; i <= MaxNodes; i++ {
+		fmt.Fprintf(&dot, "\"n%d\";\n", i)
+	}
+	dot.WriteString("}\n")
+	var out bytes.Buffer
+	err := r.Render(context.Background(), []byte(dot.String()), FormatSVG, &out)
+	assert.True(t, errors.Is(err, ErrGraphTooLarge), "got %v", err)
+	assert.Zero(t, out.Len())
+
+	// waiting for another render is abandoned when the context is done
+	if err := r.lock(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = r.Render(ctx, []byte(`digraph G { "a" -> "b" }`), FormatSVG, &out)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+	r.unlock()
+
+	assert.NoError(t, r.Render(context.Background(), []byte(`digraph G { "a" -> "b" }`), FormatSVG, &out))
+}
@@ -0,0 +1,135 @@
+// Package seed generates synthetic but realistic dependency graphs, which are used for demos, UI
+// development, and performance testing instead of crawling the Go module proxy.
+package seed
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"golang.org/x/mod/module"
+)
+
+// DefaultPrefix is the default module path prefix of generated modules, which makes them easy to find and
+// delete
+const DefaultPrefix = "example.com/seed"
+
+var (
+	// the words used to generate the names of organizations and modules
+	orgWords    = []string{"acme", "globex", "initech", "umbrella", "hooli", "vandelay", "stark", "wayne", "tyrell", "cyberdyne"}
+	prefixWords = []string{"fast", "tiny", "go", "simple", "safe", "cloud", "micro", "open", "lazy", "smart"}
+	nounWords   = []string{"log", "http", "cache", "queue", "config", "auth", "json", "metrics", "retry", "db", "grpc", "cli", "yaml", "trace", "pool"}
+)
+
+// Options controls the graph that is generated by [Generate]
+type Options struct {
+	// the module path prefix of the generated modules, ex: example.com/seed
+	Prefix string
+	// the number of modules to generate
+	Modules int
+	// the average number of direct dependencies of each module version
+	Fanout int
+	// the maximum number of versions of each module
+	MaxVersions int
+	// the seed for the random generator, where the same seed and options always generate the same graph
+	Seed uint64
+}
+
+// An Update is a version of a generated module along with its direct dependencies
+type Update struct {
+	Module module.Version
+	Deps   []module.Version
+}
+
+// genModule is a module of a generated graph
+type genModule struct {
+	path     string
+	versions []string
+	// the indexes of the modules that the module depends on, which were all generated before it
+	deps []int
+}
+
+// Generate returns an update for each version of opts.Modules synthetic modules.  The graph is acyclic
+// because each module only depends on modules that were generated before it, and dependencies are chosen
+// by preferential attachment so that, like a real ecosystem, a few modules are used by many others while
+// most have few consumers.  Each version of a module depends on the same modules, and later versions
+// depend on correspondingly later versions of them, as if the dependencies were upgraded along with each
+// release.
+func Generate(opts Options) []Update {
+	mods := generateModules(rand.New(rand.NewPCG(opts.Seed, opts.Seed)), opts)
+	var updates []Update
+	for _, m := range mods {
+		for vi, v := range m.versions {
+			u := Update{Module: module.Version{Path: m.path, Version: v}}
+			for _, j := range m.deps {
+				dep := mods[j]
+				di := vi * len(dep.versions) / len(m.versions)
+				u.Deps = append(u.Deps, module.Version{Path: dep.path, Version: dep.versions[di]})
+			}
+			updates = append(updates, u)
+		}
+	}
+	return updates
+}
+
+// generateModules returns the modules of the graph described by opts, in the order they were generated
+func generateModules(r *rand.Rand, opts Options) []genModule {
+	mods := make([]genModule, max(opts.Modules, 0))
+	seen := make(map[string]bool, len(mods))
+	// each module appears once, plus once for each of its consumers, so that picking a random element
+	// favors modules that are already popular
+	var targets []int
+	for i := range mods {
+		m := &mods[i]
+		m.path = modulePath(r, opts.Prefix, seen)
+		m.versions = versions(r, 1+r.IntN(max(opts.MaxVersions, 1)))
+
+		if i > 0 && opts.Fanout > 0 {
+			want := min(r.IntN(2*opts.Fanout+1), i)
+			picked := make(map[int]bool, want)
+			// give up after a bounded number of attempts if the popular modules keep being picked again
+			for attempts := 0; len(picked) < want && attempts < 10*want; attempts++ {
+				j := targets[r.IntN(len(targets))]
+				if !picked[j] {
+					picked[j] = true
+					m.deps = append(m.deps, j)
+					targets = append(targets, j)
+				}
+			}
+		}
+		targets = append(targets, i)
+	}
+	return mods
+}
+
+// modulePath returns a unique, generated module path under prefix, ex: example.com/seed/acme/fast-log
+func modulePath(r *rand.Rand, prefix string, seen map[string]bool) string {
+	org := orgWords[r.IntN(len(orgWords))]
+	name := prefixWords[r.IntN(len(prefixWords))] + "-" + nounWords[r.IntN(len(nounWords))]
+	path := prefix + "/" + org + "/" + name
+	for i := 2; seen[path]; i++ {
+		path = fmt.Sprintf("%s/%s/%s%d", prefix, org, name, i)
+	}
+	seen[path] = true
+	return path
+}
+
+// versions returns n increasing semantic versions that start at v0.1.0 or v1.0.0, most of which are minor
+// releases
+func versions(r *rand.Rand, n int) []string {
+	major, minor, patch := 1, 0, 0
+	if r.IntN(10) < 3 {
+		major, minor = 0, 1
+	}
+	result := make([]string, n)
+	for i := range result {
+		if i > 0 {
+			if r.IntN(10) < 7 {
+				minor, patch = minor+1, 0
+			} else {
+				patch++
+			}
+		}
+		result[i] = fmt.Sprintf("v%d.%d.%d", major, minor, patch)
+	}
+	return result
+}
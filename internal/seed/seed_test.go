@@ -0,0 +1,34 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+)
+
+func TestGenerate(t *testing.T) {
+	opts := Options{Prefix: DefaultPrefix, Modules: 500, Fanout: 4, MaxVersions: 3, Seed: 7}
+	updates := Generate(opts)
+	assert.Equal(t, updates, Generate(opts), "the same options should generate the same graph")
+
+	modules := make(map[string]bool)
+	edges := 0
+	for _, u := range updates {
+		if err := module.Check(u.Module.Path, u.Module.Version); err != nil {
+			t.Errorf("invalid module version: %v", err)
+		}
+		modules[u.Module.Path] = true
+		for _, d := range u.Deps {
+			// the graph is acyclic because modules only depend on modules that were generated earlier
+			assert.True(t, modules[d.Path], "%s depends on %s, which was generated after it", u.Module, d)
+		}
+		edges += len(u.Deps)
+	}
+	assert.Len(t, modules, opts.Modules)
+	avg := float64(edges) / float64(len(updates))
+	assert.InDelta(t, opts.Fanout, avg, 1, "the average number of dependencies should be close to the fanout")
+
+	opts.Seed++
+	assert.NotEqual(t, updates, Generate(opts), "a different seed should generate a different graph")
+}
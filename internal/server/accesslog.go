@@ -0,0 +1,38 @@
+package server
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// defaultAccessLogSamplePercent is the default percentage of successful API requests that are written
+	// to the access log
+	defaultAccessLogSamplePercent = 100
+	// defaultAccessLogSlowThreshold is the default duration after which a successful API request is always
+	// written to the access log
+	defaultAccessLogSlowThreshold = time.Second
+)
+
+// accessLogSampling controls which completed API requests are written to the access log so that
+// high-volume deployments can keep the entries that matter without logging every request.  Failed requests
+// are always logged.
+type accessLogSampling struct {
+	// the percentage, from 0 to 100, of successful requests that are logged
+	samplePercent float64
+	// successful requests that take at least this long are always logged, which is disabled if 0
+	slowThreshold time.Duration
+}
+
+// shouldLog reports whether a request that took elapsed and that did or did not fail should be written
+// to the access log and, if so, whether it was logged because it was slow.  All requests are logged if
+// s is nil.
+func (s *accessLogSampling) shouldLog(elapsed time.Duration, failed bool) (ok, slow bool) {
+	if s == nil || failed {
+		return true, false
+	}
+	if s.slowThreshold > 0 && elapsed >= s.slowThreshold {
+		return true, true
+	}
+	return rand.Float64()*100 < s.samplePercent, false
+}
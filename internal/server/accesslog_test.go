@@ -0,0 +1,53 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessLogSampling(t *testing.T) {
+	var all *accessLogSampling
+	ok, slow := all.shouldLog(time.Millisecond, false)
+	assert.True(t, ok, "all requests should be logged if sampling is not configured")
+	assert.False(t, slow)
+
+	none := &accessLogSampling{samplePercent: 0, slowThreshold: time.Second}
+	ok, _ = none.shouldLog(time.Millisecond, false)
+	assert.False(t, ok, "fast, successful requests should not be logged at 0%")
+	ok, slow = none.shouldLog(time.Millisecond, true)
+	assert.True(t, ok, "failed requests should always be logged")
+	assert.False(t, slow)
+	ok, slow = none.shouldLog(2*time.Second, false)
+	assert.True(t, ok, "slow requests should always be logged")
+	assert.True(t, slow)
+
+	none.slowThreshold = 0
+	ok, _ = none.shouldLog(time.Hour, false)
+	assert.False(t, ok, "slow requests should be sampled if the threshold is 0")
+
+	logged := 0
+	half := &accessLogSampling{samplePercent: 50}
+	for range 1000 {
+		if ok, _ := half.shouldLog(0, false); ok {
+			logged++
+		}
+	}
+	assert.InDelta(t, 500, logged, 100, "about half of the requests should be logged at 50%")
+}
+
+func TestLiveConfigAccessLogSampling(t *testing.T) {
+	lc := &liveConfig{}
+	lc.apply(serverConfig{})
+	assert.Nil(t, lc.currentAccessLogSampling(), "all requests should be logged by default")
+
+	percent := 10.0
+	lc.apply(serverConfig{accessLogSamplePercent: &percent})
+	assert.Equal(t, &accessLogSampling{samplePercent: 10, slowThreshold: defaultAccessLogSlowThreshold}, lc.currentAccessLogSampling())
+
+	threshold := 5 * time.Second
+	lc.apply(serverConfig{accessLogSlowThreshold: &threshold})
+	assert.Equal(t, &accessLogSampling{samplePercent: 10, slowThreshold: threshold}, lc.currentAccessLogSampling(),
+		"settings that are not changed should be kept")
+}
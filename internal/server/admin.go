@@ -0,0 +1,445 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"golang.org/x/mod/module"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const (
+	// maxRefreshVersions is the maximum number of module versions that a single RefreshModules call
+	// will process
+	maxRefreshVersions = 1000
+
+	// refreshQueryPageSize is the number of module versions read from the database at a time when
+	// resolving the versions to be refreshed
+	refreshQueryPageSize = 500
+)
+
+// RefreshModules re-fetches the go.mod files for all module versions matching the request filters from
+// the Go module proxy and replaces the stored dependencies of each.
+//
+// A failure to refresh an individual module version is reported in the response rather than failing
+// the entire request.
+func (s *connectServer) RefreshModules(ctx context.Context, req *connect.Request[perseusapi.RefreshModulesRequest]) (*connect.Response[perseusapi.RefreshModulesResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("RefreshModules() called", "request", msg.String())
+
+	query := store.ModuleVersionQuery{
+		ModuleFilter:      msg.GetModuleFilter(),
+		VersionFilter:     strings.TrimPrefix(msg.GetVersionFilter(), "v"),
+		IncludePrerelease: msg.GetIncludePrerelease(),
+		LatestOnly:        msg.GetLatestOnly(),
+		Count:             refreshQueryPageSize,
+	}
+	var targets []store.ModuleVersionQueryResult
+	for {
+		page, pageToken, err := s.store.QueryModuleVersions(ctx, query)
+		if err != nil {
+			log.Error(err, "unable to query module versions", "moduleFilter", query.ModuleFilter, "versionFilter", query.VersionFilter)
+			return nil, storeError(err, "unable to determine the module versions to refresh")
+		}
+		targets = append(targets, page...)
+		if len(targets) > maxRefreshVersions {
+			return nil, newInvalidArgumentError(
+				fmt.Sprintf("the filters match more than %d module versions", maxRefreshVersions),
+				fieldViolation("module_filter", "specify a more selective module or version filter"))
+		}
+		if pageToken == "" || len(page) == 0 {
+			break
+		}
+		query.PageToken = pageToken
+	}
+
+	resp := &perseusapi.RefreshModulesResponse{}
+	for _, t := range targets {
+		if err := ctx.Err(); err != nil {
+			return nil, connect.NewError(connect.CodeOf(err), err)
+		}
+		res := s.refreshModuleVersion(ctx, module.Version{Path: t.Module, Version: "v" + t.Version})
+		resp.Results = append(resp.Results, res)
+	}
+	s.audit(ctx, "refresh-modules", msg.GetModuleFilter(), fmt.Sprintf("refreshed %d module versions", len(resp.Results)))
+	return connect.NewResponse(resp), nil
+}
+
+// CheckGraph scans the database for consistency problems, optionally repairing them.
+func (s *connectServer) CheckGraph(ctx context.Context, req *connect.Request[perseusapi.CheckGraphRequest]) (*connect.Response[perseusapi.CheckGraphResponse], error) {
+	log := requestLogger(ctx)
+	log.Debug("CheckGraph() called", "repair", req.Msg.GetRepair())
+
+	issues, err := s.store.CheckConsistency(ctx, req.Msg.GetRepair())
+	if err != nil {
+		log.Error(err, "unable to check the consistency of the graph", "repair", req.Msg.GetRepair())
+		return nil, newDatabaseError(err, "unable to check the graph")
+	}
+	resp := &perseusapi.CheckGraphResponse{}
+	for _, i := range issues {
+		resp.Issues = append(resp.Issues, &perseusapi.CheckGraphResponse_Issue{
+			Kind:        i.Kind,
+			Description: i.Description,
+			Repaired:    i.Repaired,
+		})
+	}
+	if req.Msg.GetRepair() {
+		log.Info("repaired graph consistency issues", "found", len(issues))
+		s.audit(ctx, "repair-graph", "", fmt.Sprintf("found %d issues", len(issues)))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetStoreStats returns the storage used by the database, for capacity planning.
+func (s *connectServer) GetStoreStats(ctx context.Context, _ *connect.Request[perseusapi.GetStoreStatsRequest]) (*connect.Response[perseusapi.GetStoreStatsResponse], error) {
+	log := requestLogger(ctx)
+	log.Debug("GetStoreStats() called")
+
+	stats, err := s.store.GetStoreStats(ctx)
+	if err != nil {
+		log.Error(err, "unable to query the database statistics")
+		return nil, newDatabaseError(err, "unable to query the database statistics")
+	}
+	resp := &perseusapi.GetStoreStatsResponse{DatabaseBytes: stats.DatabaseBytes}
+	for _, t := range stats.Tables {
+		pt := &perseusapi.StoreTableStats{
+			Name:       t.Name,
+			Rows:       t.Rows,
+			DeadRows:   t.DeadRows,
+			TotalBytes: t.TotalBytes,
+			IndexBytes: t.IndexBytes,
+			Hint:       t.Hint,
+		}
+		if t.LastVacuum.Valid {
+			pt.LastVacuumTime = timestamppb.New(t.LastVacuum.Time)
+		}
+		if t.LastAnalyze.Valid {
+			pt.LastAnalyzeTime = timestamppb.New(t.LastAnalyze.Time)
+		}
+		resp.Tables = append(resp.Tables, pt)
+	}
+	for _, i := range stats.Indexes {
+		resp.Indexes = append(resp.Indexes, &perseusapi.StoreIndexStats{
+			Name:  i.Name,
+			Table: i.Table,
+			Bytes: i.Bytes,
+			Scans: i.Scans,
+			Hint:  i.Hint,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// MergeModules merges the source module into the target module, recording the source module's name as an
+// alias of the target.
+func (s *connectServer) MergeModules(ctx context.Context, req *connect.Request[perseusapi.MergeModulesRequest]) (*connect.Response[perseusapi.MergeModulesResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("MergeModules() called", "request", msg.String())
+
+	if msg.GetSourceModule() == msg.GetTargetModule() {
+		return nil, newInvalidArgumentError("a module cannot be merged into itself",
+			fieldViolation("target_module", "the target module must be different from the source module"))
+	}
+	res, err := s.store.MergeModules(ctx, msg.GetSourceModule(), msg.GetTargetModule())
+	if err != nil {
+		log.Error(err, "unable to merge modules", "source", msg.GetSourceModule(), "target", msg.GetTargetModule())
+		return nil, storeError(err, "unable to merge the modules")
+	}
+	log.Info("merged modules", "source", msg.GetSourceModule(), "target", msg.GetTargetModule(),
+		"movedVersions", res.MovedVersions, "mergedVersions", res.MergedVersions)
+	s.audit(ctx, "merge-modules", msg.GetSourceModule(), fmt.Sprintf("merged into %s, moved %d versions and merged %d versions",
+		msg.GetTargetModule(), res.MovedVersions, res.MergedVersions))
+
+	resp := &perseusapi.MergeModulesResponse{
+		MovedVersions:  int32(res.MovedVersions),  //nolint: gosec // a module will never have 2^31 versions
+		MergedVersions: int32(res.MergedVersions), //nolint: gosec // a module will never have 2^31 versions
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RenameModule records that a module was renamed so that dependency queries can follow the rename.
+func (s *connectServer) RenameModule(ctx context.Context, req *connect.Request[perseusapi.RenameModuleRequest]) (*connect.Response[perseusapi.RenameModuleResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("RenameModule() called", "request", msg.String())
+
+	if msg.GetOldModule() == msg.GetNewModule() {
+		return nil, newInvalidArgumentError("a module cannot be renamed to itself",
+			fieldViolation("new_module", "the new module name must be different from the old module name"))
+	}
+	if err := s.store.RenameModule(ctx, msg.GetOldModule(), msg.GetNewModule()); err != nil {
+		log.Error(err, "unable to rename module", "oldModule", msg.GetOldModule(), "newModule", msg.GetNewModule())
+		return nil, storeError(err, "unable to rename the module")
+	}
+	log.Info("renamed module", "oldModule", msg.GetOldModule(), "newModule", msg.GetNewModule())
+	s.audit(ctx, "rename-module", msg.GetOldModule(), "renamed to "+msg.GetNewModule())
+
+	return connect.NewResponse(&perseusapi.RenameModuleResponse{}), nil
+}
+
+// SetModuleLicense records the license of the specified module.
+func (s *connectServer) SetModuleLicense(ctx context.Context, req *connect.Request[perseusapi.SetModuleLicenseRequest]) (*connect.Response[perseusapi.SetModuleLicenseResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("SetModuleLicense() called", "request", msg.String())
+
+	license := strings.TrimSpace(msg.GetLicense())
+	if err := s.store.SetModuleLicense(ctx, msg.GetModuleName(), license); err != nil {
+		log.Error(err, "unable to set module license", "module", msg.GetModuleName(), "license", license)
+		return nil, storeError(err, "unable to set the module license")
+	}
+	log.Info("set module license", "module", msg.GetModuleName(), "license", license)
+	s.audit(ctx, "set-module-license", msg.GetModuleName(), license)
+
+	return connect.NewResponse(&perseusapi.SetModuleLicenseResponse{}), nil
+}
+
+// ListJobs returns the schedule and most recent status of each scheduled maintenance job.
+func (s *connectServer) ListJobs(ctx context.Context, _ *connect.Request[perseusapi.ListJobsRequest]) (*connect.Response[perseusapi.ListJobsResponse], error) {
+	log := requestLogger(ctx)
+	log.Debug("ListJobs() called")
+
+	resp := &perseusapi.ListJobsResponse{}
+	if s.jobs != nil {
+		resp.Jobs = s.jobs.status()
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteModule removes a module, all of its versions, and their dependency edges.
+func (s *connectServer) DeleteModule(ctx context.Context, req *connect.Request[perseusapi.DeleteModuleRequest]) (*connect.Response[perseusapi.DeleteModuleResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("DeleteModule() called", "request", msg.String())
+
+	if err := s.store.DeleteModule(ctx, msg.GetModuleName()); err != nil {
+		log.Error(err, "unable to delete module", "module", msg.GetModuleName())
+		return nil, storeError(err, "unable to delete the module")
+	}
+	log.Info("deleted module", "module", msg.GetModuleName(), "actor", actorFromContext(ctx))
+	s.audit(ctx, "delete-module", msg.GetModuleName(), "")
+
+	return connect.NewResponse(&perseusapi.DeleteModuleResponse{}), nil
+}
+
+// DeleteModuleVersion removes a single module version and its dependency edges.
+func (s *connectServer) DeleteModuleVersion(ctx context.Context, req *connect.Request[perseusapi.DeleteModuleVersionRequest]) (*connect.Response[perseusapi.DeleteModuleVersionResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("DeleteModuleVersion() called", "request", msg.String())
+
+	version := storedVersion(msg.GetModuleName(), msg.GetVersion())
+	if err := s.store.DeleteModuleVersion(ctx, msg.GetModuleName(), version); err != nil {
+		log.Error(err, "unable to delete module version", "module", msg.GetModuleName(), "version", version)
+		return nil, storeError(err, "unable to delete the module version")
+	}
+	log.Info("deleted module version", "module", msg.GetModuleName(), "version", version, "actor", actorFromContext(ctx))
+	s.audit(ctx, "delete-module-version", msg.GetModuleName()+"@"+version, "")
+
+	return connect.NewResponse(&perseusapi.DeleteModuleVersionResponse{}), nil
+}
+
+// UndeleteModule restores a deleted module, or a deleted version of a module, from its tombstone.
+func (s *connectServer) UndeleteModule(ctx context.Context, req *connect.Request[perseusapi.UndeleteModuleRequest]) (*connect.Response[perseusapi.UndeleteModuleResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("UndeleteModule() called", "request", msg.String())
+
+	var version string
+	if msg.GetVersion() != "" {
+		version = storedVersion(msg.GetModuleName(), msg.GetVersion())
+	}
+	result, err := s.store.UndeleteModule(ctx, msg.GetModuleName(), version)
+	if err != nil {
+		log.Error(err, "unable to undelete module", "module", msg.GetModuleName(), "version", version)
+		return nil, storeError(err, "unable to undelete the module")
+	}
+	target := msg.GetModuleName()
+	if version != "" {
+		target += "@" + version
+	}
+	log.Info("undeleted module", "module", target, "versions", result.Versions, "dependencies", result.Dependencies, "actor", actorFromContext(ctx))
+	s.audit(ctx, "undelete-module", target, fmt.Sprintf("restored %d versions and %d dependencies", result.Versions, result.Dependencies))
+
+	return connect.NewResponse(&perseusapi.UndeleteModuleResponse{
+		RestoredVersions:     int32(result.Versions),     //nolint: gosec // there will never be 2^31 versions
+		RestoredDependencies: int32(result.Dependencies), //nolint: gosec // there will never be 2^31 edges
+	}), nil
+}
+
+// CreateAPIKey generates and stores a new API key.  Only the hash of the key is stored, so the response
+// is the only time the key is available.
+func (s *connectServer) CreateAPIKey(ctx context.Context, req *connect.Request[perseusapi.CreateAPIKeyRequest]) (*connect.Response[perseusapi.CreateAPIKeyResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("CreateAPIKey() called", "name", msg.GetName())
+
+	secret, err := newAPIKey()
+	if err != nil {
+		log.Error(err, "unable to generate an API key")
+		return nil, connect.NewError(connect.CodeInternal, errors.New("unable to generate an API key"))
+	}
+	key, err := s.store.CreateAPIKey(ctx, msg.GetName(), hashAPIKey(secret))
+	if err != nil {
+		log.Error(err, "unable to create API key", "name", msg.GetName())
+		return nil, storeError(err, "unable to create the API key")
+	}
+	log.Info("created API key", "name", msg.GetName(), "actor", actorFromContext(ctx))
+	s.audit(ctx, "create-api-key", msg.GetName(), "")
+
+	resp := &perseusapi.CreateAPIKeyResponse{
+		ApiKey: apiKeyToProto(key),
+		Key:    secret,
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListAPIKeys returns all API keys, without their secrets.
+func (s *connectServer) ListAPIKeys(ctx context.Context, _ *connect.Request[perseusapi.ListAPIKeysRequest]) (*connect.Response[perseusapi.ListAPIKeysResponse], error) {
+	log := requestLogger(ctx)
+	log.Debug("ListAPIKeys() called")
+
+	keys, err := s.store.ListAPIKeys(ctx)
+	if err != nil {
+		log.Error(err, "unable to list API keys")
+		return nil, storeError(err, "unable to list the API keys")
+	}
+	resp := &perseusapi.ListAPIKeysResponse{}
+	for _, k := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, apiKeyToProto(k))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RevokeAPIKey revokes an API key so that it can no longer be used.
+func (s *connectServer) RevokeAPIKey(ctx context.Context, req *connect.Request[perseusapi.RevokeAPIKeyRequest]) (*connect.Response[perseusapi.RevokeAPIKeyResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("RevokeAPIKey() called", "name", msg.GetName())
+
+	if err := s.store.RevokeAPIKey(ctx, msg.GetName()); err != nil {
+		log.Error(err, "unable to revoke API key", "name", msg.GetName())
+		return nil, storeError(err, "unable to revoke the API key")
+	}
+	log.Info("revoked API key", "name", msg.GetName(), "actor", actorFromContext(ctx))
+	s.audit(ctx, "revoke-api-key", msg.GetName(), "")
+
+	return connect.NewResponse(&perseusapi.RevokeAPIKeyResponse{}), nil
+}
+
+// ListAuditLog returns the audit log entries, most recent first.
+func (s *connectServer) ListAuditLog(ctx context.Context, req *connect.Request[perseusapi.ListAuditLogRequest]) (*connect.Response[perseusapi.ListAuditLogResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("ListAuditLog() called", "pageToken", msg.GetPageToken(), "pageSize", msg.GetPageSize())
+
+	entries, pageToken, err := s.store.QueryAuditLog(ctx, msg.GetPageToken(), int(msg.GetPageSize()))
+	if err != nil {
+		log.Error(err, "unable to query the audit log", "pageToken", msg.GetPageToken(), "pageSize", msg.GetPageSize())
+		return nil, storeError(err, "unable to query the audit log")
+	}
+	resp := &perseusapi.ListAuditLogResponse{NextPageToken: pageToken}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &perseusapi.AuditEntry{
+			Id:      e.ID,
+			Time:    timestamppb.New(e.CreatedAt),
+			Actor:   e.Actor,
+			Action:  e.Action,
+			Target:  e.Target,
+			Details: e.Details.String,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// audit records a change made by an administrative operation in the audit log, attributed to the caller
+// that was authenticated for ctx.  Failures are logged rather than failing the operation, which has
+// already been applied.
+func (s *connectServer) audit(ctx context.Context, action, target, details string) {
+	entry := store.AuditEntry{
+		Actor:   actorFromContext(ctx),
+		Action:  action,
+		Target:  target,
+		Details: sql.NullString{String: details, Valid: details != ""},
+	}
+	if err := s.store.AddAuditEntry(ctx, entry); err != nil {
+		requestLogger(ctx).Error(err, "unable to record audit log entry", "action", action, "target", target)
+	}
+}
+
+// apiKeyToProto converts key to its API representation
+func apiKeyToProto(key store.APIKey) *perseusapi.APIKey {
+	pk := &perseusapi.APIKey{
+		Name:       key.Name,
+		CreateTime: timestamppb.New(key.CreatedAt),
+	}
+	if key.LastUsedAt.Valid {
+		pk.LastUsedTime = timestamppb.New(key.LastUsedAt.Time)
+	}
+	if key.RevokedAt.Valid {
+		pk.RevokeTime = timestamppb.New(key.RevokedAt.Time)
+	}
+	return pk
+}
+
+// refreshModuleVersion downloads the go.mod file for mod from the Go module proxy and replaces the
+// stored direct dependencies of mod with those declared in the file.
+func (s *connectServer) refreshModuleVersion(ctx context.Context, mod module.Version) *perseusapi.RefreshModulesResponse_Result {
+	log := requestLogger(ctx)
+	res := &perseusapi.RefreshModulesResponse_Result{
+		ModuleName: mod.Path,
+		Version:    mod.Version,
+	}
+
+	// versions reported by the module proxy, and the requirements in a go.mod, are stored in the same
+	// canonical form as the versions submitted through the API
+	cv, err := modver.Canonical(mod.Path, mod.Version)
+	if err != nil {
+		log.Error(err, "invalid module version", "module", mod)
+		res.Error = fmt.Sprintf("invalid module version: %v", err)
+		return res
+	}
+	mf, err := s.proxy.GetModFile(mod.Path, cv)
+	if err != nil {
+		log.Error(err, "unable to retrieve go.mod from the module proxy", "module", mod)
+		res.Error = fmt.Sprintf("unable to retrieve go.mod from the module proxy: %v", err)
+		return res
+	}
+	var deps []store.Version
+	for _, r := range mf.Require {
+		if r.Indirect {
+			continue
+		}
+		depVer, err := modver.Canonical(r.Mod.Path, r.Mod.Version)
+		if err != nil {
+			log.Error(err, "invalid requirement in go.mod", "module", mod, "requirement", r.Mod)
+			res.Error = fmt.Sprintf("invalid requirement %s in go.mod: %v", r.Mod, err)
+			return res
+		}
+		deps = append(deps, newStoreVersion(r.Mod.Path, depVer, r.Mod.Version))
+	}
+	sv := newStoreVersion(mod.Path, cv, mod.Version)
+	if err := s.store.ReplaceModuleDependencies(ctx, sv, deps...); err != nil {
+		log.Error(err, "unable to save module dependencies", "module", mod, "dependencies", deps)
+		res.Error = "unable to update the graph: a database operation failed"
+		return res
+	}
+	res.DependencyCount = int32(len(deps)) //nolint: gosec // a go.mod will never have 2^31 requirements
+
+	paths := []string{mod.Path}
+	for _, d := range deps {
+		paths = append(paths, d.ModuleID)
+	}
+	s.recordVanityAliases(ctx, paths...)
+	return res
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+
+	"github.com/CrowdStrike/perseus/internal/modproxy"
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+// refreshTestStore implements the subset of [store.Store] that is used by
+// [connectServer.refreshModuleVersion].
+type refreshTestStore struct {
+	store.Store
+	mod  store.Version
+	deps []store.Version
+}
+
+func (s *refreshTestStore) ReplaceModuleDependencies(_ context.Context, mod store.Version, deps ...store.Version) error {
+	s.mod, s.deps = mod, deps
+	return nil
+}
+
+// refreshTestGetter is a [modproxy.Getter] that serves the go.mod files in g, keyed by URL suffix, and responds
+// with 404 Not Found to any other request.
+type refreshTestGetter map[string]string
+
+func (g refreshTestGetter) Get(url string) (*http.Response, error) {
+	for suffix, content := range g {
+		if strings.HasSuffix(url, suffix) {
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(content))}, nil
+		}
+	}
+	return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestRefreshModuleVersion(t *testing.T) {
+	getter := refreshTestGetter{
+		"/example.com/a/@v/v1.2.0.mod": "module example.com/a\n\nrequire (\n\texample.com/b v1.1.0\n\texample.com/c v2.0.0+incompatible\n)\n",
+	}
+	db := &refreshTestStore{}
+	s := &connectServer{store: db, proxy: modproxy.New(getter, "https://proxy.example.com")}
+
+	res := s.refreshModuleVersion(context.Background(), module.Version{Path: "example.com/a", Version: "v1.2"})
+	assert.Empty(t, res.GetError())
+	assert.Equal(t, store.Version{ModuleID: "example.com/a", SemVer: "1.2.0", Original: "v1.2"}, db.mod)
+	assert.Equal(t, []store.Version{
+		{ModuleID: "example.com/b", SemVer: "1.1.0"},
+		{ModuleID: "example.com/c", SemVer: "2.0.0+incompatible"},
+	}, db.deps, "the requirements should be stored in canonical form")
+
+	res = s.refreshModuleVersion(context.Background(), module.Version{Path: "example.com/a", Version: "latest"})
+	assert.Contains(t, res.GetError(), "invalid module version")
+}
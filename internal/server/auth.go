@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+// the actors recorded in the audit log for operations that were not authenticated with an API key
+const (
+	actorAdmin     = "admin"
+	actorAnonymous = "anonymous"
+	actorScheduler = "scheduler"
+)
+
+// apiKeyPrefix identifies the secrets generated by [newAPIKey] so that leaked keys are easy to recognize
+const apiKeyPrefix = "perseus_"
+
+// adminProcedures are the RPCs that require authentication
+var adminProcedures = map[string]bool{
+	perseusapiconnect.PerseusServiceRefreshModulesProcedure:      true,
+	perseusapiconnect.PerseusServiceCheckGraphProcedure:          true,
+	perseusapiconnect.PerseusServiceMergeModulesProcedure:        true,
+	perseusapiconnect.PerseusServiceRenameModuleProcedure:        true,
+	perseusapiconnect.PerseusServiceSetModuleLicenseProcedure:    true,
+	perseusapiconnect.PerseusServiceListJobsProcedure:            true,
+	perseusapiconnect.PerseusServiceDeleteModuleProcedure:        true,
+	perseusapiconnect.PerseusServiceDeleteModuleVersionProcedure: true,
+	perseusapiconnect.PerseusServiceUndeleteModuleProcedure:      true,
+	perseusapiconnect.PerseusServiceCreateAPIKeyProcedure:        true,
+	perseusapiconnect.PerseusServiceListAPIKeysProcedure:         true,
+	perseusapiconnect.PerseusServiceRevokeAPIKeyProcedure:        true,
+	perseusapiconnect.PerseusServiceListAuditLogProcedure:        true,
+	perseusapiconnect.PerseusServiceGetStoreStatsProcedure:       true,
+}
+
+// actorKey is the context key for the name of the authenticated caller
+type actorKey struct{}
+
+// actorFromContext returns the name of the caller that was authenticated by [authInterceptor], or
+// "anonymous" if the call was not authenticated.
+func actorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok {
+		return actor
+	}
+	return actorAnonymous
+}
+
+// authInterceptor is a [connect.Interceptor] that requires calls to the administrative RPCs to present
+// either the server's admin token or an active API key as a bearer token in the Authorization header, or
+// the web UI session of an admin user.  If there is no admin token and no admin users, the administrative
+// RPCs are rejected unless insecure is set, which allows anonymous calls.
+type authInterceptor struct {
+	token string
+	db    store.Store
+	// the web UI login, nil if OIDC login is not configured
+	sessions *uiAuth
+	// allows anonymous calls to the administrative RPCs if authentication is not configured
+	insecure bool
+}
+
+// errAdminDisabled is returned for calls to the administrative RPCs if authentication is not configured
+var errAdminDisabled = errors.New("the administrative APIs are disabled because the server has no admin token or OIDC admins, set --admin-token to enable them")
+
+// enabled returns whether authentication is configured for the administrative RPCs
+func (a authInterceptor) enabled() bool {
+	return a.token != "" || (a.sessions != nil && len(a.sessions.admins) > 0)
+}
+
+// ensure the interceptor satisfies the Connect interface
+var _ connect.Interceptor = authInterceptor{}
+
+// WrapUnary satisfies the [connect.Interceptor] interface and handles unary RPCs.
+func (a authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !adminProcedures[req.Spec().Procedure] || (!a.enabled() && a.insecure) {
+			return next(ctx, req)
+		}
+		actor, err := a.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(context.WithValue(ctx, actorKey{}, actor), req)
+	}
+}
+
+// WrapStreamingClient satisfies the [connect.Interceptor] interface.  This is a no-op because the
+// interceptor is only used server-side.
+func (authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler satisfies the [connect.Interceptor] interface and handles streaming RPCs.
+func (a authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !adminProcedures[conn.Spec().Procedure] || (!a.enabled() && a.insecure) {
+			return next(ctx, conn)
+		}
+		actor, err := a.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(context.WithValue(ctx, actorKey{}, actor), conn)
+	}
+}
+
+// authenticate validates the bearer token in the Authorization header, or the web UI session cookie if there
+// is no bearer token and the request came from the web UI with the session's CSRF token, and returns the name of the caller.  This is "admin" for the server's admin token,
+// the name of an API key, or the email address of a web UI user.
+func (a authInterceptor) authenticate(ctx context.Context, header http.Header) (string, error) {
+	if !a.enabled() {
+		return "", connect.NewError(connect.CodeUnauthenticated, errAdminDisabled)
+	}
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		if a.sessions != nil {
+			if sess, err := a.sessions.sessionFromHeader(header); err == nil {
+				if !a.sessions.isAdmin(sess) {
+					return "", connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s is not an administrator", sess.user()))
+				}
+				// the session cookie is sent with requests from any site, and by any script running in the
+				// web UI's origin, so it only authorizes requests from the web UI itself that also carry the
+				// session's CSRF token
+				if !a.sessions.sameOrigin(header) {
+					return "", connect.NewError(connect.CodePermissionDenied, errors.New("cross-origin requests must use an API key"))
+				}
+				if !a.sessions.validCSRFToken(sess, header) {
+					return "", connect.NewError(connect.CodePermissionDenied, errors.New("requests authorized by a web UI session must include its CSRF token"))
+				}
+				return sess.user(), nil
+			}
+		}
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("an API key is required for administrative operations"))
+	}
+	if a.token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) == 1 {
+		return actorAdmin, nil
+	}
+	key, err := a.db.AuthenticateAPIKey(ctx, hashAPIKey(token))
+	switch {
+	case errors.Is(err, store.ErrAPIKeyNotFound):
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("invalid or revoked API key"))
+	case err != nil:
+		requestLogger(ctx).Error(err, "unable to authenticate API key")
+		return "", newDatabaseError(err, "unable to authenticate the API key")
+	default:
+		return key.Name, nil
+	}
+}
+
+// newAPIKey returns a new, random API key
+func newAPIKey() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("unable to generate an API key: %w", err)
+	}
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
+
+// hashAPIKey returns the hex-encoded SHA-256 hash of key, which is what is stored in the database
+func hashAPIKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
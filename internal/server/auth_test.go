@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+// apiKeyTestStore implements the subset of [store.Store] that is used by [authInterceptor]
+type apiKeyTestStore struct {
+	store.Store
+	keys map[string]string
+	err  error
+}
+
+func (s *apiKeyTestStore) AuthenticateAPIKey(_ context.Context, keyHash string) (store.APIKey, error) {
+	if s.err != nil {
+		return store.APIKey{}, s.err
+	}
+	name, ok := s.keys[keyHash]
+	if !ok {
+		return store.APIKey{}, store.ErrAPIKeyNotFound
+	}
+	return store.APIKey{Name: name}, nil
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	db := &apiKeyTestStore{
+		keys: map[string]string{hashAPIKey("perseus_ci"): "ci"},
+	}
+	type testCase struct {
+		name      string
+		token     string
+		insecure  bool
+		procedure string
+		header    string
+		dbErr     error
+		wantActor string
+		wantCode  connect.Code
+	}
+	cases := []testCase{
+		{
+			name:      "authentication not configured",
+			procedure: perseusapiconnect.PerseusServiceDeleteModuleProcedure,
+			header:    "Bearer perseus_ci",
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:      "insecure admin access",
+			insecure:  true,
+			procedure: perseusapiconnect.PerseusServiceDeleteModuleProcedure,
+			wantActor: actorAnonymous,
+		},
+		{
+			name:      "read-only procedure without authentication",
+			procedure: perseusapiconnect.PerseusServiceListModulesProcedure,
+			wantActor: actorAnonymous,
+		},
+		{
+			name:      "read-only procedure",
+			token:     "s3cr3t",
+			procedure: perseusapiconnect.PerseusServiceListModulesProcedure,
+			wantActor: actorAnonymous,
+		},
+		{
+			name:      "missing API key",
+			token:     "s3cr3t",
+			procedure: perseusapiconnect.PerseusServiceDeleteModuleProcedure,
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:      "not a bearer token",
+			token:     "s3cr3t",
+			procedure: perseusapiconnect.PerseusServiceDeleteModuleProcedure,
+			header:    "Basic s3cr3t",
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:      "admin token",
+			token:     "s3cr3t",
+			procedure: perseusapiconnect.PerseusServiceDeleteModuleProcedure,
+			header:    "Bearer s3cr3t",
+			wantActor: actorAdmin,
+		},
+		{
+			name:      "API key",
+			token:     "s3cr3t",
+			procedure: perseusapiconnect.PerseusServiceListAuditLogProcedure,
+			header:    "Bearer perseus_ci",
+			wantActor: "ci",
+		},
+		{
+			name:      "unknown API key",
+			token:     "s3cr3t",
+			procedure: perseusapiconnect.PerseusServiceListAuditLogProcedure,
+			header:    "Bearer perseus_oops",
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:      "database error",
+			token:     "s3cr3t",
+			procedure: perseusapiconnect.PerseusServiceListAuditLogProcedure,
+			header:    "Bearer perseus_ci",
+			dbErr:     &pgconn.PgError{Code: "08006", Message: "connection failure"},
+			wantCode:  connect.CodeUnavailable,
+		},
+		{
+			name:      "unexpected database error",
+			token:     "s3cr3t",
+			procedure: perseusapiconnect.PerseusServiceListAuditLogProcedure,
+			header:    "Bearer perseus_ci",
+			dbErr:     errors.New("oops"),
+			wantCode:  connect.CodeInternal,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db.err = tc.dbErr
+			ai := authInterceptor{token: tc.token, db: db, insecure: tc.insecure}
+			var gotActor string
+			next := ai.WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				gotActor = actorFromContext(ctx)
+				return nil, nil
+			})
+
+			req := newTestRequest(tc.procedure)
+			if tc.header != "" {
+				req.Header().Set("Authorization", tc.header)
+			}
+			_, err := next(context.Background(), req)
+			if tc.wantCode != 0 {
+				assert.Equal(t, tc.wantCode, connect.CodeOf(err))
+				assert.Empty(t, gotActor, "the handler should not have been called")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantActor, gotActor)
+		})
+	}
+}
+
+// testRequest is a [connect.AnyRequest] for an arbitrary RPC, which cannot be created with
+// [connect.NewRequest] because the procedure is only set by the Connect framework
+type testRequest struct {
+	*connect.Request[perseusapi.DeleteModuleRequest]
+	procedure string
+}
+
+func newTestRequest(procedure string) testRequest {
+	return testRequest{
+		Request:   connect.NewRequest(&perseusapi.DeleteModuleRequest{}),
+		procedure: procedure,
+	}
+}
+
+func (r testRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: r.procedure, StreamType: connect.StreamTypeUnary}
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"slices"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/vanguard"
+
+	"github.com/CrowdStrike/perseus/internal/compress"
+)
+
+// compressionOptions returns the Connect handler and Vanguard transcoder options that enable the specified
+// compression algorithms for the API.  Connect handlers support gzip by default, so it is disabled if it
+// isn't listed.  Messages smaller than [compress.MinBytes] are never compressed.
+func compressionOptions(algs []string) ([]connect.HandlerOption, []vanguard.TranscoderOption) {
+	handlerOpts := []connect.HandlerOption{connect.WithCompressMinBytes(compress.MinBytes)}
+	var transcoderOpts []vanguard.TranscoderOption
+	if !slices.Contains(algs, compress.Gzip) {
+		handlerOpts = append(handlerOpts, connect.WithCompression(compress.Gzip, nil, nil))
+	}
+	if slices.Contains(algs, compress.Zstd) {
+		handlerOpts = append(handlerOpts, connect.WithCompression(compress.Zstd, compress.NewZstdDecompressor, compress.NewZstdCompressor))
+		transcoderOpts = append(transcoderOpts, vanguard.WithCompression(compress.Zstd, compress.NewZstdCompressor, compress.NewZstdDecompressor))
+	}
+	return handlerOpts, transcoderOpts
+}
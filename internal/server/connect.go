@@ -2,13 +2,24 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"connectrpc.com/connect"
-	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/CrowdStrike/perseus/internal/depsdev"
+	"github.com/CrowdStrike/perseus/internal/modproxy"
+	"github.com/CrowdStrike/perseus/internal/modver"
+	"github.com/CrowdStrike/perseus/internal/osv"
+	"github.com/CrowdStrike/perseus/internal/render"
 	"github.com/CrowdStrike/perseus/internal/store"
 	"github.com/CrowdStrike/perseus/perseusapi"
 	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
@@ -20,23 +31,43 @@ type connectServer struct {
 	perseusapiconnect.UnimplementedPerseusServiceHandler
 
 	store store.Store
+	proxy modproxy.Proxy
+	jobs  *scheduler
+	live  *liveConfig
+	// the deps.dev API client used to enrich modules, nil if enrichment is disabled
+	depsdev *depsdev.Client
+	// the OSV API client used to look up vulnerabilities, nil if lookups are disabled
+	osv *osv.Client
+	// renders DOT graphs for the RenderGraph RPC
+	graphs render.Renderer
+	// limits the rate of RenderGraph calls, which are unlimited if nil
+	renderLimiter *rate.Limiter
+	// the algorithms that clients may use to compress requests and responses
+	compression []string
+	// the time that the server started, which is reported by GetServerInfo
+	started time.Time
+
+	// the modules whose vanity import path aliases have already been resolved, see recordVanityAliases()
+	vanityResolved sync.Map
 }
 
 func (s *connectServer) CreateModule(ctx context.Context, req *connect.Request[perseusapi.CreateModuleRequest]) (*connect.Response[perseusapi.CreateModuleResponse], error) {
+	log := requestLogger(ctx)
 	log.Debug("CreateModule() called", "module", req.Msg.GetModule().GetName(), "versions", req.Msg.GetModule().GetVersions())
 
-	m := req.Msg.GetModule()
-	if m.GetName() == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("module name is required"))
-	}
-	// validate the module + version(s)
+	// the request has already been validated against the protovalidate constraints so we only need
+	// to perform the Go-specific module path/version checks here
 	// . if no versions are provided, synthesize a version based on the module name so that we can
 	//   delegate to golang.org/x/mod/module.Check()
+	m := req.Msg.GetModule()
+	var versions []string
 	if vers := m.GetVersions(); len(vers) > 0 {
 		for _, v := range vers {
-			if err := module.Check(m.GetName(), v); err != nil {
-				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("version %q is invalid for module %q: %v", v, m.GetName(), err))
+			cv, err := canonicalModuleVersion("module", m.GetName(), v)
+			if err != nil {
+				return nil, err
 			}
+			versions = append(versions, cv)
 		}
 	} else {
 		sv := "v0.0.0"
@@ -44,14 +75,14 @@ func (s *connectServer) CreateModule(ctx context.Context, req *connect.Request[p
 		if len(matches) == 2 {
 			sv = "v" + matches[1] + ".0.0"
 		}
-		if err := module.Check(m.GetName(), sv); err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("module name %q is invalid: %v", m.GetName(), err))
+		if err := checkModuleVersion("module.name", m.GetName(), sv); err != nil {
+			return nil, err
 		}
 	}
 
-	if err := s.store.SaveModule(ctx, m.GetName(), "", m.GetVersions()...); err != nil {
+	if err := s.store.SaveModule(ctx, m.GetName(), "", versions...); err != nil {
 		log.Error(err, "error saving new module", "module", m.GetName(), "versions", m.GetVersions())
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to save module %q: a database operation failed", m.GetName()))
+		return nil, newDatabaseError(err, fmt.Sprintf("unable to save module %q", m.GetName()))
 	}
 
 	resp := connect.NewResponse(&perseusapi.CreateModuleResponse{
@@ -61,13 +92,14 @@ func (s *connectServer) CreateModule(ctx context.Context, req *connect.Request[p
 }
 
 func (s *connectServer) ListModules(ctx context.Context, req *connect.Request[perseusapi.ListModulesRequest]) (*connect.Response[perseusapi.ListModulesResponse], error) {
+	log := requestLogger(ctx)
 	log.Debug("ListModules() called", "args", req.Msg.String())
 
 	msg := req.Msg
 	mods, pageToken, err := s.store.QueryModules(ctx, msg.Filter, msg.PageToken, int(msg.PageSize))
 	if err != nil {
 		log.Error(err, "error querying the database", "filter", msg.Filter, "pageToken", msg.PageToken, "pageSize", msg.PageSize)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to query the database"))
+		return nil, storeError(err, "unable to query the database")
 	}
 	resp := &perseusapi.ListModulesResponse{
 		NextPageToken: pageToken,
@@ -77,23 +109,20 @@ func (s *connectServer) ListModules(ctx context.Context, req *connect.Request[pe
 			Name: m.Name,
 		}
 		// include the latest version for each matched module
-		versionQ := store.ModuleVersionQuery{
-			ModuleFilter:      m.Name,
-			LatestOnly:        true,
-			IncludePrerelease: false,
-		}
-		vers, _, err := s.store.QueryModuleVersions(ctx, versionQ)
-		if err != nil {
-			log.Error(err, "unable to query for latest module version", "moduleFilter", m.Name, "latestOnly", true, "includePrerelease", false)
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to determine latest version for module %s: a database operation failed", m.Name))
-		}
-		// if no stable version exists, try to find a pre-release
-		if len(vers) == 0 {
-			versionQ.IncludePrerelease = true
+		// . if no stable version exists, try to find a tagged pre-release and then a pseudo-version
+		var vers []store.ModuleVersionQueryResult
+		for _, versionQ := range []store.ModuleVersionQuery{
+			{ModuleFilter: m.Name, LatestOnly: true},
+			{ModuleFilter: m.Name, LatestOnly: true, IncludePrerelease: true, ExcludePseudo: true},
+			{ModuleFilter: m.Name, LatestOnly: true, IncludePrerelease: true},
+		} {
 			vers, _, err = s.store.QueryModuleVersions(ctx, versionQ)
 			if err != nil {
-				log.Error(err, "unable to query for latest module version", "moduleFilter", m.Name, "latestOnly", true, "includePrerelease", true)
-				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to determine latest version for module %s: a database operation failed", m.Name))
+				log.Error(err, "unable to query for latest module version", "moduleFilter", m.Name, "latestOnly", true, "includePrerelease", versionQ.IncludePrerelease, "excludePseudo", versionQ.ExcludePseudo)
+				return nil, newDatabaseError(err, fmt.Sprintf("unable to determine latest version for module %s", m.Name))
+			}
+			if len(vers) > 0 {
+				break
 			}
 		}
 		// assign the latest version of the module, if found
@@ -107,50 +136,63 @@ func (s *connectServer) ListModules(ctx context.Context, req *connect.Request[pe
 }
 
 func (s *connectServer) ListModuleVersions(ctx context.Context, req *connect.Request[perseusapi.ListModuleVersionsRequest]) (*connect.Response[perseusapi.ListModuleVersionsResponse], error) {
+	log := requestLogger(ctx)
 	log.Debug("ListModuleVersions() called", "req", req.Msg)
 
 	msg := req.Msg
-	mod, vfilter, vopt, pageToken := msg.GetModuleName(), msg.GetVersionFilter(), msg.GetVersionOption(), msg.GetPageToken()
+	mod, vfilter := msg.GetModuleName(), msg.GetVersionFilter()
 	if mod == "" {
 		mod = msg.GetModuleFilter()
-		if mod == "" {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Either the module name or a module filter pattern must be specified"))
-		}
 	}
-	switch vopt {
-	case perseusapi.ModuleVersionOption_none:
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("The version option cannot be 'none'"))
-	case perseusapi.ModuleVersionOption_latest:
-		if pageToken != "" {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Paging is only supported when the version option is 'all'"))
+	// the version filter is either a range of semantic versions or a glob pattern that is matched against
+	// the stored versions, which have no "v" prefix
+	var vrange modver.Range
+	if modver.IsRange(vfilter) {
+		r, err := modver.ParseRange(vfilter)
+		if err != nil {
+			return nil, newInvalidArgumentError(err.Error(),
+				fieldViolation("version_filter", "must be a glob pattern or a range of semantic versions, ex: >=v1.4.0 <v2.0.0"))
 		}
-	default:
-		// all good
+		vrange, vfilter = r, ""
+	} else {
+		vfilter = strings.TrimPrefix(vfilter, "v")
 	}
 
 	var (
-		vers []store.ModuleVersionQueryResult
-		err  error
+		vers      []store.ModuleVersionQueryResult
+		pageToken string
+		err       error
 	)
 	vers, pageToken, err = s.store.QueryModuleVersions(ctx, store.ModuleVersionQuery{
 		ModuleFilter:      mod,
+		AllMajors:         msg.AllMajors,
 		VersionFilter:     vfilter,
+		VersionRange:      vrange,
 		IncludePrerelease: msg.IncludePrerelease,
+		ExcludePseudo:     msg.ExcludePseudo,
 		LatestOnly:        msg.VersionOption == perseusapi.ModuleVersionOption_latest,
+		AsOf:              asOfTime(msg.GetAsOf()),
+		Since:             asOfTime(msg.GetSince()),
+		SortByDate:        msg.GetSort() == perseusapi.ModuleVersionSort_date,
+		Limit:             int(msg.GetLimit()),
 		PageToken:         msg.GetPageToken(),
 		Count:             int(msg.GetPageSize()),
 	})
 	if err != nil {
 		kvs := []any{
 			"moduleFilter", mod,
-			"versionFilter", vfilter,
+			"allMajors", msg.AllMajors,
+			"versionFilter", msg.GetVersionFilter(),
 			"includePrerelease", msg.IncludePrerelease,
+			"excludePseudo", msg.ExcludePseudo,
 			"latestOnly", msg.VersionOption == perseusapi.ModuleVersionOption_latest,
+			"sort", msg.GetSort().String(),
+			"limit", msg.GetLimit(),
 			"pageToken", msg.GetPageToken(),
 			"pageSize", msg.GetPageSize(),
 		}
 		log.Error(err, "unable to query module versions", kvs...)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to retrieve version list for module %s: a database operation failed", msg.GetModuleName()))
+		return nil, storeError(err, fmt.Sprintf("unable to retrieve version list for module %s", mod))
 	}
 
 	resp := perseusapi.ListModuleVersionsResponse{
@@ -167,67 +209,135 @@ func (s *connectServer) ListModuleVersions(ctx context.Context, req *connect.Req
 			resp.Modules = append(resp.Modules, currMod)
 		}
 		currMod.Versions = append(currMod.Versions, "v"+v.Version)
+		if !v.CreatedAt.IsZero() {
+			currMod.VersionTimes = append(currMod.VersionTimes, timestamppb.New(v.CreatedAt))
+		}
 	}
 
 	return connect.NewResponse(&resp), nil
 }
 
 func (s *connectServer) UpdateDependencies(ctx context.Context, req *connect.Request[perseusapi.UpdateDependenciesRequest]) (*connect.Response[perseusapi.UpdateDependenciesResponse], error) {
+	log := requestLogger(ctx)
 	msg := req.Msg
 
 	log.Debug("UpdateDependencies() called", "args", req.Msg)
 
-	modName, modVer := msg.GetModuleName(), msg.GetVersion()
-	if err := module.Check(modName, modVer); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid module/version: %v", err))
-	}
-	mod := store.Version{
-		ModuleID: modName,
-		SemVer:   strings.TrimPrefix(modVer, "v"),
+	mod, deps, err := parseDependencyUpdate("", msg)
+	if err != nil {
+		return nil, err
 	}
-	deps := make([]store.Version, len(msg.GetDependencies()))
-	for i, dep := range msg.GetDependencies() {
-		depName, depVers := dep.GetName(), dep.GetVersions()
-		if len(depVers) != 1 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("must specify exactly 1 version of a dependency"))
-		} else if err := module.Check(depName, depVers[0]); err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid module/version: %v", err))
-		}
 
-		deps[i] = store.Version{
-			ModuleID: depName,
-			SemVer:   strings.TrimPrefix(depVers[0], "v"),
+	if msg.GetValidateOnly() {
+		changes, err := s.store.PreviewModuleDependencies(ctx, mod, msg.GetPrune(), deps...)
+		if err != nil {
+			log.Error(err, "unable to preview module dependencies", "module", mod, "dependencies", deps, "prune", msg.GetPrune())
+			return nil, newDatabaseError(err, "unable to validate the update")
 		}
+		return connect.NewResponse(&perseusapi.UpdateDependenciesResponse{
+			AddedDependencies:   toAPIModules(changes.Added),
+			RemovedDependencies: toAPIModules(changes.Removed),
+		}), nil
 	}
 
-	if err := s.store.SaveModuleDependencies(ctx, mod, deps...); err != nil {
-		log.Error(err, "unable to save module dependencies", "module", mod, "dependencies", deps)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to update the graph: database operation failed"))
+	save := s.store.SaveModuleDependencies
+	if msg.GetPrune() {
+		save = s.store.ReplaceModuleDependencies
+	}
+	if err := save(ctx, mod, deps...); err != nil {
+		log.Error(err, "unable to save module dependencies", "module", mod, "dependencies", deps, "prune", msg.GetPrune())
+		return nil, newDatabaseError(err, "unable to update the graph")
 	}
 
 	resp := perseusapi.UpdateDependenciesResponse{}
 	return connect.NewResponse(&resp), nil
 }
 
+func (s *connectServer) BatchUpdateDependencies(ctx context.Context, req *connect.Request[perseusapi.BatchUpdateDependenciesRequest]) (*connect.Response[perseusapi.BatchUpdateDependenciesResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+
+	log.Debug("BatchUpdateDependencies() called", "updates", len(msg.GetUpdates()))
+
+	type update struct {
+		mod   store.Version
+		deps  []store.Version
+		prune bool
+	}
+	updates := make([]update, len(msg.GetUpdates()))
+	for i, u := range msg.GetUpdates() {
+		mod, deps, err := parseDependencyUpdate(fmt.Sprintf("updates[%d].", i), u)
+		if err != nil {
+			return nil, err
+		}
+		updates[i] = update{mod: mod, deps: deps, prune: u.GetPrune()}
+	}
+
+	err := s.store.WithTx(ctx, func(db store.Store) error {
+		for _, u := range updates {
+			save := db.SaveModuleDependencies
+			if u.prune {
+				save = db.ReplaceModuleDependencies
+			}
+			if err := save(ctx, u.mod, u.deps...); err != nil {
+				return fmt.Errorf("unable to save the dependencies of %s@v%s: %w", u.mod.ModuleID, u.mod.SemVer, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "unable to apply batched dependency updates", "updates", len(updates))
+		return nil, newDatabaseError(err, "unable to update the graph, none of the updates were applied")
+	}
+
+	resp := perseusapi.BatchUpdateDependenciesResponse{}
+	return connect.NewResponse(&resp), nil
+}
+
+// parseDependencyUpdate validates the module version and dependencies of msg, returning them in their
+// canonical form.  The field names in any validation error are prefixed with prefix, which identifies
+// msg within a batched request.
+func parseDependencyUpdate(prefix string, msg *perseusapi.UpdateDependenciesRequest) (store.Version, []store.Version, error) {
+	modName, modVer := msg.GetModuleName(), msg.GetVersion()
+	cv, err := canonicalModuleVersion(prefix+"module_name", modName, modVer)
+	if err != nil {
+		return store.Version{}, nil, err
+	}
+	mod := newStoreVersion(modName, cv, modVer)
+	deps := make([]store.Version, len(msg.GetDependencies()))
+	for i, dep := range msg.GetDependencies() {
+		depName, depVers := dep.GetName(), dep.GetVersions()
+		cv, err := canonicalModuleVersion(fmt.Sprintf("%sdependencies[%d]", prefix, i), depName, depVers[0])
+		if err != nil {
+			return store.Version{}, nil, err
+		}
+		deps[i] = newStoreVersion(depName, cv, depVers[0])
+	}
+	return mod, deps, nil
+}
+
 func (s *connectServer) QueryDependencies(ctx context.Context, req *connect.Request[perseusapi.QueryDependenciesRequest]) (*connect.Response[perseusapi.QueryDependenciesResponse], error) {
+	log := requestLogger(ctx)
 	msg := req.Msg
 
 	log.Debug("QueryDependencies() called", "request", msg.String())
 
+	if msg.GetVersionRange() != "" {
+		return s.queryDependentsInRange(ctx, msg)
+	}
 	modName, modVer := msg.GetModuleName(), msg.GetVersion()
-	if err := module.Check(modName, modVer); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid module/version: %v", err))
+	modVer, err := canonicalModuleVersion("module_name", modName, modVer)
+	if err != nil {
+		return nil, err
 	}
 	var (
 		deps      []store.Version
 		pageToken string
-		err       error
 	)
-	switch msg.GetDirection() {
-	case perseusapi.DependencyDirection_dependencies:
-		deps, pageToken, err = s.store.GetDependees(ctx, modName, strings.TrimPrefix(modVer, "v"), msg.GetPageToken(), int(msg.GetPageSize()))
-	case perseusapi.DependencyDirection_dependents:
-		deps, pageToken, err = s.store.GetDependents(ctx, modName, strings.TrimPrefix(modVer, "v"), msg.GetPageToken(), int(msg.GetPageSize()))
+	if msg.GetFollowRenames() {
+		deps, err = s.queryRenamedDependencies(ctx, modName, strings.TrimPrefix(modVer, "v"), asOfTime(msg.GetAsOf()), msg.GetDirection())
+	} else {
+		deps, pageToken, err = s.queryDependencies(ctx, modName, strings.TrimPrefix(modVer, "v"), asOfTime(msg.GetAsOf()), msg.GetDirection(), msg.GetPageToken(), int(msg.GetPageSize()))
 	}
 	if err != nil {
 		kvs := []any{
@@ -238,7 +348,7 @@ func (s *connectServer) QueryDependencies(ctx context.Context, req *connect.Requ
 			"pageSize", msg.GetPageSize(),
 		}
 		log.Error(err, "unable to query module dependencies", kvs...)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Unable to query the graph: a database operation failed"))
+		return nil, storeError(err, "unable to query the graph")
 	}
 	resp := perseusapi.QueryDependenciesResponse{
 		NextPageToken: pageToken,
@@ -249,5 +359,230 @@ func (s *connectServer) QueryDependencies(ctx context.Context, req *connect.Requ
 			Versions: []string{"v" + d.SemVer},
 		})
 	}
+	if len(msg.GetEnrich()) > 0 {
+		if s.depsdev == nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("deps.dev enrichment is not enabled on this server"))
+		}
+		s.enrichModules(ctx, resp.Modules, msg.GetEnrich())
+	}
 	return connect.NewResponse(&resp), nil
 }
+
+// GetDependencyHistory returns every direct dependency that the specified module version has declared,
+// including those that have since been removed.
+func (s *connectServer) GetDependencyHistory(ctx context.Context, req *connect.Request[perseusapi.GetDependencyHistoryRequest]) (*connect.Response[perseusapi.GetDependencyHistoryResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("GetDependencyHistory() called", "request", msg.String())
+
+	modName := msg.GetModuleName()
+	modVer, err := canonicalModuleVersion("module_name", modName, msg.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	deps, err := s.store.GetDependencyHistory(ctx, modName, strings.TrimPrefix(modVer, "v"))
+	if err != nil {
+		log.Error(err, "unable to query module dependency history", "module", modName, "version", modVer)
+		return nil, storeError(err, "unable to query the graph")
+	}
+	resp := perseusapi.GetDependencyHistoryResponse{}
+	for _, d := range deps {
+		dep := &perseusapi.GetDependencyHistoryResponse_Dependency{
+			Module: &perseusapi.Module{
+				Name:     d.ModuleID,
+				Versions: []string{"v" + d.SemVer},
+			},
+			FirstSeenTime: timestamppb.New(d.FirstSeen),
+			LastSeenTime:  timestamppb.New(d.LastSeen),
+		}
+		if d.RemovedAt.Valid {
+			dep.RemoveTime = timestamppb.New(d.RemovedAt.Time)
+		}
+		resp.Dependencies = append(resp.Dependencies, dep)
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// DiffGraph returns the modules and dependency edges that were added or removed between 2 points in time.
+func (s *connectServer) DiffGraph(ctx context.Context, req *connect.Request[perseusapi.DiffGraphRequest]) (*connect.Response[perseusapi.DiffGraphResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("DiffGraph() called", "request", msg.String())
+
+	from, to := msg.GetFromTime().AsTime(), msg.GetToTime().AsTime()
+	diff, err := s.store.DiffGraph(ctx, msg.GetModuleFilter(), from, to)
+	if err != nil {
+		log.Error(err, "unable to compare the graph", "moduleFilter", msg.GetModuleFilter(), "from", from, "to", to)
+		return nil, storeError(err, "unable to compare the graph")
+	}
+	resp := perseusapi.DiffGraphResponse{
+		AddedModules:   diff.AddedModules,
+		RemovedModules: diff.RemovedModules,
+		AddedEdges:     toAPIEdges(diff.AddedEdges),
+		RemovedEdges:   toAPIEdges(diff.RemovedEdges),
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// toAPIEdges converts the specified dependency edges to their API representation
+func toAPIEdges(edges []store.DependencyEdge) []*perseusapi.DiffGraphResponse_Edge {
+	res := make([]*perseusapi.DiffGraphResponse_Edge, 0, len(edges))
+	for _, e := range edges {
+		res = append(res, &perseusapi.DiffGraphResponse_Edge{
+			Module:     &perseusapi.Module{Name: e.Module, Versions: []string{"v" + e.Version}},
+			Dependency: &perseusapi.Module{Name: e.DependencyModule, Versions: []string{"v" + e.DependencyVersion}},
+		})
+	}
+	return res
+}
+
+// StreamEdges sends each dependency edge that matches the filters to the client as a separate message.
+func (s *connectServer) StreamEdges(ctx context.Context, req *connect.Request[perseusapi.StreamEdgesRequest], stream *connect.ServerStream[perseusapi.StreamEdgesResponse]) error {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("StreamEdges() called", "request", msg.String())
+
+	query := store.EdgeQuery{
+		ModuleFilter:     msg.GetModuleFilter(),
+		DependencyFilter: msg.GetDependencyFilter(),
+	}
+	if msg.GetAsOf() != nil {
+		query.AsOf = msg.GetAsOf().AsTime()
+	}
+	var (
+		count   int
+		sendErr error
+	)
+	err := s.store.StreamDependencyEdges(ctx, query, func(e store.DependencyEdge) error {
+		sendErr = stream.Send(&perseusapi.StreamEdgesResponse{
+			Module:     &perseusapi.Module{Name: e.Module, Versions: []string{"v" + e.Version}},
+			Dependency: &perseusapi.Module{Name: e.DependencyModule, Versions: []string{"v" + e.DependencyVersion}},
+		})
+		count++
+		return sendErr
+	})
+	switch {
+	case sendErr != nil:
+		// the client disconnected or the stream failed, so there is no point reporting a store error
+		log.Debug("unable to send dependency edge", "err", sendErr, "sent", count-1)
+		return sendErr
+	case err != nil:
+		log.Error(err, "unable to stream dependency edges", "moduleFilter", query.ModuleFilter, "dependencyFilter", query.DependencyFilter)
+		return storeError(err, "unable to stream dependency edges")
+	}
+	log.Debug("StreamEdges() completed", "edges", count)
+	return nil
+}
+
+// queryDependencies returns the direct dependencies or dependents, depending on dir, of the specified
+// module version that were declared at asOf, or that are currently declared if asOf is the zero time.
+// queryDependentsInRange returns the module versions that depend on any version of the requested module
+// in the requested version range, along with the version that each one depends on
+func (s *connectServer) queryDependentsInRange(ctx context.Context, msg *perseusapi.QueryDependenciesRequest) (*connect.Response[perseusapi.QueryDependenciesResponse], error) {
+	versions, err := modver.ParseRange(msg.GetVersionRange())
+	if err != nil {
+		return nil, newInvalidArgumentError(err.Error(),
+			fieldViolation("version_range", "must be a range of semantic versions, ex: <v1.56.3 or >=v1.2.0 <v1.5.0"))
+	}
+	edges, pageToken, err := s.store.GetDependentsInRange(ctx, msg.GetModuleName(), versions, asOfTime(msg.GetAsOf()), msg.GetPageToken(), int(msg.GetPageSize()))
+	if err != nil {
+		kvs := []any{
+			"module", msg.GetModuleName(),
+			"versionRange", msg.GetVersionRange(),
+			"pageToken", msg.GetPageToken(),
+			"pageSize", msg.GetPageSize(),
+		}
+		requestLogger(ctx).Error(err, "unable to query module dependents", kvs...)
+		return nil, storeError(err, "unable to query the graph")
+	}
+	resp := perseusapi.QueryDependenciesResponse{
+		NextPageToken: pageToken,
+	}
+	for _, e := range edges {
+		resp.Modules = append(resp.Modules, &perseusapi.Module{
+			Name:              e.Module,
+			Versions:          []string{"v" + e.Version},
+			DependencyVersion: "v" + e.DependencyVersion,
+		})
+	}
+	if len(msg.GetEnrich()) > 0 {
+		if s.depsdev == nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("deps.dev enrichment is not enabled on this server"))
+		}
+		s.enrichModules(ctx, resp.Modules, msg.GetEnrich())
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+func (s *connectServer) queryDependencies(ctx context.Context, name, version string, asOf time.Time, dir perseusapi.DependencyDirection, pageToken string, pageSize int) ([]store.Version, string, error) {
+	if dir == perseusapi.DependencyDirection_dependents {
+		return s.store.GetDependents(ctx, name, version, asOf, pageToken, pageSize)
+	}
+	return s.store.GetDependees(ctx, name, version, asOf, pageToken, pageSize)
+}
+
+// queryRenamedDependencies returns the combined direct dependencies or dependents, depending on dir, of
+// the specified version of the named module and of every module that it was renamed from or to.  The
+// results are de-duplicated and ordered by module name then by descending version.
+func (s *connectServer) queryRenamedDependencies(ctx context.Context, name, version string, asOf time.Time, dir perseusapi.DependencyDirection) ([]store.Version, error) {
+	names, err := s.store.GetRenamedModules(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		deps []store.Version
+		seen = make(map[string]struct{})
+	)
+	for _, n := range names {
+		res, _, err := s.queryDependencies(ctx, n, version, asOf, dir, "", 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range res {
+			k := d.ModuleID + "@" + d.SemVer
+			if _, found := seen[k]; found {
+				continue
+			}
+			seen[k] = struct{}{}
+			deps = append(deps, d)
+		}
+	}
+	slices.SortFunc(deps, func(a, b store.Version) int {
+		if c := strings.Compare(a.ModuleID, b.ModuleID); c != 0 {
+			return c
+		}
+		return semver.Compare("v"+b.SemVer, "v"+a.SemVer)
+	})
+	return deps, nil
+}
+
+// asOfTime returns the time specified by ts, or the zero time if ts is not set so that queries return the
+// current state of the graph.
+func asOfTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// toAPIModules converts each of the module versions in vers to an API module with a single version
+func toAPIModules(vers []store.Version) []*perseusapi.Module {
+	var mods []*perseusapi.Module
+	for _, v := range vers {
+		mods = append(mods, &perseusapi.Module{Name: v.ModuleID, Versions: []string{"v" + v.SemVer}})
+	}
+	return mods
+}
+
+// newStoreVersion returns a [store.Version] for the canonical version cv of the named module, recording
+// the version as it was provided if that differs.
+func newStoreVersion(name, cv, original string) store.Version {
+	v := store.Version{
+		ModuleID: name,
+		SemVer:   strings.TrimPrefix(cv, "v"),
+	}
+	if original != cv {
+		v.Original = original
+	}
+	return v
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+// previewTestStore implements the subset of [store.Store] that is used by a validate-only call to
+// [connectServer.UpdateDependencies].  Any attempt to save the dependencies panics.
+type previewTestStore struct {
+	store.Store
+	replace bool
+	mod     store.Version
+	deps    []store.Version
+}
+
+func (s *previewTestStore) PreviewModuleDependencies(_ context.Context, mod store.Version, replace bool, deps ...store.Version) (store.DependencyChanges, error) {
+	s.replace, s.mod, s.deps = replace, mod, deps
+	return store.DependencyChanges{
+		Added:   []store.Version{{ModuleID: "example.com/b", SemVer: "1.1.0"}},
+		Removed: []store.Version{{ModuleID: "example.com/b", SemVer: "1.0.0"}},
+	}, nil
+}
+
+func TestUpdateDependenciesValidateOnly(t *testing.T) {
+	db := &previewTestStore{}
+	s := &connectServer{store: db}
+	resp, err := s.UpdateDependencies(context.Background(), connect.NewRequest(&perseusapi.UpdateDependenciesRequest{
+		ModuleName: "example.com/a",
+		Version:    "v1.0.0",
+		Dependencies: []*perseusapi.Module{
+			{Name: "example.com/b", Versions: []string{"v1.1"}},
+		},
+		Prune:        true,
+		ValidateOnly: true,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, db.replace)
+	assert.Equal(t, []store.Version{{ModuleID: "example.com/b", SemVer: "1.1.0", Original: "v1.1"}}, db.deps, "the dependencies should be validated and canonicalized")
+	assert.Equal(t, "example.com/b@v1.1.0", resp.Msg.GetAddedDependencies()[0].GetName()+"@"+resp.Msg.GetAddedDependencies()[0].GetVersions()[0])
+	assert.Equal(t, "example.com/b@v1.0.0", resp.Msg.GetRemovedDependencies()[0].GetName()+"@"+resp.Msg.GetRemovedDependencies()[0].GetVersions()[0])
+
+	_, err = s.UpdateDependencies(context.Background(), connect.NewRequest(&perseusapi.UpdateDependenciesRequest{
+		ModuleName:   "example.com/a",
+		Version:      "v1.0.0",
+		Dependencies: []*perseusapi.Module{{Name: "example.com/b/v2", Versions: []string{"v1.0.0"}}},
+		ValidateOnly: true,
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "invalid dependencies should be rejected")
+}
+
+func TestUpdateDependenciesShorthandVersions(t *testing.T) {
+	vi, err := newValidationInterceptor()
+	if err != nil {
+		t.Fatalf("unable to create validation interceptor: %v", err)
+	}
+	db := &previewTestStore{}
+	path, handler := perseusapiconnect.NewPerseusServiceHandler(&connectServer{store: db}, connect.WithInterceptors(vi))
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	svr := httptest.NewServer(mux)
+	defer svr.Close()
+
+	client := perseusapiconnect.NewPerseusServiceClient(svr.Client(), svr.URL)
+	_, err = client.UpdateDependencies(context.Background(), connect.NewRequest(&perseusapi.UpdateDependenciesRequest{
+		ModuleName: "example.com/a",
+		Version:    "v1.2",
+		Dependencies: []*perseusapi.Module{
+			{Name: "example.com/b", Versions: []string{"1.2.3"}},
+			{Name: "example.com/c", Versions: []string{"v2.0.0"}},
+		},
+		ValidateOnly: true,
+	}))
+	if assert.NoError(t, err, "shorthand versions should pass validation") {
+		assert.Equal(t, store.Version{ModuleID: "example.com/a", SemVer: "1.2.0", Original: "v1.2"}, db.mod)
+		assert.Equal(t, []store.Version{
+			{ModuleID: "example.com/b", SemVer: "1.2.3", Original: "1.2.3"},
+			{ModuleID: "example.com/c", SemVer: "2.0.0+incompatible", Original: "v2.0.0"},
+		}, db.deps, "the versions should be canonicalized")
+	}
+
+	_, err = client.UpdateDependencies(context.Background(), connect.NewRequest(&perseusapi.UpdateDependenciesRequest{
+		ModuleName:   "example.com/a",
+		Version:      "v1.2.3.4",
+		ValidateOnly: true,
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "invalid versions should be rejected")
+}
+
+// batchTestStore implements the subset of [store.Store] that is used by
+// [connectServer.BatchUpdateDependencies].  Saves within a transaction are only recorded when it commits.
+type batchTestStore struct {
+	store.Store
+	// the module versions whose dependencies were saved, and whether they were replaced
+	saved map[string]bool
+	// saving the dependencies of this module fails
+	failing string
+}
+
+func (s *batchTestStore) WithTx(_ context.Context, fn func(store.Store) error) error {
+	tx := &batchTestStore{saved: make(map[string]bool), failing: s.failing}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	for k, v := range tx.saved {
+		s.saved[k] = v
+	}
+	return nil
+}
+
+func (s *batchTestStore) SaveModuleDependencies(_ context.Context, mod store.Version, _ ...store.Version) error {
+	return s.save(mod, false)
+}
+
+func (s *batchTestStore) ReplaceModuleDependencies(_ context.Context, mod store.Version, _ ...store.Version) error {
+	return s.save(mod, true)
+}
+
+func (s *batchTestStore) save(mod store.Version, replace bool) error {
+	if mod.ModuleID == s.failing {
+		return &pgconn.PgError{Code: "40001", Message: "could not serialize access"}
+	}
+	s.saved[mod.ModuleID+"@v"+mod.SemVer] = replace
+	return nil
+}
+
+func TestBatchUpdateDependencies(t *testing.T) {
+	db := &batchTestStore{saved: make(map[string]bool)}
+	s := &connectServer{store: db}
+	newRequest := func() *connect.Request[perseusapi.BatchUpdateDependenciesRequest] {
+		return connect.NewRequest(&perseusapi.BatchUpdateDependenciesRequest{
+			Updates: []*perseusapi.UpdateDependenciesRequest{
+				{
+					ModuleName:   "example.com/a",
+					Version:      "v1.0",
+					Dependencies: []*perseusapi.Module{{Name: "example.com/b", Versions: []string{"v1.1.0"}}},
+				},
+				{ModuleName: "example.com/b", Version: "v1.1.0", Prune: true},
+			},
+		})
+	}
+
+	db.failing = "example.com/b"
+	_, err := s.BatchUpdateDependencies(context.Background(), newRequest())
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	assert.Empty(t, db.saved, "none of the updates should be applied if one fails")
+
+	db.failing = ""
+	_, err = s.BatchUpdateDependencies(context.Background(), newRequest())
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]bool{"example.com/a@v1.0.0": false, "example.com/b@v1.1.0": true}, db.saved)
+	}
+
+	req := newRequest()
+	req.Msg.Updates[1].Dependencies = []*perseusapi.Module{{Name: "example.com/c/v2", Versions: []string{"v1.0.0"}}}
+	_, err = s.BatchUpdateDependencies(context.Background(), req)
+	if assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "invalid dependencies should be rejected") {
+		assert.Contains(t, err.Error(), "example.com/c/v2")
+	}
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CrowdStrike/perseus/internal/seed"
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+const (
+	// defaultListenAddr is the default TCP address of the server
+	defaultListenAddr = ":31138"
+	// devListenAddr is the TCP address of the server in dev mode, which is only reachable from this machine
+	devListenAddr = "localhost:31138"
+	// devDBAddr, devDBUser, and devDBPass match the database defined in docker-compose.yml
+	devDBAddr, devDBUser, devDBPass = "localhost:5432", "postgres", "postgres"
+	// defaultDevSeedModules is the default number of generated modules that an empty database is seeded
+	// with in dev mode
+	defaultDevSeedModules = 100
+)
+
+// devDatabaseHint is added to database errors in dev mode.  Dev mode doesn't start a database of its own
+// because the schema requires the pg-semver extension, which the Postgres image in docker-compose.yml
+// provides.
+const devDatabaseHint = "dev mode requires the database from docker-compose.yml, run \"docker-compose up -d\" first"
+
+// applyDevDefaults fills in the settings that are required to run against the local database defined in
+// docker-compose.yml, and binds to localhost rather than all interfaces, if dev mode is enabled.  Explicit
+// settings are left as-is.
+func applyDevDefaults(conf *serverConfig) {
+	if !conf.devMode {
+		return
+	}
+	if conf.listenAddr == "" || conf.listenAddr == defaultListenAddr {
+		conf.listenAddr = devListenAddr
+	}
+	if conf.dbAddr == "" {
+		conf.dbAddr = devDBAddr
+	}
+	if conf.dbUser == "" {
+		conf.dbUser = devDBUser
+	}
+	if conf.dbPwd == "" {
+		conf.dbPwd = devDBPass
+	}
+	if conf.devSeedModules == nil {
+		n := defaultDevSeedModules
+		conf.devSeedModules = &n
+	}
+}
+
+// setupDevDatabase creates the schema of the database if it is empty, then seeds it with a generated
+// graph of n modules.  An existing database is left as-is so that restarting the server in dev mode
+// doesn't disturb any data that was added while using it.
+func setupDevDatabase(ctx context.Context, pg *store.PostgresClient, db store.Store, n int) error {
+	created, err := pg.CreateSchema(ctx)
+	if err != nil {
+		return fmt.Errorf("%w (%s)", err, devDatabaseHint)
+	}
+	if !created {
+		log.Debug("dev mode: using the existing database schema")
+		return nil
+	}
+	log.Info("dev mode: created the database schema")
+	if n == 0 {
+		return nil
+	}
+
+	updates := seed.Generate(seed.Options{Prefix: seed.DefaultPrefix, Modules: n, Fanout: 5, MaxVersions: 5, Seed: 1})
+	err = db.WithTx(ctx, func(tx store.Store) error {
+		for _, u := range updates {
+			mod := newStoreVersion(u.Module.Path, u.Module.Version, u.Module.Version)
+			deps := make([]store.Version, len(u.Deps))
+			for i, d := range u.Deps {
+				deps[i] = newStoreVersion(d.Path, d.Version, d.Version)
+			}
+			if err := tx.SaveModuleDependencies(ctx, mod, deps...); err != nil {
+				return fmt.Errorf("unable to save the dependencies of %s: %w", u.Module, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to seed the database: %w", err)
+	}
+	log.Info("dev mode: seeded the database with demo data", "modules", n, "versions", len(updates), "prefix", seed.DefaultPrefix)
+	return nil
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/CrowdStrike/perseus/internal/depsdev"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// the supported values for the 'enrich' field of QueryDependenciesRequest
+const (
+	enrichScorecard  = "scorecard"
+	enrichLicenses   = "licenses"
+	enrichAdvisories = "advisories"
+)
+
+// enrichConcurrency is the number of modules that are enriched with deps.dev data at the same time
+const enrichConcurrency = 8
+
+// enrichModules adds the requested deps.dev data to each of mods, which must have exactly 1 version.  The
+// data is supplemental, so failures are logged and leave the corresponding fields empty rather than
+// failing the request.
+func (s *connectServer) enrichModules(ctx context.Context, mods []*perseusapi.Module, fields []string) {
+	log := requestLogger(ctx)
+	eg, gctx := errgroup.WithContext(ctx)
+	eg.SetLimit(enrichConcurrency)
+	for _, m := range mods {
+		m := m
+		eg.Go(func() error {
+			v, err := s.depsdev.GetVersion(gctx, m.GetName(), m.GetVersions()[0])
+			if err != nil {
+				if !errors.Is(err, depsdev.ErrNotFound) {
+					log.Error(err, "unable to enrich module with deps.dev data", "module", m.GetName(), "version", m.GetVersions()[0])
+				}
+				return nil
+			}
+			if slices.Contains(fields, enrichLicenses) {
+				m.Licenses = v.Licenses
+			}
+			if slices.Contains(fields, enrichAdvisories) {
+				m.Advisories = v.Advisories
+			}
+			if slices.Contains(fields, enrichScorecard) && v.SourceRepo != "" {
+				score, ok, err := s.depsdev.GetScorecard(gctx, v.SourceRepo)
+				switch {
+				case err != nil && !errors.Is(err, depsdev.ErrNotFound):
+					log.Error(err, "unable to retrieve the Scorecard score from deps.dev", "module", m.GetName(), "project", v.SourceRepo)
+				case ok:
+					m.Scorecard = &score
+				}
+			}
+			return nil
+		})
+	}
+	_ = eg.Wait()
+}
+
+// recordDepsDevLicense saves the license that deps.dev reports for the specified module version as the
+// module's license, which is evaluated by the license rules of the dependency policy.  A license that was
+// already recorded, possibly by an administrator, is never replaced, and nothing is recorded if deps.dev
+// reports multiple licenses or a license that is not a standard SPDX identifier.
+func (s *connectServer) recordDepsDevLicense(ctx context.Context, mod, version string) {
+	known, err := s.store.GetModuleLicenses(ctx, []string{mod})
+	if err != nil {
+		log.Error(err, "unable to query the module license", "module", mod)
+		return
+	}
+	if known[mod] != "" {
+		return
+	}
+	v, err := s.depsdev.GetVersion(ctx, mod, version)
+	if err != nil {
+		if !errors.Is(err, depsdev.ErrNotFound) {
+			log.Error(err, "unable to retrieve the module license from deps.dev", "module", mod, "version", version)
+		}
+		return
+	}
+	if len(v.Licenses) != 1 || v.Licenses[0] == "non-standard" {
+		return
+	}
+	if err := s.store.SetModuleLicense(ctx, mod, v.Licenses[0]); err != nil {
+		log.Error(err, "unable to save the module license", "module", mod, "license", v.Licenses[0])
+		return
+	}
+	log.Debug("recorded the module license reported by deps.dev", "module", mod, "license", v.Licenses[0])
+}
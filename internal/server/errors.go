@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+const (
+	// errorDomain is the value assigned to the 'domain' attribute of any ErrorInfo details returned by the
+	// server
+	errorDomain = "perseus.crowdstrike.com"
+
+	// reasonDatabaseError is the ErrorInfo reason returned when a database operation fails
+	reasonDatabaseError = "DATABASE_ERROR"
+
+	// defaultRetryDelay is the delay returned to clients in a RetryInfo detail for transient failures
+	defaultRetryDelay = time.Second
+)
+
+// fieldViolation returns a BadRequest field violation for the specified request field.
+func fieldViolation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	}
+}
+
+// newInvalidArgumentError returns a [connect.Error] with [connect.CodeInvalidArgument] and a BadRequest
+// detail that contains the provided field violations.
+func newInvalidArgumentError(msg string, violations ...*errdetails.BadRequest_FieldViolation) *connect.Error {
+	cerr := connect.NewError(connect.CodeInvalidArgument, errors.New(msg))
+	if len(violations) > 0 {
+		addErrorDetail(cerr, &errdetails.BadRequest{FieldViolations: violations})
+	}
+	return cerr
+}
+
+// newDatabaseError returns a [connect.Error] for a database operation that failed with err.  The
+// returned error includes an ErrorInfo detail so that clients can distinguish backend failures from
+// invalid requests.  Transient failures, as determined by [isTransientDBError], have a code of
+// [connect.CodeUnavailable] and also include a RetryInfo detail.  All other failures, such as constraint
+// violations, would fail again if retried so they have a code of [connect.CodeInternal].
+func newDatabaseError(err error, msg string) *connect.Error {
+	transient := isTransientDBError(err)
+	code := connect.CodeInternal
+	if transient {
+		code = connect.CodeUnavailable
+	}
+	cerr := connect.NewError(code, fmt.Errorf("%s: a database operation failed", msg))
+	addErrorDetail(cerr, &errdetails.ErrorInfo{
+		Reason: reasonDatabaseError,
+		Domain: errorDomain,
+	})
+	if transient {
+		addErrorDetail(cerr, &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(defaultRetryDelay),
+		})
+	}
+	return cerr
+}
+
+// isTransientDBError returns true if err is a database failure that may succeed if retried: a connection
+// failure, a timeout, or a serialization failure or deadlock.  These are the SQLSTATE classes 08
+// (connection exception) and 40 (transaction rollback), 57P (operator intervention, ex: the server is
+// shutting down), and 57014 (query canceled, ex: by statement_timeout).
+func isTransientDBError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return strings.HasPrefix(pgErr.Code, "08") || strings.HasPrefix(pgErr.Code, "40") ||
+			strings.HasPrefix(pgErr.Code, "57P") || pgErr.Code == "57014"
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return true
+	}
+	if pgconn.Timeout(err) || pgconn.SafeToRetry(err) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// storeError translates an error returned by the [store.Store] to the appropriate [connect.Error].  An
+// invalid page token is reported as a field violation on the 'page_token' field, a reference to a
+// non-existent module or ingestion job is reported as [connect.CodeNotFound], and a conflict with an existing
+// module or API key is reported as [connect.CodeAlreadyExists].  All other errors are reported by
+// [newDatabaseError].
+func storeError(err error, msg string) *connect.Error {
+	if errors.Is(err, store.ErrInvalidPageToken) {
+		return newInvalidArgumentError("invalid page token", fieldViolation("page_token", err.Error()))
+	}
+	if errors.Is(err, store.ErrModuleNotFound) || errors.Is(err, store.ErrModuleVersionNotFound) ||
+		errors.Is(err, store.ErrIngestionJobNotFound) || errors.Is(err, store.ErrAPIKeyNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	if errors.Is(err, store.ErrAPIKeyExists) || errors.Is(err, store.ErrModuleExists) {
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	}
+	return newDatabaseError(err, msg)
+}
+
+// addErrorDetail attaches detail to cerr, logging any error that occurs.
+func addErrorDetail(cerr *connect.Error, detail proto.Message) {
+	ed, err := connect.NewErrorDetail(detail)
+	if err != nil {
+		log.Error(err, "unable to construct error detail", "detail", detail)
+		return
+	}
+	cerr.AddDetail(ed)
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestNewDatabaseError(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		wantCode connect.Code
+	}{
+		{"connection failure", &pgconn.PgError{Code: "08006"}, connect.CodeUnavailable},
+		{"serialization failure", &pgconn.PgError{Code: "40001"}, connect.CodeUnavailable},
+		{"deadlock", fmt.Errorf("unable to save: %w", &pgconn.PgError{Code: "40P01"}), connect.CodeUnavailable},
+		{"admin shutdown", &pgconn.PgError{Code: "57P01"}, connect.CodeUnavailable},
+		{"statement timeout", &pgconn.PgError{Code: "57014"}, connect.CodeUnavailable},
+		{"context deadline", context.DeadlineExceeded, connect.CodeUnavailable},
+		{"closed connection", sql.ErrConnDone, connect.CodeUnavailable},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, connect.CodeInternal},
+		{"syntax error", &pgconn.PgError{Code: "42601"}, connect.CodeInternal},
+		{"scan error", errors.New("sql: Scan error on column index 0"), connect.CodeInternal},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cerr := newDatabaseError(tc.err, "unable to do the thing")
+			assert.Equal(t, tc.wantCode, cerr.Code())
+			var hasRetryInfo bool
+			for _, d := range cerr.Details() {
+				if v, err := d.Value(); err == nil {
+					if _, ok := v.(*errdetails.RetryInfo); ok {
+						hasRetryInfo = true
+					}
+				}
+			}
+			assert.Equal(t, tc.wantCode == connect.CodeUnavailable, hasRetryInfo, "only transient errors should include a RetryInfo detail")
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"connectrpc.com/connect"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CrowdStrike/perseus/internal/modproxy"
+	"github.com/CrowdStrike/perseus/internal/modver"
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// verifyOptions controls the behavior of [connectServer.verifyVersions]
+type verifyOptions struct {
+	// a glob pattern specifying which known modules should be checked, all modules if empty
+	moduleFilter string
+	// the number of modules to check at the same time
+	concurrency int
+	// the maximum number of module proxy requests per second
+	qps float64
+}
+
+// verifyVersions cross-checks the stored tagged versions of each known module that matches
+// opts.moduleFilter against the versions that the Go module proxy currently lists and the retractions
+// declared by the module's latest version, then records which versions are unavailable.  Pseudo-versions
+// are never listed by the proxy, so they are not checked.  The version lists are always read from the
+// proxy rather than the response cache so that recently removed versions are detected.
+//
+// Modules that the proxy has never heard of, which are usually private modules that a public proxy
+// can't serve, and private modules that no configured source can retrieve are skipped and their stored
+// availability is left as-is.  Like [connectServer.discoverNewVersions], a failure to check any other
+// module is logged and counted, and an error is returned after all modules have been processed.
+func (s *connectServer) verifyVersions(ctx context.Context, opts verifyOptions) error {
+	if opts.moduleFilter == "" {
+		opts.moduleFilter = "*"
+	}
+	// the same proxy requests are made as when discovering new versions, so the same defaults apply
+	if opts.concurrency <= 0 {
+		opts.concurrency = defaultDiscoveryConcurrency
+	}
+	if opts.qps <= 0 {
+		opts.qps = defaultDiscoveryQPS
+	}
+
+	query := store.ModuleVersionQuery{
+		ModuleFilter:      opts.moduleFilter,
+		IncludePrerelease: true,
+		ExcludePseudo:     true,
+		Count:             refreshQueryPageSize,
+	}
+	var (
+		modules []string
+		stored  = make(map[string][]string)
+	)
+	for {
+		page, pageToken, err := s.store.QueryModuleVersions(ctx, query)
+		if err != nil {
+			return fmt.Errorf("unable to query the known module versions: %w", err)
+		}
+		for _, r := range page {
+			if _, found := stored[r.Module]; !found {
+				modules = append(modules, r.Module)
+			}
+			stored[r.Module] = append(stored[r.Module], "v"+r.Version)
+		}
+		if pageToken == "" || len(page) == 0 {
+			break
+		}
+		query.PageToken = pageToken
+	}
+
+	var (
+		unavailable, skipped, failed atomic.Int64
+		proxy                        = s.proxy.WithListCacheTTL(0)
+		limiter                      = rate.NewLimiter(rate.Limit(opts.qps), 1)
+		eg, gctx                     = errgroup.WithContext(ctx)
+	)
+	eg.SetLimit(opts.concurrency)
+	for _, mod := range modules {
+		mod := mod
+		eg.Go(func() error {
+			ghosts, err := checkModuleVersions(gctx, proxy, limiter, mod, stored[mod])
+			if errors.Is(err, errVerifySkipped) {
+				skipped.Add(1)
+				log.Debug("skipped verifying the versions of a module", "module", mod, "reason", err)
+				return nil
+			}
+			if err == nil {
+				err = s.store.SaveVersionAvailability(gctx, mod, ghosts)
+			}
+			if err != nil {
+				// a context error means the server is shutting down so there's no point in continuing
+				if gctx.Err() != nil {
+					return gctx.Err()
+				}
+				failed.Add(1)
+				log.Error(err, "unable to verify the versions of a module", "module", mod)
+				return nil
+			}
+			unavailable.Add(int64(len(ghosts)))
+			if len(ghosts) > 0 {
+				log.Debug("found unavailable module versions", "module", mod, "versions", ghosts)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	log.Info("verified the versions of known modules against the module proxy",
+		"modules", len(modules), "unavailable", unavailable.Load(), "skipped", skipped.Load(), "failed", failed.Load())
+	if n := failed.Load(); n > 0 {
+		return fmt.Errorf("%d of %d module(s) could not be verified", n, len(modules))
+	}
+	return nil
+}
+
+// errVerifySkipped is returned, wrapped, by [checkModuleVersions] when the versions of a module can't be
+// verified against the module proxy
+var errVerifySkipped = errors.New("the module proxy cannot verify the module")
+
+// checkModuleVersions asks the Go module proxy for the versions of mod and the go.mod file of its latest
+// version, then returns the versions in stored that are unavailable, mapped to the reason.  If the proxy
+// responds that it doesn't have mod, or mod is a private module that can't be retrieved, the returned
+// error wraps errVerifySkipped.
+func checkModuleVersions(ctx context.Context, proxy modproxy.Proxy, limiter *rate.Limiter, mod string, stored []string) (map[string]string, error) {
+	if err := limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	listed, err := proxy.GetModuleVersions(mod)
+	if err != nil {
+		if errors.Is(err, modproxy.ErrNoVersions) || errors.Is(err, modproxy.ErrPrivateModule) {
+			return nil, fmt.Errorf("%w: %w", errVerifySkipped, err)
+		}
+		return nil, err
+	}
+	var retractions []*modfile.Retract
+	if latest := modver.Latest(listed, false); latest != "" {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		mf, err := proxy.GetModFile(mod, latest)
+		if err != nil {
+			if errors.Is(err, modproxy.ErrVersionNotFound) {
+				return nil, fmt.Errorf("%w: %w", errVerifySkipped, err)
+			}
+			return nil, fmt.Errorf("unable to read the retractions of %s@%s: %w", mod, latest, err)
+		}
+		retractions = mf.Retract
+	}
+	return unavailableVersions(stored, listed, retractions), nil
+}
+
+// unavailableVersions returns the versions in stored that are retracted by retractions or, otherwise,
+// that are missing from listed, mapped to the reason that each one is unavailable
+func unavailableVersions(stored, listed []string, retractions []*modfile.Retract) map[string]string {
+	available := make(map[string]struct{}, len(listed))
+	for _, v := range listed {
+		available[v] = struct{}{}
+	}
+	result := make(map[string]string)
+	for _, v := range stored {
+		for _, r := range retractions {
+			if semver.Compare(r.Low, v) <= 0 && semver.Compare(v, r.High) <= 0 {
+				result[v] = store.UnavailableRetracted
+				break
+			}
+		}
+		if _, found := available[v]; !found && result[v] == "" {
+			result[v] = store.UnavailableMissing
+		}
+	}
+	return result
+}
+
+// ListGhostVersions returns the stored module versions that can no longer be used.
+func (s *connectServer) ListGhostVersions(ctx context.Context, req *connect.Request[perseusapi.ListGhostVersionsRequest]) (*connect.Response[perseusapi.ListGhostVersionsResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("ListGhostVersions() called", "request", msg.String())
+
+	moduleFilter := msg.GetModuleFilter()
+	if moduleFilter == "" {
+		moduleFilter = "*"
+	}
+	results, err := s.store.GetGhostVersions(ctx, moduleFilter)
+	if err != nil {
+		log.Error(err, "unable to query ghost module versions", "moduleFilter", moduleFilter)
+		return nil, storeError(err, "unable to query the unavailable module versions")
+	}
+	resp := perseusapi.ListGhostVersionsResponse{}
+	for _, r := range results {
+		resp.Versions = append(resp.Versions, &perseusapi.ListGhostVersionsResponse_GhostVersion{
+			Module:     &perseusapi.Module{Name: r.Module, Versions: []string{"v" + r.Version}},
+			Reason:     r.Reason,
+			Since:      timestamppb.New(r.Since),
+			Dependents: int32(r.Dependents), //nolint: gosec // there will never be 2^31 module versions
+		})
+	}
+	return connect.NewResponse(&resp), nil
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/time/rate"
+
+	"github.com/CrowdStrike/perseus/internal/modproxy"
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+func TestUnavailableVersions(t *testing.T) {
+	stored := []string{"v1.0.0", "v1.1.0", "v1.2.0", "v1.2.1", "v1.3.0-rc.1", "v2.0.0+incompatible"}
+	listed := []string{"v1.0.0", "v1.2.0", "v1.2.1", "v1.3.0-rc.1", "v1.3.0", "v2.0.0+incompatible"}
+	retractions := []*modfile.Retract{
+		{VersionInterval: modfile.VersionInterval{Low: "v1.2.0", High: "v1.2.0"}, Rationale: "published accidentally"},
+		// retracting a version that is also missing reports it as retracted
+		{VersionInterval: modfile.VersionInterval{Low: "v1.1.0", High: "v1.1.5"}},
+	}
+
+	got := unavailableVersions(stored, listed, retractions)
+	want := map[string]string{
+		"v1.1.0": store.UnavailableRetracted,
+		"v1.2.0": store.UnavailableRetracted,
+	}
+	assert.Equal(t, want, got)
+
+	got = unavailableVersions(stored, []string{"v1.0.0", "v1.2.1", "v1.3.0"}, nil)
+	want = map[string]string{
+		"v1.1.0":              store.UnavailableMissing,
+		"v1.2.0":              store.UnavailableMissing,
+		"v1.3.0-rc.1":         store.UnavailableMissing,
+		"v2.0.0+incompatible": store.UnavailableMissing,
+	}
+	assert.Equal(t, want, got)
+
+	assert.Len(t, unavailableVersions(stored, nil, nil), len(stored), "every version should be missing if the proxy has none")
+}
+
+func TestCheckModuleVersions(t *testing.T) {
+	t.Setenv("GOPROXY", "https://proxy.golang.org")
+	t.Setenv("GONOPROXY", "example.com/private")
+	getter := refreshTestGetter{
+		"/example.com/a/@v/list":       "v1.0.0\nv1.1.0\n",
+		"/example.com/a/@v/v1.1.0.mod": "module example.com/a\n",
+	}
+	cached := modproxy.New(getter).WithCache(t.TempDir(), time.Hour)
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	ctx := context.Background()
+
+	// populate the cache then remove a version from the proxy
+	_, err := cached.GetModuleVersions("example.com/a")
+	assert.NoError(t, err)
+	getter["/example.com/a/@v/list"] = "v1.1.0\n"
+
+	t.Run("bypasses the cached version list", func(t *testing.T) {
+		ghosts, err := checkModuleVersions(ctx, cached.WithListCacheTTL(0), limiter, "example.com/a", []string{"v1.0.0", "v1.1.0"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"v1.0.0": store.UnavailableMissing}, ghosts)
+	})
+	t.Run("module not found", func(t *testing.T) {
+		_, err := checkModuleVersions(ctx, cached.WithListCacheTTL(0), limiter, "example.com/b", []string{"v1.0.0"})
+		assert.ErrorIs(t, err, errVerifySkipped)
+	})
+	t.Run("private module", func(t *testing.T) {
+		_, err := checkModuleVersions(ctx, cached.WithListCacheTTL(0), limiter, "example.com/private/c", []string{"v1.0.0"})
+		assert.ErrorIs(t, err, errVerifySkipped)
+	})
+}
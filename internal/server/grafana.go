@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+// the prefixes of the Grafana targets that are parameterized by a module name or glob pattern, ex:
+// dependents:github.com/CrowdStrike/perseus or staleness:github.com/CrowdStrike/*
+const (
+	grafanaDependentsPrefix = "dependents:"
+	grafanaStalenessPrefix  = "staleness:"
+)
+
+const (
+	// grafanaMaxPoints is the maximum number of points returned for each time series, regardless of the
+	// maxDataPoints requested by Grafana, to bound the cost of the database queries
+	grafanaMaxPoints = 1000
+	// grafanaMaxSuggestions is the maximum number of module targets suggested by the search endpoint
+	grafanaMaxSuggestions = 25
+)
+
+// grafanaSeriesTargets are the targets that are not parameterized
+var grafanaSeriesTargets = []string{
+	store.SeriesModules,
+	store.SeriesVersions,
+	store.SeriesIngestions,
+	store.SeriesIngestionFailures,
+}
+
+// grafanaQueryRequest is the body of a query request from the Grafana JSON data source plugin
+type grafanaQueryRequest struct {
+	Range struct {
+		From time.Time `json:"from"`
+		To   time.Time `json:"to"`
+	} `json:"range"`
+	IntervalMs    int64 `json:"intervalMs"`
+	MaxDataPoints int   `json:"maxDataPoints"`
+	Targets       []struct {
+		Target string `json:"target"`
+		RefID  string `json:"refId"`
+	} `json:"targets"`
+}
+
+// grafanaTimeSeries is a time series result, where each data point is a [value, Unix time in milliseconds] pair
+type grafanaTimeSeries struct {
+	Target     string     `json:"target"`
+	Datapoints [][2]int64 `json:"datapoints"`
+}
+
+// grafanaTable is a table result
+type grafanaTable struct {
+	Type    string          `json:"type"`
+	Columns []grafanaColumn `json:"columns"`
+	Rows    [][]any         `json:"rows"`
+}
+
+// grafanaColumn describes a column of a [grafanaTable]
+type grafanaColumn struct {
+	Text string `json:"text"`
+	Type string `json:"type"`
+}
+
+// handleGrafana exposes the endpoints used by the Grafana JSON data source plugin so that dashboards can
+// chart the size and growth of the graph, ingestion activity, the dependents of a module over time, and
+// module staleness without direct access to the database.  The handler is mounted at /api/v1/grafana/ and
+// supports:
+//   - GET / - a connection test that always succeeds
+//   - POST /search - the list of available targets, including module-specific targets whose names match the
+//     partially typed target
+//   - POST /query - the results for the requested targets over the requested time range
+func handleGrafana(db store.Store, log Logger) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/grafana/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/grafana/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/api/v1/grafana/search", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req struct {
+			Target string `json:"target"`
+		}
+		// the body is empty for older versions of the plugin
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+		targets := append([]string{}, grafanaSeriesTargets...)
+		for _, prefix := range []string{grafanaDependentsPrefix, grafanaStalenessPrefix} {
+			partial, ok := strings.CutPrefix(req.Target, prefix)
+			if !ok {
+				continue
+			}
+			mods, _, err := db.QueryModules(r.Context(), partial+"*", "", grafanaMaxSuggestions)
+			if err != nil {
+				log.Error(err, "unable to query modules for Grafana", "target", req.Target)
+				http.Error(w, "unable to query the modules", http.StatusInternalServerError)
+				return
+			}
+			for _, m := range mods {
+				targets = append(targets, prefix+m.Name)
+			}
+		}
+		writeGrafanaResponse(w, targets, log)
+	})
+	mux.HandleFunc("/api/v1/grafana/query", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req grafanaQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+		if !req.Range.From.Before(req.Range.To) {
+			http.Error(w, "the start of the time range must be before the end", http.StatusBadRequest)
+			return
+		}
+		step := grafanaStep(req.Range.From, req.Range.To, req.IntervalMs, req.MaxDataPoints)
+		results := make([]any, 0, len(req.Targets))
+		for _, t := range req.Targets {
+			if pattern, ok := strings.CutPrefix(t.Target, grafanaStalenessPrefix); ok {
+				table, err := grafanaStaleness(r.Context(), db, pattern)
+				if err != nil {
+					log.Error(err, "unable to query module staleness for Grafana", "target", t.Target)
+					http.Error(w, fmt.Sprintf("unable to query %q", t.Target), http.StatusInternalServerError)
+					return
+				}
+				results = append(results, table)
+				continue
+			}
+			query := store.TimeSeriesQuery{Metric: t.Target, From: req.Range.From, To: req.Range.To, Step: step}
+			if mod, ok := strings.CutPrefix(t.Target, grafanaDependentsPrefix); ok {
+				query.Metric, query.Module = store.SeriesDependents, mod
+			} else if !slices.Contains(grafanaSeriesTargets, t.Target) {
+				http.Error(w, fmt.Sprintf("unknown target %q", t.Target), http.StatusBadRequest)
+				return
+			}
+			points, err := db.GetTimeSeries(r.Context(), query)
+			switch {
+			case errors.Is(err, store.ErrModuleNotFound):
+				http.Error(w, fmt.Sprintf("unknown module in target %q", t.Target), http.StatusBadRequest)
+				return
+			case err != nil:
+				log.Error(err, "unable to query time series for Grafana", "target", t.Target)
+				http.Error(w, fmt.Sprintf("unable to query %q", t.Target), http.StatusInternalServerError)
+				return
+			}
+			series := grafanaTimeSeries{Target: t.Target, Datapoints: make([][2]int64, 0, len(points))}
+			for _, p := range points {
+				series.Datapoints = append(series.Datapoints, [2]int64{p.Value, p.Time.UnixMilli()})
+			}
+			results = append(results, series)
+		}
+		writeGrafanaResponse(w, results, log)
+	})
+	return mux
+}
+
+// grafanaStep returns the time between the points of the time series for a query over the range from-to,
+// which is the interval requested by Grafana unless that would return more than maxPoints points, or
+// [grafanaMaxPoints] if maxPoints is not positive or is larger.
+func grafanaStep(from, to time.Time, intervalMs int64, maxPoints int) time.Duration {
+	if maxPoints <= 0 || maxPoints > grafanaMaxPoints {
+		maxPoints = grafanaMaxPoints
+	}
+	step := time.Duration(intervalMs) * time.Millisecond
+	if minStep := to.Sub(from) / time.Duration(maxPoints); step < minStep {
+		step = minStep
+	}
+	if step < time.Second {
+		step = time.Second
+	}
+	return step
+}
+
+// grafanaStaleness returns the staleness scores of the latest versions of the modules matching pattern as
+// a table
+func grafanaStaleness(ctx context.Context, db store.Store, pattern string) (grafanaTable, error) {
+	scores, err := db.GetModuleStaleness(ctx, store.StalenessQuery{ModuleFilter: pattern})
+	if err != nil {
+		return grafanaTable{}, err
+	}
+	table := grafanaTable{
+		Type: "table",
+		Columns: []grafanaColumn{
+			{Text: "Module", Type: "string"},
+			{Text: "Version", Type: "string"},
+			{Text: "Dependencies", Type: "number"},
+			{Text: "Outdated", Type: "number"},
+			{Text: "Score", Type: "number"},
+		},
+		Rows: make([][]any, 0, len(scores)),
+	}
+	for _, s := range scores {
+		table.Rows = append(table.Rows, []any{s.Module, "v" + s.Version, s.Dependencies, s.OutdatedDependencies, s.Score})
+	}
+	return table, nil
+}
+
+// writeGrafanaResponse writes v to w as JSON
+func writeGrafanaResponse(w http.ResponseWriter, v any, log Logger) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error(err, "unable to write the Grafana response")
+	}
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+// grafanaTestStore implements the subset of [store.Store] that is used by the Grafana endpoints
+type grafanaTestStore struct {
+	store.Store
+}
+
+func (grafanaTestStore) QueryModules(_ context.Context, filter, _ string, _ int) ([]store.Module, string, error) {
+	if filter == "github.com/example/*" {
+		return []store.Module{{Name: "github.com/example/foo"}, {Name: "github.com/example/bar"}}, "", nil
+	}
+	return nil, "", nil
+}
+
+func (grafanaTestStore) GetTimeSeries(_ context.Context, q store.TimeSeriesQuery) ([]store.TimeSeriesPoint, error) {
+	if q.Metric == store.SeriesDependents && q.Module != "github.com/example/foo" {
+		return nil, store.ErrModuleNotFound
+	}
+	var points []store.TimeSeriesPoint
+	for t, v := q.From, int64(1); !t.After(q.To); t, v = t.Add(q.Step), v+1 {
+		points = append(points, store.TimeSeriesPoint{Time: t, Value: v})
+	}
+	return points, nil
+}
+
+func (grafanaTestStore) GetModuleStaleness(_ context.Context, _ store.StalenessQuery) ([]store.ModuleStaleness, error) {
+	return []store.ModuleStaleness{{Module: "github.com/example/foo", Version: "1.2.0", Dependencies: 4, OutdatedDependencies: 1, Score: 0.5}}, nil
+}
+
+func TestHandleGrafana(t *testing.T) {
+	type testCase struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}
+	cases := []testCase{
+		{
+			name:       "connection test",
+			method:     http.MethodGet,
+			path:       "/api/v1/grafana/",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "search without a target",
+			method:     http.MethodPost,
+			path:       "/api/v1/grafana/search",
+			wantStatus: http.StatusOK,
+			wantBody:   `["modules","versions","ingestions","ingestion-failures"]`,
+		},
+		{
+			name:       "search for module targets",
+			method:     http.MethodPost,
+			path:       "/api/v1/grafana/search",
+			body:       `{"target":"dependents:github.com/example/"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `["modules","versions","ingestions","ingestion-failures","dependents:github.com/example/foo","dependents:github.com/example/bar"]`,
+		},
+		{
+			name:       "time series",
+			method:     http.MethodPost,
+			path:       "/api/v1/grafana/query",
+			body:       `{"range":{"from":"2024-01-01T00:00:00Z","to":"2024-01-01T00:02:00Z"},"intervalMs":60000,"targets":[{"target":"modules"},{"target":"dependents:github.com/example/foo"}]}`,
+			wantStatus: http.StatusOK,
+			wantBody: `[{"target":"modules","datapoints":[[1,1704067200000],[2,1704067260000],[3,1704067320000]]},
+				{"target":"dependents:github.com/example/foo","datapoints":[[1,1704067200000],[2,1704067260000],[3,1704067320000]]}]`,
+		},
+		{
+			name:       "interval limited by max data points",
+			method:     http.MethodPost,
+			path:       "/api/v1/grafana/query",
+			body:       `{"range":{"from":"2024-01-01T00:00:00Z","to":"2024-01-01T00:02:00Z"},"intervalMs":1000,"maxDataPoints":2,"targets":[{"target":"versions"}]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `[{"target":"versions","datapoints":[[1,1704067200000],[2,1704067260000],[3,1704067320000]]}]`,
+		},
+		{
+			name:       "staleness table",
+			method:     http.MethodPost,
+			path:       "/api/v1/grafana/query",
+			body:       `{"range":{"from":"2024-01-01T00:00:00Z","to":"2024-01-02T00:00:00Z"},"targets":[{"target":"staleness:github.com/example/*"}]}`,
+			wantStatus: http.StatusOK,
+			wantBody: `[{"type":"table","columns":[{"text":"Module","type":"string"},{"text":"Version","type":"string"},{"text":"Dependencies","type":"number"},{"text":"Outdated","type":"number"},{"text":"Score","type":"number"}],
+				"rows":[["github.com/example/foo","v1.2.0",4,1,0.5]]}]`,
+		},
+		{
+			name:       "unknown target",
+			method:     http.MethodPost,
+			path:       "/api/v1/grafana/query",
+			body:       `{"range":{"from":"2024-01-01T00:00:00Z","to":"2024-01-02T00:00:00Z"},"targets":[{"target":"bogus"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown module",
+			method:     http.MethodPost,
+			path:       "/api/v1/grafana/query",
+			body:       `{"range":{"from":"2024-01-01T00:00:00Z","to":"2024-01-02T00:00:00Z"},"targets":[{"target":"dependents:example.com/missing"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "reversed time range",
+			method:     http.MethodPost,
+			path:       "/api/v1/grafana/query",
+			body:       `{"range":{"from":"2024-01-02T00:00:00Z","to":"2024-01-01T00:00:00Z"},"targets":[{"target":"modules"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "query with GET",
+			method:     http.MethodGet,
+			path:       "/api/v1/grafana/query",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	h := handleGrafana(grafanaTestStore{}, nopLogger{})
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			if tc.wantBody != "" {
+				assert.JSONEq(t, tc.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGrafanaStep(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Minute, grafanaStep(from, from.Add(time.Hour), 60000, 100))
+	assert.Equal(t, 6*time.Minute, grafanaStep(from, from.Add(time.Hour), 60000, 10))
+	assert.Equal(t, time.Hour*24*365/grafanaMaxPoints, grafanaStep(from, from.Add(time.Hour*24*365), 0, 0))
+	assert.Equal(t, time.Second, grafanaStep(from, from.Add(time.Minute), 0, 0))
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+const (
+	// graphMetricsTTL is how long the graph metrics are cached so that frequent or concurrent scrapes don't
+	// repeatedly query the database
+	graphMetricsTTL = time.Minute
+	// graphMetricsTimeout is the maximum amount of time to wait for the database when collecting the graph
+	// metrics
+	graphMetricsTimeout = 10 * time.Second
+)
+
+var (
+	graphModulesDesc = prometheus.NewDesc("perseus_graph_modules",
+		"The number of modules in the graph", nil, nil)
+	graphVersionsDesc = prometheus.NewDesc("perseus_graph_module_versions",
+		"The number of module versions in the graph", nil, nil)
+	graphEdgesDesc = prometheus.NewDesc("perseus_graph_dependency_edges",
+		"The number of dependency edges between module versions that are currently declared", nil, nil)
+	graphLastIngestDesc = prometheus.NewDesc("perseus_graph_last_ingest_timestamp_seconds",
+		"The Unix time at which a module version was last added or a dependency edge was last saved", nil, nil)
+	graphPrefixModulesDesc = prometheus.NewDesc("perseus_graph_prefix_modules",
+		"The number of modules whose paths are under each prefix", []string{"prefix"}, nil)
+)
+
+// graphCollector is a [prometheus.Collector] that reports the size of the dependency graph and when it last
+// changed so that operators can alert when ingestion stalls.  The values are read from the database when
+// /metrics is scraped, at most once per [graphMetricsTTL].
+type graphCollector struct {
+	db store.Store
+	// the module path prefixes to count modules under, or by host if empty
+	prefixes []string
+
+	mu       sync.Mutex
+	expires  time.Time
+	stats    store.GraphStats
+	byPrefix map[string]int64
+	ok       bool
+}
+
+// newGraphCollector returns a collector that reports the graph metrics for db, with module counts for each
+// of prefixes or, if prefixes is empty, for each host
+func newGraphCollector(db store.Store, prefixes []string) *graphCollector {
+	return &graphCollector{db: db, prefixes: prefixes}
+}
+
+// Describe satisfies the [prometheus.Collector] interface
+func (c *graphCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- graphModulesDesc
+	ch <- graphVersionsDesc
+	ch <- graphEdgesDesc
+	ch <- graphLastIngestDesc
+	ch <- graphPrefixModulesDesc
+}
+
+// Collect satisfies the [prometheus.Collector] interface.  If the database cannot be queried the failure is
+// logged and no graph metrics are reported, so that the other server metrics are still available and
+// alerts on missing series fire.
+func (c *graphCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Now().After(c.expires) {
+		c.refresh()
+	}
+	if !c.ok {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(graphModulesDesc, prometheus.GaugeValue, float64(c.stats.Modules))
+	ch <- prometheus.MustNewConstMetric(graphVersionsDesc, prometheus.GaugeValue, float64(c.stats.Versions))
+	ch <- prometheus.MustNewConstMetric(graphEdgesDesc, prometheus.GaugeValue, float64(c.stats.Edges))
+	if c.stats.LastIngest.Valid {
+		ch <- prometheus.MustNewConstMetric(graphLastIngestDesc, prometheus.GaugeValue, float64(c.stats.LastIngest.Time.Unix()))
+	}
+	for prefix, n := range c.byPrefix {
+		ch <- prometheus.MustNewConstMetric(graphPrefixModulesDesc, prometheus.GaugeValue, float64(n), prefix)
+	}
+}
+
+// refresh reads the current metric values from the database.  The caller must hold c.mu.
+func (c *graphCollector) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), graphMetricsTimeout)
+	defer cancel()
+
+	// a failure is retried on the next scrape rather than waiting for the TTL to expire
+	c.ok = false
+	stats, err := c.db.GetGraphStats(ctx)
+	if err != nil {
+		log.Error(err, "unable to collect the graph metrics")
+		return
+	}
+	byPrefix, err := c.db.CountModulesByPrefix(ctx, c.prefixes)
+	if err != nil {
+		log.Error(err, "unable to collect the per-prefix module counts")
+		return
+	}
+	c.stats, c.byPrefix, c.ok = stats, byPrefix, true
+	c.expires = time.Now().Add(graphMetricsTTL)
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+// graphStatsTestStore implements the subset of [store.Store] that is used by [graphCollector]
+type graphStatsTestStore struct {
+	store.Store
+	queries int
+	err     error
+}
+
+func (s *graphStatsTestStore) GetGraphStats(context.Context) (store.GraphStats, error) {
+	s.queries++
+	if s.err != nil {
+		return store.GraphStats{}, s.err
+	}
+	return store.GraphStats{
+		Modules:    3,
+		Versions:   10,
+		Edges:      25,
+		LastIngest: sql.NullTime{Time: time.Unix(1704067200, 0), Valid: true},
+	}, nil
+}
+
+func (s *graphStatsTestStore) CountModulesByPrefix(_ context.Context, prefixes []string) (map[string]int64, error) {
+	if len(prefixes) == 0 {
+		return map[string]int64{"github.com": 2, "golang.org": 1}, nil
+	}
+	return map[string]int64{"github.com/example": 2}, nil
+}
+
+func TestGraphCollector(t *testing.T) {
+	t.Parallel()
+
+	db := &graphStatsTestStore{}
+	c := newGraphCollector(db, nil)
+	want := `
+# HELP perseus_graph_dependency_edges The number of dependency edges between module versions that are currently declared
+# TYPE perseus_graph_dependency_edges gauge
+perseus_graph_dependency_edges 25
+# HELP perseus_graph_last_ingest_timestamp_seconds The Unix time at which a module version was last added or a dependency edge was last saved
+# TYPE perseus_graph_last_ingest_timestamp_seconds gauge
+perseus_graph_last_ingest_timestamp_seconds 1.7040672e+09
+# HELP perseus_graph_module_versions The number of module versions in the graph
+# TYPE perseus_graph_module_versions gauge
+perseus_graph_module_versions 10
+# HELP perseus_graph_modules The number of modules in the graph
+# TYPE perseus_graph_modules gauge
+perseus_graph_modules 3
+# HELP perseus_graph_prefix_modules The number of modules whose paths are under each prefix
+# TYPE perseus_graph_prefix_modules gauge
+perseus_graph_prefix_modules{prefix="github.com"} 2
+perseus_graph_prefix_modules{prefix="golang.org"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(want)))
+	// the 2nd scrape should use the cached values
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(want)))
+	assert.Equal(t, 1, db.queries)
+
+	// no graph metrics are reported if the database cannot be queried, and the query is retried on the
+	// next scrape
+	db = &graphStatsTestStore{err: errors.New("oops")}
+	c = newGraphCollector(db, []string{"github.com/example"})
+	assert.Equal(t, 0, testutil.CollectAndCount(c))
+	assert.Equal(t, 0, testutil.CollectAndCount(c))
+	assert.Equal(t, 2, db.queries)
+
+	db.err = nil
+	assert.Equal(t, 5, testutil.CollectAndCount(c))
+}
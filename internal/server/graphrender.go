@@ -0,0 +1,62 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/time/rate"
+
+	"github.com/CrowdStrike/perseus/internal/render"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const (
+	// renderTimeout is the longest that a RenderGraph call waits for the graph to be rendered, including
+	// the time spent waiting for other graphs, since only one graph is rendered at a time
+	renderTimeout = 20 * time.Second
+	// renderRateLimit and renderBurst limit the rate of RenderGraph calls across all clients
+	renderRateLimit = rate.Limit(1)
+	renderBurst     = 5
+)
+
+// RenderGraph lays out a DOT graph with the embedded Graphviz runtime and returns the resulting image.
+func (s *connectServer) RenderGraph(ctx context.Context, req *connect.Request[perseusapi.RenderGraphRequest]) (*connect.Response[perseusapi.RenderGraphResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("RenderGraph() called", "format", msg.GetFormat().String(), "size", len(msg.GetDot()))
+
+	if s.renderLimiter != nil && !s.renderLimiter.Allow() {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("too many graphs are being rendered, try again later"))
+	}
+	ctx, cancel := context.WithTimeout(ctx, renderTimeout)
+	defer cancel()
+
+	format := render.FormatSVG
+	if msg.GetFormat() == perseusapi.RenderFormat_png {
+		format = render.FormatPNG
+	}
+	var img bytes.Buffer
+	if err := s.graphs.Render(ctx, []byte(msg.GetDot()), format, &img); err != nil {
+		switch {
+		case errors.Is(err, render.ErrInvalidGraph):
+			return nil, newInvalidArgumentError("the graph could not be parsed", fieldViolation("dot", err.Error()))
+		case errors.Is(err, render.ErrGraphTooLarge):
+			return nil, newInvalidArgumentError("the graph is too large to render", fieldViolation("dot", err.Error()))
+		case errors.Is(err, context.DeadlineExceeded):
+			return nil, connect.NewError(connect.CodeDeadlineExceeded, errors.New("the graph could not be rendered in time"))
+		case errors.Is(err, context.Canceled):
+			return nil, connect.NewError(connect.CodeCanceled, err)
+		}
+		log.Error(err, "unable to render graph", "format", format)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("unable to render the graph"))
+	}
+
+	resp := &perseusapi.RenderGraphResponse{
+		Image:       img.Bytes(),
+		ContentType: render.ContentType(format),
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+
+	"github.com/CrowdStrike/perseus/internal/render"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+func TestRenderGraph(t *testing.T) {
+	s := &connectServer{renderLimiter: rate.NewLimiter(0, 2)}
+	t.Cleanup(func() { _ = s.graphs.Close() })
+	ctx := context.Background()
+
+	resp, err := s.RenderGraph(ctx, connect.NewRequest(&perseusapi.RenderGraphRequest{Dot: `digraph G { "a" -> "b" }`}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "image/svg+xml", resp.Msg.GetContentType())
+	}
+
+	var dot strings.Builder
+	dot.WriteString("digraph G {\n")
+	for i := 0; i <= render.MaxEdges; i++ {
+		fmt.Fprintf(&dot, "\"a\" -> \"n%d\";\n", i%render.MaxNodes)
+	}
+	dot.WriteString("}\n")
+	_, err = s.RenderGraph(ctx, connect.NewRequest(&perseusapi.RenderGraphRequest{Dot: dot.String()}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "got %v", err)
+
+	// the limiter allows a burst of 2 and never refills
+	_, err = s.RenderGraph(ctx, connect.NewRequest(&perseusapi.RenderGraphRequest{Dot: `digraph G { "a" -> "b" }`}))
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+	"golang.org/x/time/rate"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+func TestDecodeHookPayload(t *testing.T) {
+	type testCase struct {
+		name     string
+		body     string
+		expected module.Version
+		wantErr  bool
+	}
+	cases := []testCase{
+		{
+			name:     "athens validation request",
+			body:     `{"Module":"github.com/foo/bar","Version":"v1.2.3","Mod":"bW9kdWxlIGdpdGh1Yi5jb20vZm9vL2Jhcgo=","Zip":"UEsDBA=="}`,
+			expected: module.Version{Path: "github.com/foo/bar", Version: "v1.2.3"},
+		},
+		{
+			name:     "lower-case keys after other fields",
+			body:     `{"extra":{"a":[1,2]},"version":"v0.1.0","module":"example.com/baz"}`,
+			expected: module.Version{Path: "example.com/baz", Version: "v0.1.0"},
+		},
+		{
+			// decoding stops once both values have been read, so trailing garbage is never seen
+			name:     "stops after module and version",
+			body:     `{"Module":"github.com/foo/bar","Version":"v1.2.3","Zip":"...`,
+			expected: module.Version{Path: "github.com/foo/bar", Version: "v1.2.3"},
+		},
+		{
+			name:    "missing version",
+			body:    `{"Module":"github.com/foo/bar"}`,
+			wantErr: true,
+		},
+		{
+			name:    "not an object",
+			body:    `["github.com/foo/bar","v1.2.3"]`,
+			wantErr: true,
+		},
+	}
+	t.Parallel()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := decodeHookPayload(strings.NewReader(tc.body))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+// hookTestStore implements the subset of [store.Store] that is used by [handleAthensHook]
+type hookTestStore struct {
+	*apiKeyTestStore
+	queued []store.Version
+}
+
+func (s *hookTestStore) EnqueueIngestionJobs(_ context.Context, _ string, mods ...store.Version) ([]store.IngestionJob, error) {
+	s.queued = append(s.queued, mods...)
+	return nil, nil
+}
+
+func TestHandleAthensHook(t *testing.T) {
+	const body = `{"Module":"github.com/foo/bar","Version":"v1.2.3"}`
+	type testCase struct {
+		name       string
+		method     string
+		setAuth    func(*http.Request)
+		wantStatus int
+		wantQueued bool
+	}
+	cases := []testCase{
+		{
+			name:       "no credentials",
+			method:     http.MethodPost,
+			setAuth:    func(*http.Request) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid API key",
+			method:     http.MethodPost,
+			setAuth:    func(r *http.Request) { r.Header.Set("Authorization", "Bearer perseus_revoked") },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "session cookie",
+			method:     http.MethodPost,
+			setAuth:    func(r *http.Request) { r.Header.Set("Cookie", "perseus_session=abc") },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "API key",
+			method:     http.MethodPost,
+			setAuth:    func(r *http.Request) { r.Header.Set("Authorization", "Bearer perseus_athens") },
+			wantStatus: http.StatusOK,
+			wantQueued: true,
+		},
+		{
+			name:       "admin token in the hook URL",
+			method:     http.MethodPost,
+			setAuth:    func(r *http.Request) { r.SetBasicAuth("athens", "s3cr3t") },
+			wantStatus: http.StatusOK,
+			wantQueued: true,
+		},
+		{
+			name:       "not a POST",
+			method:     http.MethodGet,
+			setAuth:    func(r *http.Request) { r.Header.Set("Authorization", "Bearer perseus_athens") },
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := &hookTestStore{apiKeyTestStore: &apiKeyTestStore{keys: map[string]string{hashAPIKey("perseus_athens"): "athens"}}}
+			h := handleAthensHook(authInterceptor{token: "s3cr3t", db: db}, db, rate.NewLimiter(100, hookRateBurst(100)), nopLogger{})
+
+			req := httptest.NewRequest(tc.method, "/hooks/athens", strings.NewReader(body))
+			tc.setAuth(req)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantQueued {
+				assert.Equal(t, []store.Version{{ModuleID: "github.com/foo/bar", SemVer: "v1.2.3"}}, db.queued)
+			} else {
+				assert.Empty(t, db.queued, "the module version should not be queued")
+			}
+		})
+	}
+
+	t.Run("rate limit", func(t *testing.T) {
+		db := &hookTestStore{apiKeyTestStore: &apiKeyTestStore{}}
+		h := handleAthensHook(authInterceptor{token: "s3cr3t", db: db}, db, rate.NewLimiter(1, hookRateBurst(1)), nopLogger{})
+		codes := make([]int, 0, 2)
+		for range 2 {
+			req := httptest.NewRequest(http.MethodPost, "/hooks/athens", strings.NewReader(body))
+			req.Header.Set("Authorization", "Bearer s3cr3t")
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			codes = append(codes, rec.Code)
+		}
+		assert.Equal(t, []int{http.StatusOK, http.StatusTooManyRequests}, codes)
+		assert.Len(t, db.queued, 1, "requests over the limit should not be queued")
+	})
+}
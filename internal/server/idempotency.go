@@ -0,0 +1,177 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/CrowdStrike/perseus/internal/headers"
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+const (
+	// maxIdempotencyKeyLength is the maximum length of an idempotency key
+	maxIdempotencyKeyLength = 255
+	// idempotencyKeyTTL is how long idempotency keys are kept, after which a retry is processed again
+	idempotencyKeyTTL = 24 * time.Hour
+	// idempotencyClaimTimeout is how long a request can be processing before its claim on the key is
+	// assumed to have been abandoned by a server that exited
+	idempotencyClaimTimeout = 5 * time.Minute
+	// defaultIdempotencyPurgeSchedule is the schedule for the job that purges expired idempotency keys
+	// when the jobs configuration does not include it
+	defaultIdempotencyPurgeSchedule = "@hourly"
+
+	// reasonRequestInProgress is the ErrorInfo reason returned when a retry arrives while the original
+	// request is still being processed
+	reasonRequestInProgress = "REQUEST_IN_PROGRESS"
+)
+
+// idempotentResponseDecoder decodes a stored response message into a [connect.AnyResponse] of the concrete
+// type that the generated handler for an RPC expects
+type idempotentResponseDecoder func(data []byte) (connect.AnyResponse, error)
+
+// idempotentProcedures lists the write RPCs that accept an idempotency key, along with the decoder for
+// each one's response message.  The header is ignored for all other RPCs.
+var idempotentProcedures = map[string]idempotentResponseDecoder{
+	perseusapiconnect.PerseusServiceCreateModuleProcedure:            decodeStoredResponse[perseusapi.CreateModuleResponse],
+	perseusapiconnect.PerseusServiceUpdateDependenciesProcedure:      decodeStoredResponse[perseusapi.UpdateDependenciesResponse],
+	perseusapiconnect.PerseusServiceBatchUpdateDependenciesProcedure: decodeStoredResponse[perseusapi.BatchUpdateDependenciesResponse],
+}
+
+// decodeStoredResponse is an [idempotentResponseDecoder] for responses of type T
+func decodeStoredResponse[T any, PT interface {
+	*T
+	proto.Message
+}](data []byte) (connect.AnyResponse, error) {
+	msg := PT(new(T))
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse((*T)(msg)), nil
+}
+
+// idempotencyInterceptor is a [connect.Interceptor] that de-duplicates retried write requests.  The first
+// request with a given idempotency key is processed normally and its response is stored, then any retry
+// of the same request returns the stored response without calling the handler again.  Reusing a key for
+// a different request is rejected.
+type idempotencyInterceptor struct {
+	db store.Store
+}
+
+// ensure the interceptor satisfies the Connect interface
+var _ connect.Interceptor = idempotencyInterceptor{}
+
+// WrapUnary satisfies the [connect.Interceptor] interface and handles unary RPCs.
+func (ii idempotencyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		decode, ok := idempotentProcedures[procedure]
+		key := req.Header().Get(headers.IdempotencyKey)
+		if !ok || key == "" {
+			return next(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return nil, newInvalidArgumentError("invalid idempotency key",
+				fieldViolation(headers.IdempotencyKey, "the key must be at most 255 characters"))
+		}
+		msg, ok := req.Any().(proto.Message)
+		if !ok {
+			return next(ctx, req)
+		}
+		hash, err := requestHash(msg)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		log := requestLogger(ctx)
+		existing, err := ii.db.ClaimIdempotencyKey(ctx, procedure, key, hash, time.Now().Add(-idempotencyClaimTimeout))
+		if err != nil {
+			log.Error(err, "unable to claim the idempotency key", "procedure", procedure, "key", key)
+			return nil, newDatabaseError(err, "unable to check the idempotency key")
+		}
+		if existing != nil {
+			return replayResponse(ctx, *existing, hash, decode)
+		}
+
+		resp, err := next(ctx, req)
+		// the outcome is recorded even if the caller has gone away so that its retry finds it
+		ctx = context.WithoutCancel(ctx)
+		if err != nil {
+			if rerr := ii.db.ReleaseIdempotencyKey(ctx, procedure, key); rerr != nil {
+				log.Error(rerr, "unable to release the idempotency key", "procedure", procedure, "key", key)
+			}
+			return resp, err
+		}
+		if m, ok := resp.Any().(proto.Message); ok {
+			data, merr := proto.Marshal(m)
+			if merr == nil {
+				merr = ii.db.CompleteIdempotencyKey(ctx, procedure, key, data)
+			}
+			if merr != nil {
+				log.Error(merr, "unable to store the response for the idempotency key", "procedure", procedure, "key", key)
+			}
+		}
+		return resp, nil
+	}
+}
+
+// WrapStreamingClient satisfies the [connect.Interceptor] interface.  This is a no-op because the
+// interceptor is only used server-side.
+func (idempotencyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler satisfies the [connect.Interceptor] interface.  This is a no-op because none of
+// the idempotent RPCs are streaming.
+func (idempotencyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// replayResponse returns the stored response for a request whose idempotency key was already claimed.
+// The retry is rejected if the key was used for a different request, identified by hash, or asked to try
+// again later if the original request has not finished yet.
+func replayResponse(ctx context.Context, existing store.IdempotencyKey, hash []byte, decode idempotentResponseDecoder) (connect.AnyResponse, error) {
+	if !bytes.Equal(existing.RequestHash, hash) {
+		return nil, newInvalidArgumentError("the idempotency key was already used for a different request",
+			fieldViolation(headers.IdempotencyKey, "each key must only be used for retries of the same request"))
+	}
+	if !existing.CompletedAt.Valid {
+		cerr := connect.NewError(connect.CodeUnavailable, errors.New("a request with the same idempotency key is still being processed"))
+		addErrorDetail(cerr, &errdetails.ErrorInfo{
+			Reason: reasonRequestInProgress,
+			Domain: errorDomain,
+		})
+		addErrorDetail(cerr, &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(defaultRetryDelay),
+		})
+		return nil, cerr
+	}
+	resp, err := decode(existing.Response)
+	if err != nil {
+		requestLogger(ctx).Error(err, "unable to decode the stored response for the idempotency key", "procedure", existing.Procedure, "key", existing.Key)
+		return nil, connect.NewError(connect.CodeInternal, errors.New("unable to replay the response to the original request"))
+	}
+	requestLogger(ctx).Debug("replayed the response for a retried request", "procedure", existing.Procedure, "key", existing.Key)
+	resp.Header().Set(headers.IdempotentReplayed, "true")
+	return resp, nil
+}
+
+// requestHash returns a hash of the serialized request message that identifies retries of the same
+// request
+func requestHash(msg proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(data)
+	return h[:], nil
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CrowdStrike/perseus/internal/headers"
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+// idempotencyTestStore implements the subset of [store.Store] that is used by [idempotencyInterceptor]
+type idempotencyTestStore struct {
+	store.Store
+	keys map[string]*store.IdempotencyKey
+}
+
+func (s *idempotencyTestStore) ClaimIdempotencyKey(_ context.Context, procedure, key string, requestHash []byte, _ time.Time) (*store.IdempotencyKey, error) {
+	if k, ok := s.keys[procedure+" "+key]; ok {
+		return k, nil
+	}
+	s.keys[procedure+" "+key] = &store.IdempotencyKey{Procedure: procedure, Key: key, RequestHash: requestHash}
+	return nil, nil
+}
+
+func (s *idempotencyTestStore) CompleteIdempotencyKey(_ context.Context, procedure, key string, response []byte) error {
+	k := s.keys[procedure+" "+key]
+	k.Response, k.CompletedAt = response, sql.NullTime{Time: time.Now(), Valid: true}
+	return nil
+}
+
+func (s *idempotencyTestStore) ReleaseIdempotencyKey(_ context.Context, procedure, key string) error {
+	delete(s.keys, procedure+" "+key)
+	return nil
+}
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	db := &idempotencyTestStore{keys: make(map[string]*store.IdempotencyKey)}
+	var (
+		calls   int
+		failing bool
+	)
+	next := idempotencyInterceptor{db: db}.WrapUnary(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		calls++
+		if failing {
+			return nil, connect.NewError(connect.CodeUnavailable, errors.New("try again"))
+		}
+		return connect.NewResponse(&perseusapi.UpdateDependenciesResponse{}), nil
+	})
+	send := func(procedure, key, module string) (connect.AnyResponse, error) {
+		req := newTestRequest(procedure)
+		req.Msg.ModuleName = module
+		if key != "" {
+			req.Header().Set(headers.IdempotencyKey, key)
+		}
+		return next(context.Background(), req)
+	}
+	update := perseusapiconnect.PerseusServiceUpdateDependenciesProcedure
+
+	resp, err := send(update, "k1", "example.com/a")
+	if assert.NoError(t, err) {
+		assert.Empty(t, resp.Header().Get(headers.IdempotentReplayed))
+	}
+	resp, err = send(update, "k1", "example.com/a")
+	if assert.NoError(t, err) {
+		assert.IsType(t, &perseusapi.UpdateDependenciesResponse{}, resp.Any())
+		assert.Equal(t, "true", resp.Header().Get(headers.IdempotentReplayed))
+	}
+	assert.Equal(t, 1, calls, "the retry should return the stored response")
+
+	_, err = send(update, "k1", "example.com/b")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "reusing a key for a different request should be rejected")
+
+	calls, failing = 0, true
+	_, err = send(update, "k2", "example.com/a")
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	failing = false
+	_, err = send(update, "k2", "example.com/a")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls, "a retry of a failed request should be processed again")
+
+	req := newTestRequest(update)
+	req.Msg.ModuleName = "example.com/a"
+	hash, _ := requestHash(req.Msg)
+	db.keys[update+" k3"] = &store.IdempotencyKey{Procedure: update, Key: "k3", RequestHash: hash}
+	_, err = send(update, "k3", "example.com/a")
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err), "a retry should wait for the original request to finish")
+
+	calls = 0
+	for range 2 {
+		_, err = send(perseusapiconnect.PerseusServiceDeleteModuleProcedure, "k4", "example.com/a")
+		assert.NoError(t, err)
+		_, err = send(update, "", "example.com/a")
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 4, calls, "requests without a key, or to other RPCs, should always be processed")
+}
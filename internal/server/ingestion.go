@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/mod/module"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const (
+	// defaultIngestionWorkers is the default number of workers that process the ingestion queue
+	defaultIngestionWorkers = 2
+	// ingestionPollInterval is how long an idle worker waits before checking the queue again
+	ingestionPollInterval = 5 * time.Second
+	// ingestionStaleAfter is how long a job can be running before it is assumed to have been abandoned
+	ingestionStaleAfter = 15 * time.Minute
+	// maxIngestionAttempts is the number of times a job is tried before it is marked as failed
+	maxIngestionAttempts = 3
+	// defaultIngestionSource is the source recorded for jobs submitted without one
+	defaultIngestionSource = "api"
+)
+
+// SubmitIngestionJobs adds an ingestion job to the queue for each of the specified module versions.
+func (s *connectServer) SubmitIngestionJobs(ctx context.Context, req *connect.Request[perseusapi.SubmitIngestionJobsRequest]) (*connect.Response[perseusapi.SubmitIngestionJobsResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("SubmitIngestionJobs() called", "modules", len(msg.GetModules()), "source", msg.GetSource())
+
+	var mods []store.Version
+	for i, m := range msg.GetModules() {
+		if len(m.GetVersions()) == 0 {
+			return nil, newInvalidArgumentError("at least 1 version must be specified for each module",
+				fieldViolation(fmt.Sprintf("modules[%d].versions", i), "at least 1 version is required"))
+		}
+		for j, v := range m.GetVersions() {
+			cv, err := canonicalModuleVersion(fmt.Sprintf("modules[%d].versions[%d]", i, j), m.GetName(), v)
+			if err != nil {
+				return nil, err
+			}
+			mods = append(mods, store.Version{ModuleID: m.GetName(), SemVer: cv})
+		}
+	}
+	source := msg.GetSource()
+	if source == "" {
+		source = defaultIngestionSource
+	}
+
+	jobs, err := s.store.EnqueueIngestionJobs(ctx, source, mods...)
+	if err != nil {
+		log.Error(err, "unable to enqueue ingestion jobs", "source", source, "count", len(mods))
+		return nil, newDatabaseError(err, "unable to submit the ingestion jobs")
+	}
+	resp := &perseusapi.SubmitIngestionJobsResponse{}
+	for _, j := range jobs {
+		resp.Jobs = append(resp.Jobs, toAPIIngestionJob(j))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetIngestionJob returns the current state of the requested ingestion job.
+func (s *connectServer) GetIngestionJob(ctx context.Context, req *connect.Request[perseusapi.GetIngestionJobRequest]) (*connect.Response[perseusapi.GetIngestionJobResponse], error) {
+	log := requestLogger(ctx)
+	log.Debug("GetIngestionJob() called", "id", req.Msg.GetId())
+
+	job, err := s.store.GetIngestionJob(ctx, req.Msg.GetId())
+	if err != nil {
+		if !errors.Is(err, store.ErrIngestionJobNotFound) {
+			log.Error(err, "unable to retrieve ingestion job", "id", req.Msg.GetId())
+		}
+		return nil, storeError(err, "unable to retrieve the ingestion job")
+	}
+	return connect.NewResponse(&perseusapi.GetIngestionJobResponse{Job: toAPIIngestionJob(job)}), nil
+}
+
+// ListIngestionJobs returns a page of ingestion jobs, most recent first.
+func (s *connectServer) ListIngestionJobs(ctx context.Context, req *connect.Request[perseusapi.ListIngestionJobsRequest]) (*connect.Response[perseusapi.ListIngestionJobsResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("ListIngestionJobs() called", "request", msg.String())
+
+	jobs, pageToken, err := s.store.QueryIngestionJobs(ctx, msg.GetStatus(), msg.GetPageToken(), int(msg.GetPageSize()))
+	if err != nil {
+		log.Error(err, "unable to query ingestion jobs", "status", msg.GetStatus(), "pageToken", msg.GetPageToken())
+		return nil, storeError(err, "unable to retrieve the ingestion jobs")
+	}
+	resp := &perseusapi.ListIngestionJobsResponse{NextPageToken: pageToken}
+	for _, j := range jobs {
+		resp.Jobs = append(resp.Jobs, toAPIIngestionJob(j))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// runIngestionWorkers starts n workers that process the ingestion queue and blocks until ctx is
+// cancelled and all of the workers have exited.
+func (s *connectServer) runIngestionWorkers(ctx context.Context, n int) {
+	log.Info("starting ingestion workers", "count", n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			s.ingestionWorker(ctx, id)
+		}(i)
+	}
+	wg.Wait()
+	log.Debug("ingestion workers stopped")
+}
+
+// ingestionWorker claims and processes ingestion jobs until ctx is cancelled, waiting for
+// [ingestionPollInterval] whenever the queue is empty.
+func (s *connectServer) ingestionWorker(ctx context.Context, id int) {
+	for {
+		job, err := s.store.ClaimIngestionJob(ctx, time.Now().Add(-ingestionStaleAfter))
+		if err != nil && ctx.Err() == nil {
+			log.Error(err, "unable to claim an ingestion job", "worker", id)
+		}
+		if job != nil {
+			s.processIngestionJob(ctx, id, *job)
+			continue
+		}
+		select {
+		case <-time.After(ingestionPollInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// processIngestionJob ingests the module version for job and records the outcome.  A failed job is
+// returned to the queue until it has been tried [maxIngestionAttempts] times.
+func (s *connectServer) processIngestionJob(ctx context.Context, worker int, job store.IngestionJob) {
+	log.Debug("processing ingestion job", "worker", worker, "id", job.ID, "module", job.Module, "version", job.Version, "attempt", job.Attempts)
+
+	var jobErr error
+	res := s.refreshModuleVersion(ctx, module.Version{Path: job.Module, Version: job.Version})
+	if res.GetError() != "" {
+		jobErr = errors.New(res.GetError())
+	}
+	// if the server is shutting down the job will be claimed again once it is considered stale
+	if ctx.Err() != nil {
+		return
+	}
+	retry := jobErr != nil && job.Attempts < maxIngestionAttempts
+	if err := s.store.CompleteIngestionJob(ctx, job.ID, jobErr, retry); err != nil {
+		log.Error(err, "unable to record the outcome of an ingestion job", "id", job.ID)
+		return
+	}
+	if jobErr != nil {
+		log.Info("ingestion job failed", "id", job.ID, "module", job.Module, "version", job.Version, "willRetry", retry, "err", jobErr)
+		return
+	}
+	log.Info("ingested module version", "id", job.ID, "module", job.Module, "version", job.Version, "dependencies", res.GetDependencyCount())
+	if s.depsdev != nil {
+		s.recordDepsDevLicense(ctx, job.Module, job.Version)
+	}
+}
+
+// toAPIIngestionJob converts a [store.IngestionJob] to the equivalent API message
+func toAPIIngestionJob(j store.IngestionJob) *perseusapi.IngestionJob {
+	return &perseusapi.IngestionJob{
+		Id:         j.ID,
+		ModuleName: j.Module,
+		Version:    j.Version,
+		Source:     j.Source,
+		Status:     j.Status,
+		Attempts:   j.Attempts,
+		Error:      j.Error.String,
+		CreateTime: timestamppb.New(j.CreatedAt),
+		UpdateTime: timestamppb.New(j.UpdatedAt),
+	}
+}
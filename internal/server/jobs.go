@@ -0,0 +1,362 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/internal/upstream"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// the supported scheduled job types
+const (
+	jobTypePrunePrereleases     = "prune-prereleases"
+	jobTypeCheckGraph           = "check-graph"
+	jobTypeRefreshModules       = "refresh-modules"
+	jobTypeDiscoverVersions     = "discover-versions"
+	jobTypeScoreModules         = "score-modules"
+	jobTypeCheckUpstreams       = "check-upstreams"
+	jobTypeVerifyVersions       = "verify-versions"
+	jobTypePurgeDeleted         = "purge-deleted"
+	jobTypePurgeIdempotencyKeys = "purge-idempotency-keys"
+)
+
+// the possible values for the status of a job's most recent run
+const (
+	jobStatusNever     = "never"
+	jobStatusRunning   = "running"
+	jobStatusSucceeded = "succeeded"
+	jobStatusFailed    = "failed"
+)
+
+var (
+	jobRunsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "perseus_job_runs_total",
+		Help: "The number of runs of each scheduled job, by outcome",
+	}, []string{"job", "status"})
+	jobRunDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "perseus_job_run_duration_seconds",
+		Help:    "The duration of each run of a scheduled job",
+		Buckets: prometheus.ExponentialBuckets(0.1, 4, 10),
+	}, []string{"job"})
+	jobLastSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "perseus_job_last_success_timestamp_seconds",
+		Help: "The Unix time of the last successful run of each scheduled job",
+	}, []string{"job"})
+)
+
+// jobSpec defines a single scheduled job in the jobs configuration file
+type jobSpec struct {
+	// a unique name for the job, defaults to the job type
+	Name string `yaml:"name"`
+	// the kind of task to run, one of the jobType* constants
+	Type string `yaml:"type"`
+	// a standard 5-field cron expression or a descriptor such as @daily or @every 6h
+	Schedule string `yaml:"schedule"`
+
+	// prune-prereleases, purge-deleted: the number of days to keep superseded pre-release versions or the
+	// tombstones of deleted modules
+	RetentionDays int `yaml:"retention-days"`
+	// check-graph: whether to repair the problems that are found
+	Repair bool `yaml:"repair"`
+	// refresh-modules, discover-versions, check-upstreams, verify-versions: the module and version
+	// filters, see the RefreshModules RPC
+	ModuleFilter      string `yaml:"module-filter"`
+	VersionFilter     string `yaml:"version-filter"`
+	IncludePrerelease bool   `yaml:"include-prerelease"`
+	LatestOnly        bool   `yaml:"latest-only"`
+	// discover-versions, check-upstreams, verify-versions: the number of modules or repositories to check
+	// at the same time and the maximum number of module proxy or code host API requests per second
+	Concurrency int     `yaml:"concurrency"`
+	QPS         float64 `yaml:"qps"`
+}
+
+// jobsConfigFile defines the contents of the YAML file passed to the server's --jobs-config flag
+type jobsConfigFile struct {
+	Jobs []jobSpec `yaml:"jobs"`
+}
+
+// readJobsConfigFile loads the scheduled job definitions from the YAML file at path
+func readJobsConfigFile(path string) ([]jobSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the jobs configuration file: %w", err)
+	}
+	var f jobsConfigFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("unable to parse the jobs configuration file %q: %w", path, err)
+	}
+	return f.Jobs, nil
+}
+
+// jobFunc is the work performed by a single run of a scheduled job
+type jobFunc func(ctx context.Context) error
+
+// scheduledJob is a job registered with the [scheduler] along with the status of its most recent run
+type scheduledJob struct {
+	name, kind, schedule string
+	run                  jobFunc
+	entryID              cron.EntryID
+
+	mu           sync.Mutex
+	running      bool
+	lastStatus   string
+	lastErr      error
+	lastStart    time.Time
+	lastDuration time.Duration
+	runs         int64
+	failures     int64
+}
+
+// scheduler runs the server's maintenance jobs on their configured schedules
+type scheduler struct {
+	ctx  context.Context
+	cron *cron.Cron
+	jobs []*scheduledJob
+}
+
+// newScheduler constructs a scheduler that runs the jobs defined by specs.  The jobs are passed ctx,
+// which should be cancelled when the server shuts down.
+func newScheduler(ctx context.Context, svr *connectServer, specs []jobSpec) (*scheduler, error) {
+	s := &scheduler{
+		ctx:  ctx,
+		cron: cron.New(),
+	}
+	names := make(map[string]struct{}, len(specs))
+	for i, spec := range specs {
+		if spec.Name == "" {
+			spec.Name = spec.Type
+		}
+		if _, exists := names[spec.Name]; exists {
+			return nil, fmt.Errorf("invalid job at index %d: the job name %q is not unique", i, spec.Name)
+		}
+		names[spec.Name] = struct{}{}
+
+		fn, err := newJobFunc(svr, spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid job %q: %w", spec.Name, err)
+		}
+		job := &scheduledJob{
+			name:       spec.Name,
+			kind:       spec.Type,
+			schedule:   spec.Schedule,
+			run:        fn,
+			lastStatus: jobStatusNever,
+		}
+		job.entryID, err = s.cron.AddFunc(spec.Schedule, func() { s.execute(job) })
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q for job %q: %w", spec.Schedule, spec.Name, err)
+		}
+		s.jobs = append(s.jobs, job)
+	}
+	return s, nil
+}
+
+// newJobFunc returns the function that implements a job of the type specified by spec
+func newJobFunc(svr *connectServer, spec jobSpec) (jobFunc, error) {
+	switch spec.Type {
+	case jobTypePrunePrereleases:
+		if spec.RetentionDays <= 0 {
+			return nil, fmt.Errorf("retention-days must be greater than zero")
+		}
+		return func(ctx context.Context) error {
+			return prunePrereleases(ctx, svr.store, spec.RetentionDays)
+		}, nil
+
+	case jobTypePurgeDeleted:
+		if spec.RetentionDays <= 0 {
+			return nil, fmt.Errorf("retention-days must be greater than zero")
+		}
+		return func(ctx context.Context) error {
+			return purgeDeleted(ctx, svr.store, spec.RetentionDays)
+		}, nil
+
+	case jobTypePurgeIdempotencyKeys:
+		return func(ctx context.Context) error {
+			return purgeIdempotencyKeys(ctx, svr.store)
+		}, nil
+
+	case jobTypeCheckGraph:
+		return func(ctx context.Context) error {
+			issues, err := svr.store.CheckConsistency(ctx, spec.Repair)
+			if err != nil {
+				return err
+			}
+			repaired := 0
+			for _, i := range issues {
+				if i.Repaired {
+					repaired++
+				}
+			}
+			log.Info("checked the graph for consistency problems", "job", spec.Name, "found", len(issues), "repaired", repaired)
+			return nil
+		}, nil
+
+	case jobTypeRefreshModules:
+		if spec.ModuleFilter == "" {
+			return nil, fmt.Errorf("module-filter must be specified")
+		}
+		return func(ctx context.Context) error {
+			ctx = context.WithValue(ctx, actorKey{}, actorScheduler)
+			resp, err := svr.RefreshModules(ctx, connect.NewRequest(&perseusapi.RefreshModulesRequest{
+				ModuleFilter:      spec.ModuleFilter,
+				VersionFilter:     spec.VersionFilter,
+				IncludePrerelease: spec.IncludePrerelease,
+				LatestOnly:        spec.LatestOnly,
+			}))
+			if err != nil {
+				return err
+			}
+			failed := 0
+			for _, r := range resp.Msg.GetResults() {
+				if r.GetError() != "" {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d module version(s) could not be refreshed", failed, len(resp.Msg.GetResults()))
+			}
+			return nil
+		}, nil
+
+	case jobTypeDiscoverVersions:
+		if spec.Concurrency < 0 || spec.QPS < 0 {
+			return nil, fmt.Errorf("concurrency and qps must not be negative")
+		}
+		return func(ctx context.Context) error {
+			return svr.discoverNewVersions(ctx, discoveryOptions{
+				moduleFilter:      spec.ModuleFilter,
+				includePrerelease: spec.IncludePrerelease,
+				concurrency:       spec.Concurrency,
+				qps:               spec.QPS,
+			})
+		}, nil
+
+	case jobTypeScoreModules:
+		return func(ctx context.Context) error {
+			return scoreModules(ctx, svr.store)
+		}, nil
+
+	case jobTypeCheckUpstreams:
+		if spec.Concurrency < 0 || spec.QPS < 0 {
+			return nil, fmt.Errorf("concurrency and qps must not be negative")
+		}
+		client := upstream.NewFromEnv(&http.Client{Timeout: 30 * time.Second})
+		return func(ctx context.Context) error {
+			return checkUpstreams(ctx, svr.store, client, upstreamOptions{
+				moduleFilter: spec.ModuleFilter,
+				concurrency:  spec.Concurrency,
+				qps:          spec.QPS,
+			})
+		}, nil
+
+	case jobTypeVerifyVersions:
+		if spec.Concurrency < 0 || spec.QPS < 0 {
+			return nil, fmt.Errorf("concurrency and qps must not be negative")
+		}
+		return func(ctx context.Context) error {
+			return svr.verifyVersions(ctx, verifyOptions{
+				moduleFilter: spec.ModuleFilter,
+				concurrency:  spec.Concurrency,
+				qps:          spec.QPS,
+			})
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported job type %q", spec.Type)
+	}
+}
+
+// start begins running the scheduled jobs
+func (s *scheduler) start() {
+	for _, job := range s.jobs {
+		log.Info("scheduled maintenance job", "job", job.name, "type", job.kind, "schedule", job.schedule)
+	}
+	s.cron.Start()
+}
+
+// stop prevents any further jobs from starting and waits for running jobs to complete
+func (s *scheduler) stop() {
+	<-s.cron.Stop().Done()
+}
+
+// execute runs job, recording its status and metrics.  A run is skipped if the previous run of the same
+// job has not yet completed.
+func (s *scheduler) execute(job *scheduledJob) {
+	job.mu.Lock()
+	if job.running {
+		job.mu.Unlock()
+		log.Info("skipping scheduled job because the previous run is still in progress", "job", job.name)
+		jobRunsTotal.WithLabelValues(job.name, "skipped").Inc()
+		return
+	}
+	job.running = true
+	job.lastStatus = jobStatusRunning
+	job.lastErr = nil
+	job.lastStart = time.Now()
+	job.mu.Unlock()
+
+	log.Debug("starting scheduled job", "job", job.name)
+	err := job.run(s.ctx)
+	elapsed := time.Since(job.lastStart)
+
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	job.running = false
+	job.lastDuration = elapsed
+	job.runs++
+	jobRunDuration.WithLabelValues(job.name).Observe(elapsed.Seconds())
+	if err != nil {
+		job.failures++
+		job.lastStatus = jobStatusFailed
+		job.lastErr = err
+		jobRunsTotal.WithLabelValues(job.name, jobStatusFailed).Inc()
+		log.Error(err, "scheduled job failed", "job", job.name, "duration", elapsed.String())
+		return
+	}
+	job.lastStatus = jobStatusSucceeded
+	jobRunsTotal.WithLabelValues(job.name, jobStatusSucceeded).Inc()
+	jobLastSuccess.WithLabelValues(job.name).SetToCurrentTime()
+	log.Debug("scheduled job completed", "job", job.name, "duration", elapsed.String())
+}
+
+// status returns the current state of each scheduled job
+func (s *scheduler) status() []*perseusapi.Job {
+	jobs := make([]*perseusapi.Job, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		job.mu.Lock()
+		j := &perseusapi.Job{
+			Name:         job.name,
+			Type:         job.kind,
+			Schedule:     job.schedule,
+			LastStatus:   job.lastStatus,
+			RunCount:     job.runs,
+			FailureCount: job.failures,
+		}
+		if job.lastErr != nil {
+			j.LastError = job.lastErr.Error()
+		}
+		if !job.lastStart.IsZero() {
+			j.LastRunTime = timestamppb.New(job.lastStart)
+			j.LastRunDuration = durationpb.New(job.lastDuration)
+		}
+		job.mu.Unlock()
+		if next := s.cron.Entry(job.entryID).Next; !next.IsZero() {
+			j.NextRunTime = timestamppb.New(next)
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewScheduler(t *testing.T) {
+	type testCase struct {
+		name    string
+		specs   []jobSpec
+		wantErr bool
+	}
+	cases := []testCase{
+		{
+			name: "valid jobs",
+			specs: []jobSpec{
+				{Type: jobTypeCheckGraph, Schedule: "0 3 * * *", Repair: true},
+				{Type: jobTypePrunePrereleases, Schedule: "@daily", RetentionDays: 30},
+				{Name: "refresh", Type: jobTypeRefreshModules, Schedule: "@every 6h", ModuleFilter: "github.com/CrowdStrike/*"},
+				{Type: jobTypeDiscoverVersions, Schedule: "@hourly", Concurrency: 8, QPS: 2.5},
+				{Type: jobTypeScoreModules, Schedule: "@daily"},
+				{Type: jobTypeCheckUpstreams, Schedule: "@weekly", ModuleFilter: "github.com/*", QPS: 0.5},
+				{Type: jobTypeVerifyVersions, Schedule: "@weekly", Concurrency: 2},
+				{Type: jobTypePurgeDeleted, Schedule: "@daily", RetentionDays: 90},
+				{Type: jobTypePurgeIdempotencyKeys, Schedule: "@hourly"},
+			},
+		},
+		{
+			name:    "unknown job type",
+			specs:   []jobSpec{{Type: "reticulate-splines", Schedule: "@daily"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid schedule",
+			specs:   []jobSpec{{Type: jobTypeCheckGraph, Schedule: "every day"}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate job names",
+			specs: []jobSpec{
+				{Type: jobTypeCheckGraph, Schedule: "@daily"},
+				{Type: jobTypeCheckGraph, Schedule: "@weekly", Repair: true},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing retention period",
+			specs:   []jobSpec{{Type: jobTypePrunePrereleases, Schedule: "@daily"}},
+			wantErr: true,
+		},
+		{
+			name:    "negative discovery concurrency",
+			specs:   []jobSpec{{Type: jobTypeDiscoverVersions, Schedule: "@hourly", Concurrency: -1}},
+			wantErr: true,
+		},
+		{
+			name:    "negative upstream check rate",
+			specs:   []jobSpec{{Type: jobTypeCheckUpstreams, Schedule: "@weekly", QPS: -1}},
+			wantErr: true,
+		},
+		{
+			name:    "missing module filter",
+			specs:   []jobSpec{{Type: jobTypeRefreshModules, Schedule: "@daily"}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := newScheduler(context.Background(), &connectServer{}, tc.specs)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				status := s.status()
+				assert.Len(t, status, len(tc.specs))
+				for _, j := range status {
+					assert.Equal(t, jobStatusNever, j.GetLastStatus())
+				}
+			}
+		})
+	}
+}
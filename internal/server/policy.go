@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/mod/module"
+
+	"github.com/CrowdStrike/perseus/internal/policy"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// CheckPolicy evaluates the dependency policy against the transitive dependencies of the specified module
+// version.
+func (s *connectServer) CheckPolicy(ctx context.Context, req *connect.Request[perseusapi.CheckPolicyRequest]) (*connect.Response[perseusapi.CheckPolicyResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("CheckPolicy() called", "request", msg.String())
+
+	pol := s.live.currentPolicy()
+	if pol == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("no dependency policy is configured on the server"))
+	}
+	modName := msg.GetModuleName()
+	modVer, err := canonicalModuleVersion("module_name", modName, msg.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	root := module.Version{Path: modName, Version: modVer}
+	violations, err := s.checkPolicy(ctx, pol, root, int(msg.GetMaxDepth()), msg.GetKind())
+	if err != nil {
+		log.Error(err, "unable to check the dependency policy", "module", modName, "version", modVer)
+		return nil, storeError(err, "unable to query the graph")
+	}
+	resp := perseusapi.CheckPolicyResponse{}
+	for _, v := range violations {
+		resp.Violations = append(resp.Violations, &perseusapi.CheckPolicyResponse_PolicyViolation{
+			Kind:        v.Kind,
+			License:     v.License,
+			Rule:        v.Rule,
+			Severity:    v.Severity,
+			Message:     v.Message,
+			Replacement: v.Replacement,
+			Module:      &perseusapi.Module{Name: v.Module.Path, Versions: []string{v.Module.Version}},
+			Path:        v.path,
+		})
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// pathViolation is a [policy.Violation] along with the chain of module versions from the checked module to
+// the banned dependency
+type pathViolation struct {
+	policy.Violation
+	path []string
+}
+
+// dependencyNode is a module version found by [connectServer.walkDependencies]
+type dependencyNode struct {
+	mod module.Version
+	// the index of the node through which this module version was reached, -1 for the root
+	parent int
+	depth  int
+}
+
+// dependencyWalk is the list of module versions found by [connectServer.walkDependencies], starting
+// with the root
+type dependencyWalk []dependencyNode
+
+// pathTo returns the chain of module versions from the root to the i-th node, ex: example.com/foo@v1.2.0
+func (w dependencyWalk) pathTo(i int) []string {
+	var path []string
+	for p := i; p >= 0; p = w[p].parent {
+		path = append([]string{w[p].mod.String()}, path...)
+	}
+	return path
+}
+
+// walkDependencies walks the dependencies of root breadth-first, up to maxDepth levels or every level if
+// maxDepth is 0, and returns root followed by each of its transitive dependencies.  Each module version is
+// only returned once, through the shortest path from root, which also stops the walk if the graph contains
+// a cycle.  Any extra module versions are walked as if root also required them.
+func (s *connectServer) walkDependencies(ctx context.Context, root module.Version, maxDepth int, extra ...module.Version) (dependencyWalk, error) {
+	nodes := dependencyWalk{{mod: root, parent: -1}}
+	seen := map[module.Version]struct{}{root: {}}
+	for _, mod := range extra {
+		if _, found := seen[mod]; !found {
+			seen[mod] = struct{}{}
+			nodes = append(nodes, dependencyNode{mod: mod, parent: 0, depth: 1})
+		}
+	}
+	for i := 0; i < len(nodes); i++ {
+		n := nodes[i]
+		if maxDepth > 0 && n.depth >= maxDepth {
+			continue
+		}
+		deps, _, err := s.store.GetDependees(ctx, n.mod.Path, strings.TrimPrefix(n.mod.Version, "v"), time.Time{}, "", 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range deps {
+			mod := module.Version{Path: d.ModuleID, Version: "v" + d.SemVer}
+			if _, found := seen[mod]; found {
+				continue
+			}
+			seen[mod] = struct{}{}
+			nodes = append(nodes, dependencyNode{mod: mod, parent: i, depth: n.depth + 1})
+		}
+	}
+	return nodes, nil
+}
+
+// checkPolicy walks the dependencies of root, up to maxDepth levels or every level if maxDepth is 0, and
+// returns the violations of pol of the specified kind, or of every kind if kind is empty.  Module violations
+// are returned before license violations.
+func (s *connectServer) checkPolicy(ctx context.Context, pol *policy.Policy, root module.Version, maxDepth int, kind string) ([]pathViolation, error) {
+	nodes, err := s.walkDependencies(ctx, root, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []pathViolation
+	if kind == "" || kind == policy.KindModule {
+		for i := 1; i < len(nodes); i++ {
+			for _, v := range pol.Check(nodes[i].mod) {
+				violations = append(violations, pathViolation{Violation: v, path: nodes.pathTo(i)})
+			}
+		}
+	}
+	if (kind == "" || kind == policy.KindLicense) && pol.HasLicenseRules() && len(nodes) > 1 {
+		names := make([]string, 0, len(nodes)-1)
+		for _, n := range nodes[1:] {
+			names = append(names, n.mod.Path)
+		}
+		licenses, err := s.store.GetModuleLicenses(ctx, names)
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i < len(nodes); i++ {
+			if v := pol.CheckLicense(nodes[i].mod, licenses[nodes[i].mod.Path]); v != nil {
+				violations = append(violations, pathViolation{Violation: *v, path: nodes.pathTo(i)})
+			}
+		}
+	}
+	return violations, nil
+}
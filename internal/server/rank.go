@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CrowdStrike/perseus/internal/graph"
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// defaultTopModules is the number of modules returned by ListTopModules if the request does not specify
+// a limit
+const defaultTopModules = 20
+
+// ListTopModules ranks modules by the number of distinct modules that depend on them or by importance.
+func (s *connectServer) ListTopModules(ctx context.Context, req *connect.Request[perseusapi.ListTopModulesRequest]) (*connect.Response[perseusapi.ListTopModulesResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("ListTopModules() called", "request", msg.String())
+
+	query := store.ModuleRankQuery{
+		ModuleFilter: msg.GetModuleFilter(),
+		By:           msg.GetBy(),
+		Limit:        int(msg.GetLimit()),
+	}
+	if query.By == "" {
+		query.By = store.RankByDependents
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultTopModules
+	}
+	ranks, err := s.store.RankModules(ctx, query)
+	if err != nil {
+		log.Error(err, "unable to rank modules", "moduleFilter", query.ModuleFilter, "by", query.By, "limit", query.Limit)
+		return nil, storeError(err, "unable to rank the modules")
+	}
+	resp := perseusapi.ListTopModulesResponse{}
+	for _, r := range ranks {
+		resp.Modules = append(resp.Modules, &perseusapi.ListTopModulesResponse_RankedModule{
+			Name:                 r.Module,
+			DirectDependents:     int32(r.DirectDependents),     //nolint: gosec // there will never be 2^31 modules
+			TransitiveDependents: int32(r.TransitiveDependents), //nolint: gosec // there will never be 2^31 modules
+			Importance:           r.Importance,
+		})
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// GetModuleMetrics returns the fan-in and fan-out of the specified module.
+func (s *connectServer) GetModuleMetrics(ctx context.Context, req *connect.Request[perseusapi.GetModuleMetricsRequest]) (*connect.Response[perseusapi.GetModuleMetricsResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("GetModuleMetrics() called", "request", msg.String())
+
+	m, err := s.store.GetModuleMetrics(ctx, msg.GetModuleName())
+	if err != nil {
+		log.Error(err, "unable to query module metrics", "module", msg.GetModuleName())
+		return nil, storeError(err, "unable to query the module metrics")
+	}
+	resp := perseusapi.GetModuleMetricsResponse{
+		DirectDependents:       int32(m.DirectDependents),       //nolint: gosec // there will never be 2^31 modules
+		DirectDependencies:     int32(m.DirectDependencies),     //nolint: gosec // there will never be 2^31 modules
+		TransitiveDependents:   int32(m.TransitiveDependents),   //nolint: gosec // there will never be 2^31 modules
+		TransitiveDependencies: int32(m.TransitiveDependencies), //nolint: gosec // there will never be 2^31 modules
+		Importance:             m.Importance,
+		Archived:               m.Archived.Bool,
+	}
+	if m.LastCommitAt.Valid {
+		resp.LastCommitTime = timestamppb.New(m.LastCommitAt.Time)
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// ListModuleConsumers returns the modules that depend on the specified module, grouped by the version
+// that they consume.
+func (s *connectServer) ListModuleConsumers(ctx context.Context, req *connect.Request[perseusapi.ListModuleConsumersRequest]) (*connect.Response[perseusapi.ListModuleConsumersResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("ListModuleConsumers() called", "request", msg.String())
+
+	edges, err := s.store.GetModuleConsumers(ctx, msg.GetModuleName(), msg.GetAllMajors())
+	if err != nil {
+		log.Error(err, "unable to query module consumers", "module", msg.GetModuleName(), "allMajors", msg.GetAllMajors())
+		return nil, storeError(err, "unable to query the module consumers")
+	}
+	// the edges are ordered by the consumed module version so each group is contiguous
+	resp := perseusapi.ListModuleConsumersResponse{}
+	var current *perseusapi.ListModuleConsumersResponse_ConsumedVersion
+	for _, e := range edges {
+		v := "v" + e.DependencyVersion
+		if current == nil || current.Module.GetName() != e.DependencyModule || current.Module.Versions[0] != v {
+			current = &perseusapi.ListModuleConsumersResponse_ConsumedVersion{
+				Module: &perseusapi.Module{Name: e.DependencyModule, Versions: []string{v}},
+			}
+			resp.Versions = append(resp.Versions, current)
+		}
+		current.Dependents = append(current.Dependents, &perseusapi.Module{Name: e.Module, Versions: []string{"v" + e.Version}})
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// ListModuleStaleness returns the staleness score of the module versions matching the request.
+func (s *connectServer) ListModuleStaleness(ctx context.Context, req *connect.Request[perseusapi.ListModuleStalenessRequest]) (*connect.Response[perseusapi.ListModuleStalenessResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("ListModuleStaleness() called", "request", msg.String())
+
+	query := store.StalenessQuery{
+		ModuleFilter: msg.GetModuleFilter(),
+		AllVersions:  msg.GetAllVersions(),
+	}
+	results, err := s.store.GetModuleStaleness(ctx, query)
+	if err != nil {
+		log.Error(err, "unable to compute module staleness", "moduleFilter", query.ModuleFilter, "allVersions", query.AllVersions)
+		return nil, storeError(err, "unable to compute the module staleness")
+	}
+	resp := perseusapi.ListModuleStalenessResponse{}
+	for _, r := range results {
+		resp.Modules = append(resp.Modules, &perseusapi.ListModuleStalenessResponse_ModuleStaleness{
+			Module:               &perseusapi.Module{Name: r.Module, Versions: []string{"v" + r.Version}},
+			Dependencies:         int32(r.Dependencies),         //nolint: gosec // there will never be 2^31 dependencies
+			OutdatedDependencies: int32(r.OutdatedDependencies), //nolint: gosec // there will never be 2^31 dependencies
+			Score:                r.Score,
+		})
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// scoreModules computes the PageRank of every module in the module-level dependency graph and stores it
+// as the module's importance score
+func scoreModules(ctx context.Context, db store.Store) error {
+	edges, err := db.GetModuleEdges(ctx)
+	if err != nil {
+		return err
+	}
+	links := make([]graph.Edge, len(edges))
+	for i, e := range edges {
+		links[i] = graph.Edge{From: e.DependentID, To: e.DependeeID}
+	}
+	scores := graph.PageRank(links)
+	if err := db.SaveModuleImportance(ctx, scores); err != nil {
+		return err
+	}
+	log.Info("computed module importance scores", "modules", len(scores), "edges", len(edges))
+	return nil
+}
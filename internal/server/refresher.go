@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"golang.org/x/mod/semver"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+const (
+	// defaultDiscoveryConcurrency is the default number of modules that are checked for new versions
+	// at the same time
+	defaultDiscoveryConcurrency = 4
+	// defaultDiscoveryQPS is the default maximum number of module proxy requests per second made while
+	// checking for new versions
+	defaultDiscoveryQPS = 5.0
+)
+
+// discoveryOptions controls the behavior of [connectServer.discoverNewVersions]
+type discoveryOptions struct {
+	// a glob pattern specifying which known modules should be checked, all modules if empty
+	moduleFilter string
+	// if true, new pre-release versions are also ingested
+	includePrerelease bool
+	// the number of modules to check at the same time
+	concurrency int
+	// the maximum number of module proxy requests per second
+	qps float64
+}
+
+// discoveryStats summarizes a run of [connectServer.discoverNewVersions]
+type discoveryStats struct {
+	modules, queued, failed atomic.Int64
+}
+
+// discoverNewVersions walks all known modules that match opts.moduleFilter, asks the Go module proxy for
+// any versions that are newer than the highest version in the graph, and adds an ingestion job for each
+// new version to the ingestion queue.
+//
+// A failure to check an individual module is logged and counted, and an error is returned after all
+// modules have been processed.
+func (s *connectServer) discoverNewVersions(ctx context.Context, opts discoveryOptions) error {
+	if opts.moduleFilter == "" {
+		opts.moduleFilter = "*"
+	}
+	if opts.concurrency <= 0 {
+		opts.concurrency = defaultDiscoveryConcurrency
+	}
+	if opts.qps <= 0 {
+		opts.qps = defaultDiscoveryQPS
+	}
+
+	query := store.ModuleVersionQuery{
+		ModuleFilter:      opts.moduleFilter,
+		IncludePrerelease: true,
+		LatestOnly:        true,
+		Count:             refreshQueryPageSize,
+	}
+	var known []store.ModuleVersionQueryResult
+	for {
+		page, pageToken, err := s.store.QueryModuleVersions(ctx, query)
+		if err != nil {
+			return fmt.Errorf("unable to query the known modules: %w", err)
+		}
+		known = append(known, page...)
+		if pageToken == "" || len(page) == 0 {
+			break
+		}
+		query.PageToken = pageToken
+	}
+
+	var (
+		stats    discoveryStats
+		limiter  = rate.NewLimiter(rate.Limit(opts.qps), 1)
+		eg, gctx = errgroup.WithContext(ctx)
+	)
+	eg.SetLimit(opts.concurrency)
+	for _, k := range known {
+		k := k
+		eg.Go(func() error {
+			stats.modules.Add(1)
+			n, err := s.enqueueNewVersions(gctx, limiter, k.Module, "v"+k.Version, opts.includePrerelease)
+			stats.queued.Add(int64(n))
+			if err != nil {
+				// a context error means the server is shutting down so there's no point in continuing
+				if gctx.Err() != nil {
+					return gctx.Err()
+				}
+				stats.failed.Add(1)
+				log.Error(err, "unable to check for new module versions", "module", k.Module)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	log.Info("checked known modules for new versions",
+		"modules", stats.modules.Load(), "queued", stats.queued.Load(), "failed", stats.failed.Load())
+	if n := stats.failed.Load(); n > 0 {
+		return fmt.Errorf("%d of %d module(s) could not be checked for new versions", n, stats.modules.Load())
+	}
+	return nil
+}
+
+// enqueueNewVersions adds an ingestion job for each version of mod that the Go module proxy reports as
+// being newer than latest, returning the number of jobs that were added.
+func (s *connectServer) enqueueNewVersions(ctx context.Context, limiter *rate.Limiter, mod, latest string, includePrerelease bool) (int, error) {
+	if err := limiter.Wait(ctx); err != nil {
+		return 0, err
+	}
+	available, err := s.proxy.GetModuleVersions(mod)
+	if err != nil {
+		return 0, err
+	}
+	var newer []string
+	for _, v := range available {
+		if !semver.IsValid(v) || semver.Compare(v, latest) <= 0 {
+			continue
+		}
+		if semver.Prerelease(v) != "" && !includePrerelease {
+			continue
+		}
+		newer = append(newer, v)
+	}
+	if len(newer) == 0 {
+		return 0, nil
+	}
+	semver.Sort(newer)
+
+	jobs := make([]store.Version, len(newer))
+	for i, v := range newer {
+		jobs[i] = store.Version{ModuleID: mod, SemVer: v}
+	}
+	if _, err := s.store.EnqueueIngestionJobs(ctx, jobTypeDiscoverVersions, jobs...); err != nil {
+		return 0, fmt.Errorf("unable to queue the new versions of %s: %w", mod, err)
+	}
+	log.Info("queued new module versions for ingestion", "module", mod, "versions", newer)
+	return len(newer), nil
+}
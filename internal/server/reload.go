@@ -0,0 +1,151 @@
+package server
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/CrowdStrike/perseus/internal/policy"
+)
+
+// DebugSetter is an optional interface that a [Logger] can implement to allow the server to change the
+// log verbosity at runtime when the configuration file is reloaded.
+type DebugSetter interface {
+	SetDebug(enabled bool)
+}
+
+// liveConfig holds the server settings that can be changed at runtime by sending the process a SIGHUP
+// signal.  All other settings, such as the listen address and database connection, require a restart.
+type liveConfig struct {
+	healthzTimeout atomic.Int64
+	// the dependency policy, which is nil if no policy file is configured
+	policy atomic.Pointer[policy.Policy]
+	// controls which API requests are written to the access log, all requests are logged if nil
+	accessLog atomic.Pointer[accessLogSampling]
+	// limits the requests accepted by the module proxy hooks, which is created by the first call to apply
+	// and updated in place by later ones
+	hookLimiter *rate.Limiter
+}
+
+// healthCheckTimeout returns the current timeout for the /healthz endpoint.
+func (lc *liveConfig) healthCheckTimeout() time.Duration {
+	return time.Duration(lc.healthzTimeout.Load())
+}
+
+// currentPolicy returns the current dependency policy, or nil if no policy file is configured.
+func (lc *liveConfig) currentPolicy() *policy.Policy {
+	if lc == nil {
+		return nil
+	}
+	return lc.policy.Load()
+}
+
+// currentAccessLogSampling returns the current access log sampling settings, or nil if all requests
+// should be logged.
+func (lc *liveConfig) currentAccessLogSampling() *accessLogSampling {
+	if lc == nil {
+		return nil
+	}
+	return lc.accessLog.Load()
+}
+
+// hookRateLimiter returns the limiter for the requests accepted by the module proxy hooks.
+func (lc *liveConfig) hookRateLimiter() *rate.Limiter {
+	return lc.hookLimiter
+}
+
+// loadPolicy replaces the current dependency policy with the one defined in the file at path.  The
+// current policy is kept if the file cannot be loaded.
+func (lc *liveConfig) loadPolicy(path string) error {
+	if path == "" {
+		lc.policy.Store(nil)
+		return nil
+	}
+	p, err := policy.Load(path)
+	if err != nil {
+		return err
+	}
+	lc.policy.Store(p)
+	log.Info("loaded the dependency policy", "path", path, "rules", len(p.Rules))
+	return nil
+}
+
+// apply updates the live settings from conf.
+func (lc *liveConfig) apply(conf serverConfig) {
+	if conf.healthzTimeout > 0 {
+		lc.healthzTimeout.Store(int64(conf.healthzTimeout))
+	}
+	if conf.accessLogSamplePercent != nil || conf.accessLogSlowThreshold != nil {
+		sampling := accessLogSampling{samplePercent: defaultAccessLogSamplePercent, slowThreshold: defaultAccessLogSlowThreshold}
+		if current := lc.accessLog.Load(); current != nil {
+			sampling = *current
+		}
+		if conf.accessLogSamplePercent != nil {
+			sampling.samplePercent = *conf.accessLogSamplePercent
+		}
+		if conf.accessLogSlowThreshold != nil {
+			sampling.slowThreshold = *conf.accessLogSlowThreshold
+		}
+		lc.accessLog.Store(&sampling)
+	}
+	limit := hookRateLimit(conf)
+	if lc.hookLimiter == nil {
+		lc.hookLimiter = rate.NewLimiter(rate.Limit(limit), hookRateBurst(limit))
+	} else {
+		lc.hookLimiter.SetLimit(rate.Limit(limit))
+		lc.hookLimiter.SetBurst(hookRateBurst(limit))
+	}
+	if conf.debugMode != nil {
+		if ds, ok := log.(DebugSetter); ok {
+			ds.SetDebug(*conf.debugMode)
+		}
+	}
+}
+
+// reload re-reads the configuration file, if one was specified at startup, and applies any settings that
+// can be changed at runtime.  Settings from environment variables and CLI flags keep their precedence
+// over the file.  A warning is logged for any changed settings that require a restart.
+func (lc *liveConfig) reload(current serverConfig) error {
+	if current.configFile == "" {
+		log.Info("no configuration file was specified at startup, ignoring reload request")
+		return nil
+	}
+	opts, err := readServerConfigFile(current.configFile)
+	if err != nil {
+		return err
+	}
+	// settings from environment variables and CLI flags take precedence over the config file
+	opts = append(opts, current.overrides...)
+	var conf serverConfig
+	for _, fn := range opts {
+		if err := fn(&conf); err != nil {
+			return fmt.Errorf("could not apply service config option: %w", err)
+		}
+	}
+
+	restartRequired := map[string]bool{
+		"listen-addr": conf.listenAddr != "" && conf.listenAddr != current.listenAddr,
+		"db-addr":     conf.dbAddr != "" && conf.dbAddr != current.dbAddr,
+		"db-user":     conf.dbUser != "" && conf.dbUser != current.dbUser,
+		"db-pass":     conf.dbPwd != "" && conf.dbPwd != current.dbPwd,
+		"db-name":     conf.dbName != "" && conf.dbName != current.dbName,
+	}
+	for k, changed := range restartRequired {
+		if changed {
+			log.Info("ignoring changed setting that requires a restart", "setting", k)
+		}
+	}
+
+	lc.apply(conf)
+	// the policy file is re-read even if its path did not change so that rule changes take effect
+	if conf.policyFile == "" {
+		conf.policyFile = current.policyFile
+	}
+	if err := lc.loadPolicy(conf.policyFile); err != nil {
+		return err
+	}
+	log.Info("reloaded server configuration", "path", current.configFile, "healthzTimeout", lc.healthCheckTimeout().String())
+	return nil
+}
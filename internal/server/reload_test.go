@@ -0,0 +1,108 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+// debugTestLogger is a [Logger] that records the log verbosity that the server sets
+type debugTestLogger struct {
+	nopLogger
+	debug bool
+}
+
+func (l *debugTestLogger) SetDebug(enabled bool) { l.debug = enabled }
+
+func TestReloadPrecedence(t *testing.T) {
+	logger := &debugTestLogger{}
+	prev := log
+	log = logger
+	t.Cleanup(func() { log = prev })
+
+	path := filepath.Join(t.TempDir(), "server.yaml")
+	writeConfig := func(contents string) {
+		if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeConfig("debug: true\nhealthz-timeout: 1s\naccess-log-sample-percent: 50\naccess-log-slow-threshold: 2s\n")
+	t.Setenv("HEALTHZ_TIMEOUT", "500ms")
+
+	fset := CreateServerCommand(nil, "").Flags()
+	// --debug is a persistent flag of the root command
+	fset.Bool("debug", false, "")
+	if err := fset.Parse([]string{"--config", path, "--debug=false", "--access-log-sample-percent", "10"}); err != nil {
+		t.Fatal(err)
+	}
+	opts, err := readServerConfig(fset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var conf serverConfig
+	for _, fn := range opts {
+		if err := fn(&conf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	live := &liveConfig{}
+	live.apply(conf)
+
+	check := func(when string, slowThreshold time.Duration) {
+		assert.False(t, logger.debug, "the --debug flag should override the config file %s", when)
+		assert.Equal(t, 500*time.Millisecond, live.healthCheckTimeout(), "$HEALTHZ_TIMEOUT should override the config file %s", when)
+		assert.Equal(t, &accessLogSampling{samplePercent: 10, slowThreshold: slowThreshold}, live.currentAccessLogSampling(),
+			"the --access-log-sample-percent flag should override the config file %s", when)
+	}
+	check("at startup", 2*time.Second)
+
+	writeConfig("debug: true\nhealthz-timeout: 3s\naccess-log-sample-percent: 75\naccess-log-slow-threshold: 4s\n")
+	if err := live.reload(conf); err != nil {
+		t.Fatal(err)
+	}
+	check("when it is reloaded", 4*time.Second)
+}
+
+func TestReloadHookRateLimit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.yaml")
+	writeConfig := func(contents string) {
+		if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeConfig("hook-rate-limit: 2.5\n")
+
+	opts, err := readServerConfigFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := serverConfig{configFile: path}
+	for _, fn := range opts {
+		if err := fn(&conf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	live := &liveConfig{}
+	live.apply(conf)
+	limiter := live.hookRateLimiter()
+	assert.Equal(t, rate.Limit(2.5), limiter.Limit())
+	assert.Equal(t, 3, limiter.Burst())
+
+	writeConfig("hook-rate-limit: 20\n")
+	if err := live.reload(conf); err != nil {
+		t.Fatal(err)
+	}
+	assert.Same(t, limiter, live.hookRateLimiter(), "the limiter used by the hook handler should be updated in place")
+	assert.Equal(t, rate.Limit(20), limiter.Limit())
+	assert.Equal(t, 20, limiter.Burst())
+
+	writeConfig("debug: false\n")
+	if err := live.reload(conf); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, rate.Limit(defaultHookRateLimit), limiter.Limit(), "removing the setting should restore the default")
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/CrowdStrike/perseus/internal/headers"
+)
+
+// validRequestID matches the caller-provided request IDs that are accepted.  Other values are replaced
+// so that callers cannot inject arbitrary content into the logs or send very large headers.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// requestIDKey is the context key for the request ID
+type requestIDKey struct{}
+
+// requestIDFromContext returns the request ID attached to ctx, if any.
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// requestLogger returns a [Logger] that includes the request ID attached to ctx, if any, in every
+// log entry.
+func requestLogger(ctx context.Context) Logger {
+	id := requestIDFromContext(ctx)
+	if id == "" {
+		return log
+	}
+	return kvLogger{next: log, kvs: []any{"requestID", id}}
+}
+
+// kvLogger is a [Logger] that adds a fixed set of key/value attributes to every log entry.
+type kvLogger struct {
+	next Logger
+	kvs  []any
+}
+
+func (l kvLogger) Info(msg string, kvs ...any) { l.next.Info(msg, append(kvs, l.kvs...)...) }
+
+func (l kvLogger) Debug(msg string, kvs ...any) { l.next.Debug(msg, append(kvs, l.kvs...)...) }
+
+func (l kvLogger) Error(err error, msg string, kvs ...any) {
+	l.next.Error(err, msg, append(kvs, l.kvs...)...)
+}
+
+// requestIDInterceptor is a [connect.Interceptor] that assigns or propagates the request ID for each
+// RPC and emits a structured access log entry when the call completes, subject to the current access log
+// sampling settings.
+type requestIDInterceptor struct {
+	live *liveConfig
+}
+
+// ensure the interceptor satisfies the Connect interface
+var _ connect.Interceptor = requestIDInterceptor{}
+
+// WrapUnary satisfies the [connect.Interceptor] interface and handles unary RPCs.
+func (ri requestIDInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		id := ensureRequestID(req.Header().Get(headers.RequestID))
+		ctx = context.WithValue(ctx, requestIDKey{}, id)
+
+		resp, err := next(ctx, req)
+		if resp != nil {
+			resp.Header().Set(headers.RequestID, id)
+		}
+		var cerr *connect.Error
+		if err != nil {
+			cerr = asConnectError(err)
+			cerr.Meta().Set(headers.RequestID, id)
+			err = cerr
+		}
+		logAccess(ctx, ri.live.currentAccessLogSampling(), req.Spec().Procedure, req.Peer(), start, cerr)
+		return resp, err
+	}
+}
+
+// WrapStreamingClient satisfies the [connect.Interceptor] interface.  This is a no-op because the
+// interceptor is only used server-side.
+func (requestIDInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler satisfies the [connect.Interceptor] interface and handles streaming RPCs.
+func (ri requestIDInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		id := ensureRequestID(conn.RequestHeader().Get(headers.RequestID))
+		ctx = context.WithValue(ctx, requestIDKey{}, id)
+		conn.ResponseHeader().Set(headers.RequestID, id)
+
+		err := next(ctx, conn)
+		var cerr *connect.Error
+		if err != nil {
+			cerr = asConnectError(err)
+			err = cerr
+		}
+		logAccess(ctx, ri.live.currentAccessLogSampling(), conn.Spec().Procedure, conn.Peer(), start, cerr)
+		return err
+	}
+}
+
+// ensureRequestID returns id if it is a valid request ID or a newly generated ID if it is empty or invalid.
+func ensureRequestID(id string) string {
+	if validRequestID.MatchString(id) {
+		return id
+	}
+	return uuid.NewString()
+}
+
+// asConnectError returns err as a [connect.Error], wrapping it if necessary.
+func asConnectError(err error) *connect.Error {
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		return cerr
+	}
+	return connect.NewError(connect.CodeOf(err), err)
+}
+
+// logAccess writes the access log entry for a completed RPC if it is selected by sampling.  Entries for
+// sampled requests include the sample percentage so that request volumes can be estimated from the logs.
+func logAccess(ctx context.Context, sampling *accessLogSampling, procedure string, peer connect.Peer, start time.Time, err *connect.Error) {
+	elapsed := time.Since(start)
+	ok, slow := sampling.shouldLog(elapsed, err != nil)
+	if !ok {
+		return
+	}
+	status := "ok"
+	if err != nil {
+		status = err.Code().String()
+	}
+	kvs := []any{
+		"method", procedure,
+		"duration", elapsed.String(),
+		"status", status,
+		"peer", peer.Addr,
+		"protocol", peer.Protocol,
+	}
+	switch {
+	case slow:
+		kvs = append(kvs, "slow", true)
+	case err == nil && sampling != nil && sampling.samplePercent < 100:
+		kvs = append(kvs, "samplePercent", sampling.samplePercent)
+	}
+	requestLogger(ctx).Info("access", kvs...)
+}
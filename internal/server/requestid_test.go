@@ -0,0 +1,21 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnsureRequestID(t *testing.T) {
+	for _, id := range []string{"abc-123", "4f1c2b7e-9d0a-4c53-8e2f-0b6a1d3c5e7f", "trace.span_1", strings.Repeat("a", 128)} {
+		assert.Equal(t, id, ensureRequestID(id), "a valid request ID should be used as-is")
+	}
+	for _, id := range []string{"", "abc\nlevel=ERROR msg=injected", "a b", `"quoted"`, strings.Repeat("a", 129)} {
+		got := ensureRequestID(id)
+		assert.NotEqual(t, id, got, "an invalid request ID should be replaced")
+		_, err := uuid.Parse(got)
+		assert.NoError(t, err, "the replacement should be a generated UUID")
+	}
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// ResolveModuleSet computes the build list of the specified module version by running Minimal Version
+// Selection over the stored requirements, plus any proposed upgrades.
+func (s *connectServer) ResolveModuleSet(ctx context.Context, req *connect.Request[perseusapi.ResolveModuleSetRequest]) (*connect.Response[perseusapi.ResolveModuleSetResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("ResolveModuleSet() called", "request", msg.String())
+
+	modName := msg.GetModuleName()
+	modVer, err := canonicalModuleVersion("module_name", modName, msg.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	root := module.Version{Path: modName, Version: modVer}
+	var upgrades []module.Version
+	for _, u := range msg.GetUpgrades() {
+		path, ver, _ := strings.Cut(u, "@")
+		if path == modName {
+			return nil, newInvalidArgumentError("invalid upgrade "+u, fieldViolation("upgrades", "the resolved module cannot upgrade itself"))
+		}
+		cv, err := canonicalModuleVersion("upgrades", path, ver)
+		if err != nil {
+			return nil, err
+		}
+		upgrades = append(upgrades, module.Version{Path: path, Version: cv})
+	}
+	nodes, err := s.walkDependencies(ctx, root, 0, upgrades...)
+	if err != nil {
+		log.Error(err, "unable to walk the requirement graph", "module", modName, "version", modVer)
+		return nil, storeError(err, "unable to query the graph")
+	}
+
+	resp := perseusapi.ResolveModuleSetResponse{}
+	for _, sel := range buildList(nodes) {
+		mod := nodes[sel.node].mod
+		resp.Modules = append(resp.Modules, &perseusapi.ResolveModuleSetResponse_SelectedModule{
+			Module:             &perseusapi.Module{Name: mod.Path, Versions: []string{mod.Version}},
+			Path:               nodes.pathTo(sel.node),
+			SupersededVersions: sel.superseded,
+		})
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// selectedVersion is the version of a module that is selected by [buildList]
+type selectedVersion struct {
+	// the index of the selected module version in the walk
+	node int
+	// the lower versions of the same module that were found by the walk, in descending order
+	superseded []string
+}
+
+// buildList applies Minimal Version Selection to the module versions in nodes, which are every version
+// that is reachable from the root, by selecting the highest version of each module.  Like the go command,
+// the root is always selected even if a dependency requires another version of it.  The result is the root
+// followed by the selected version of every other module, ordered by module path.
+func buildList(nodes dependencyWalk) []selectedVersion {
+	if len(nodes) == 0 {
+		return nil
+	}
+	rootPath := nodes[0].mod.Path
+	selected := map[string]*selectedVersion{rootPath: {node: 0}}
+	// group the versions of each module, other than the root, then select the highest
+	versions := make(map[string][]int)
+	for i, n := range nodes[1:] {
+		if n.mod.Path != rootPath {
+			versions[n.mod.Path] = append(versions[n.mod.Path], i+1)
+		}
+	}
+	for path, idxs := range versions {
+		sort.Slice(idxs, func(i, j int) bool {
+			return semver.Compare(nodes[idxs[i]].mod.Version, nodes[idxs[j]].mod.Version) > 0
+		})
+		sel := &selectedVersion{node: idxs[0]}
+		for _, i := range idxs[1:] {
+			sel.superseded = append(sel.superseded, nodes[i].mod.Version)
+		}
+		selected[path] = sel
+	}
+
+	result := make([]selectedVersion, 0, len(selected))
+	result = append(result, *selected[rootPath])
+	delete(selected, rootPath)
+	paths := make([]string, 0, len(selected))
+	for p := range selected {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		result = append(result, *selected[p])
+	}
+	return result
+}
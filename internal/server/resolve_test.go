@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// requirementsTestStore implements the subset of [store.Store] that is used by [connectServer.walkDependencies]
+type requirementsTestStore struct {
+	store.Store
+	// the requirements of each module version, ex: "example.com/a@v1.0.0" => ["example.com/b@v1.2.0"]
+	reqs map[string][]string
+}
+
+func (s *requirementsTestStore) GetDependees(_ context.Context, id, version string, _ time.Time, _ string, _ int) ([]store.Version, string, error) {
+	var deps []store.Version
+	for _, r := range s.reqs[id+"@v"+version] {
+		path, ver, _ := strings.Cut(r, "@")
+		deps = append(deps, store.Version{ModuleID: path, SemVer: strings.TrimPrefix(ver, "v")})
+	}
+	return deps, "", nil
+}
+
+func TestResolveModuleSet(t *testing.T) {
+	s := &connectServer{store: &requirementsTestStore{reqs: map[string][]string{
+		"example.com/app@v1.0.0": {"example.com/a@v1.1.0", "example.com/b@v1.0.0"},
+		"example.com/a@v1.1.0":   {"example.com/c@v1.2.0"},
+		"example.com/b@v1.0.0":   {"example.com/c@v1.3.0", "example.com/app@v0.9.0"},
+		"example.com/c@v1.2.0":   {"example.com/d@v1.1.0"},
+		"example.com/c@v1.3.0":   {"example.com/d@v1.0.0", "example.com/b@v1.0.0"},
+	}}}
+
+	resp, err := s.ResolveModuleSet(context.Background(), connect.NewRequest(&perseusapi.ResolveModuleSetRequest{
+		ModuleName: "example.com/app",
+		Version:    "v1.0.0",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	type selection struct {
+		mod        string
+		path       []string
+		superseded []string
+	}
+	var got []selection
+	for _, m := range resp.Msg.GetModules() {
+		got = append(got, selection{
+			mod:        m.GetModule().GetName() + "@" + m.GetModule().GetVersions()[0],
+			path:       m.GetPath(),
+			superseded: m.GetSupersededVersions(),
+		})
+	}
+	want := []selection{
+		{mod: "example.com/app@v1.0.0", path: []string{"example.com/app@v1.0.0"}},
+		{mod: "example.com/a@v1.1.0", path: []string{"example.com/app@v1.0.0", "example.com/a@v1.1.0"}},
+		{mod: "example.com/b@v1.0.0", path: []string{"example.com/app@v1.0.0", "example.com/b@v1.0.0"}},
+		{
+			mod:        "example.com/c@v1.3.0",
+			path:       []string{"example.com/app@v1.0.0", "example.com/b@v1.0.0", "example.com/c@v1.3.0"},
+			superseded: []string{"v1.2.0"},
+		},
+		{
+			// the requirements of superseded versions are also considered, as they are by the go command
+			mod:        "example.com/d@v1.1.0",
+			path:       []string{"example.com/app@v1.0.0", "example.com/a@v1.1.0", "example.com/c@v1.2.0", "example.com/d@v1.1.0"},
+			superseded: []string{"v1.0.0"},
+		},
+	}
+	assert.Equal(t, want, got, "the root should be selected even though a dependency requires an older version of it")
+}
+
+func TestResolveModuleSetWithUpgrades(t *testing.T) {
+	s := &connectServer{store: &requirementsTestStore{reqs: map[string][]string{
+		"example.com/app@v1.0.0": {"example.com/a@v1.1.0", "example.com/b@v1.0.0"},
+		"example.com/a@v1.1.0":   {"example.com/c@v1.2.0"},
+		"example.com/a@v1.2.0":   {"example.com/c@v1.4.0", "example.com/e@v0.3.0"},
+		"example.com/b@v1.0.0":   {"example.com/c@v1.3.0"},
+	}}}
+
+	resp, err := s.ResolveModuleSet(context.Background(), connect.NewRequest(&perseusapi.ResolveModuleSetRequest{
+		ModuleName: "example.com/app",
+		Version:    "v1.0.0",
+		Upgrades:   []string{"example.com/a@v1.2.0", "example.com/b@v0.9.0"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, m := range resp.Msg.GetModules() {
+		got = append(got, m.GetModule().GetName()+"@"+m.GetModule().GetVersions()[0])
+	}
+	want := []string{
+		"example.com/app@v1.0.0",
+		"example.com/a@v1.2.0",
+		// a lower proposed version does not downgrade the dependency
+		"example.com/b@v1.0.0",
+		"example.com/c@v1.4.0",
+		"example.com/e@v0.3.0",
+	}
+	assert.Equal(t, want, got)
+
+	_, err = s.ResolveModuleSet(context.Background(), connect.NewRequest(&perseusapi.ResolveModuleSetRequest{
+		ModuleName: "example.com/app",
+		Version:    "v1.0.0",
+		Upgrades:   []string{"example.com/app@v1.1.0"},
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "upgrading the resolved module should be rejected")
+}
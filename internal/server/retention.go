@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+)
+
+// defaultRetentionSchedule is the schedule for the pre-release and deleted module retention jobs that are
+// created when a retention period is configured but the jobs configuration does not include the job
+const defaultRetentionSchedule = "@daily"
+
+// prunePrereleases removes superseded pre-release versions that are more than days old
+func prunePrereleases(ctx context.Context, db store.Store, days int) error {
+	cutoff := time.Now().AddDate(0, 0, -days)
+	n, err := db.PrunePrereleaseVersions(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	log.Info("pruned pre-release versions", "count", n, "cutoff", cutoff)
+	return nil
+}
+
+// purgeDeleted permanently removes the tombstones of modules and module versions that were deleted more
+// than days ago, after which they can no longer be restored
+func purgeDeleted(ctx context.Context, db store.Store, days int) error {
+	cutoff := time.Now().AddDate(0, 0, -days)
+	n, err := db.PurgeDeletedModules(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	log.Info("purged deleted modules", "count", n, "cutoff", cutoff)
+	return nil
+}
+
+// purgeIdempotencyKeys removes the idempotency keys that are older than [idempotencyKeyTTL]
+func purgeIdempotencyKeys(ctx context.Context, db store.Store) error {
+	cutoff := time.Now().Add(-idempotencyKeyTTL)
+	n, err := db.PurgeIdempotencyKeys(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	log.Info("purged idempotency keys", "count", n, "cutoff", cutoff)
+	return nil
+}
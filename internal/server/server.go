@@ -15,18 +15,28 @@ import (
 	"connectrpc.com/connect"
 	"connectrpc.com/otelconnect"
 	"connectrpc.com/vanguard"
+	promclient "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 
+	"github.com/CrowdStrike/perseus/internal/compress"
+	"github.com/CrowdStrike/perseus/internal/depsdev"
+	"github.com/CrowdStrike/perseus/internal/modproxy"
+	"github.com/CrowdStrike/perseus/internal/osv"
 	"github.com/CrowdStrike/perseus/internal/store"
 	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
 )
 
+// proxyRequestTimeout is the time limit for each HTTP request the server makes to a Go module proxy
+const proxyRequestTimeout = 30 * time.Second
+
 // Logger defines the required behavior for the service's logger.  This type is defined here so that the server
 // implementation is not tied to any specified logging library.
 type Logger interface {
@@ -52,11 +62,18 @@ func (nopLogger) Error(error, string, ...any) { /* no-op */ }
 // overridden by [CreateServerCommand]
 var log Logger = nopLogger{}
 
-// CreateServerCommand initializes and returns a *cobra.Command that implements the 'server' CLI sub-command
-func CreateServerCommand(logger Logger) *cobra.Command {
+// the version of the server, which is reported by the GetServerInfo RPC, overridden by [CreateServerCommand]
+var buildVersion = "v0.0.0-dev"
+
+// CreateServerCommand initializes and returns a *cobra.Command that implements the 'server' CLI sub-command.
+// The version is reported to clients so that they can detect incompatible versions.
+func CreateServerCommand(logger Logger, version string) *cobra.Command {
 	if logger != nil {
 		log = logger
 	}
+	if version != "" {
+		buildVersion = version
+	}
 
 	cmd := cobra.Command{
 		Use:          "server",
@@ -65,26 +82,70 @@ func CreateServerCommand(logger Logger) *cobra.Command {
 		SilenceUsage: true,
 	}
 	fset := cmd.Flags()
-	fset.String("listen-addr", ":31138", "the TCP address to listen on")
+	fset.String("listen-addr", defaultListenAddr, "the TCP address to listen on")
 	fset.String("db-addr", "", "the TCP host and port of the Perseus DB")
 	fset.String("db-user", "", "the login to be used when connecting to the Perseus DB")
 	fset.String("db-pass", "", "the password to be used when connecting to the Perseus DB")
 	fset.String("db-name", defaultDbName, "the name of the Perseus DB to connect to")
+	fset.Int("prerelease-retention-days", 0, "the number of days to keep pre-release versions that have been superseded by a stable release, 0 to keep them forever (default is $PRERELEASE_RETENTION_DAYS environment variable)")
+	fset.Int("deleted-retention-days", 0, "the number of days that deleted modules and module versions can be restored, 0 to keep them forever (default is $DELETED_RETENTION_DAYS environment variable)")
+	fset.String("jobs-config", "", "the path to a YAML file that defines scheduled maintenance jobs (default is $JOBS_CONFIG environment variable)")
+	fset.Int("ingest-workers", defaultIngestionWorkers, "the number of workers that process the ingestion queue, 0 to disable processing (default is $INGEST_WORKERS environment variable)")
+	fset.String("proxy-cache-dir", "", "the path to a folder used to cache Go module proxy responses, caching is disabled if not set (default is $PROXY_CACHE_DIR environment variable)")
+	fset.String("policy-file", "", "the path to a YAML file that defines the dependency policy evaluated by the CheckPolicy API (default is $POLICY_FILE environment variable)")
+	fset.String("depsdev-url", "", "the base URL of the deps.dev API, ex: "+depsdev.DefaultURL+", used to enrich query results with license, advisory and Scorecard data, enrichment is disabled if not set (default is $DEPSDEV_URL environment variable)")
+	fset.String("osv-url", "", "the base URL of the OSV API, ex: "+osv.DefaultURL+", used to look up the vulnerabilities that affect modules, lookups are disabled if not set (default is $OSV_URL environment variable)")
+	fset.StringSlice("metrics-module-prefixes", nil, "the module path prefixes, ex: github.com/CrowdStrike, for which the number of modules is exported as a Prometheus metric, by host if not set (default is $METRICS_MODULE_PREFIXES environment variable)")
+	fset.String("admin-token", "", "the bearer token that grants access to the administrative APIs and the admin console, which are disabled if neither this nor OIDC admins are set (default is $ADMIN_TOKEN environment variable)")
+	fset.Bool("insecure-admin", false, "allow anonymous calls to the administrative APIs if no admin token or OIDC admins are set, for local development only (default is $INSECURE_ADMIN environment variable)")
+	fset.Float64("hook-rate-limit", defaultHookRateLimit, "the maximum number of requests per second accepted by the /hooks/athens endpoint, which responds '429 Too Many Requests' to any others (default is $HOOK_RATE_LIMIT environment variable)")
+	fset.String("oidc-issuer", "", "the issuer URL of the OIDC provider used to log in to the web UI, ex: https://accounts.google.com, the web UI does not require a login if not set (default is $OIDC_ISSUER environment variable)")
+	fset.String("oidc-client-id", "", "the OIDC client ID of the web UI (default is $OIDC_CLIENT_ID environment variable)")
+	fset.String("oidc-client-secret", "", "the OIDC client secret of the web UI (default is $OIDC_CLIENT_SECRET environment variable)")
+	fset.String("oidc-redirect-url", "", "the external URL of the server's OIDC callback, ex: https://perseus.example.com"+uiCallbackPath+" (default is $OIDC_REDIRECT_URL environment variable)")
+	fset.StringSlice("oidc-admins", nil, "the email addresses of the web UI users who can use the administrative APIs and the admin console (default is $OIDC_ADMINS environment variable)")
+	fset.String("session-key", "", "the secret, at least 32 characters, used to sign web UI session cookies, a random key is generated at startup if not set (default is $SESSION_KEY environment variable)")
+	fset.StringSlice("compression", nil, "the algorithms, gzip and/or zstd, that API clients can use to compress requests and responses, or none to disable compression (default is $COMPRESSION environment variable or gzip,zstd)")
+	fset.Float64("access-log-sample-percent", defaultAccessLogSamplePercent, "the percentage, from 0 to 100, of successful API requests that are written to the access log, failed requests are always logged (default is $ACCESS_LOG_SAMPLE_PERCENT environment variable)")
+	fset.Duration("access-log-slow-threshold", defaultAccessLogSlowThreshold, "successful API requests that take at least this long are always written to the access log, 0 to only sample them (default is $ACCESS_LOG_SLOW_THRESHOLD environment variable)")
+	fset.Bool("dev", false, "run against the local database from docker-compose.yml, which must be started with \"docker-compose up\" first, creating its schema and seeding it with demo data if it is empty, and listen on localhost (default is $DEV_MODE environment variable)")
+	fset.Int("dev-seed-modules", defaultDevSeedModules, "the number of generated modules that an empty database is seeded with in dev mode, 0 to not seed it (default is $DEV_SEED_MODULES environment variable)")
+	fset.String("config", "", "the path to a YAML configuration file for the server (default is $CONFIG_FILE environment variable)")
 	return &cmd
 }
 
 // runServerCmd implements the logic for the 'server' CLI sub-command
 func runServerCmd(cmd *cobra.Command, _ []string) error {
-	var opts []serverOption
-	opts = append(opts, readServerConfigEnv()...)
-	opts = append(opts, readServerConfigFlags(cmd.Flags())...)
-
+	opts, err := readServerConfig(cmd.Flags())
+	if err != nil {
+		return err
+	}
 	if err := runServer(opts...); err != nil {
 		return err
 	}
 	return nil
 }
 
+// readServerConfig returns the options from the config file, environment variables, and CLI flags, in
+// increasing order of precedence.
+func readServerConfig(fset *pflag.FlagSet) ([]serverOption, error) {
+	var opts []serverOption
+	// settings from the config file, if any, have the lowest priority so they are applied first
+	if path := configFilePath(fset); path != "" {
+		fileOpts, err := readServerConfigFile(path)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, fileOpts...)
+	}
+	// environment variables override the config file and CLI flags override both.  These are kept so that
+	// they are re-applied over the config file when it is reloaded.
+	overrides := append(readServerConfigEnv(), readServerConfigFlags(fset)...)
+	opts = append(opts, overrides...)
+	opts = append(opts, withOverrides(overrides))
+	return opts, nil
+}
+
 // runServer starts the server with the specified runtime options.
 func runServer(opts ...serverOption) error {
 	// apply and validate runtime options
@@ -94,12 +155,27 @@ func runServer(opts ...serverOption) error {
 			return fmt.Errorf("could not apply service config option: %w", err)
 		}
 	}
+	if conf.listenAddr == "" {
+		conf.listenAddr = defaultListenAddr
+	}
+	if conf.dbName == "" {
+		conf.dbName = defaultDbName
+	}
+	applyDevDefaults(&conf)
 	if conf.dbAddr == "" || conf.dbUser == "" || conf.dbPwd == "" {
 		return fmt.Errorf("the host, user name, and password for the Perseus database must be specified")
 	}
 	if conf.healthzTimeout <= 0 {
 		conf.healthzTimeout = 300 * time.Millisecond
 	}
+	if conf.compression == nil {
+		conf.compression = []string{compress.Gzip, compress.Zstd}
+	}
+	live := &liveConfig{}
+	live.apply(conf)
+	if err := live.loadPolicy(conf.policyFile); err != nil {
+		return err
+	}
 
 	log.Debug("starting the server")
 	// create the root listener
@@ -118,15 +194,58 @@ func runServer(opts ...serverOption) error {
 
 	// connect to the database
 	connStr := fmt.Sprintf("postgres://%s:%s@%s/%s", url.PathEscape(conf.dbUser), url.PathEscape(conf.dbPwd), url.PathEscape(conf.dbAddr), url.PathEscape(conf.dbName))
-	db, err := store.NewPostgresClient(ctx, connStr, store.WithLog(log))
+	pg, err := store.NewPostgresClient(ctx, connStr, store.WithLog(log))
 	if err != nil {
+		if conf.devMode {
+			return fmt.Errorf("could not connect to the database %q at %q: %w (%s)", conf.dbName, conf.dbAddr, err, devDatabaseHint)
+		}
 		return fmt.Errorf("could not connect to the database %q at %q: %w", conf.dbName, conf.dbAddr, err)
 	}
+	// every store call is instrumented, including those made by the background jobs and HTTP handlers
+	db := store.NewInstrumentedStore(pg, log)
 	log.Debug("connected to the database", "addr", conf.dbAddr, "database", conf.dbName, "user", conf.dbUser)
+	if conf.devMode {
+		if err := setupDevDatabase(ctx, pg, db, *conf.devSeedModules); err != nil {
+			return err
+		}
+	}
 
 	// spin up the Connect server
 	svr := &connectServer{
-		store: db,
+		store:       db,
+		live:        live,
+		compression: conf.compression,
+		started:     time.Now(),
+		proxy:       modproxy.NewFromEnv(&http.Client{Timeout: proxyRequestTimeout}).WithCache(conf.proxyCacheDir, modproxy.DefaultListCacheTTL),
+		// rendering is CPU-intensive and unauthenticated, so it is limited server-wide
+		renderLimiter: rate.NewLimiter(renderRateLimit, renderBurst),
+	}
+	if conf.depsDevURL != "" {
+		svr.depsdev = depsdev.New(&http.Client{Timeout: proxyRequestTimeout}, conf.depsDevURL, depsdev.DefaultCacheTTL)
+		log.Debug("enabled deps.dev enrichment", "url", conf.depsDevURL)
+	}
+	if conf.osvURL != "" {
+		svr.osv = osv.New(&http.Client{Timeout: proxyRequestTimeout}, conf.osvURL, osv.DefaultCacheTTL)
+		log.Debug("enabled OSV vulnerability lookups", "url", conf.osvURL)
+	}
+	jobs, err := jobSpecs(conf)
+	if err != nil {
+		return err
+	}
+	svr.jobs, err = newScheduler(ctx, svr, jobs)
+	if err != nil {
+		return fmt.Errorf("unable to initialize the job scheduler: %w", err)
+	}
+	svr.jobs.start()
+	defer svr.jobs.stop()
+	defer func() {
+		if err := svr.graphs.Close(); err != nil {
+			log.Error(err, "unexpected error releasing the Graphviz runtime")
+		}
+	}()
+
+	if err := promclient.Register(newGraphCollector(db, conf.metricsPrefixes)); err != nil {
+		return fmt.Errorf("unable to register the graph metrics: %w", err)
 	}
 	exporter, err := prometheus.New()
 	if err != nil {
@@ -142,13 +261,34 @@ func runServer(opts ...serverOption) error {
 	if err != nil {
 		return fmt.Errorf("unable to initialize metrics interceptor: %w", err)
 	}
+	validator, err := newValidationInterceptor()
+	if err != nil {
+		return err
+	}
+	var sessions *uiAuth
+	if conf.oidcIssuer != "" {
+		sessions, err = initUIAuth(ctx, conf)
+		if err != nil {
+			return err
+		}
+		log.Debug("enabled OIDC login for the web UI", "issuer", conf.oidcIssuer)
+	}
+	auth := authInterceptor{token: conf.adminToken, db: db, sessions: sessions, insecure: conf.insecureAdmin}
+	switch {
+	case auth.enabled():
+	case auth.insecure:
+		log.Info("no admin token or admin users are configured and insecure admin access is enabled, the administrative APIs do not require authentication")
+	default:
+		log.Info("no admin token or admin users are configured, the administrative APIs are disabled")
+	}
+	handlerOpts, transcoderOpts := compressionOptions(conf.compression)
 	path, ch := perseusapiconnect.NewPerseusServiceHandler(
 		svr,
-		connect.WithInterceptors(metricsInterceptor),
+		append(handlerOpts, connect.WithInterceptors(requestIDInterceptor{live: live}, auth, metricsInterceptor, validator, idempotencyInterceptor{db: db}))...,
 	)
 	// spin up the Vanguard server and transcoder for JSON/REST mappings
-	vs := vanguard.NewService(path, ch)
-	vt, err := vanguard.NewTranscoder([]*vanguard.Service{vs})
+	vs := vanguard.NewService(path, ch, vanguard.WithTargetCompression(conf.compression...))
+	vt, err := vanguard.NewTranscoder([]*vanguard.Service{vs}, transcoderOpts...)
 	if err != nil {
 		return fmt.Errorf("unable to initialize Vanguard transcoder: %w", err)
 	}
@@ -156,14 +296,24 @@ func runServer(opts ...serverOption) error {
 	// spin up HTTP server
 	// The supported paths are:
 	//   - /api/v1/* - Vanguard REST mappings for the Connect endpoints
+	//   - /api/v1/grafana/* - Grafana JSON data source endpoints
 	//   - /ui/ - web UI
 	//   - /healthz/ - server health checks
+	//   - /readyz/ - server readiness checks
+	//   - /hooks/athens - notifications of newly fetched module versions from an Athens proxy
 	//   - /metrics/ - Prometheus server metrics
 	//   - /debug/pprof/* - pprof runtime profiles
 	mux := http.NewServeMux()
 	mux.Handle("/", vt)
-	mux.Handle("/ui/", handleUX())
-	mux.Handle("/healthz", handleHealthz(db, conf.healthzTimeout, log))
+	if sessions != nil {
+		mux.Handle("/ui/", sessions.protect(handleUX()))
+	} else {
+		mux.Handle("/ui/", handleUX())
+	}
+	mux.Handle("/api/v1/grafana/", handleGrafana(db, log))
+	mux.Handle("/healthz", handleHealthz(db, live.healthCheckTimeout, log))
+	mux.Handle("/readyz", handleHealthz(db, live.healthCheckTimeout, log))
+	mux.Handle("/hooks/athens", handleAthensHook(auth, db, live.hookRateLimiter(), log))
 	mux.Handle("/metrics", promhttp.Handler())
 	mux.HandleFunc("/debug/pprof/", pprof.Index)
 	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
@@ -184,6 +334,13 @@ func runServer(opts ...serverOption) error {
 		return httpSrv.Serve(lis)
 	})
 
+	if workers := ingestWorkers(conf); workers > 0 {
+		eg.Go(func() error {
+			svr.runIngestionWorkers(ctx, workers)
+			return nil
+		})
+	}
+
 	// handle shutdown
 	eg.Go(func() (err error) {
 		defer func() {
@@ -198,7 +355,10 @@ func runServer(opts ...serverOption) error {
 			case sig := <-sigs:
 				switch sig {
 				case syscall.SIGHUP:
-					log.Debug("Got SIGHUP signal, TODO - reload config")
+					log.Debug("Got SIGHUP signal, reloading config")
+					if err := live.reload(conf); err != nil {
+						log.Error(err, "unable to reload the server configuration", "path", conf.configFile)
+					}
 				default:
 					log.Debug("Got stop signal, shutting down", "signal", sig.String())
 					return nil
@@ -210,6 +370,9 @@ func runServer(opts ...serverOption) error {
 	})
 
 	log.Info("Server listening", "addr", conf.listenAddr)
+	if conf.devMode {
+		log.Info("dev mode: the web UI is available", "url", "http://"+conf.listenAddr+"/ui/")
+	}
 	defer log.Info("Server exited")
 	// wait for shutdown
 	if err := eg.Wait(); err != nil && err != context.Canceled {
@@ -218,3 +381,63 @@ func runServer(opts ...serverOption) error {
 
 	return nil
 }
+
+// jobSpecs returns the scheduled jobs defined by the jobs configuration file, if any, along with a daily
+// pre-release retention job and a daily deleted module retention job if the corresponding retention
+// periods are configured and the file does not define them.  An hourly job that purges expired
+// idempotency keys is always included unless the file defines one.
+func jobSpecs(conf serverConfig) ([]jobSpec, error) {
+	var specs []jobSpec
+	if conf.jobsConfigFile != "" {
+		var err error
+		if specs, err = readJobsConfigFile(conf.jobsConfigFile); err != nil {
+			return nil, err
+		}
+	}
+	defined := make(map[string]bool, len(specs))
+	for _, s := range specs {
+		defined[s.Type] = true
+	}
+	if conf.prereleaseRetentionDays > 0 && !defined[jobTypePrunePrereleases] {
+		specs = append(specs, jobSpec{
+			Name:          jobTypePrunePrereleases,
+			Type:          jobTypePrunePrereleases,
+			Schedule:      defaultRetentionSchedule,
+			RetentionDays: conf.prereleaseRetentionDays,
+		})
+	}
+	if conf.deletedRetentionDays > 0 && !defined[jobTypePurgeDeleted] {
+		specs = append(specs, jobSpec{
+			Name:          jobTypePurgeDeleted,
+			Type:          jobTypePurgeDeleted,
+			Schedule:      defaultRetentionSchedule,
+			RetentionDays: conf.deletedRetentionDays,
+		})
+	}
+	if !defined[jobTypePurgeIdempotencyKeys] {
+		specs = append(specs, jobSpec{
+			Name:     jobTypePurgeIdempotencyKeys,
+			Type:     jobTypePurgeIdempotencyKeys,
+			Schedule: defaultIdempotencyPurgeSchedule,
+		})
+	}
+	return specs, nil
+}
+
+// hookRateLimit returns the configured maximum number of requests per second accepted by the module proxy
+// hooks or the default if no value was provided.
+func hookRateLimit(conf serverConfig) float64 {
+	if conf.hookRateLimit == nil {
+		return defaultHookRateLimit
+	}
+	return *conf.hookRateLimit
+}
+
+// ingestWorkers returns the configured number of ingestion queue workers or the default if no value was
+// provided.
+func ingestWorkers(conf serverConfig) int {
+	if conf.ingestWorkers == nil {
+		return defaultIngestionWorkers
+	}
+	return *conf.ingestWorkers
+}
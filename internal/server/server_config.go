@@ -1,10 +1,16 @@
 package server
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/internal/compress"
 )
 
 const defaultDbName = "perseus"
@@ -15,6 +21,64 @@ type serverConfig struct {
 	dbAddr, dbUser, dbPwd, dbName string
 
 	healthzTimeout time.Duration
+
+	// the number of days to keep superseded pre-release versions, 0 to keep them forever
+	prereleaseRetentionDays int
+	// the number of days that deleted modules can be restored, 0 to keep their tombstones forever
+	deletedRetentionDays int
+	// the path to the YAML file that defines the scheduled maintenance jobs, if any
+	jobsConfigFile string
+	// the number of workers that process the ingestion queue, the default is used if nil
+	ingestWorkers *int
+	// the path to the folder used to cache module proxy responses, if any
+	proxyCacheDir string
+	// the path to the YAML file that defines the dependency policy, if any
+	policyFile string
+	// the base URL of the deps.dev API used to enrich modules, enrichment is disabled if empty
+	depsDevURL string
+	// the base URL of the OSV API used to look up vulnerabilities, lookups are disabled if empty
+	osvURL string
+	// the module path prefixes for which the number of modules is exported as a Prometheus metric, by host
+	// if empty
+	metricsPrefixes []string
+	// the token that grants access to the administrative RPCs
+	adminToken string
+	// allows anonymous calls to the administrative RPCs if there is no admin token and no OIDC admins,
+	// which are otherwise rejected
+	insecureAdmin bool
+	// the maximum number of requests per second accepted by the module proxy hooks, the default is used if
+	// nil
+	hookRateLimit *float64
+	// the OIDC provider and client registration used to log in to the web UI, login is disabled if the
+	// issuer is empty
+	oidcIssuer, oidcClientID, oidcClientSecret, oidcRedirectURL string
+	// the email addresses of the web UI users who can call the administrative RPCs
+	oidcAdmins []string
+	// the secret used to sign web UI session cookies, a random key is generated if empty
+	sessionKey string
+	// the compression algorithms that the API accepts and uses for responses, the default is used if nil
+	// and compression is disabled if empty
+	compression []string
+	// the percentage, from 0 to 100, of successful API requests that are written to the access log, the
+	// default is used if nil
+	accessLogSamplePercent *float64
+	// successful API requests that take at least this long are always written to the access log, 0 to
+	// only sample them, the default is used if nil
+	accessLogSlowThreshold *time.Duration
+	// creates the schema of an empty database, seeds it with demo data, and applies defaults for a local
+	// database and listener
+	devMode bool
+	// the number of generated modules that an empty database is seeded with in dev mode, 0 to not seed it
+	// and the default is used if nil
+	devSeedModules *int
+
+	// the path to the YAML configuration file, if any
+	configFile string
+	// the options read from environment variables and CLI flags, which are re-applied over the settings
+	// in the configuration file when it is reloaded so that they keep their precedence
+	overrides []serverOption
+	// overrides the process-level log verbosity if not nil
+	debugMode *bool
 }
 
 type serverOption func(*serverConfig) error
@@ -67,12 +131,380 @@ func withHealthCheckTimeout(d time.Duration) serverOption {
 	}
 }
 
+func withPrereleaseRetentionDays(days int) serverOption {
+	return func(conf *serverConfig) error {
+		if days < 0 {
+			return fmt.Errorf("the pre-release retention period must not be negative")
+		}
+		conf.prereleaseRetentionDays = days
+		return nil
+	}
+}
+
+func withDeletedRetentionDays(days int) serverOption {
+	return func(conf *serverConfig) error {
+		if days < 0 {
+			return fmt.Errorf("the deleted module retention period must not be negative")
+		}
+		conf.deletedRetentionDays = days
+		return nil
+	}
+}
+
+func withJobsConfigFile(path string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.jobsConfigFile = path
+		return nil
+	}
+}
+
+func withIngestWorkers(n int) serverOption {
+	return func(conf *serverConfig) error {
+		if n < 0 {
+			return fmt.Errorf("the number of ingestion workers must not be negative")
+		}
+		conf.ingestWorkers = &n
+		return nil
+	}
+}
+
+func withProxyCacheDir(dir string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.proxyCacheDir = dir
+		return nil
+	}
+}
+
+func withPolicyFile(path string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.policyFile = path
+		return nil
+	}
+}
+
+func withDepsDevURL(u string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.depsDevURL = u
+		return nil
+	}
+}
+
+func withOSVURL(u string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.osvURL = u
+		return nil
+	}
+}
+
+func withMetricsPrefixes(prefixes []string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.metricsPrefixes = prefixes
+		return nil
+	}
+}
+
+func withAdminToken(token string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.adminToken = token
+		return nil
+	}
+}
+
+func withInsecureAdmin(enabled bool) serverOption {
+	return func(conf *serverConfig) error {
+		conf.insecureAdmin = enabled
+		return nil
+	}
+}
+
+func withHookRateLimit(limit float64) serverOption {
+	return func(conf *serverConfig) error {
+		if limit <= 0 {
+			return fmt.Errorf("the module proxy hook rate limit must be greater than 0")
+		}
+		conf.hookRateLimit = &limit
+		return nil
+	}
+}
+
+func withOIDCIssuer(issuer string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.oidcIssuer = issuer
+		return nil
+	}
+}
+
+func withOIDCClient(id, secret string) serverOption {
+	return func(conf *serverConfig) error {
+		if id != "" {
+			conf.oidcClientID = id
+		}
+		if secret != "" {
+			conf.oidcClientSecret = secret
+		}
+		return nil
+	}
+}
+
+func withOIDCRedirectURL(u string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.oidcRedirectURL = u
+		return nil
+	}
+}
+
+func withOIDCAdmins(emails []string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.oidcAdmins = emails
+		return nil
+	}
+}
+
+func withSessionKey(key string) serverOption {
+	return func(conf *serverConfig) error {
+		if len(key) < 32 {
+			return fmt.Errorf("the session key must be at least 32 characters")
+		}
+		conf.sessionKey = key
+		return nil
+	}
+}
+
+func withConfigFile(path string) serverOption {
+	return func(conf *serverConfig) error {
+		conf.configFile = path
+		return nil
+	}
+}
+
+func withOverrides(opts []serverOption) serverOption {
+	return func(conf *serverConfig) error {
+		conf.overrides = opts
+		return nil
+	}
+}
+
+func withDebugLogging(enabled bool) serverOption {
+	return func(conf *serverConfig) error {
+		conf.debugMode = &enabled
+		return nil
+	}
+}
+
+func withCompression(names []string) serverOption {
+	return func(conf *serverConfig) error {
+		algs, err := compress.ParseNames(names)
+		if err != nil {
+			return err
+		}
+		// use a non-nil slice for "none" so that the default isn't applied
+		conf.compression = append([]string{}, algs...)
+		return nil
+	}
+}
+
+func withAccessLogSamplePercent(percent float64) serverOption {
+	return func(conf *serverConfig) error {
+		if percent < 0 || percent > 100 {
+			return fmt.Errorf("the access log sample percentage must be between 0 and 100")
+		}
+		conf.accessLogSamplePercent = &percent
+		return nil
+	}
+}
+
+func withAccessLogSlowThreshold(d time.Duration) serverOption {
+	return func(conf *serverConfig) error {
+		if d < 0 {
+			d = 0
+		}
+		conf.accessLogSlowThreshold = &d
+		return nil
+	}
+}
+
+func withDevMode(enabled bool) serverOption {
+	return func(conf *serverConfig) error {
+		conf.devMode = enabled
+		return nil
+	}
+}
+
+func withDevSeedModules(n int) serverOption {
+	return func(conf *serverConfig) error {
+		if n < 0 {
+			return fmt.Errorf("the number of dev seed modules must not be negative")
+		}
+		conf.devSeedModules = &n
+		return nil
+	}
+}
+
+// serverConfigFile defines the contents of the YAML configuration file for the server.  The keys
+// match the names of the corresponding CLI flags.
+type serverConfigFile struct {
+	ListenAddr     string `yaml:"listen-addr"`
+	DBAddr         string `yaml:"db-addr"`
+	DBUser         string `yaml:"db-user"`
+	DBPass         string `yaml:"db-pass"`
+	DBName         string `yaml:"db-name"`
+	HealthzTimeout string `yaml:"healthz-timeout"`
+	Debug          *bool  `yaml:"debug"`
+
+	PrereleaseRetentionDays *int     `yaml:"prerelease-retention-days"`
+	DeletedRetentionDays    *int     `yaml:"deleted-retention-days"`
+	JobsConfig              string   `yaml:"jobs-config"`
+	IngestWorkers           *int     `yaml:"ingest-workers"`
+	ProxyCacheDir           string   `yaml:"proxy-cache-dir"`
+	PolicyFile              string   `yaml:"policy-file"`
+	DepsDevURL              string   `yaml:"depsdev-url"`
+	OSVURL                  string   `yaml:"osv-url"`
+	MetricsModulePrefixes   []string `yaml:"metrics-module-prefixes"`
+	AdminToken              string   `yaml:"admin-token"`
+	InsecureAdmin           *bool    `yaml:"insecure-admin"`
+	HookRateLimit           *float64 `yaml:"hook-rate-limit"`
+	OIDCIssuer              string   `yaml:"oidc-issuer"`
+	OIDCClientID            string   `yaml:"oidc-client-id"`
+	OIDCClientSecret        string   `yaml:"oidc-client-secret"`
+	OIDCRedirectURL         string   `yaml:"oidc-redirect-url"`
+	OIDCAdmins              []string `yaml:"oidc-admins"`
+	SessionKey              string   `yaml:"session-key"`
+	Compression             []string `yaml:"compression"`
+	AccessLogSamplePercent  *float64 `yaml:"access-log-sample-percent"`
+	AccessLogSlowThreshold  string   `yaml:"access-log-slow-threshold"`
+	Dev                     *bool    `yaml:"dev"`
+	DevSeedModules          *int     `yaml:"dev-seed-modules"`
+}
+
+// readServerConfigFile loads the YAML configuration file at path and returns a list of 0 or more config
+// options.
+func readServerConfigFile(path string) ([]serverOption, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the server configuration file: %w", err)
+	}
+	var f serverConfigFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("unable to parse the server configuration file %q: %w", path, err)
+	}
+
+	opts := []serverOption{withConfigFile(path)}
+	if f.ListenAddr != "" {
+		opts = append(opts, withListenAddress(f.ListenAddr))
+	}
+	if f.DBAddr != "" {
+		opts = append(opts, withDBAddress(f.DBAddr))
+	}
+	if f.DBUser != "" {
+		opts = append(opts, withDBUser(f.DBUser))
+	}
+	if f.DBPass != "" {
+		opts = append(opts, withDBPass(f.DBPass))
+	}
+	if f.DBName != "" {
+		opts = append(opts, withDBName(f.DBName))
+	}
+	if f.HealthzTimeout != "" {
+		d, err := time.ParseDuration(f.HealthzTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for 'healthz-timeout' in the server configuration file: %w", err)
+		}
+		opts = append(opts, withHealthCheckTimeout(d))
+	}
+	if f.Debug != nil {
+		opts = append(opts, withDebugLogging(*f.Debug))
+	}
+	if f.PrereleaseRetentionDays != nil {
+		opts = append(opts, withPrereleaseRetentionDays(*f.PrereleaseRetentionDays))
+	}
+	if f.DeletedRetentionDays != nil {
+		opts = append(opts, withDeletedRetentionDays(*f.DeletedRetentionDays))
+	}
+	if f.JobsConfig != "" {
+		opts = append(opts, withJobsConfigFile(f.JobsConfig))
+	}
+	if f.IngestWorkers != nil {
+		opts = append(opts, withIngestWorkers(*f.IngestWorkers))
+	}
+	if f.ProxyCacheDir != "" {
+		opts = append(opts, withProxyCacheDir(f.ProxyCacheDir))
+	}
+	if f.PolicyFile != "" {
+		opts = append(opts, withPolicyFile(f.PolicyFile))
+	}
+	if f.DepsDevURL != "" {
+		opts = append(opts, withDepsDevURL(f.DepsDevURL))
+	}
+	if f.OSVURL != "" {
+		opts = append(opts, withOSVURL(f.OSVURL))
+	}
+	if len(f.MetricsModulePrefixes) > 0 {
+		opts = append(opts, withMetricsPrefixes(f.MetricsModulePrefixes))
+	}
+	if f.AdminToken != "" {
+		opts = append(opts, withAdminToken(f.AdminToken))
+	}
+	if f.InsecureAdmin != nil {
+		opts = append(opts, withInsecureAdmin(*f.InsecureAdmin))
+	}
+	if f.HookRateLimit != nil {
+		opts = append(opts, withHookRateLimit(*f.HookRateLimit))
+	}
+	if f.OIDCIssuer != "" {
+		opts = append(opts, withOIDCIssuer(f.OIDCIssuer))
+	}
+	if f.OIDCClientID != "" || f.OIDCClientSecret != "" {
+		opts = append(opts, withOIDCClient(f.OIDCClientID, f.OIDCClientSecret))
+	}
+	if f.OIDCRedirectURL != "" {
+		opts = append(opts, withOIDCRedirectURL(f.OIDCRedirectURL))
+	}
+	if len(f.OIDCAdmins) > 0 {
+		opts = append(opts, withOIDCAdmins(f.OIDCAdmins))
+	}
+	if f.SessionKey != "" {
+		opts = append(opts, withSessionKey(f.SessionKey))
+	}
+	if len(f.Compression) > 0 {
+		opts = append(opts, withCompression(f.Compression))
+	}
+	if f.AccessLogSamplePercent != nil {
+		opts = append(opts, withAccessLogSamplePercent(*f.AccessLogSamplePercent))
+	}
+	if f.AccessLogSlowThreshold != "" {
+		d, err := time.ParseDuration(f.AccessLogSlowThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for 'access-log-slow-threshold' in the server configuration file: %w", err)
+		}
+		opts = append(opts, withAccessLogSlowThreshold(d))
+	}
+	if f.Dev != nil {
+		opts = append(opts, withDevMode(*f.Dev))
+	}
+	if f.DevSeedModules != nil {
+		opts = append(opts, withDevSeedModules(*f.DevSeedModules))
+	}
+	return opts, nil
+}
+
+// configFilePath returns the path to the server configuration file from the CLI flags or, if the flag
+// was not provided, the CONFIG_FILE environment variable.
+func configFilePath(fset *pflag.FlagSet) string {
+	if path, err := fset.GetString("config"); err == nil && path != "" {
+		return path
+	}
+	return os.Getenv("CONFIG_FILE")
+}
+
 func readServerConfigEnv() []serverOption {
 	var opts []serverOption
 
 	if addr := os.Getenv("LISTEN_ADDR"); addr != "" {
 		opts = append(opts, withListenAddress(addr))
 	}
+	if v := os.Getenv("LOG_VERBOSITY"); v != "" {
+		opts = append(opts, withDebugLogging(v == "debug"))
+	}
 
 	if addr := os.Getenv("DB_ADDR"); addr != "" {
 		opts = append(opts, withDBAddress(addr))
@@ -91,6 +523,90 @@ func readServerConfigEnv() []serverOption {
 			opts = append(opts, withHealthCheckTimeout(d))
 		}
 	}
+	if s := os.Getenv("PRERELEASE_RETENTION_DAYS"); s != "" {
+		if days, err := strconv.Atoi(s); err == nil {
+			opts = append(opts, withPrereleaseRetentionDays(days))
+		}
+	}
+	if s := os.Getenv("DELETED_RETENTION_DAYS"); s != "" {
+		if days, err := strconv.Atoi(s); err == nil {
+			opts = append(opts, withDeletedRetentionDays(days))
+		}
+	}
+	if path := os.Getenv("JOBS_CONFIG"); path != "" {
+		opts = append(opts, withJobsConfigFile(path))
+	}
+	if s := os.Getenv("INGEST_WORKERS"); s != "" {
+		if n, err := strconv.Atoi(s); err == nil {
+			opts = append(opts, withIngestWorkers(n))
+		}
+	}
+	if dir := os.Getenv("PROXY_CACHE_DIR"); dir != "" {
+		opts = append(opts, withProxyCacheDir(dir))
+	}
+	if path := os.Getenv("POLICY_FILE"); path != "" {
+		opts = append(opts, withPolicyFile(path))
+	}
+	if u := os.Getenv("DEPSDEV_URL"); u != "" {
+		opts = append(opts, withDepsDevURL(u))
+	}
+	if u := os.Getenv("OSV_URL"); u != "" {
+		opts = append(opts, withOSVURL(u))
+	}
+	if s := os.Getenv("METRICS_MODULE_PREFIXES"); s != "" {
+		opts = append(opts, withMetricsPrefixes(strings.Split(s, ",")))
+	}
+	if token := os.Getenv("ADMIN_TOKEN"); token != "" {
+		opts = append(opts, withAdminToken(token))
+	}
+	if s := os.Getenv("INSECURE_ADMIN"); s != "" {
+		if enabled, err := strconv.ParseBool(s); err == nil {
+			opts = append(opts, withInsecureAdmin(enabled))
+		}
+	}
+	if s := os.Getenv("HOOK_RATE_LIMIT"); s != "" {
+		if limit, err := strconv.ParseFloat(s, 64); err == nil {
+			opts = append(opts, withHookRateLimit(limit))
+		}
+	}
+	if issuer := os.Getenv("OIDC_ISSUER"); issuer != "" {
+		opts = append(opts, withOIDCIssuer(issuer))
+	}
+	if id, secret := os.Getenv("OIDC_CLIENT_ID"), os.Getenv("OIDC_CLIENT_SECRET"); id != "" || secret != "" {
+		opts = append(opts, withOIDCClient(id, secret))
+	}
+	if u := os.Getenv("OIDC_REDIRECT_URL"); u != "" {
+		opts = append(opts, withOIDCRedirectURL(u))
+	}
+	if s := os.Getenv("OIDC_ADMINS"); s != "" {
+		opts = append(opts, withOIDCAdmins(strings.Split(s, ",")))
+	}
+	if key := os.Getenv("SESSION_KEY"); key != "" {
+		opts = append(opts, withSessionKey(key))
+	}
+	if s := os.Getenv("COMPRESSION"); s != "" {
+		opts = append(opts, withCompression(strings.Split(s, ",")))
+	}
+	if s := os.Getenv("ACCESS_LOG_SAMPLE_PERCENT"); s != "" {
+		if percent, err := strconv.ParseFloat(s, 64); err == nil {
+			opts = append(opts, withAccessLogSamplePercent(percent))
+		}
+	}
+	if t := os.Getenv("ACCESS_LOG_SLOW_THRESHOLD"); t != "" {
+		if d, err := time.ParseDuration(t); err == nil {
+			opts = append(opts, withAccessLogSlowThreshold(d))
+		}
+	}
+	if s := os.Getenv("DEV_MODE"); s != "" {
+		if enabled, err := strconv.ParseBool(s); err == nil {
+			opts = append(opts, withDevMode(enabled))
+		}
+	}
+	if s := os.Getenv("DEV_SEED_MODULES"); s != "" {
+		if n, err := strconv.Atoi(s); err == nil {
+			opts = append(opts, withDevSeedModules(n))
+		}
+	}
 
 	return opts
 }
@@ -98,9 +614,17 @@ func readServerConfigEnv() []serverOption {
 func readServerConfigFlags(fset *pflag.FlagSet) []serverOption {
 	var opts []serverOption
 
-	// TODO
-	if addr, err := fset.GetString("listen-addr"); err == nil && addr != "" {
-		opts = append(opts, withListenAddress(addr))
+	// flags with non-empty defaults are only applied if they were set so that they don't override the
+	// config file and environment variables
+	if fset.Changed("listen-addr") {
+		if addr, err := fset.GetString("listen-addr"); err == nil && addr != "" {
+			opts = append(opts, withListenAddress(addr))
+		}
+	}
+	if fset.Changed("debug") {
+		if enabled, err := fset.GetBool("debug"); err == nil {
+			opts = append(opts, withDebugLogging(enabled))
+		}
 	}
 
 	if addr, err := fset.GetString("db-addr"); err == nil && addr != "" {
@@ -112,8 +636,98 @@ func readServerConfigFlags(fset *pflag.FlagSet) []serverOption {
 	if pwd, err := fset.GetString("db-pass"); err == nil && pwd != "" {
 		opts = append(opts, withDBPass(pwd))
 	}
-	if db, err := fset.GetString("db-name"); err == nil && db != "" {
-		opts = append(opts, withDBName(db))
+	if fset.Changed("db-name") {
+		if db, err := fset.GetString("db-name"); err == nil && db != "" {
+			opts = append(opts, withDBName(db))
+		}
+	}
+	if fset.Changed("prerelease-retention-days") {
+		if days, err := fset.GetInt("prerelease-retention-days"); err == nil {
+			opts = append(opts, withPrereleaseRetentionDays(days))
+		}
+	}
+	if fset.Changed("deleted-retention-days") {
+		if days, err := fset.GetInt("deleted-retention-days"); err == nil {
+			opts = append(opts, withDeletedRetentionDays(days))
+		}
+	}
+	if path, err := fset.GetString("jobs-config"); err == nil && path != "" {
+		opts = append(opts, withJobsConfigFile(path))
+	}
+	if fset.Changed("ingest-workers") {
+		if n, err := fset.GetInt("ingest-workers"); err == nil {
+			opts = append(opts, withIngestWorkers(n))
+		}
+	}
+	if dir, err := fset.GetString("proxy-cache-dir"); err == nil && dir != "" {
+		opts = append(opts, withProxyCacheDir(dir))
+	}
+	if path, err := fset.GetString("policy-file"); err == nil && path != "" {
+		opts = append(opts, withPolicyFile(path))
+	}
+	if u, err := fset.GetString("depsdev-url"); err == nil && u != "" {
+		opts = append(opts, withDepsDevURL(u))
+	}
+	if u, err := fset.GetString("osv-url"); err == nil && u != "" {
+		opts = append(opts, withOSVURL(u))
+	}
+	if prefixes, err := fset.GetStringSlice("metrics-module-prefixes"); err == nil && len(prefixes) > 0 {
+		opts = append(opts, withMetricsPrefixes(prefixes))
+	}
+	if token, err := fset.GetString("admin-token"); err == nil && token != "" {
+		opts = append(opts, withAdminToken(token))
+	}
+	if fset.Changed("insecure-admin") {
+		if enabled, err := fset.GetBool("insecure-admin"); err == nil {
+			opts = append(opts, withInsecureAdmin(enabled))
+		}
+	}
+	if fset.Changed("hook-rate-limit") {
+		if limit, err := fset.GetFloat64("hook-rate-limit"); err == nil {
+			opts = append(opts, withHookRateLimit(limit))
+		}
+	}
+	if issuer, err := fset.GetString("oidc-issuer"); err == nil && issuer != "" {
+		opts = append(opts, withOIDCIssuer(issuer))
+	}
+	id, _ := fset.GetString("oidc-client-id")
+	secret, _ := fset.GetString("oidc-client-secret")
+	if id != "" || secret != "" {
+		opts = append(opts, withOIDCClient(id, secret))
+	}
+	if u, err := fset.GetString("oidc-redirect-url"); err == nil && u != "" {
+		opts = append(opts, withOIDCRedirectURL(u))
+	}
+	if admins, err := fset.GetStringSlice("oidc-admins"); err == nil && len(admins) > 0 {
+		opts = append(opts, withOIDCAdmins(admins))
+	}
+	if key, err := fset.GetString("session-key"); err == nil && key != "" {
+		opts = append(opts, withSessionKey(key))
+	}
+	if fset.Changed("compression") {
+		if names, err := fset.GetStringSlice("compression"); err == nil {
+			opts = append(opts, withCompression(names))
+		}
+	}
+	if fset.Changed("access-log-sample-percent") {
+		if percent, err := fset.GetFloat64("access-log-sample-percent"); err == nil {
+			opts = append(opts, withAccessLogSamplePercent(percent))
+		}
+	}
+	if fset.Changed("access-log-slow-threshold") {
+		if d, err := fset.GetDuration("access-log-slow-threshold"); err == nil {
+			opts = append(opts, withAccessLogSlowThreshold(d))
+		}
+	}
+	if fset.Changed("dev") {
+		if enabled, err := fset.GetBool("dev"); err == nil {
+			opts = append(opts, withDevMode(enabled))
+		}
+	}
+	if fset.Changed("dev-seed-modules") {
+		if n, err := fset.GetInt("dev-seed-modules"); err == nil {
+			opts = append(opts, withDevSeedModules(n))
+		}
 	}
 
 	return opts
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CrowdStrike/perseus/internal/compress"
+	"github.com/CrowdStrike/perseus/internal/features"
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// GetServerInfo returns the server's version, the database schema version it requires, and the optional
+// features it supports so that clients can detect incompatible versions.  If requested, it also checks
+// the database and returns statistics about the graph.
+func (s *connectServer) GetServerInfo(ctx context.Context, req *connect.Request[perseusapi.GetServerInfoRequest]) (*connect.Response[perseusapi.GetServerInfoResponse], error) {
+	log := requestLogger(ctx)
+	log.Debug("GetServerInfo() called", "request", req.Msg.String())
+
+	resp := &perseusapi.GetServerInfoResponse{
+		Version:       buildVersion,
+		SchemaVersion: int32(store.SchemaVersion()), //nolint: gosec // the number of migration scripts will never overflow
+		Features:      s.features(),
+		StartTime:     timestamppb.New(s.started),
+	}
+	if !req.Msg.GetIncludeStats() {
+		return connect.NewResponse(resp), nil
+	}
+
+	resp.Database = s.checkDatabase(ctx)
+	if resp.Database.GetHealthy() {
+		stats, err := s.store.GetGraphStats(ctx)
+		if err != nil {
+			log.Error(err, "unable to query the graph stats")
+			return nil, storeError(err, "unable to query the graph stats")
+		}
+		resp.Graph = &perseusapi.GraphStats{
+			Modules:  stats.Modules,
+			Versions: stats.Versions,
+			Edges:    stats.Edges,
+		}
+		if stats.LastIngest.Valid {
+			resp.Graph.LastIngestTime = timestamppb.New(stats.LastIngest.Time)
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// checkDatabase pings the database using the same timeout as the /healthz endpoint
+func (s *connectServer) checkDatabase(ctx context.Context) *perseusapi.DatabaseHealth {
+	ctx, cancel := context.WithTimeout(ctx, s.live.healthCheckTimeout())
+	defer cancel()
+	start := time.Now()
+	err := s.store.Ping(ctx)
+	health := &perseusapi.DatabaseHealth{
+		Healthy: err == nil,
+		Latency: durationpb.New(time.Since(start)),
+	}
+	if err != nil {
+		requestLogger(ctx).Error(err, "the database health check failed")
+		health.Error = err.Error()
+	}
+	return health
+}
+
+// features returns the optional features that the server supports, which includes the RPCs that were added
+// after GetServerInfo and the capabilities that depend on the server's configuration
+func (s *connectServer) features() []string {
+	result := []string{
+		features.StreamEdges, features.RenderGraph, features.IngestionJobs, features.DependentsInRange, features.ResolveModuleSet,
+		features.ResolveUpgrades, features.GhostVersions, features.IdempotencyKeys, features.UpdateValidateOnly,
+		features.BatchUpdates, features.StoreStats,
+	}
+	if slices.Contains(s.compression, compress.Zstd) {
+		result = append(result, features.CompressionZstd)
+	}
+	if s.depsdev != nil {
+		result = append(result, features.Enrichment)
+	}
+	if s.osv != nil {
+		result = append(result, features.Vulnerabilities)
+	}
+	return result
+}
@@ -0,0 +1,377 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/CrowdStrike/perseus/internal/oidc"
+)
+
+const (
+	// sessionCookie holds the signed session of a user who logged in to the web UI
+	sessionCookie = "perseus_session"
+	// loginCookie holds the signed state of an in-progress login
+	loginCookie = "perseus_login"
+	// sessionTTL is how long a web UI session lasts before the user must log in again
+	sessionTTL = 12 * time.Hour
+	// loginTTL is how long the user has to complete a login at the OIDC provider
+	loginTTL = 10 * time.Minute
+	// uiAuthPath is the path prefix of the login, callback, logout, and session endpoints
+	uiAuthPath = "/ui/auth/"
+	// uiCallbackPath is the path that the OIDC provider redirects to after the user logs in
+	uiCallbackPath = uiAuthPath + "callback"
+	// csrfHeader holds the session's CSRF token, which the web UI must send with every administrative
+	// request that is authorized by the session cookie
+	csrfHeader = "X-Perseus-CSRF-Token"
+)
+
+// errNoSession is returned by [uiAuth.session] if the request does not have a valid session cookie
+var errNoSession = errors.New("not logged in")
+
+// uiSession identifies a user who logged in to the web UI
+type uiSession struct {
+	Subject string `json:"sub"`
+	Email   string `json:"email,omitempty"`
+	// whether the provider verified that the user controls the email address, which is required for the
+	// address to grant admin access
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Expires       int64  `json:"exp"`
+	// a random token that is generated at login and returned by the session endpoint.  Other sites can't
+	// read it, so requiring it in the csrfHeader header proves that a request was sent by the web UI.
+	CSRF string `json:"csrf"`
+}
+
+// uiLogin is the state of an in-progress login, which is verified when the OIDC provider redirects back
+type uiLogin struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	ReturnTo string `json:"return"`
+	Expires  int64  `json:"exp"`
+}
+
+// uiAuth protects the web UI with an OIDC login and signed session cookies.  Users whose email addresses are
+// listed as admins can also call the administrative APIs with their session instead of an API key, but only
+// from the web UI's own origin and with the session's CSRF token.
+type uiAuth struct {
+	provider *oidc.Provider
+	// the HMAC key used to sign the session and login cookies
+	key []byte
+	// the email addresses of the users who can call the administrative APIs, in lower case
+	admins map[string]bool
+	// whether cookies are restricted to HTTPS, which is true if the redirect URL uses HTTPS
+	secure bool
+	// the origin of the web UI, ex: https://perseus.example.com, which is taken from the redirect URL
+	origin string
+}
+
+// newUIAuth returns a uiAuth that logs users in with provider.  The redirect URL must refer to the
+// server's OIDC callback endpoint.
+func newUIAuth(provider *oidc.Provider, redirectURL string, key []byte, admins []string) (*uiAuth, error) {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OIDC redirect URL: %w", err)
+	}
+	if u.Path != uiCallbackPath {
+		return nil, fmt.Errorf("the OIDC redirect URL must refer to %s on this server", uiCallbackPath)
+	}
+	if len(key) < 32 {
+		return nil, fmt.Errorf("the session key must be at least 32 bytes")
+	}
+	ua := &uiAuth{
+		provider: provider,
+		key:      key,
+		admins:   make(map[string]bool, len(admins)),
+		secure:   u.Scheme == "https",
+		origin:   u.Scheme + "://" + u.Host,
+	}
+	for _, a := range admins {
+		if a = strings.TrimSpace(a); a != "" {
+			ua.admins[strings.ToLower(a)] = true
+		}
+	}
+	return ua, nil
+}
+
+// initUIAuth discovers the configured OIDC provider and returns a uiAuth that uses it
+func initUIAuth(ctx context.Context, conf serverConfig) (*uiAuth, error) {
+	provider, err := oidc.NewProvider(ctx, &http.Client{Timeout: proxyRequestTimeout}, oidc.Config{
+		Issuer:       conf.oidcIssuer,
+		ClientID:     conf.oidcClientID,
+		ClientSecret: conf.oidcClientSecret,
+		RedirectURL:  conf.oidcRedirectURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the web UI login: %w", err)
+	}
+	var key []byte
+	if conf.sessionKey != "" {
+		sum := sha256.Sum256([]byte(conf.sessionKey))
+		key = sum[:]
+	} else {
+		log.Info("no session key is configured, web UI sessions will not survive a restart or be shared between replicas")
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("unable to generate a session key: %w", err)
+		}
+	}
+	ua, err := newUIAuth(provider, conf.oidcRedirectURL, key, conf.oidcAdmins)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the web UI login: %w", err)
+	}
+	return ua, nil
+}
+
+// protect returns a handler that requires a valid session for every request to next, and that serves the
+// login, callback, logout, and session endpoints.  Requests without a session are redirected to the login
+// endpoint.
+func (ua *uiAuth) protect(next http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(uiAuthPath+"login", ua.handleLogin)
+	mux.HandleFunc(uiCallbackPath, ua.handleCallback)
+	mux.HandleFunc(uiAuthPath+"logout", ua.handleLogout)
+	mux.HandleFunc(uiAuthPath+"session", ua.handleSession)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, uiAuthPath) {
+			mux.ServeHTTP(w, r)
+			return
+		}
+		if _, err := ua.session(r); err != nil {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				http.Error(w, "login required", http.StatusUnauthorized)
+				return
+			}
+			http.Redirect(w, r, uiAuthPath+"login?return="+url.QueryEscape(r.URL.RequestURI()), http.StatusFound)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleLogin starts the login flow by redirecting to the OIDC provider
+func (ua *uiAuth) handleLogin(w http.ResponseWriter, r *http.Request) {
+	login := uiLogin{
+		ReturnTo: safeReturnPath(r.URL.Query().Get("return")),
+		Expires:  time.Now().Add(loginTTL).Unix(),
+	}
+	for _, v := range []*string{&login.State, &login.Nonce, &login.Verifier} {
+		s, err := oidc.RandomString()
+		if err != nil {
+			log.Error(err, "unable to start the OIDC login")
+			http.Error(w, "unable to start the login", http.StatusInternalServerError)
+			return
+		}
+		*v = s
+	}
+	if err := ua.setCookie(w, loginCookie, uiAuthPath, login, loginTTL); err != nil {
+		log.Error(err, "unable to start the OIDC login")
+		http.Error(w, "unable to start the login", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, ua.provider.AuthCodeURL(login.State, login.Nonce, login.Verifier), http.StatusFound)
+}
+
+// handleCallback completes the login flow after the OIDC provider redirects back, creating the session
+func (ua *uiAuth) handleCallback(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		log.Info("the OIDC provider rejected the login", "error", e, "description", q.Get("error_description"))
+		http.Error(w, "login failed: "+e, http.StatusUnauthorized)
+		return
+	}
+	var login uiLogin
+	if err := ua.readCookie(r, loginCookie, &login); err != nil || time.Now().Unix() > login.Expires {
+		http.Error(w, "the login has expired, please try again", http.StatusBadRequest)
+		return
+	}
+	if !hmac.Equal([]byte(q.Get("state")), []byte(login.State)) {
+		http.Error(w, "invalid login state", http.StatusBadRequest)
+		return
+	}
+	claims, err := ua.provider.Exchange(r.Context(), q.Get("code"), login.Verifier, login.Nonce)
+	if err != nil {
+		log.Error(err, "unable to complete the OIDC login")
+		http.Error(w, "login failed", http.StatusUnauthorized)
+		return
+	}
+	csrf, err := oidc.RandomString()
+	if err != nil {
+		log.Error(err, "unable to create the web UI session")
+		http.Error(w, "login failed", http.StatusInternalServerError)
+		return
+	}
+	sess := uiSession{
+		CSRF:          csrf,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+		Expires:       time.Now().Add(sessionTTL).Unix(),
+	}
+	if err := ua.setCookie(w, sessionCookie, "/", sess, sessionTTL); err != nil {
+		log.Error(err, "unable to create the web UI session")
+		http.Error(w, "login failed", http.StatusInternalServerError)
+		return
+	}
+	ua.clearCookie(w, loginCookie, uiAuthPath)
+	log.Info("user logged in to the web UI", "user", sess.user())
+	http.Redirect(w, r, login.ReturnTo, http.StatusFound)
+}
+
+// handleLogout ends the session.  This does not redirect to the login endpoint because the provider would
+// usually log the user straight back in.  Only same-origin POST requests are accepted so that another site
+// can't log the user out.
+func (ua *uiAuth) handleLogout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !ua.sameOrigin(r.Header) {
+		http.Error(w, "cross-origin requests are not allowed", http.StatusForbidden)
+		return
+	}
+	ua.clearCookie(w, sessionCookie, "/")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, `<!DOCTYPE html><p>You have been logged out of Perseus. <a href="/ui/">Log in again</a></p>`)
+}
+
+// handleSession returns the current user as JSON so that the web UI can display it
+func (ua *uiAuth) handleSession(w http.ResponseWriter, r *http.Request) {
+	sess, err := ua.session(r)
+	if err != nil {
+		http.Error(w, "login required", http.StatusUnauthorized)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"user":      sess.user(),
+		"name":      sess.Name,
+		"admin":     ua.isAdmin(sess),
+		"csrfToken": sess.CSRF,
+	})
+}
+
+// session returns the session for the request, or [errNoSession] if it does not have a valid session
+// cookie
+func (ua *uiAuth) session(r *http.Request) (uiSession, error) {
+	return ua.sessionFromHeader(r.Header)
+}
+
+// sessionFromHeader returns the session in the cookies in h, or [errNoSession] if there is no valid session
+// cookie
+func (ua *uiAuth) sessionFromHeader(h http.Header) (uiSession, error) {
+	var sess uiSession
+	r := http.Request{Header: h}
+	if err := ua.readCookie(&r, sessionCookie, &sess); err != nil || time.Now().Unix() > sess.Expires {
+		return uiSession{}, errNoSession
+	}
+	return sess, nil
+}
+
+// isAdmin returns whether the user can call the administrative APIs.  The email address must have been
+// verified by the provider, otherwise anyone who can register an account with an admin's address would be
+// granted access.
+func (ua *uiAuth) isAdmin(sess uiSession) bool {
+	return sess.Email != "" && sess.EmailVerified && ua.admins[strings.ToLower(sess.Email)]
+}
+
+// validCSRFToken returns whether the headers in h contain the session's CSRF token
+func (ua *uiAuth) validCSRFToken(sess uiSession, h http.Header) bool {
+	token := h.Get(csrfHeader)
+	return sess.CSRF != "" && hmac.Equal([]byte(token), []byte(sess.CSRF))
+}
+
+// sameOrigin returns whether a request with the headers in h was sent by the web UI rather than by a page on
+// another site that is riding on the user's session cookie.  Browsers send the Origin header with every
+// cross-origin request and Sec-Fetch-Site with every request, so a request with neither didn't come from a
+// browser and can't be a cross-site request forgery.
+func (ua *uiAuth) sameOrigin(h http.Header) bool {
+	if origin := h.Get("Origin"); origin != "" {
+		return origin == ua.origin
+	}
+	switch h.Get("Sec-Fetch-Site") {
+	case "", "same-origin", "none":
+		return true
+	default:
+		return false
+	}
+}
+
+// user returns the name recorded in the audit log for the session's user, their email address if the
+// provider returned one
+func (s uiSession) user() string {
+	if s.Email != "" {
+		return s.Email
+	}
+	return s.Subject
+}
+
+// setCookie sets a cookie with the signed JSON encoding of v
+func (ua *uiAuth) setCookie(w http.ResponseWriter, name, path string, v any, ttl time.Duration) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("unable to encode the %s cookie: %w", name, err)
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    payload + "." + ua.sign(name, payload),
+		Path:     path,
+		MaxAge:   int(ttl.Seconds()),
+		HttpOnly: true,
+		Secure:   ua.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// readCookie verifies the signature of the named cookie and decodes its contents into v
+func (ua *uiAuth) readCookie(r *http.Request, name string, v any) error {
+	c, err := r.Cookie(name)
+	if err != nil {
+		return err
+	}
+	payload, sig, ok := strings.Cut(c.Value, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(ua.sign(name, payload))) {
+		return fmt.Errorf("invalid %s cookie signature", name)
+	}
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return fmt.Errorf("invalid %s cookie: %w", name, err)
+	}
+	return json.Unmarshal(data, v)
+}
+
+func (ua *uiAuth) clearCookie(w http.ResponseWriter, name, path string) {
+	http.SetCookie(w, &http.Cookie{Name: name, Path: path, MaxAge: -1, HttpOnly: true, Secure: ua.secure})
+}
+
+// sign returns the HMAC of a cookie's payload.  The cookie name is included so that a login cookie can't
+// be presented as a session cookie.
+func (ua *uiAuth) sign(name, payload string) string {
+	mac := hmac.New(sha256.New, ua.key)
+	mac.Write([]byte(name + "\x00" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// safeReturnPath returns p if it is a path within the web UI, or the web UI's home page otherwise, so that
+// the login flow can't be used to redirect to another site
+func safeReturnPath(p string) string {
+	if !strings.HasPrefix(p, "/ui/") || strings.HasPrefix(p, uiAuthPath) || strings.ContainsAny(p, "\\\r\n") {
+		return "/ui/"
+	}
+	return p
+}
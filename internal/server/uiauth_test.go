@@ -0,0 +1,185 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+func TestUIAuth(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	ua, err := newUIAuth(nil, "https://perseus.example.com/ui/auth/callback", key, []string{" Admin@Example.com "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, ua.secure)
+
+	_, err = newUIAuth(nil, "https://perseus.example.com/callback", key, nil)
+	assert.Error(t, err, "the redirect URL must refer to the callback endpoint")
+
+	// sessionCookies returns the cookies set for a session
+	sessionCookies := func(sess uiSession, name string) []*http.Cookie {
+		rec := httptest.NewRecorder()
+		if err := ua.setCookie(rec, name, "/", sess, sessionTTL); err != nil {
+			t.Fatal(err)
+		}
+		return rec.Result().Cookies()
+	}
+	valid := uiSession{Subject: "123", Email: "admin@example.com", EmailVerified: true, Expires: time.Now().Add(time.Hour).Unix(), CSRF: "t0ken"}
+	expired := uiSession{Subject: "123", Expires: time.Now().Add(-time.Hour).Unix()}
+
+	handler := ua.protect(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	type testCase struct {
+		name         string
+		method       string
+		cookies      []*http.Cookie
+		wantStatus   int
+		wantLocation string
+	}
+	cases := []testCase{
+		{
+			name:         "no session",
+			method:       http.MethodGet,
+			wantStatus:   http.StatusFound,
+			wantLocation: "/ui/auth/login?return=%2Fui%2Fmodule.html%3Fid%3Dfoo",
+		},
+		{
+			name:       "no session, not a GET",
+			method:     http.MethodPost,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "valid session",
+			method:     http.MethodGet,
+			cookies:    sessionCookies(valid, sessionCookie),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "expired session",
+			method:       http.MethodGet,
+			cookies:      sessionCookies(expired, sessionCookie),
+			wantStatus:   http.StatusFound,
+			wantLocation: "/ui/auth/login?return=%2Fui%2Fmodule.html%3Fid%3Dfoo",
+		},
+		{
+			name:         "tampered session",
+			method:       http.MethodGet,
+			cookies:      []*http.Cookie{{Name: sessionCookie, Value: "eyJzdWIiOiIxMjMifQ.abc"}},
+			wantStatus:   http.StatusFound,
+			wantLocation: "/ui/auth/login?return=%2Fui%2Fmodule.html%3Fid%3Dfoo",
+		},
+		{
+			name: "login cookie presented as a session",
+			cookies: func() []*http.Cookie {
+				c := sessionCookies(valid, loginCookie)
+				c[0].Name = sessionCookie
+				return c
+			}(),
+			method:       http.MethodGet,
+			wantStatus:   http.StatusFound,
+			wantLocation: "/ui/auth/login?return=%2Fui%2Fmodule.html%3Fid%3Dfoo",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "/ui/module.html?id=foo", nil)
+			for _, c := range tc.cookies {
+				req.AddCookie(c)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantLocation, rec.Header().Get("Location"))
+		})
+	}
+
+	// logging out requires a same-origin POST
+	for _, tc := range []struct {
+		method     string
+		origin     string
+		wantStatus int
+	}{
+		{method: http.MethodGet, wantStatus: http.StatusMethodNotAllowed},
+		{method: http.MethodPost, origin: "https://evil.example.com", wantStatus: http.StatusForbidden},
+		{method: http.MethodPost, origin: "https://perseus.example.com", wantStatus: http.StatusOK},
+	} {
+		req := httptest.NewRequest(tc.method, "/ui/auth/logout", nil)
+		req.Header.Set("Origin", tc.origin)
+		for _, c := range sessionCookies(valid, sessionCookie) {
+			req.AddCookie(c)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, tc.wantStatus, rec.Code, "%s from %q", tc.method, tc.origin)
+		cleared := slices.ContainsFunc(rec.Result().Cookies(), func(c *http.Cookie) bool {
+			return c.Name == sessionCookie && c.MaxAge < 0
+		})
+		assert.Equal(t, tc.wantStatus == http.StatusOK, cleared, "%s from %q", tc.method, tc.origin)
+	}
+
+	// web UI sessions can call the administrative APIs from the web UI, with the session's CSRF token, if
+	// the user is an admin
+	ai := authInterceptor{sessions: ua}
+	assert.True(t, ai.enabled())
+	unverified := valid
+	unverified.EmailVerified = false
+	for _, tc := range []struct {
+		sess      uiSession
+		header    map[string]string
+		wantActor string
+		wantCode  connect.Code
+	}{
+		{sess: valid, header: map[string]string{csrfHeader: "t0ken"}, wantActor: "admin@example.com"},
+		{sess: valid, header: map[string]string{csrfHeader: "t0ken", "Origin": "https://perseus.example.com", "Sec-Fetch-Site": "same-origin"}, wantActor: "admin@example.com"},
+		{sess: valid, wantCode: connect.CodePermissionDenied},
+		{sess: valid, header: map[string]string{csrfHeader: "other"}, wantCode: connect.CodePermissionDenied},
+		{sess: uiSession{Subject: "123", Email: "admin@example.com", EmailVerified: true, Expires: valid.Expires}, wantCode: connect.CodePermissionDenied},
+		{sess: uiSession{Subject: "456", Email: "user@example.com", EmailVerified: true, Expires: valid.Expires, CSRF: "t0ken"}, header: map[string]string{csrfHeader: "t0ken"}, wantCode: connect.CodePermissionDenied},
+		{sess: unverified, header: map[string]string{csrfHeader: "t0ken"}, wantCode: connect.CodePermissionDenied},
+		{sess: valid, header: map[string]string{csrfHeader: "t0ken", "Origin": "https://evil.example.com"}, wantCode: connect.CodePermissionDenied},
+		{sess: valid, header: map[string]string{csrfHeader: "t0ken", "Sec-Fetch-Site": "cross-site"}, wantCode: connect.CodePermissionDenied},
+		{sess: expired, wantCode: connect.CodeUnauthenticated},
+	} {
+		req := newTestRequest(perseusapiconnect.PerseusServiceDeleteModuleProcedure)
+		for _, c := range sessionCookies(tc.sess, sessionCookie) {
+			req.Header().Add("Cookie", c.Name+"="+c.Value)
+		}
+		for k, v := range tc.header {
+			req.Header().Set(k, v)
+		}
+		var gotActor string
+		_, err := ai.WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+			gotActor = actorFromContext(ctx)
+			return nil, nil
+		})(context.Background(), req)
+		if tc.wantCode != 0 {
+			assert.Equal(t, tc.wantCode, connect.CodeOf(err))
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tc.wantActor, gotActor)
+	}
+}
+
+func TestSafeReturnPath(t *testing.T) {
+	for in, want := range map[string]string{
+		"":                         "/ui/",
+		"/ui/module.html?id=foo":   "/ui/module.html?id=foo",
+		"https://evil.example.com": "/ui/",
+		"//evil.example.com/ui/":   "/ui/",
+		"/ui/auth/logout":          "/ui/",
+		"/ui/\\evil.example.com":   "/ui/",
+	} {
+		assert.Equal(t, want, safeReturnPath(in), "input: %q", in)
+	}
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CrowdStrike/perseus/internal/store"
+	"github.com/CrowdStrike/perseus/internal/upstream"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const (
+	// defaultUpstreamConcurrency is the default number of repositories that are checked at the same time
+	defaultUpstreamConcurrency = 2
+	// defaultUpstreamQPS is the default maximum number of code host API requests per second, which stays
+	// within GitHub's limit of 5000 requests per hour for authenticated clients
+	defaultUpstreamQPS = 1.0
+	// defaultInactiveYears is the default number of years without a commit after which a module is
+	// considered abandoned
+	defaultInactiveYears = 2
+)
+
+// upstreamOptions controls the behavior of [checkUpstreams]
+type upstreamOptions struct {
+	// a glob pattern specifying which known modules should be checked, all modules if empty
+	moduleFilter string
+	// the number of repositories to check at the same time
+	concurrency int
+	// the maximum number of code host API requests per second
+	qps float64
+}
+
+// checkUpstreams asks the GitHub or GitLab API for the state of the source repository of each known
+// module that matches opts.moduleFilter and records whether it is archived and when it last had a commit.
+// Modules that are not hosted on GitHub or GitLab are skipped, and each repository is only checked once
+// even if it contains multiple modules.
+//
+// Like [connectServer.discoverNewVersions], a failure to check an individual repository is logged and
+// counted, and an error is returned after all modules have been processed.  Repositories that no longer
+// exist, or are private, are logged but are not considered to be failures.
+func checkUpstreams(ctx context.Context, db store.Store, client *upstream.Client, opts upstreamOptions) error {
+	if opts.moduleFilter == "" {
+		opts.moduleFilter = "*"
+	}
+	if opts.concurrency <= 0 {
+		opts.concurrency = defaultUpstreamConcurrency
+	}
+	if opts.qps <= 0 {
+		opts.qps = defaultUpstreamQPS
+	}
+
+	// group the modules by repository so that each repository is only checked once
+	repos := make(map[string][]string)
+	pageToken := ""
+	for {
+		mods, next, err := db.QueryModules(ctx, opts.moduleFilter, pageToken, refreshQueryPageSize)
+		if err != nil {
+			return fmt.Errorf("unable to query the known modules: %w", err)
+		}
+		for _, m := range mods {
+			if repo := upstream.Repository(m.Name); repo != "" {
+				repos[repo] = append(repos[repo], m.Name)
+			}
+		}
+		if next == "" || len(mods) == 0 {
+			break
+		}
+		pageToken = next
+	}
+
+	var (
+		checked, missing, failed atomic.Int64
+		limiter                  = rate.NewLimiter(rate.Limit(opts.qps), 1)
+		eg, gctx                 = errgroup.WithContext(ctx)
+	)
+	eg.SetLimit(opts.concurrency)
+	for repo, mods := range repos {
+		repo, mods := repo, mods
+		eg.Go(func() error {
+			if err := limiter.Wait(gctx); err != nil {
+				return err
+			}
+			status, err := client.Check(gctx, mods[0])
+			switch {
+			case gctx.Err() != nil:
+				// the server is shutting down so there's no point in continuing
+				return gctx.Err()
+			case errors.Is(err, upstream.ErrRepositoryNotFound):
+				missing.Add(1)
+				log.Info("the source repository of a module was not found", "repository", repo)
+				return nil
+			case err != nil:
+				failed.Add(1)
+				log.Error(err, "unable to check the source repository of a module", "repository", repo)
+				return nil
+			}
+			for _, mod := range mods {
+				if err := db.SaveUpstreamStatus(gctx, mod, status.Archived, status.LastCommit); err != nil {
+					failed.Add(1)
+					log.Error(err, "unable to save the upstream status of a module", "module", mod)
+					return nil
+				}
+			}
+			checked.Add(1)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	log.Info("checked the source repositories of known modules",
+		"repositories", len(repos), "checked", checked.Load(), "missing", missing.Load(), "failed", failed.Load())
+	if n := failed.Load(); n > 0 {
+		return fmt.Errorf("%d of %d repositories could not be checked", n, len(repos))
+	}
+	return nil
+}
+
+// ListAbandonedModules returns the modules whose source repositories have been archived or have not had a
+// commit within the requested number of years.
+func (s *connectServer) ListAbandonedModules(ctx context.Context, req *connect.Request[perseusapi.ListAbandonedModulesRequest]) (*connect.Response[perseusapi.ListAbandonedModulesResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("ListAbandonedModules() called", "request", msg.String())
+
+	years := int(msg.GetInactiveYears())
+	if years == 0 {
+		years = defaultInactiveYears
+	}
+	query := store.AbandonedModuleQuery{
+		ModuleFilter:  msg.GetModuleFilter(),
+		InactiveSince: time.Now().AddDate(-years, 0, 0),
+	}
+	if query.ModuleFilter == "" {
+		query.ModuleFilter = "*"
+	}
+	results, err := s.store.GetAbandonedModules(ctx, query)
+	if err != nil {
+		log.Error(err, "unable to query abandoned modules", "moduleFilter", query.ModuleFilter, "inactiveYears", years)
+		return nil, storeError(err, "unable to query the abandoned modules")
+	}
+	resp := perseusapi.ListAbandonedModulesResponse{}
+	for _, r := range results {
+		m := &perseusapi.ListAbandonedModulesResponse_AbandonedModule{
+			Name:        r.Module,
+			Archived:    r.Archived,
+			CheckedTime: timestamppb.New(r.CheckedAt),
+			Dependents:  int32(r.Dependents), //nolint: gosec // there will never be 2^31 modules
+		}
+		if r.LastCommitAt.Valid {
+			m.LastCommitTime = timestamppb.New(r.LastCommitAt.Time)
+		}
+		resp.Modules = append(resp.Modules, m)
+	}
+	return connect.NewResponse(&resp), nil
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	"golang.org/x/mod/module"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
+)
+
+// validationInterceptor is a [connect.Interceptor] that validates all incoming request messages against
+// the protovalidate constraints declared in perseus.proto before the request is passed to the handler.
+//
+// Invalid requests are rejected with a [connect.CodeInvalidArgument] error that describes each violated
+// constraint.
+type validationInterceptor struct {
+	v *protovalidate.Validator
+}
+
+// ensure the interceptor satisfies the Connect interface
+var _ connect.Interceptor = (*validationInterceptor)(nil)
+
+// newValidationInterceptor initializes and returns a new [validationInterceptor].
+func newValidationInterceptor() (*validationInterceptor, error) {
+	v, err := protovalidate.New()
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the protovalidate validator: %w", err)
+	}
+	return &validationInterceptor{v: v}, nil
+}
+
+// WrapUnary satisfies the [connect.Interceptor] interface and validates the request message for unary RPCs.
+func (vi *validationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if msg, ok := req.Any().(proto.Message); ok {
+			if err := vi.validate(ctx, msg); err != nil {
+				return nil, err
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient satisfies the [connect.Interceptor] interface.  This is a no-op because the
+// interceptor is only used server-side.
+func (vi *validationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler satisfies the [connect.Interceptor] interface and validates each message received
+// on a streaming RPC.
+func (vi *validationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &validatingHandlerConn{StreamingHandlerConn: conn, vi: vi, ctx: ctx})
+	}
+}
+
+// validate checks msg against its declared constraints and returns a [connect.Error] describing any
+// violations.  Unexpected errors are logged with the logger of the request identified by ctx.
+func (vi *validationInterceptor) validate(ctx context.Context, msg proto.Message) error {
+	err := vi.v.Validate(msg)
+	if err == nil {
+		return nil
+	}
+	var verr *protovalidate.ValidationError
+	if errors.As(err, &verr) {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(verr.Violations))
+		for _, v := range verr.Violations {
+			violations = append(violations, fieldViolation(v.GetFieldPath(), v.GetMessage()))
+		}
+		return newInvalidArgumentError("the request is invalid", violations...)
+	}
+	requestLogger(ctx).Error(err, "unable to validate request message", "message", msg.ProtoReflect().Descriptor().FullName())
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("unable to validate the request"))
+}
+
+// validatingHandlerConn wraps a [connect.StreamingHandlerConn] to validate each received message.
+type validatingHandlerConn struct {
+	connect.StreamingHandlerConn
+	vi *validationInterceptor
+	// the context of the streaming RPC, which identifies the request in log messages
+	ctx context.Context
+}
+
+// Receive reads the next message from the stream and validates it.
+func (c *validatingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if pm, ok := msg.(proto.Message); ok {
+		return c.vi.validate(c.ctx, pm)
+	}
+	return nil
+}
+
+// checkModuleVersion performs the Go-specific validation of a module path and version that cannot be
+// expressed as protovalidate constraints, such as ensuring that the major version suffix of the path
+// matches the version.
+func checkModuleVersion(field, path, version string) error {
+	if err := module.Check(path, version); err != nil {
+		return newInvalidArgumentError(fmt.Sprintf("invalid module/version %s@%s", path, version), fieldViolation(field, err.Error()))
+	}
+	return nil
+}
+
+// canonicalModuleVersion returns the canonical form of version for the module at path, which is the form
+// that is stored, so that non-canonical versions, such as those with build metadata or v2+ versions of a
+// module without a major version suffix that are missing "+incompatible", are accepted.
+func canonicalModuleVersion(field, path, version string) (string, error) {
+	cv, err := modver.Canonical(path, version)
+	if err != nil {
+		return "", newInvalidArgumentError(fmt.Sprintf("invalid module/version %s@%s", path, version), fieldViolation(field, err.Error()))
+	}
+	return cv, nil
+}
+
+// storedVersion returns the canonical form of version for the module at path, which is the form that is
+// stored, or version unchanged if it cannot be canonicalized so that versions that were stored before they
+// were canonicalized can still be deleted and restored.
+func storedVersion(path, version string) string {
+	if cv, err := modver.Canonical(path, version); err == nil {
+		return cv
+	}
+	return version
+}
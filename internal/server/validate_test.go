@@ -0,0 +1,295 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+func TestValidationInterceptor(t *testing.T) {
+	vi, err := newValidationInterceptor()
+	if err != nil {
+		t.Fatalf("unable to create validation interceptor: %v", err)
+	}
+
+	type testCase struct {
+		name    string
+		msg     proto.Message
+		wantErr bool
+	}
+	cases := []testCase{
+		{
+			name: "valid dependency update",
+			msg: &perseusapi.UpdateDependenciesRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "v0.22.0",
+				Dependencies: []*perseusapi.Module{
+					{Name: "golang.org/x/mod", Versions: []string{"v0.21.0"}},
+					{Name: "github.com/go-git/go-git/v5", Versions: []string{"v5.12.0"}},
+				},
+			},
+		},
+		{
+			name: "invalid module version",
+			msg: &perseusapi.UpdateDependenciesRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "v0.22.0.1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "shorthand module versions",
+			msg: &perseusapi.UpdateDependenciesRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "0.22",
+				Dependencies: []*perseusapi.Module{
+					{Name: "golang.org/x/mod", Versions: []string{"v0"}},
+				},
+			},
+		},
+		{
+			name: "shorthand pre-release",
+			msg: &perseusapi.UpdateDependenciesRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "v0.22-rc.1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "dependency with multiple versions",
+			msg: &perseusapi.UpdateDependenciesRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "v0.22.0",
+				Dependencies: []*perseusapi.Module{
+					{Name: "golang.org/x/mod", Versions: []string{"v0.21.0", "v0.20.0"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "follow renames",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName:    "github.com/CrowdStrike/perseus",
+				Version:       "v0.22.0",
+				FollowRenames: true,
+			},
+		},
+		{
+			name: "paging while following renames",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName:    "github.com/CrowdStrike/perseus",
+				Version:       "v0.22.0",
+				FollowRenames: true,
+				PageToken:     "abc",
+			},
+			wantErr: true,
+		},
+		{
+			name: "deps.dev enrichment",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "v0.22.0",
+				Enrich:     []string{"scorecard", "advisories"},
+			},
+		},
+		{
+			name: "dependents in a version range",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName:   "google.golang.org/grpc",
+				VersionRange: "<v1.56.3",
+				Direction:    perseusapi.DependencyDirection_dependents,
+			},
+		},
+		{
+			name: "dependencies in a version range",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName:   "google.golang.org/grpc",
+				VersionRange: "<v1.56.3",
+			},
+			wantErr: true,
+		},
+		{
+			name: "both a version and a version range",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName:   "google.golang.org/grpc",
+				Version:      "v1.56.2",
+				VersionRange: "<v1.56.3",
+				Direction:    perseusapi.DependencyDirection_dependents,
+			},
+			wantErr: true,
+		},
+		{
+			name: "neither a version nor a version range",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName: "google.golang.org/grpc",
+				Direction:  perseusapi.DependencyDirection_dependents,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown enrichment",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "v0.22.0",
+				Enrich:     []string{"stars"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "graph diff",
+			msg: &perseusapi.DiffGraphRequest{
+				ModuleFilter: "github.com/CrowdStrike/*",
+				FromTime:     timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				ToTime:       timestamppb.New(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "graph diff with reversed window",
+			msg: &perseusapi.DiffGraphRequest{
+				FromTime: timestamppb.New(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+				ToTime:   timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "graph diff without end time",
+			msg: &perseusapi.DiffGraphRequest{
+				FromTime: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "pre-release and build metadata",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "v1.0.0-rc.1+build.2",
+			},
+		},
+		{
+			name: "invalid characters in module name",
+			msg: &perseusapi.QueryDependenciesRequest{
+				ModuleName: "github.com/Crowd Strike/perseus",
+				Version:    "v1.0.0",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing module name and filter",
+			msg:     &perseusapi.ListModuleVersionsRequest{VersionOption: perseusapi.ModuleVersionOption_all},
+			wantErr: true,
+		},
+		{
+			name: "paging with latest version option",
+			msg: &perseusapi.ListModuleVersionsRequest{
+				ModuleFilter:  "github.com/CrowdStrike/*",
+				VersionOption: perseusapi.ModuleVersionOption_latest,
+				PageToken:     "abc",
+			},
+			wantErr: true,
+		},
+		{
+			name: "limit with latest version option",
+			msg: &perseusapi.ListModuleVersionsRequest{
+				ModuleFilter:  "github.com/CrowdStrike/*",
+				VersionOption: perseusapi.ModuleVersionOption_latest,
+				Limit:         5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "since after as of",
+			msg: &perseusapi.ListModuleVersionsRequest{
+				ModuleName:    "github.com/CrowdStrike/perseus",
+				VersionOption: perseusapi.ModuleVersionOption_all,
+				Since:         timestamppb.New(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)),
+				AsOf:          timestamppb.New(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "most recent versions since a date",
+			msg: &perseusapi.ListModuleVersionsRequest{
+				ModuleName:    "github.com/CrowdStrike/perseus",
+				VersionOption: perseusapi.ModuleVersionOption_all,
+				Sort:          perseusapi.ModuleVersionSort_date,
+				Limit:         5,
+				Since:         timestamppb.New(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:    "page size out of range",
+			msg:     &perseusapi.ListModulesRequest{PageSize: 5000},
+			wantErr: true,
+		},
+		{
+			name:    "missing module filter on refresh",
+			msg:     &perseusapi.RefreshModulesRequest{LatestOnly: true},
+			wantErr: true,
+		},
+		{
+			name:    "missing target module on merge",
+			msg:     &perseusapi.MergeModulesRequest{SourceModule: "GitHub.com/CrowdStrike/perseus"},
+			wantErr: true,
+		},
+		{
+			name:    "no modules on ingestion job submission",
+			msg:     &perseusapi.SubmitIngestionJobsRequest{Source: "cli"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid ingestion job status filter",
+			msg:     &perseusapi.ListIngestionJobsRequest{Status: "exploded"},
+			wantErr: true,
+		},
+		{
+			name:    "missing module on create",
+			msg:     &perseusapi.CreateModuleRequest{},
+			wantErr: true,
+		},
+		{
+			name:    "vulnerability lookup without a version",
+			msg:     &perseusapi.ListVulnerabilitiesRequest{ModuleName: "golang.org/x/net"},
+			wantErr: true,
+		},
+		{
+			name: "resolve with upgrades",
+			msg: &perseusapi.ResolveModuleSetRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "v0.22.0",
+				Upgrades:   []string{"golang.org/x/net@v0.25.0"},
+			},
+		},
+		{
+			name: "upgrade without a version",
+			msg: &perseusapi.ResolveModuleSetRequest{
+				ModuleName: "github.com/CrowdStrike/perseus",
+				Version:    "v0.22.0",
+				Upgrades:   []string{"golang.org/x/net"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := vi.validate(context.Background(), tc.msg)
+			if !tc.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			var cerr *connect.Error
+			if assert.ErrorAs(t, err, &cerr) {
+				assert.NotEmpty(t, cerr.Details(), "validation errors should include field violations")
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+)
+
+// recordVanityAliases resolves the repository path of each of the specified modules that has a vanity
+// import path, such as github.com/uber-go/zap for go.uber.org/zap, and records it as an alias of the
+// module so that queries using either name match.
+//
+// Each module is only resolved once per server process.  Failures are logged but otherwise ignored
+// because the aliases are a convenience and must never cause an ingestion to fail.
+func (s *connectServer) recordVanityAliases(ctx context.Context, mods ...string) {
+	for _, mod := range mods {
+		if _, seen := s.vanityResolved.LoadOrStore(mod, struct{}{}); seen {
+			continue
+		}
+		alias, err := s.proxy.ResolveVanityPath(mod)
+		if err != nil {
+			log.Debug("unable to resolve the repository for a module", "module", mod, "err", err)
+			continue
+		}
+		if alias == "" {
+			continue
+		}
+		if err := s.store.SaveModuleAlias(ctx, mod, alias); err != nil {
+			log.Error(err, "unable to save the alias of a vanity import path", "module", mod, "alias", alias)
+			// try again the next time the module is ingested
+			s.vanityResolved.Delete(mod)
+			continue
+		}
+		log.Debug("recorded the alias of a vanity import path", "module", mod, "alias", alias)
+	}
+}
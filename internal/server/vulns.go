@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"connectrpc.com/connect"
+	"golang.org/x/mod/module"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/CrowdStrike/perseus/internal/osv"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// osvConcurrency is the number of module versions that are checked against the OSV database at the same time
+const osvConcurrency = 8
+
+// ListVulnerabilities returns the OSV advisories that affect the specified module version or any of its
+// transitive dependencies.
+func (s *connectServer) ListVulnerabilities(ctx context.Context, req *connect.Request[perseusapi.ListVulnerabilitiesRequest]) (*connect.Response[perseusapi.ListVulnerabilitiesResponse], error) {
+	log := requestLogger(ctx)
+	msg := req.Msg
+	log.Debug("ListVulnerabilities() called", "request", msg.String())
+
+	if s.osv == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("vulnerability lookups are not enabled on this server"))
+	}
+	modName := msg.GetModuleName()
+	modVer, err := canonicalModuleVersion("module_name", modName, msg.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	root := module.Version{Path: modName, Version: modVer}
+	nodes, err := s.walkDependencies(ctx, root, int(msg.GetMaxDepth()))
+	if err != nil {
+		log.Error(err, "unable to walk the dependency graph", "module", modName, "version", modVer)
+		return nil, storeError(err, "unable to query the graph")
+	}
+
+	// unlike deps.dev enrichment, a failed lookup fails the request so that a vulnerable dependency is never
+	// silently omitted
+	results := make([][]osv.Entry, len(nodes))
+	eg, gctx := errgroup.WithContext(ctx)
+	eg.SetLimit(osvConcurrency)
+	for i, n := range nodes {
+		i, n := i, n
+		eg.Go(func() error {
+			entries, err := s.osv.Query(gctx, n.mod.Path, n.mod.Version)
+			if err != nil {
+				return err
+			}
+			results[i] = entries
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		log.Error(err, "unable to query the OSV database", "module", modName, "version", modVer)
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("unable to query the OSV database: %w", err))
+	}
+
+	resp := perseusapi.ListVulnerabilitiesResponse{Database: s.osv.URL()}
+	advisories := make(map[string]*perseusapi.ListVulnerabilitiesResponse_Advisory)
+	for i, entries := range results {
+		mod := nodes[i].mod
+		for _, e := range entries {
+			if _, found := advisories[e.ID]; !found {
+				advisories[e.ID] = &perseusapi.ListVulnerabilitiesResponse_Advisory{
+					Id:      e.ID,
+					Aliases: e.Aliases,
+					Summary: e.Summary,
+					Osv:     string(e.Raw),
+				}
+			}
+			resp.Findings = append(resp.Findings, &perseusapi.ListVulnerabilitiesResponse_Finding{
+				AdvisoryId:   e.ID,
+				Module:       &perseusapi.Module{Name: mod.Path, Versions: []string{mod.Version}},
+				FixedVersion: e.FixedVersion(mod.Path, mod.Version),
+				Path:         nodes.pathTo(i),
+			})
+		}
+	}
+	for _, a := range advisories {
+		resp.Advisories = append(resp.Advisories, a)
+	}
+	sort.Slice(resp.Advisories, func(i, j int) bool {
+		return resp.Advisories[i].GetId() < resp.Advisories[j].GetId()
+	})
+	return connect.NewResponse(&resp), nil
+}
@@ -0,0 +1,288 @@
+// The admin console.  If the server is configured with an admin token, the administrative APIs require
+// that token or an API key, which is kept in session storage so that it is forgotten when the browser tab
+// is closed.  Users who logged in to the web UI as an administrator don't need a key, but their requests
+// must include the session's CSRF token.
+
+const tokenStorageKey = "perseus-admin-token";
+const auditLogPageSize = 50;
+
+const adminToken = () => sessionStorage.getItem(tokenStorageKey) || "";
+
+// Calls an administrative API endpoint, sending body as JSON if it is specified, and returns the decoded
+// response.  Errors reported by the server are thrown with the server's message.
+const adminFetch = async (path, body) => {
+  const headers = {};
+  const token = adminToken();
+  if (token !== "") {
+    headers["Authorization"] = `Bearer ${token}`;
+  } else if (session && session.csrfToken) {
+    headers["X-Perseus-CSRF-Token"] = session.csrfToken;
+  }
+  const init = { method: body === undefined ? "GET" : "POST", headers: headers };
+  if (body !== undefined) {
+    headers["Content-Type"] = "application/json";
+    init.body = JSON.stringify(body);
+  }
+  const resp = await fetch(`${apiBase}/admin/${path}`, init);
+  const data = await resp.json().catch(() => ({}));
+  if (!resp.ok) {
+    if (resp.status === 401) {
+      throw new Error(`not authorized, set a valid API key above (${data.message || resp.status})`);
+    }
+    throw new Error(data.message || `${path} returned ${resp.status}`);
+  }
+  return data;
+};
+
+const formatTime = (t) => (t ? new Date(t).toLocaleString() : "");
+
+const setStatus = (id, text) => {
+  document.getElementById(id).textContent = text;
+};
+
+// Wires up a form that performs an administrative operation.  run is called with no arguments when the
+// form is submitted and returns the status message to display on success, or null if it has already
+// updated the status.
+const handleForm = (formId, statusId, confirmMsg, run) => {
+  document.getElementById(formId).addEventListener("submit", async (e) => {
+    e.preventDefault();
+    const msg = confirmMsg();
+    if (msg && !confirm(msg)) {
+      return;
+    }
+    setStatus(statusId, "working...");
+    try {
+      const result = await run();
+      if (result !== null) {
+        setStatus(statusId, result);
+      }
+    } catch (err) {
+      setStatus(statusId, `failed: ${err.message}`);
+      return;
+    }
+    auditLog.reset();
+  });
+};
+
+const value = (id) => document.getElementById(id).value.trim();
+
+handleForm(
+  "delete-module-form",
+  "delete-module-status",
+  () => `Delete ${value("delete-module-name")} and all of its versions?`,
+  async () => {
+    const name = value("delete-module-name");
+    await adminFetch("delete-module", { moduleName: name });
+    return `deleted ${name}`;
+  }
+);
+
+handleForm(
+  "delete-version-form",
+  "delete-version-status",
+  () => `Delete ${value("delete-version-name")}@${value("delete-version-version")}?`,
+  async () => {
+    const name = value("delete-version-name");
+    let version = value("delete-version-version");
+    if (!version.startsWith("v")) {
+      version = "v" + version;
+    }
+    await adminFetch("delete-module-version", { moduleName: name, version: version });
+    return `deleted ${name}@${version}`;
+  }
+);
+
+handleForm(
+  "undelete-form",
+  "undelete-status",
+  () => null,
+  async () => {
+    const name = value("undelete-name");
+    let version = value("undelete-version");
+    if (version !== "" && !version.startsWith("v")) {
+      version = "v" + version;
+    }
+    const resp = await adminFetch("undelete-module", { moduleName: name, version: version });
+    const target = version === "" ? name : `${name}@${version}`;
+    return `restored ${target} with ${resp.restoredVersions || 0} versions and ${resp.restoredDependencies || 0} dependencies`;
+  }
+);
+
+handleForm(
+  "merge-form",
+  "merge-status",
+  () => `Merge ${value("merge-source")} into ${value("merge-target")}? The source module will be removed.`,
+  async () => {
+    const resp = await adminFetch("merge-modules", {
+      sourceModule: value("merge-source"),
+      targetModule: value("merge-target"),
+    });
+    return `moved ${resp.movedVersions || 0} versions and merged ${resp.mergedVersions || 0} versions`;
+  }
+);
+
+handleForm(
+  "create-key-form",
+  "create-key-status",
+  () => null,
+  async () => {
+    const resp = await adminFetch("api-keys", { name: value("create-key-name") });
+    document.getElementById("create-key-name").value = "";
+    loadAPIKeys();
+    const status = document.getElementById("create-key-status");
+    status.textContent = `created ${resp.apiKey.name}, copy the key now, it will not be shown again: `;
+    const key = document.createElement("span");
+    key.className = "new-key";
+    key.textContent = resp.key;
+    status.append(key);
+    return null;
+  }
+);
+
+// Lists the API keys, with a button to revoke each active key
+const loadAPIKeys = async () => {
+  const table = document.getElementById("api-keys");
+  let keys;
+  try {
+    keys = (await adminFetch("api-keys")).apiKeys || [];
+  } catch (err) {
+    table.innerHTML = "";
+    setStatus("create-key-status", `unable to list the API keys: ${err.message}`);
+    return;
+  }
+  table.innerHTML = "";
+  keys.forEach((key) => {
+    const row = table.insertRow();
+    [key.name, formatTime(key.createTime), formatTime(key.lastUsedTime), formatTime(key.revokeTime)].forEach(
+      (text) => {
+        row.insertCell().textContent = text;
+      }
+    );
+    const cell = row.insertCell();
+    if (!key.revokeTime) {
+      const btn = document.createElement("button");
+      btn.type = "button";
+      btn.textContent = "Revoke";
+      btn.onclick = async () => {
+        if (!confirm(`Revoke the API key ${key.name}?`)) {
+          return;
+        }
+        try {
+          await adminFetch("revoke-api-key", { name: key.name });
+        } catch (err) {
+          alert(`unable to revoke ${key.name}: ${err.message}`);
+          return;
+        }
+        loadAPIKeys();
+        auditLog.reset();
+      };
+      cell.append(btn);
+    }
+  });
+};
+
+// Formats a size in bytes using binary units, ex: 1.5 MiB
+const formatBytes = (n) => {
+  const units = ["B", "KiB", "MiB", "GiB", "TiB"];
+  let i = 0;
+  for (; n >= 1024 && i < units.length - 1; i++) {
+    n /= 1024;
+  }
+  return i === 0 ? `${n} B` : `${n.toFixed(1)} ${units[i]}`;
+};
+
+const loadStoreStats = async () => {
+  const tables = document.getElementById("store-tables");
+  const indexes = document.getElementById("store-indexes");
+  let stats;
+  try {
+    stats = await adminFetch("store-stats");
+  } catch (err) {
+    tables.innerHTML = "";
+    indexes.innerHTML = "";
+    setStatus("store-stats-status", `unable to retrieve the database statistics: ${err.message}`);
+    return;
+  }
+  // int64 fields are encoded as strings in JSON
+  setStatus("store-stats-status", `total size ${formatBytes(Number(stats.databaseBytes || 0))}`);
+  tables.innerHTML = "";
+  (stats.tables || []).forEach((t) => {
+    const row = tables.insertRow();
+    [
+      t.name,
+      t.rows || "0",
+      t.deadRows || "0",
+      formatBytes(Number(t.totalBytes || 0)),
+      formatBytes(Number(t.indexBytes || 0)),
+      formatTime(t.lastVacuumTime) || "never",
+      formatTime(t.lastAnalyzeTime) || "never",
+      t.hint || "",
+    ].forEach((text) => {
+      row.insertCell().textContent = text;
+    });
+  });
+  indexes.innerHTML = "";
+  (stats.indexes || []).forEach((i) => {
+    const row = indexes.insertRow();
+    [i.name, i.table, formatBytes(Number(i.bytes || 0)), i.scans || "0", i.hint || ""].forEach((text) => {
+      row.insertCell().textContent = text;
+    });
+  });
+};
+
+const auditLog = new Pager(
+  async (pageToken) => {
+    let path = `audit-log?page_size=${auditLogPageSize}`;
+    if (pageToken) {
+      path += `&page_token=${encodeURIComponent(pageToken)}`;
+    }
+    const resp = await adminFetch(path);
+    return { items: resp.entries || [], nextPageToken: resp.nextPageToken || "" };
+  },
+  (entries) => {
+    const table = document.getElementById("audit-log");
+    table.innerHTML = "";
+    entries.forEach((entry) => {
+      const row = table.insertRow();
+      [formatTime(entry.time), entry.actor, entry.action, entry.target, entry.details || ""].forEach((text) => {
+        row.insertCell().textContent = text;
+      });
+    });
+  }
+);
+
+const tokenInput = document.getElementById("token");
+// the web UI session, if the server requires a login
+let session = null;
+const showTokenStatus = () => {
+  if (adminToken() !== "") {
+    setStatus("token-status", "using the saved API key for this session");
+  } else if (session && session.admin) {
+    setStatus("token-status", `no API key set, using the administrator login of ${session.user}`);
+  } else {
+    setStatus("token-status", "no API key set");
+  }
+};
+document.getElementById("token-form").addEventListener("submit", (e) => {
+  e.preventDefault();
+  sessionStorage.setItem(tokenStorageKey, tokenInput.value.trim());
+  tokenInput.value = "";
+  showTokenStatus();
+  loadAPIKeys();
+  loadStoreStats();
+  auditLog.reset();
+});
+document.getElementById("token-clear").addEventListener("click", () => {
+  sessionStorage.removeItem(tokenStorageKey);
+  showTokenStatus();
+});
+
+// the session is loaded first because its CSRF token is required to use the administrator login
+showTokenStatus();
+showSession("session").then((s) => {
+  session = s;
+  showTokenStatus();
+  loadAPIKeys();
+  loadStoreStats();
+  auditLog.reset();
+});
@@ -1,9 +1,80 @@
 const apiBase = "/api/v1";
 
-const listModules = async () => {
-  return fetch(`${apiBase}/modules`)
-    .then((data) => data.json())
-    .then((resp) => resp.modules);
+// Fetches a single page of results from a paged API endpoint, starting at pageToken, and returns the values
+// of the specified field of the response along with the token for the next page, which is empty for the
+// last page
+const fetchPage = async (url, field, pageToken) => {
+  const sep = url.includes("?") ? "&" : "?";
+  const resp = await fetch(pageToken ? `${url}${sep}page_token=${encodeURIComponent(pageToken)}` : url);
+  if (!resp.ok) {
+    throw new Error(`${url} returned ${resp.status}`);
+  }
+  const data = await resp.json();
+  return { items: data[field] || [], nextPageToken: data.nextPageToken || "" };
+};
+
+// Fetches every page of results from a paged API endpoint, following the next page token returned with
+// each page, and returns the concatenated values of the specified field of each response
+const fetchAllPages = async (url, field) => {
+  let results = [];
+  let pageToken = "";
+  do {
+    const page = await fetchPage(url, field, pageToken);
+    results = results.concat(page.items);
+    pageToken = page.nextPageToken;
+  } while (pageToken !== "");
+  return results;
+};
+
+// Returns the modules that match the optional glob pattern, each with its latest version
+const listModules = async (filter) => {
+  const url = filter ? `${apiBase}/modules?filter=${encodeURIComponent(filter)}` : `${apiBase}/modules`;
+  return fetchAllPages(url, "modules");
+};
+
+// Returns a page of the modules that match the glob pattern, each with its latest version
+const searchModules = (filter, pageSize, pageToken) => {
+  return fetchPage(
+    `${apiBase}/modules?filter=${encodeURIComponent(filter)}&page_size=${pageSize}`,
+    "modules",
+    pageToken
+  );
+};
+
+// Returns a page of the direct dependencies or dependents of module@version as {name, version} objects
+const getDirectDepsPage = async (module, version, direction, pageSize, pageToken) => {
+  const page = await fetchPage(
+    `${apiBase}/modules-dependencies?module_name=${module}&version=${version}&direction=${direction}&page_size=${pageSize}`,
+    "modules",
+    pageToken
+  );
+  page.items = page.items.map((mod) => ({ name: mod.name, version: mod.versions[0] }));
+  return page;
+};
+
+// Returns every version of the module, highest first, as {version, addedAt} objects, where addedAt is the
+// time the version was added to the graph, if known
+const getModuleVersionDetails = async (module) => {
+  const mods = await fetchAllPages(
+    `${apiBase}/module-versions?module_name=${module}&version_option=all&include_prerelease=true`,
+    "modules"
+  );
+  let out = [];
+  mods.forEach((mod) => {
+    mod.versions.forEach((v, i) => {
+      const t = (mod.versionTimes || [])[i];
+      out.push({ version: v, addedAt: t ? new Date(t) : null });
+    });
+  });
+  return out;
+};
+
+// Returns "pseudo" for pseudo-versions, "pre-release" for other pre-release versions, or null otherwise
+const versionBadge = (version) => {
+  if (/^v[0-9]+\.[0-9]+\.[0-9]+-(.+\.)?(0\.)?[0-9]{14}-[0-9a-f]{12}(\+incompatible)?$/.test(version)) {
+    return "pseudo";
+  }
+  return /^v[0-9]+\.[0-9]+\.[0-9]+-/.test(version) ? "pre-release" : null;
 };
 
 const getModuleVersions = (module) => {
@@ -19,27 +90,122 @@ const getModuleVersions = (module) => {
     });
 };
 
-const getModuleDeps = (module, version, direction) => {
-  return fetch(
-    `${apiBase}/modules-dependencies?module_name=${module}&version=${version}&direction=${direction}`
-  )
+// Returns the direct dependencies or dependents of module@version as a list of {name, version} objects
+const getDirectDeps = async (module, version, direction) => {
+  // API response structure is an array of modules that are direct dependencies/dependants of
+  // the current module, each with a single version
+  //  {"modules":[{"name": "github.com/example/foo", "versions":["v0.1.0"]}, ...]}
+  //
+  const mods = await fetchAllPages(
+    `${apiBase}/modules-dependencies?module_name=${module}&version=${version}&direction=${direction}`,
+    "modules"
+  );
+  return mods.map((mod) => ({ name: mod.name, version: mod.versions[0] }));
+};
+
+const getModuleDeps = async (module, version, direction) => {
+  const fqmn = `${module}@${version}`;
+
+  let out = { nodes: [], links: [] };
+  out.nodes.push({ id: fqmn, label: fqmn, level: 0 });
+
+  const deps = await getDirectDeps(module, version, direction);
+  deps.forEach((dep) => {
+    const name = `${dep.name}@${dep.version}`;
+    out.nodes.push({ id: name, label: name, level: 1 });
+    out.links.push({ source: fqmn, target: name });
+  });
+
+  return out;
+};
+
+const getModuleMetrics = (module) => {
+  return fetch(`${apiBase}/module-metrics?module_name=${module}`)
     .then((resp) => resp.json())
     .then((data) => {
-      // API response structure is an array of modules that are direct dependencies/dependants of
-      // the current module, each with a single version
-      //  {"modules":[{"name": "github.com/example/foo", "versions":["v0.1.0"]}, ...]}
+      // API response contains the fan-in and fan-out of the module, with zero values omitted
+      //  {"directDependents": 3, "directDependencies": 12, "transitiveDependents": 41, "transitiveDependencies": 57}
       //
-      const fqmn = `${module}@${version}`;
+      return {
+        directDependents: data.directDependents || 0,
+        directDependencies: data.directDependencies || 0,
+        transitiveDependents: data.transitiveDependents || 0,
+        transitiveDependencies: data.transitiveDependencies || 0,
+        archived: data.archived || false,
+        lastCommitTime: data.lastCommitTime ? new Date(data.lastCommitTime) : null,
+      };
+    });
+};
 
-      let out = { nodes: [], links: [] };
-      out.nodes.push({ id: fqmn, label: fqmn, level: 0 });
+// Returns whether u is an absolute http or https URL, which is required of any external URL before it is
+// linked to, so that a javascript: or data: URL can't be used to run script when the link is clicked
+const isWebURL = (u) => {
+  try {
+    return ["http:", "https:"].includes(new URL(u).protocol);
+  } catch {
+    return false;
+  }
+};
 
-      data.modules.forEach((mod) => {
-        const name = `${mod.name}@${mod.versions[0]}`;
-        out.nodes.push({ id: name, label: name, level: 1 });
-        out.links.push({ source: fqmn, target: name });
-      });
+// Returns the URL of a web UI page with the specified query parameters, which are encoded so that module
+// paths and versions taken from the page's own URL can't inject other parameters
+const uiURL = (page, params) => `/ui/${page}?${new URLSearchParams(params)}`;
 
-      return out;
-    });
+// Returns a link to href with the specified text.  Pages must build markup that contains module paths,
+// versions, or anything else taken from the URL or the API with DOM methods like this rather than
+// innerHTML, which would run any script that was injected into those values.
+const newLink = (href, text) => {
+  const a = document.createElement("a");
+  a.href = href;
+  a.textContent = text;
+  return a;
+};
+
+// Returns the pkg.go.dev URL for a module version, or for the module if version is empty
+const docsURL = (module, version) => {
+  return version ? `https://pkg.go.dev/${module}@${version}` : `https://pkg.go.dev/${module}`;
+};
+
+// Returns the web URL of a module's source repository, or null if it can't be determined from the
+// module path.  Repositories on well-known code hosts are identified by the first 3 path elements.
+const sourceURL = (module) => {
+  const toks = module.split("/");
+  if (toks[0] === "golang.org" && toks[1] === "x" && toks.length > 2) {
+    return `https://cs.opensource.google/go/x/${toks[2]}`;
+  }
+  if (["github.com", "gitlab.com", "bitbucket.org"].includes(toks[0]) && toks.length > 2) {
+    return `https://${toks.slice(0, 3).join("/")}`;
+  }
+  return null;
+};
+
+// Converts a glob pattern, where '*' matches any sequence of characters, into a regular expression.  A
+// pattern without a '*' matches any module path that contains it.
+const globToRegExp = (pattern) => {
+  const escaped = pattern.replace(/[.+?^${}()|[\]\\]/g, "\\$&").replace(/\*/g, ".*");
+  return pattern.includes("*") ? new RegExp(`^${escaped}$`) : new RegExp(escaped);
+};
+
+// Shows the user who is logged in to the web UI, with a link to log out, in the element with the specified
+// ID and returns the session as {user, name, admin, csrfToken}.  Nothing is shown, and null is returned, if the server
+// does not require a login.
+const showSession = async (id) => {
+  const resp = await fetch("/ui/auth/session").catch(() => null);
+  if (!resp || !resp.ok) {
+    return null;
+  }
+  const session = await resp.json();
+  const el = document.getElementById(id);
+  el.textContent = `logged in as ${session.user} | `;
+  // logging out must be a POST so that another site can't log the user out with a link
+  const logout = document.createElement("form");
+  logout.method = "post";
+  logout.action = "/ui/auth/logout";
+  logout.style.display = "inline";
+  const button = document.createElement("button");
+  button.type = "submit";
+  button.textContent = "log out";
+  logout.append(button);
+  el.append(logout);
+  return session;
 };
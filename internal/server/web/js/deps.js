@@ -0,0 +1,47 @@
+const pageSize = 50;
+
+const params = new URLSearchParams(window.location.search);
+const module = params.get("id");
+const version = params.get("version");
+const direction = params.get("direction") || "dependencies";
+
+document.title = `${module}@${version} ${direction}`;
+document.getElementById("title").textContent = `${module}@${version}`;
+document.getElementById("versions").href = uiURL("versions.html", { id: module });
+
+const addDepsToElement = (el, deps) => {
+  el.replaceChildren();
+  if (deps.length === 0) {
+    const td = document.createElement("td");
+    td.colSpan = 2;
+    const i = document.createElement("i");
+    i.textContent = `no ${direction}`;
+    td.append(i);
+    el.insertRow().append(td);
+    return;
+  }
+  deps.forEach((dep) => {
+    // link each result to its own dependencies or dependents so the graph can be walked a page at a time
+    const row = el.insertRow();
+    row.insertCell().append(newLink(uiURL("versions.html", { id: dep.name }), dep.name));
+    row
+      .insertCell()
+      .append(newLink(uiURL("deps.html", { id: dep.name, version: dep.version, direction: direction }), dep.version));
+  });
+};
+
+function loadPage() {
+  const directionSelect = document.getElementById("direction");
+  directionSelect.value = direction;
+  directionSelect.addEventListener("change", function () {
+    window.location.href = uiURL("deps.html", { id: module, version: version, direction: this.value });
+  });
+
+  const pager = new Pager(
+    (token) => getDirectDepsPage(module, version, direction, pageSize, token),
+    (deps) => addDepsToElement(document.getElementById("content"), deps)
+  );
+  pager.reset();
+}
+
+loadPage();
@@ -0,0 +1,311 @@
+// Interactive exploration of the dependency graph.  Starting from a single module version, each click on a
+// module fetches its direct dependencies or dependents and adds them to the graph, so arbitrarily large
+// graphs can be explored without loading them up front.
+
+const params = new URLSearchParams(window.location.search);
+const explorer = {
+  direction: params.get("direction") || "dependencies",
+  // module@version => { id, name, version, level, expanded }
+  nodes: new Map(),
+  links: [],
+};
+
+const svg = d3.select("#graph");
+const width = +svg.attr("width"),
+  height = +svg.attr("height"),
+  nodeRadius = 10;
+
+// arrowheads point from a module to its dependency, regardless of the direction being explored
+svg
+  .append("defs")
+  .append("marker")
+  .attr("id", "arrow")
+  .attr("viewBox", "0 -5 10 10")
+  .attr("refX", nodeRadius + 10)
+  .attr("markerWidth", 6)
+  .attr("markerHeight", 6)
+  .attr("orient", "auto")
+  .append("path")
+  .attr("d", "M0,-5L10,0L0,5")
+  .attr("fill", "rgba(50, 50, 50, 0.5)");
+
+const viewport = svg.append("g");
+svg.call(
+  d3.zoom().on("zoom", () => {
+    viewport.attr("transform", d3.event.transform);
+  })
+).on("dblclick.zoom", null);
+let linkGroup = viewport.append("g").attr("class", "links");
+let nodeGroup = viewport.append("g").attr("class", "nodes");
+let textGroup = viewport.append("g").attr("class", "texts");
+
+const simulation = d3
+  .forceSimulation()
+  .force(
+    "link",
+    d3
+      .forceLink()
+      .id((node) => node.id)
+      .distance(120)
+  )
+  .force("charge", d3.forceManyBody().strength(-300))
+  .force("center", d3.forceCenter(width / 2, height / 2))
+  .on("tick", () => {
+    linkGroup
+      .selectAll("line")
+      .attr("x1", (link) => link.source.x)
+      .attr("y1", (link) => link.source.y)
+      .attr("x2", (link) => link.target.x)
+      .attr("y2", (link) => link.target.y);
+    nodeGroup
+      .selectAll("circle")
+      .attr("cx", (node) => node.x)
+      .attr("cy", (node) => node.y);
+    textGroup
+      .selectAll("text")
+      .attr("x", (node) => node.x)
+      .attr("y", (node) => node.y);
+  });
+
+// Returns the nodes and links that pass the current depth and pattern filters.  The root module is always
+// shown.
+const visibleGraph = () => {
+  const maxDepth = parseInt(document.getElementById("depth").value, 10) || Infinity;
+  const pattern = document.getElementById("pattern").value.trim();
+  const re = pattern ? globToRegExp(pattern) : null;
+  const nodes = Array.from(explorer.nodes.values()).filter(
+    (node) => node.level === 0 || (node.level <= maxDepth && (re == null || re.test(node.name)))
+  );
+  const ids = new Set(nodes.map((node) => node.id));
+  const links = explorer.links.filter((link) => {
+    const source = typeof link.source === "object" ? link.source.id : link.source;
+    const target = typeof link.target === "object" ? link.target.id : link.target;
+    return ids.has(source) && ids.has(target);
+  });
+  return { nodes, links };
+};
+
+const getNodeColor = (node) => {
+  if (node.level === 0) {
+    return "#EC3525";
+  }
+  return node.expanded ? "#555" : "#aaa";
+};
+
+// Re-renders the graph after nodes have been added or the filters have changed
+const render = () => {
+  const { nodes, links } = visibleGraph();
+
+  const linkSel = linkGroup.selectAll("line").data(links, (link) => {
+    const source = typeof link.source === "object" ? link.source.id : link.source;
+    const target = typeof link.target === "object" ? link.target.id : link.target;
+    return `${source}->${target}`;
+  });
+  linkSel.exit().remove();
+  linkSel.enter().append("line").attr("stroke-width", 1).attr("marker-end", "url(#arrow)");
+
+  const nodeSel = nodeGroup.selectAll("circle").data(nodes, (node) => node.id);
+  nodeSel.exit().remove();
+  nodeSel
+    .enter()
+    .append("circle")
+    .attr("r", nodeRadius)
+    .on("click", (node) => expandNode(node))
+    .on("dblclick", (node) => {
+      window.location.href = uiURL("module.html", { id: node.name, version: node.version, direction: explorer.direction });
+    })
+    .call(
+      d3
+        .drag()
+        .on("start", (node) => {
+          if (!d3.event.active) {
+            simulation.alphaTarget(0.3).restart();
+          }
+          node.fx = node.x;
+          node.fy = node.y;
+        })
+        .on("drag", (node) => {
+          node.fx = d3.event.x;
+          node.fy = d3.event.y;
+        })
+        .on("end", (node) => {
+          if (!d3.event.active) {
+            simulation.alphaTarget(0);
+          }
+          node.fx = null;
+          node.fy = null;
+        })
+    )
+    .append("title")
+    .text((node) => node.id);
+  nodeGroup.selectAll("circle").attr("fill", getNodeColor);
+
+  const textSel = textGroup.selectAll("text").data(nodes, (node) => node.id);
+  textSel.exit().remove();
+  textSel
+    .enter()
+    .append("text")
+    .attr("dx", nodeRadius + 4)
+    .attr("dy", 4)
+    .text((node) => node.id);
+
+  simulation.nodes(nodes);
+  simulation.force("link").links(links);
+  simulation.alpha(0.5).restart();
+
+  const total = explorer.nodes.size;
+  document.getElementById("nodecount").textContent =
+    nodes.length === total ? `${total} modules` : `${nodes.length} of ${total} modules shown`;
+};
+
+// Fetches the direct dependencies or dependents of node and adds them to the graph
+const expandNode = async (node) => {
+  if (node.expanded) {
+    return;
+  }
+  node.expanded = true;
+  let deps;
+  try {
+    deps = await getDirectDeps(node.name, node.version, explorer.direction);
+  } catch (err) {
+    node.expanded = false;
+    alert(`unable to retrieve the ${explorer.direction} of ${node.id}: ${err.message}`);
+    return;
+  }
+  deps.forEach((dep) => {
+    const id = `${dep.name}@${dep.version}`;
+    if (!explorer.nodes.has(id)) {
+      explorer.nodes.set(id, {
+        id: id,
+        name: dep.name,
+        version: dep.version,
+        level: node.level + 1,
+        expanded: false,
+        // start new nodes next to the one that was expanded rather than at the origin
+        x: node.x,
+        y: node.y,
+      });
+    }
+    // links always point from a module to its dependency
+    explorer.links.push(
+      explorer.direction === "dependencies" ? { source: node.id, target: id } : { source: id, target: node.id }
+    );
+  });
+  render();
+};
+
+// Resets the graph so that it only contains the specified module version, then expands it
+const exploreModule = async (name, version) => {
+  if (!version) {
+    const versions = await getModuleVersions(name);
+    if (versions.length === 0) {
+      alert(`no versions found for ${name}`);
+      return;
+    }
+    version = versions[0];
+  }
+  const url = new URL(window.location.href);
+  url.search = new URLSearchParams({ id: name, version: version, direction: explorer.direction }).toString();
+  window.history.replaceState(null, "", url);
+  document.title = `${name}@${version}`;
+  document.getElementById("search").value = name;
+
+  explorer.nodes.clear();
+  explorer.links = [];
+  const root = { id: `${name}@${version}`, name: name, version: version, level: 0, expanded: false, x: width / 2, y: height / 2 };
+  explorer.nodes.set(root.id, root);
+  render();
+  await expandNode(root);
+};
+
+// Returns the current view as a standalone SVG document
+const serializeGraph = () => {
+  const clone = svg.node().cloneNode(true);
+  clone.setAttribute("xmlns", "http://www.w3.org/2000/svg");
+  // inline the styles from the page so that the exported image looks the same
+  clone.querySelectorAll(".links line").forEach((el) => el.setAttribute("stroke", "rgba(50, 50, 50, 0.3)"));
+  clone.querySelectorAll(".texts text").forEach((el) => {
+    el.setAttribute("font-family", "sans-serif");
+    el.setAttribute("font-size", "12px");
+  });
+  return new XMLSerializer().serializeToString(clone);
+};
+
+const download = (blob, filename) => {
+  const a = document.createElement("a");
+  a.href = URL.createObjectURL(blob);
+  a.download = filename;
+  a.click();
+  URL.revokeObjectURL(a.href);
+};
+
+const exportFilename = (ext) => {
+  const root = Array.from(explorer.nodes.values()).find((node) => node.level === 0);
+  const base = root ? root.id.replace(/[^A-Za-z0-9.@-]+/g, "_") : "perseus";
+  return `${base}-${explorer.direction}.${ext}`;
+};
+
+document.getElementById("export-svg").addEventListener("click", () => {
+  download(new Blob([serializeGraph()], { type: "image/svg+xml" }), exportFilename("svg"));
+});
+
+document.getElementById("export-png").addEventListener("click", () => {
+  const img = new Image();
+  img.onload = () => {
+    const canvas = document.createElement("canvas");
+    canvas.width = width;
+    canvas.height = height;
+    const ctx = canvas.getContext("2d");
+    ctx.fillStyle = "#fff";
+    ctx.fillRect(0, 0, width, height);
+    ctx.drawImage(img, 0, 0);
+    URL.revokeObjectURL(img.src);
+    canvas.toBlob((blob) => download(blob, exportFilename("png")), "image/png");
+  };
+  img.src = URL.createObjectURL(new Blob([serializeGraph()], { type: "image/svg+xml" }));
+});
+
+// Suggest matching modules as the user types
+let suggestTimer = null;
+document.getElementById("search").addEventListener("input", function () {
+  clearTimeout(suggestTimer);
+  const term = this.value.trim();
+  if (term.length < 3) {
+    return;
+  }
+  suggestTimer = setTimeout(async () => {
+    const mods = await listModules(`*${term}*`);
+    const list = document.getElementById("suggestions");
+    list.replaceChildren();
+    mods.slice(0, 50).forEach((mod) => {
+      const option = document.createElement("option");
+      option.value = mod.name;
+      list.append(option);
+    });
+  }, 250);
+});
+
+document.getElementById("search-form").addEventListener("submit", (e) => {
+  e.preventDefault();
+  const name = document.getElementById("search").value.trim();
+  if (name !== "") {
+    exploreModule(name, "");
+  }
+});
+
+const directionSelect = document.getElementById("direction");
+directionSelect.value = explorer.direction;
+directionSelect.addEventListener("change", function () {
+  explorer.direction = this.value;
+  const root = Array.from(explorer.nodes.values()).find((node) => node.level === 0);
+  if (root) {
+    exploreModule(root.name, root.version);
+  }
+});
+
+document.getElementById("depth").addEventListener("input", render);
+document.getElementById("pattern").addEventListener("input", render);
+
+if (params.get("id")) {
+  exploreModule(params.get("id"), params.get("version") || "");
+}
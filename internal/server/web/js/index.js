@@ -1,46 +1,63 @@
+const pageSize = 100;
+
 const addModulesToElement = (el, modules) => {
+  el.replaceChildren();
+  if (modules.length === 0) {
+    const td = document.createElement("td");
+    td.colSpan = 3;
+    const i = document.createElement("i");
+    i.textContent = "no matching modules";
+    td.append(i);
+    el.insertRow().append(td);
+    return;
+  }
   modules.forEach((mod) => {
-    let a = document.createElement("a");
-    a.href = `/ui/module.html?id=${mod.name}`;
-    a.innerHTML = `${mod.name}`;
+    const a = newLink(uiURL("module.html", { id: mod.name }), mod.name);
+    const versions = newLink(uiURL("versions.html", { id: mod.name }), "versions");
+    const docs = newLink(docsURL(mod.name, ""), "docs");
 
-    let div = document.createElement("div");
-    div.append(a);
+    let row = document.createElement("tr");
+    let name = document.createElement("td");
+    name.append(a);
+    let latest = document.createElement("td");
+    latest.textContent = (mod.versions || [])[0] || "-";
+    let links = document.createElement("td");
+    links.append(versions, " | ", docs);
+    row.append(name, latest, links);
 
-    el.append(div);
+    el.append(row);
   });
 };
 
-const fuzzySearch = function (string, term, ratio) {
-  string = string.toLowerCase();
-  let compare = term.toLowerCase();
-  let matches = 0;
-  if (string.indexOf(compare) > -1) return true; // covers basic partial matches
-  for (let i = 0; i < compare.length; i++) {
-    string.indexOf(compare[i]) > -1 ? (matches += 1) : (matches -= 1);
+// Converts the search input into a glob pattern for the API.  Input without any wildcards matches every
+// module whose path contains it.
+const searchFilter = (input) => {
+  input = input.trim();
+  if (input === "") {
+    return "*";
   }
-  return matches / string.length >= ratio || term == "";
+  return /[*?]/.test(input) ? input : `*${input}*`;
 };
 
-async function loadPage() {
+function loadPage() {
   const content = document.getElementById("content");
-  const count = document.getElementById("count");
-
-  // Populate the page with modules
-  const modules = await listModules();
-  count.innerHTML = `(${modules.length})`;
-  addModulesToElement(content, modules);
+  const search = document.getElementById("search");
+  const params = new URLSearchParams(window.location.search);
+  search.value = params.get("q") || "";
 
-  // Filter the modules based on user input
-  const filter = document.getElementById("search");
-  filter.addEventListener("keyup", function () {
-    const input = this.value;
-    const filteredMods = modules.filter((mod) =>
-      fuzzySearch(mod.name, input, 0.5)
-    );
-    content.innerHTML = "";
-    addModulesToElement(content, filteredMods);
+  const pager = new Pager(
+    (token) => searchModules(searchFilter(search.value), pageSize, token),
+    (modules) => addModulesToElement(content, modules)
+  );
+  document.getElementById("search-form").addEventListener("submit", (e) => {
+    e.preventDefault();
+    const url = new URL(window.location.href);
+    url.search = search.value.trim() ? new URLSearchParams({ q: search.value.trim() }).toString() : "";
+    window.history.replaceState(null, "", url);
+    pager.reset();
   });
+  pager.reset();
 }
 
 loadPage();
+showSession("session");
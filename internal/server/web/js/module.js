@@ -19,7 +19,40 @@ let direction = params.get("direction");
 
 // Set the page title
 document.title = module;
-document.getElementById("title").innerHTML = module;
+document.getElementById("title").textContent = module;
+
+// Show the module's fan-in and fan-out, which is its "blast radius"
+const showMetrics = async () => {
+  const m = await getModuleMetrics(module);
+  const bold = (text) => {
+    const b = document.createElement("b");
+    b.textContent = text;
+    return b;
+  };
+  const metrics = document.getElementById("metrics");
+  metrics.replaceChildren(
+    bold(m.directDependents),
+    " direct and ",
+    bold(m.transitiveDependents),
+    " transitive dependents, ",
+    bold(m.directDependencies),
+    " direct and ",
+    bold(m.transitiveDependencies),
+    " transitive dependencies"
+  );
+
+  // Warn if the module's source repository is archived or hasn't had a commit in 2 years
+  const inactiveSince = new Date();
+  inactiveSince.setFullYear(inactiveSince.getFullYear() - 2);
+  if (m.archived) {
+    metrics.append(document.createElement("br"), bold("The source repository has been archived"));
+  } else if (m.lastCommitTime != null && m.lastCommitTime < inactiveSince) {
+    metrics.append(
+      document.createElement("br"),
+      bold(`The source repository has not had a commit since ${m.lastCommitTime.toISOString().slice(0, 10)}`)
+    );
+  }
+};
 
 async function loadPage() {
   // Fetch the module's versions
@@ -32,20 +65,38 @@ async function loadPage() {
   if (version == null) {
     version = versions[0];
   }
+
+  // Link to the module's documentation and source repository
   if (direction == null) {
     direction = "dependents";
   }
+  const links = document.getElementById("links");
+  links.replaceChildren(newLink(docsURL(module, version), "pkg.go.dev"));
+  const src = sourceURL(module);
+  if (src != null && isWebURL(src)) {
+    links.append(" | ", newLink(src, "source"));
+  }
+  links.append(
+    " | ",
+    newLink(uiURL("versions.html", { id: module }), "versions"),
+    " | ",
+    newLink(uiURL("deps.html", { id: module, version: version, direction: direction }), "list"),
+    " | ",
+    newLink(uiURL("explorer.html", { id: module, version: version, direction: direction }), "explore"),
+    " | ",
+    newLink(uiURL("paths.html", { from: `${module}@${version}` }), "find paths")
+  );
 
   // Populate select dropdowns
   const versionSelect = document.getElementById("version");
   versionSelect.addEventListener("change", function () {
-    window.location.href = `/ui/module.html?id=${module}&version=${this.value}&direction=${direction}`;
+    window.location.href = uiURL("module.html", { id: module, version: this.value, direction: direction });
   });
   addOptionsToElement(versionSelect, versions, version);
 
   const directionSelect = document.getElementById("direction");
   directionSelect.addEventListener("change", function () {
-    window.location.href = `/ui/module.html?id=${module}&version=${version}&direction=${this.value}`;
+    window.location.href = uiURL("module.html", { id: module, version: version, direction: this.value });
   });
   addOptionsToElement(
     directionSelect,
@@ -54,14 +105,15 @@ async function loadPage() {
   );
 
   // Fetch the module@version's first-level dependencies and render the graph
-  const { nodes, links } = await getModuleDeps(module, version, direction);
+  const { nodes, links: edges } = await getModuleDeps(module, version, direction);
   const onClick = function (node) {
     const [module, version] = node.id.split("@");
-    window.location.href = `/ui/module.html?id=${module}&version=${version}&direction=${direction}`;
+    window.location.href = uiURL("module.html", { id: module, version: version, direction: direction });
   };
 
-  document.getElementById("nodecount").innerHTML += `${nodes.length - 1} ${(direction == "dependencies")? "dependencies" : "dependents"}`
-  RenderGraph(nodes, links, onClick);
+  document.getElementById("nodecount").textContent += `${nodes.length - 1} ${(direction == "dependencies")? "dependencies" : "dependents"}`
+  RenderGraph(nodes, edges, onClick);
 }
 
+showMetrics();
 loadPage();
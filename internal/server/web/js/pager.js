@@ -0,0 +1,43 @@
+// Pages through the results of a paged API endpoint using the Previous and Next buttons on the page.
+// fetchPage is called with a page token, which is empty for the first page, and must return the results
+// for that page and the token for the next one, as returned by fetchPage() in data.js.  render is called
+// with the results of each page as it is loaded.
+const Pager = function (fetchPage, render) {
+  const prev = document.getElementById("prev"),
+    next = document.getElementById("next"),
+    label = document.getElementById("page");
+  // the tokens of the pages that have been visited, so that Previous can go back to them
+  let tokens = [];
+  let nextToken = "";
+
+  const load = async (token) => {
+    prev.disabled = next.disabled = true;
+    let page;
+    try {
+      page = await fetchPage(token);
+    } catch (err) {
+      label.textContent = `unable to load the results: ${err.message}`;
+      return;
+    }
+    nextToken = page.nextPageToken;
+    render(page.items);
+    prev.disabled = tokens.length <= 1;
+    next.disabled = nextToken === "";
+    label.textContent = `page ${tokens.length}`;
+  };
+
+  prev.onclick = () => {
+    tokens.pop();
+    load(tokens[tokens.length - 1]);
+  };
+  next.onclick = () => {
+    tokens.push(nextToken);
+    load(nextToken);
+  };
+
+  // Loads the first page of results
+  this.reset = () => {
+    tokens = [""];
+    load("");
+  };
+};
@@ -0,0 +1,204 @@
+// Finds the dependency paths between 2 modules, mirroring the 'perseus find-paths' CLI command.  The
+// search walks the direct dependencies of the 'from' module level by level, so the shortest paths are
+// found first.
+
+// the maximum number of module versions whose dependencies are retrieved at the same time
+const searchConcurrency = 8;
+// the maximum number of paths returned when all paths are requested
+const maxPaths = 100;
+// the maximum number of partial paths that are followed at each level when all paths are requested
+const maxFrontier = 20000;
+
+// Parses a module[@version] string into a {name, version} object, where version is empty if omitted
+const parseModule = (s) => {
+  const [name, version] = s.trim().split("@");
+  return { name: name, version: version || "" };
+};
+
+const moduleID = (mod) => `${mod.name}@${mod.version}`;
+
+// Calls fn for each of items, with at most limit calls outstanding, and returns the results in order
+const mapConcurrently = async (items, limit, fn) => {
+  const results = new Array(items.length);
+  let next = 0;
+  const worker = async () => {
+    while (next < items.length) {
+      const i = next++;
+      results[i] = await fn(items[i]);
+    }
+  };
+  await Promise.all(Array.from({ length: Math.min(limit, items.length) }, worker));
+  return results;
+};
+
+// Returns the dependency paths, up to maxDepth links long, from the module version 'from' to any version
+// of 'to' or, if to.version is not empty, to that version.  Modules whose paths match any of exclude are
+// not followed.  Only the shortest paths are returned unless all is true.
+const findPaths = async (from, to, maxDepth, all, exclude, onStatus) => {
+  const cache = new Map();
+  const getDeps = (mod) => {
+    const id = moduleID(mod);
+    if (!cache.has(id)) {
+      cache.set(id, getDirectDeps(mod.name, mod.version, "dependencies"));
+    }
+    return cache.get(id);
+  };
+  const isTarget = (mod) => mod.name === to.name && (to.version === "" || mod.version === to.version);
+  const isExcluded = (mod) => exclude.some((re) => re.test(mod.name));
+
+  let found = [];
+  let truncated = false;
+  // when only the shortest paths are wanted, each module version only needs to be reached once
+  const visited = new Set([moduleID(from)]);
+  let frontier = [[from]];
+  for (let depth = 1; depth <= maxDepth && frontier.length > 0; depth++) {
+    onStatus(`searching ${frontier.length} paths of length ${depth}...`);
+    const deps = await mapConcurrently(frontier, searchConcurrency, (chain) => getDeps(chain[chain.length - 1]));
+    let next = [];
+    frontier.forEach((chain, i) => {
+      deps[i].forEach((dep) => {
+        if (isTarget(dep)) {
+          found.push(chain.concat([dep]));
+          return;
+        }
+        const id = moduleID(dep);
+        if (isExcluded(dep) || chain.some((m) => moduleID(m) === id)) {
+          return;
+        }
+        if (!all) {
+          if (visited.has(id)) {
+            return;
+          }
+          visited.add(id);
+        }
+        next.push(chain.concat([dep]));
+      });
+    });
+    if (found.length > 0 && !all) {
+      break;
+    }
+    if (found.length >= maxPaths) {
+      found = found.slice(0, maxPaths);
+      truncated = true;
+      break;
+    }
+    if (next.length > maxFrontier) {
+      next = next.slice(0, maxFrontier);
+      truncated = true;
+    }
+    frontier = next;
+  }
+  return { paths: found, truncated: truncated };
+};
+
+// Renders each path as a chain of modules, where each intermediate module can be clicked to exclude it
+// from the search and re-run it
+const renderPaths = (paths, onExclude) => {
+  const el = document.getElementById("paths");
+  el.replaceChildren();
+  paths.forEach((path) => {
+    let div = document.createElement("div");
+    div.className = "path";
+    path.forEach((mod, i) => {
+      if (i > 0) {
+        div.append(" → ");
+      }
+      div.append(newLink(uiURL("module.html", { id: mod.name, version: mod.version }), moduleID(mod)));
+      if (i > 0 && i < path.length - 1) {
+        let x = document.createElement("span");
+        x.className = "exclude";
+        x.title = `exclude ${mod.name} and search again`;
+        x.textContent = " [exclude]";
+        x.onclick = () => onExclude(mod.name);
+        div.append(x);
+      }
+    });
+    el.append(div);
+  });
+};
+
+// Renders the union of the paths as a graph
+const renderPathGraph = (paths) => {
+  d3.select("#graph").selectAll("*").remove();
+  let nodes = new Map();
+  let links = new Map();
+  paths.forEach((path) => {
+    path.forEach((mod, i) => {
+      const id = moduleID(mod);
+      if (!nodes.has(id)) {
+        nodes.set(id, { id: id, label: id, level: i === 0 ? 0 : 1 });
+      }
+      if (i > 0) {
+        const source = moduleID(path[i - 1]);
+        links.set(`${source}->${id}`, { source: source, target: id });
+      }
+    });
+  });
+  RenderGraph(Array.from(nodes.values()), Array.from(links.values()), (node) => {
+    const [module, version] = node.id.split("@");
+    window.location.href = uiURL("module.html", { id: module, version: version });
+  });
+};
+
+const runSearch = async () => {
+  const status = (msg) => {
+    document.getElementById("status").textContent = msg;
+  };
+  const fromInput = document.getElementById("from").value,
+    toInput = document.getElementById("to").value,
+    depth = parseInt(document.getElementById("depth").value, 10) || 4,
+    all = document.getElementById("all").checked,
+    excludeInput = document.getElementById("exclude").value;
+
+  // keep the search in the URL so that it can be shared
+  const url = new URL(window.location.href);
+  url.search = new URLSearchParams({ from: fromInput, to: toInput, depth: depth, all: all, exclude: excludeInput }).toString();
+  window.history.replaceState(null, "", url);
+
+  const from = parseModule(fromInput),
+    to = parseModule(toInput);
+  const exclude = excludeInput
+    .split("\n")
+    .map((line) => line.trim())
+    .filter((line) => line !== "")
+    .map((line) => (line.includes("*") ? globToRegExp(line) : new RegExp(`^${globToRegExp(line).source}$`)));
+  try {
+    if (from.version === "") {
+      const versions = await getModuleVersions(from.name);
+      if (versions.length === 0) {
+        status(`no versions found for ${from.name}`);
+        return;
+      }
+      from.version = versions[0];
+    }
+    const result = await findPaths(from, to, depth, all, exclude, status);
+    if (result.paths.length === 0) {
+      status(`no paths found from ${moduleID(from)} to ${toInput.trim()} within ${depth} levels`);
+    } else {
+      status(`found ${result.paths.length} path(s) from ${moduleID(from)} to ${toInput.trim()}` +
+        (result.truncated ? ", the search was stopped early because there are too many paths" : ""));
+    }
+    renderPaths(result.paths, (name) => {
+      const el = document.getElementById("exclude");
+      el.value = (el.value.trim() + "\n" + name).trim();
+      runSearch();
+    });
+    renderPathGraph(result.paths);
+  } catch (err) {
+    status(`unable to find the paths: ${err.message}`);
+  }
+};
+
+const params = new URLSearchParams(window.location.search);
+document.getElementById("from").value = params.get("from") || "";
+document.getElementById("to").value = params.get("to") || "";
+document.getElementById("depth").value = params.get("depth") || "4";
+document.getElementById("all").checked = params.get("all") === "true";
+document.getElementById("exclude").value = params.get("exclude") || "";
+document.getElementById("paths-form").addEventListener("submit", (e) => {
+  e.preventDefault();
+  runSearch();
+});
+if (params.get("from") && params.get("to")) {
+  runSearch();
+}
@@ -0,0 +1,43 @@
+const params = new URLSearchParams(window.location.search);
+const module = params.get("id");
+
+document.title = `${module} versions`;
+document.getElementById("title").textContent = module;
+
+async function loadPage() {
+  const content = document.getElementById("content");
+  const versions = await getModuleVersionDetails(module);
+  document.getElementById("count").textContent = `${versions.length} versions`;
+
+  versions.forEach((v) => {
+    let row = document.createElement("tr");
+
+    let version = document.createElement("td");
+    version.append(newLink(uiURL("module.html", { id: module, version: v.version }), v.version));
+    const badge = versionBadge(v.version);
+    if (badge != null) {
+      let span = document.createElement("span");
+      span.className = `badge ${badge}`;
+      span.textContent = badge;
+      version.append(" ", span);
+    }
+
+    // the time the version was added to the graph, which approximates its release date
+    let added = document.createElement("td");
+    added.textContent = v.addedAt != null ? v.addedAt.toISOString().slice(0, 10) : "-";
+
+    let links = document.createElement("td");
+    links.append(
+      newLink(uiURL("deps.html", { id: module, version: v.version, direction: "dependencies" }), "dependencies"),
+      " | ",
+      newLink(uiURL("deps.html", { id: module, version: v.version, direction: "dependents" }), "dependents"),
+      " | ",
+      newLink(docsURL(module, v.version), "docs")
+    );
+
+    row.append(version, added, links);
+    content.append(row);
+  });
+}
+
+loadPage();
@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// SaveModuleAlias records alias as an alternate name for the existing module name, such as the code
+// hosting site path of a module with a vanity import path, so that queries and updates that use either
+// name refer to the same module.
+//
+// The alias is not recorded if it is already an alias or if a module with that name exists.  If name does
+// not exist, the returned error wraps [ErrModuleNotFound].
+func (p *PostgresClient) SaveModuleAlias(ctx context.Context, name, alias string) error {
+	if name == "" || alias == "" {
+		return fmt.Errorf("both the module name and the alias must be specified")
+	}
+	if name == alias {
+		return fmt.Errorf("a module cannot be an alias of itself")
+	}
+	q := `INSERT INTO module_alias (alias, module_id)
+	      SELECT $2, m.id FROM module m
+	       WHERE m.name = $1
+	         AND NOT EXISTS (SELECT 1 FROM module WHERE name = $2)
+	      ON CONFLICT (alias) DO NOTHING`
+	p.log.Debug("saving module alias", "sql", q, "module", name, "alias", alias)
+	if _, err := p.db.ExecContext(ctx, q, name, alias); err != nil {
+		return fmt.Errorf("database error saving module alias: %w", err)
+	}
+
+	var exists bool
+	if err := p.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM module WHERE name = $1)`, name); err != nil {
+		return fmt.Errorf("database error querying for module %s: %w", name, err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrModuleNotFound, name)
+	}
+	return nil
+}
+
+// matchModuleName returns a WHERE clause that matches the modules whose name, or any of whose aliases,
+// matches filter, which is a glob pattern if it contains any wildcards and an exact name otherwise.  The
+// id and name columns of the module table are referenced using the specified table alias, if any.
+func matchModuleName(table, filter string) sq.Sqlizer {
+	if table != "" {
+		table += "."
+	}
+	if strings.ContainsAny(filter, "*?") {
+		like := globToLike(filter)
+		return sq.Or{
+			sq.Like{table + "name": like},
+			sq.Expr(table+"id IN (SELECT module_id FROM "+tableModuleAliases+" WHERE alias LIKE ?)", like),
+		}
+	}
+	return sq.Or{
+		sq.Eq{table + "name": filter},
+		sq.Expr(table+"id IN (SELECT module_id FROM "+tableModuleAliases+" WHERE alias = ?)", filter),
+	}
+}
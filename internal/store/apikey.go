@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrAPIKeyNotFound is returned, possibly wrapped, when an operation references an API key that does not
+// exist or has been revoked.
+var ErrAPIKeyNotFound = errors.New("API key not found")
+
+// ErrAPIKeyExists is returned, possibly wrapped, when an API key is created with the same name as an
+// existing key.
+var ErrAPIKeyExists = errors.New("API key already exists")
+
+var columnsAPIKeys = []string{"name", "created_at", "last_used_at", "revoked_at"}
+
+// An APIKey authenticates callers of the server's administrative operations.  Only the hash of the key
+// is stored.
+type APIKey struct {
+	Name       string       `db:"name"`
+	CreatedAt  time.Time    `db:"created_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at"`
+}
+
+// CreateAPIKey stores a new API key with the specified name and hash.  If a key with the same name already
+// exists, including a revoked key, the returned error wraps [ErrAPIKeyExists].
+func (p *PostgresClient) CreateAPIKey(ctx context.Context, name, keyHash string) (APIKey, error) {
+	q := `INSERT INTO api_key (name, key_hash) VALUES ($1, $2)
+	      ON CONFLICT DO NOTHING
+	   RETURNING ` + strings.Join(columnsAPIKeys, ", ")
+	p.log.Debug("create API key", "sql", q, "name", name)
+	var key APIKey
+	err := p.db.GetContext(ctx, &key, q, name, keyHash)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		// nothing is inserted if the name is taken
+		return key, fmt.Errorf("%w: %s", ErrAPIKeyExists, name)
+	case err != nil:
+		return key, fmt.Errorf("database error creating API key %s: %w", name, err)
+	default:
+		return key, nil
+	}
+}
+
+// ListAPIKeys returns all API keys, including revoked keys, ordered by name
+func (p *PostgresClient) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	q := `SELECT ` + strings.Join(columnsAPIKeys, ", ") + ` FROM api_key ORDER BY name`
+	p.log.Debug("list API keys", "sql", q)
+	var keys []APIKey
+	if err := p.db.SelectContext(ctx, &keys, q); err != nil {
+		return nil, fmt.Errorf("database error querying for API keys: %w", err)
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revokes the API key with the specified name so that it can no longer be used.  If there is
+// no such key, or it was already revoked, the returned error wraps [ErrAPIKeyNotFound].
+func (p *PostgresClient) RevokeAPIKey(ctx context.Context, name string) error {
+	q := `UPDATE api_key SET revoked_at = now() WHERE name = $1 AND revoked_at IS NULL`
+	p.log.Debug("revoke API key", "sql", q, "name", name)
+	res, err := p.db.ExecContext(ctx, q, name)
+	if err != nil {
+		return fmt.Errorf("database error revoking API key %s: %w", name, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("%w: %s", ErrAPIKeyNotFound, name)
+	}
+	return nil
+}
+
+// AuthenticateAPIKey returns the API key with the specified hash and records that it was used.  If there
+// is no such key, or it has been revoked, the returned error wraps [ErrAPIKeyNotFound].
+func (p *PostgresClient) AuthenticateAPIKey(ctx context.Context, keyHash string) (APIKey, error) {
+	q := `UPDATE api_key SET last_used_at = now() WHERE key_hash = $1 AND revoked_at IS NULL
+	   RETURNING ` + strings.Join(columnsAPIKeys, ", ")
+	var key APIKey
+	err := p.db.GetContext(ctx, &key, q, keyHash)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return key, ErrAPIKeyNotFound
+	case err != nil:
+		return key, fmt.Errorf("database error authenticating API key: %w", err)
+	default:
+		return key, nil
+	}
+}
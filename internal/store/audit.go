@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+const tableAuditLog = "audit_log"
+
+var columnsAuditLog = []string{"id", "created_at", "actor", "action", "target", "details"}
+
+// An AuditEntry records a change made by an administrative operation
+type AuditEntry struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	// the name of the API key that authenticated the operation, "admin" for the server's admin token, or
+	// "anonymous" if authentication is disabled
+	Actor string `db:"actor"`
+	// the operation, ex: delete-module
+	Action string `db:"action"`
+	// what the operation changed, ex: a module path
+	Target  string         `db:"target"`
+	Details sql.NullString `db:"details"`
+}
+
+// AddAuditEntry appends an entry to the audit log.  The ID and time of entry are assigned by the database.
+func (p *PostgresClient) AddAuditEntry(ctx context.Context, entry AuditEntry) error {
+	sql, args, err := psql.
+		Insert(tableAuditLog).
+		Columns("actor", "action", "target", "details").
+		Values(entry.Actor, entry.Action, entry.Target, entry.Details).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("add audit log entry", "sql", sql, "args", args)
+	if _, err := p.db.ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("database error adding an audit log entry: %w", err)
+	}
+	return nil
+}
+
+// QueryAuditLog returns a list of 0 to count audit log entries, most recent first, along with a paging
+// token.
+func (p *PostgresClient) QueryAuditLog(ctx context.Context, pageToken string, count int) ([]AuditEntry, string, error) {
+	pageTokenKey := "auditlog"
+	offset := 0
+	if pageToken != "" {
+		var err error
+		if offset, err = decodePageToken(pageToken, pageTokenKey); err != nil {
+			return nil, "", err
+		}
+	}
+
+	q := psql.
+		Select(columnsAuditLog...).
+		From(tableAuditLog).
+		OrderBy("id DESC")
+	if offset > 0 {
+		q = q.Offset(uint64(offset))
+	}
+	if count > 0 {
+		q = q.Limit(uint64(count))
+	}
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, "", fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("QueryAuditLog", "sql", sql, "args", args)
+	var entries []AuditEntry
+	if err := p.db.SelectContext(ctx, &entries, sql, args...); err != nil {
+		return nil, "", fmt.Errorf("database error querying the audit log: %w", err)
+	}
+	return entries, encodePageToken(pageTokenKey, len(entries), offset, count), nil
+}
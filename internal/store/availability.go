@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// the reasons that a stored module version is unavailable
+const (
+	// the Go module proxy no longer lists the version, ex: its tag was deleted
+	UnavailableMissing = "missing"
+	// the latest version of the module retracts the version
+	UnavailableRetracted = "retracted"
+)
+
+// GhostVersion is a stored module version that can no longer be used, along with how many module
+// versions still depend on it
+type GhostVersion struct {
+	Module  string `db:"module"`
+	Version string `db:"version"`
+	// why the version is unavailable, either [UnavailableMissing] or [UnavailableRetracted]
+	Reason string `db:"unavailable_reason"`
+	// when the version was first found to be unavailable for Reason
+	Since time.Time `db:"unavailable_since"`
+	// the number of module versions that currently depend directly on the version
+	Dependents int `db:"dependents"`
+}
+
+// SaveVersionAvailability records which versions of the named module are unavailable, where unavailable
+// maps each version to the reason that it is unavailable.  Every other version of the module is marked as
+// available.  The time that a version became unavailable is kept as long as the reason does not change.
+// If the module does not exist, the returned error wraps [ErrModuleNotFound].
+func (p *PostgresClient) SaveVersionAvailability(ctx context.Context, name string, unavailable map[string]string) error {
+	name, err := resolveModuleAlias(ctx, p.db, name)
+	if err != nil {
+		return err
+	}
+	id, err := getModuleID(ctx, p.db, name)
+	if err != nil {
+		return err
+	}
+
+	var cmd string
+	args := []any{id}
+	if len(unavailable) == 0 {
+		cmd = `UPDATE module_version SET unavailable_reason = NULL, unavailable_since = NULL
+		        WHERE module_id = $1 AND unavailable_reason IS NOT NULL`
+	} else {
+		values := make([]string, 0, len(unavailable))
+		for v, reason := range unavailable {
+			values = append(values, fmt.Sprintf("($%d::semver, $%d::text)", len(args)+1, len(args)+2))
+			args = append(args, strings.TrimPrefix(v, "v"), reason)
+		}
+		cmd = `UPDATE module_version mv
+		          SET unavailable_reason = u.reason,
+		              unavailable_since = CASE WHEN u.reason IS NULL THEN NULL ELSE now() END
+		         FROM module_version v
+		              LEFT JOIN (VALUES ` + strings.Join(values, ", ") + `) AS u (version, reason) ON (u.version = v.version)
+		        WHERE mv.id = v.id
+		          AND v.module_id = $1
+		          AND v.unavailable_reason IS DISTINCT FROM u.reason`
+	}
+	p.log.Debug("saving module version availability", "module", name, "unavailable", unavailable)
+	if _, err := p.db.ExecContext(ctx, cmd, args...); err != nil {
+		return fmt.Errorf("database error saving module version availability: %w", err)
+	}
+	return nil
+}
+
+// GetGhostVersions returns the unavailable versions of the modules matching moduleFilter, ordered by
+// descending number of dependents then by module name and version.
+func (p *PostgresClient) GetGhostVersions(ctx context.Context, moduleFilter string) ([]GhostVersion, error) {
+	if moduleFilter == "" {
+		return nil, fmt.Errorf("the module filter must be specified")
+	}
+	q := psql.
+		Select(
+			"m.name AS module",
+			"mv.version",
+			"mv.unavailable_reason",
+			"mv.unavailable_since",
+			"(SELECT count(*) FROM module_dependency d WHERE d.dependee_id = mv.id AND d.removed_at IS NULL) AS dependents").
+		From(tableModuleVersions+" mv").
+		Join(tableModules+" m ON (m.id = mv.module_id)").
+		Where(matchModuleName("m", moduleFilter)).
+		Where(sq.NotEq{"mv.unavailable_reason": nil}).
+		OrderBy("dependents DESC", "m.name", "mv.version DESC")
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("querying ghost module versions", "sql", sql, "args", args)
+	var results []GhostVersion
+	if err := p.db.SelectContext(ctx, &results, sql, args...); err != nil {
+		return nil, fmt.Errorf("database error querying ghost module versions: %w", err)
+	}
+	return results, nil
+}
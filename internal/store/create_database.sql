@@ -6,16 +6,30 @@ CREATE TABLE module (
     id              SERIAL,
     name            TEXT NOT NULL,
     description     TEXT,
+    family          TEXT NOT NULL,
+    importance      DOUBLE PRECISION NOT NULL DEFAULT 0,
+    license         TEXT NULL,
+    archived        BOOLEAN NULL,
+    last_commit_at  TIMESTAMPTZ NULL,
+    upstream_checked_at TIMESTAMPTZ NULL,
     CONSTRAINT pk_module
         PRIMARY KEY(id),
     CONSTRAINT uc_module_name
         UNIQUE(name)
 );
 
+CREATE INDEX idx_module_family
+    ON module USING btree
+    (family);
+
 CREATE TABLE module_version (
     id          SERIAL,
     module_id   INTEGER NOT NULL,
     version     SEMVER NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    original_version TEXT NULL,
+    unavailable_reason TEXT NULL,
+    unavailable_since TIMESTAMPTZ NULL,
     CONSTRAINT pk_module_version
         PRIMARY KEY(id),
     CONSTRAINT uc_module_version_module_id_version
@@ -33,6 +47,9 @@ CREATE INDEX idx_module_version_version
 CREATE TABLE module_dependency (
     dependent_id    INTEGER NOT NULL,
     dependee_id     INTEGER NOT NULL,
+    first_seen      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_seen       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    removed_at      TIMESTAMPTZ NULL,
     CONSTRAINT pk_module_dependency
         PRIMARY KEY(dependent_id, dependee_id),
     CONSTRAINT fk_module_dependency_dependent_id_module_verison_id
@@ -44,3 +61,116 @@ CREATE TABLE module_dependency (
         ON UPDATE NO ACTION
         ON DELETE CASCADE
 );
+
+CREATE TABLE module_dependency_gap (
+    dependent_id    INTEGER NOT NULL,
+    dependee_id     INTEGER NOT NULL,
+    removed_at      TIMESTAMPTZ NOT NULL,
+    restored_at     TIMESTAMPTZ NOT NULL,
+    CONSTRAINT pk_module_dependency_gap
+        PRIMARY KEY(dependent_id, dependee_id, removed_at),
+    CONSTRAINT fk_module_dependency_gap_module_dependency
+        FOREIGN KEY(dependent_id, dependee_id) REFERENCES module_dependency (dependent_id, dependee_id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE module_alias (
+    alias       TEXT NOT NULL,
+    module_id   INTEGER NOT NULL,
+    CONSTRAINT pk_module_alias
+        PRIMARY KEY(alias),
+    CONSTRAINT fk_module_alias_module_id_module_id
+        FOREIGN KEY(module_id) REFERENCES module (id)
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
+
+CREATE TABLE module_rename (
+    old_module_id   INTEGER NOT NULL,
+    new_module_id   INTEGER NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT pk_module_rename
+        PRIMARY KEY(old_module_id),
+    CONSTRAINT fk_module_rename_old_module_id_module_id
+        FOREIGN KEY(old_module_id) REFERENCES module (id)
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT fk_module_rename_new_module_id_module_id
+        FOREIGN KEY(new_module_id) REFERENCES module (id)
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
+
+CREATE TABLE ingestion_job (
+    id          BIGSERIAL,
+    module      TEXT NOT NULL,
+    version     TEXT NOT NULL,
+    source      TEXT NOT NULL,
+    status      TEXT NOT NULL DEFAULT 'pending',
+    attempts    INTEGER NOT NULL DEFAULT 0,
+    error       TEXT,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT pk_ingestion_job
+        PRIMARY KEY(id)
+);
+
+CREATE INDEX idx_ingestion_job_status
+    ON ingestion_job USING btree
+    (status, id);
+
+CREATE TABLE api_key (
+    id              SERIAL,
+    name            TEXT NOT NULL,
+    key_hash        TEXT NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at    TIMESTAMPTZ NULL,
+    revoked_at      TIMESTAMPTZ NULL,
+    CONSTRAINT pk_api_key
+        PRIMARY KEY(id),
+    CONSTRAINT uc_api_key_name
+        UNIQUE(name),
+    CONSTRAINT uc_api_key_key_hash
+        UNIQUE(key_hash)
+);
+
+CREATE TABLE audit_log (
+    id          BIGSERIAL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    actor       TEXT NOT NULL,
+    action      TEXT NOT NULL,
+    target      TEXT NOT NULL,
+    details     TEXT NULL,
+    CONSTRAINT pk_audit_log
+        PRIMARY KEY(id)
+);
+
+CREATE TABLE deleted_module (
+    id          BIGSERIAL,
+    module_name TEXT NOT NULL,
+    version     SEMVER NULL,
+    deleted_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    snapshot    JSONB NOT NULL,
+    CONSTRAINT pk_deleted_module
+        PRIMARY KEY(id)
+);
+
+CREATE INDEX idx_deleted_module_module_name
+    ON deleted_module USING btree
+    (module_name, deleted_at DESC);
+
+CREATE TABLE idempotency_key (
+    procedure       TEXT NOT NULL,
+    key             TEXT NOT NULL,
+    request_hash    BYTEA NOT NULL,
+    response        BYTEA NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    completed_at    TIMESTAMPTZ NULL,
+    CONSTRAINT pk_idempotency_key
+        PRIMARY KEY(procedure, key)
+);
+
+CREATE INDEX idx_idempotency_key_created_at
+    ON idempotency_key USING btree
+    (created_at);
@@ -0,0 +1,278 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrModuleVersionNotFound is returned, possibly wrapped, when an operation references a version of a
+// module that does not exist.
+var ErrModuleVersionNotFound = errors.New("module version not found")
+
+// ErrModuleExists is returned, possibly wrapped, when a deleted module or module version cannot be
+// restored because another one with the same name has been added since it was deleted.
+var ErrModuleExists = errors.New("module already exists")
+
+// the snapshot of the rows removed by [PostgresClient.DeleteModule], which is kept in the deleted_module
+// table.  Every table is included so that the module can be restored exactly as it was.
+const deletedModuleSnapshot = `jsonb_build_object(
+	    'module', to_jsonb(m),
+	    'versions', COALESCE((SELECT jsonb_agg(to_jsonb(v)) FROM module_version v WHERE v.module_id = m.id), '[]'::jsonb),
+	    'dependencies', COALESCE((SELECT jsonb_agg(to_jsonb(d)) FROM module_dependency d
+	                               WHERE d.dependent_id IN (SELECT id FROM module_version WHERE module_id = m.id)
+	                                  OR d.dependee_id IN (SELECT id FROM module_version WHERE module_id = m.id)), '[]'::jsonb),
+	    'dependency_gaps', COALESCE((SELECT jsonb_agg(to_jsonb(g)) FROM module_dependency_gap g
+	                                  WHERE g.dependent_id IN (SELECT id FROM module_version WHERE module_id = m.id)
+	                                     OR g.dependee_id IN (SELECT id FROM module_version WHERE module_id = m.id)), '[]'::jsonb),
+	    'aliases', COALESCE((SELECT jsonb_agg(to_jsonb(a)) FROM module_alias a WHERE a.module_id = m.id), '[]'::jsonb),
+	    'renames', COALESCE((SELECT jsonb_agg(to_jsonb(r)) FROM module_rename r
+	                          WHERE m.id IN (r.old_module_id, r.new_module_id)), '[]'::jsonb))`
+
+// the snapshot of the rows removed by [PostgresClient.DeleteModuleVersion]
+const deletedVersionSnapshot = `jsonb_build_object(
+	    'versions', jsonb_build_array(to_jsonb(v)),
+	    'dependencies', COALESCE((SELECT jsonb_agg(to_jsonb(d)) FROM module_dependency d
+	                               WHERE v.id IN (d.dependent_id, d.dependee_id)), '[]'::jsonb),
+	    'dependency_gaps', COALESCE((SELECT jsonb_agg(to_jsonb(g)) FROM module_dependency_gap g
+	                                  WHERE v.id IN (g.dependent_id, g.dependee_id)), '[]'::jsonb))`
+
+// UndeleteResult summarizes the rows restored by [PostgresClient.UndeleteModule]
+type UndeleteResult struct {
+	// the number of module versions that were restored
+	Versions int
+	// the number of dependency edges that were restored.  Edges to or from module versions that have
+	// since been deleted are not restored.
+	Dependencies int
+}
+
+// DeleteModule removes the module with the specified name along with all of its versions, their
+// dependency edges in both directions, and any aliases and renames that refer to it.  A tombstone of the
+// removed rows is kept so that the module can be restored by [PostgresClient.UndeleteModule].  If the
+// module does not exist the returned error wraps [ErrModuleNotFound].
+func (p *PostgresClient) DeleteModule(ctx context.Context, name string) (err error) {
+	txn, err := p.beginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = txn.Commit()
+		} else {
+			if e2 := txn.Rollback(); e2 != nil {
+				p.log.Error(e2, "error rolling back transaction after error")
+			}
+		}
+	}()
+
+	id, err := getModuleID(ctx, txn, name)
+	if err != nil {
+		return err
+	}
+	q := `INSERT INTO deleted_module (module_name, snapshot)
+	      SELECT m.name, ` + deletedModuleSnapshot + ` FROM module m WHERE m.id = $1`
+	if _, err = txn.ExecContext(ctx, q, id); err != nil {
+		return fmt.Errorf("database error saving the tombstone of module %s: %w", name, err)
+	}
+	// the versions, edges, aliases, and renames are removed by the ON DELETE CASCADE foreign keys
+	q = `DELETE FROM module WHERE id = $1`
+	p.log.Debug("delete module", "sql", q, "name", name, "id", id)
+	if _, err = txn.ExecContext(ctx, q, id); err != nil {
+		return fmt.Errorf("database error deleting module %s: %w", name, err)
+	}
+	return nil
+}
+
+// DeleteModuleVersion removes the specified version of the named module along with the dependency edges
+// in both directions.  A tombstone of the removed rows is kept so that the version can be restored by
+// [PostgresClient.UndeleteModule].  The version is specified without the "v" prefix.  If the module does
+// not exist the returned error wraps [ErrModuleNotFound], and if the version does not exist it wraps
+// [ErrModuleVersionNotFound].
+func (p *PostgresClient) DeleteModuleVersion(ctx context.Context, name, version string) (err error) {
+	txn, err := p.beginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = txn.Commit()
+		} else {
+			if e2 := txn.Rollback(); e2 != nil {
+				p.log.Error(e2, "error rolling back transaction after error")
+			}
+		}
+	}()
+
+	id, err := getModuleID(ctx, txn, name)
+	if err != nil {
+		return err
+	}
+	version = strings.TrimPrefix(version, "v")
+	q := `INSERT INTO deleted_module (module_name, version, snapshot)
+	      SELECT m.name, v.version, ` + deletedVersionSnapshot + `
+	        FROM module_version v JOIN module m ON (m.id = v.module_id)
+	       WHERE v.module_id = $1 AND v.version = $2`
+	if _, err = txn.ExecContext(ctx, q, id, version); err != nil {
+		return fmt.Errorf("database error saving the tombstone of module version %s@v%s: %w", name, version, err)
+	}
+	q = `DELETE FROM module_version WHERE module_id = $1 AND version = $2`
+	p.log.Debug("delete module version", "sql", q, "name", name, "version", version)
+	res, err := txn.ExecContext(ctx, q, id, version)
+	if err != nil {
+		return fmt.Errorf("database error deleting module version %s@v%s: %w", name, version, err)
+	}
+	if n, e2 := res.RowsAffected(); e2 == nil && n == 0 {
+		return fmt.Errorf("%w: %s@v%s", ErrModuleVersionNotFound, name, version)
+	}
+	return nil
+}
+
+// UndeleteModule restores the most recently deleted module with the specified name, or the most recently
+// deleted version of it if version is not empty, from the tombstone kept when it was deleted.  The version
+// is specified without the "v" prefix.  If there is no tombstone the returned error wraps
+// [ErrModuleNotFound] or [ErrModuleVersionNotFound], and if a module or version with the same name has
+// been added since then it wraps [ErrModuleExists].
+func (p *PostgresClient) UndeleteModule(ctx context.Context, name, version string) (result UndeleteResult, err error) {
+	txn, err := p.beginTx(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = txn.Commit()
+		} else {
+			if e2 := txn.Rollback(); e2 != nil {
+				p.log.Error(e2, "error rolling back transaction after error")
+			}
+		}
+	}()
+
+	version = strings.TrimPrefix(version, "v")
+	var tombstoneID int64
+	if version == "" {
+		q := `SELECT id FROM deleted_module WHERE module_name = $1 AND version IS NULL
+		      ORDER BY deleted_at DESC LIMIT 1 FOR UPDATE`
+		if err = txn.GetContext(ctx, &tombstoneID, q, name); errors.Is(err, sql.ErrNoRows) {
+			return result, fmt.Errorf("%w: no deleted module named %s", ErrModuleNotFound, name)
+		}
+	} else {
+		q := `SELECT id FROM deleted_module WHERE module_name = $1 AND version = $2
+		      ORDER BY deleted_at DESC LIMIT 1 FOR UPDATE`
+		if err = txn.GetContext(ctx, &tombstoneID, q, name, version); errors.Is(err, sql.ErrNoRows) {
+			return result, fmt.Errorf("%w: no deleted version %s@v%s", ErrModuleVersionNotFound, name, version)
+		}
+	}
+	if err != nil {
+		return result, fmt.Errorf("database error querying deleted modules: %w", err)
+	}
+
+	if version == "" {
+		var exists bool
+		if err = txn.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM module WHERE name = $1)`, name); err != nil {
+			return result, fmt.Errorf("database error checking for module %s: %w", name, err)
+		}
+		if exists {
+			return result, fmt.Errorf("%w: %s was added again after it was deleted", ErrModuleExists, name)
+		}
+		q := `INSERT INTO module
+		      SELECT r.* FROM deleted_module t, jsonb_populate_record(NULL::module, t.snapshot->'module') r WHERE t.id = $1`
+		if _, err = txn.ExecContext(ctx, q, tombstoneID); err != nil {
+			return result, fmt.Errorf("database error restoring module %s: %w", name, err)
+		}
+	} else {
+		// the module itself may have been deleted after the version was
+		var state struct {
+			ModuleExists  bool `db:"module_exists"`
+			VersionExists bool `db:"version_exists"`
+		}
+		q := `SELECT EXISTS (SELECT 1 FROM module m WHERE m.id = r.module_id) AS module_exists,
+		             EXISTS (SELECT 1 FROM module_version v WHERE v.module_id = r.module_id AND v.version = r.version) AS version_exists
+		        FROM deleted_module t, jsonb_populate_recordset(NULL::module_version, t.snapshot->'versions') r
+		       WHERE t.id = $1`
+		if err = txn.GetContext(ctx, &state, q, tombstoneID); err != nil {
+			return result, fmt.Errorf("database error checking for module version %s@v%s: %w", name, version, err)
+		}
+		switch {
+		case !state.ModuleExists:
+			return result, fmt.Errorf("%w: %s was deleted after %s@v%s, restore the module first", ErrModuleNotFound, name, name, version)
+		case state.VersionExists:
+			return result, fmt.Errorf("%w: %s@v%s was added again after it was deleted", ErrModuleExists, name, version)
+		}
+	}
+
+	q := `INSERT INTO module_version
+	      SELECT r.* FROM deleted_module t, jsonb_populate_recordset(NULL::module_version, t.snapshot->'versions') r WHERE t.id = $1`
+	res, err := txn.ExecContext(ctx, q, tombstoneID)
+	if err != nil {
+		return result, fmt.Errorf("database error restoring the versions of module %s: %w", name, err)
+	}
+	if n, e2 := res.RowsAffected(); e2 == nil {
+		result.Versions = int(n)
+	}
+	// edges to or from versions that no longer exist cannot be restored
+	q = `INSERT INTO module_dependency
+	     SELECT r.* FROM deleted_module t, jsonb_populate_recordset(NULL::module_dependency, t.snapshot->'dependencies') r
+	      WHERE t.id = $1
+	        AND EXISTS (SELECT 1 FROM module_version v WHERE v.id = r.dependent_id)
+	        AND EXISTS (SELECT 1 FROM module_version v WHERE v.id = r.dependee_id)
+	     ON CONFLICT DO NOTHING`
+	if res, err = txn.ExecContext(ctx, q, tombstoneID); err != nil {
+		return result, fmt.Errorf("database error restoring the dependencies of module %s: %w", name, err)
+	}
+	if n, e2 := res.RowsAffected(); e2 == nil {
+		result.Dependencies = int(n)
+	}
+	// tombstones created before the module_dependency_gap table was added don't have any gaps
+	q = `INSERT INTO module_dependency_gap
+	     SELECT r.* FROM deleted_module t,
+	            jsonb_populate_recordset(NULL::module_dependency_gap, COALESCE(t.snapshot->'dependency_gaps', '[]'::jsonb)) r
+	      WHERE t.id = $1
+	        AND EXISTS (SELECT 1 FROM module_dependency d WHERE d.dependent_id = r.dependent_id AND d.dependee_id = r.dependee_id)
+	     ON CONFLICT DO NOTHING`
+	if _, err = txn.ExecContext(ctx, q, tombstoneID); err != nil {
+		return result, fmt.Errorf("database error restoring the dependency history of module %s: %w", name, err)
+	}
+	if version == "" {
+		q = `INSERT INTO module_alias
+		     SELECT r.* FROM deleted_module t, jsonb_populate_recordset(NULL::module_alias, t.snapshot->'aliases') r WHERE t.id = $1
+		     ON CONFLICT DO NOTHING`
+		if _, err = txn.ExecContext(ctx, q, tombstoneID); err != nil {
+			return result, fmt.Errorf("database error restoring the aliases of module %s: %w", name, err)
+		}
+		q = `INSERT INTO module_rename
+		     SELECT r.* FROM deleted_module t, jsonb_populate_recordset(NULL::module_rename, t.snapshot->'renames') r
+		      WHERE t.id = $1
+		        AND EXISTS (SELECT 1 FROM module m WHERE m.id = r.old_module_id)
+		        AND EXISTS (SELECT 1 FROM module m WHERE m.id = r.new_module_id)
+		     ON CONFLICT DO NOTHING`
+		if _, err = txn.ExecContext(ctx, q, tombstoneID); err != nil {
+			return result, fmt.Errorf("database error restoring the renames of module %s: %w", name, err)
+		}
+	}
+
+	p.log.Debug("undelete module", "name", name, "version", version, "tombstone", tombstoneID)
+	if _, err = txn.ExecContext(ctx, `DELETE FROM deleted_module WHERE id = $1`, tombstoneID); err != nil {
+		return result, fmt.Errorf("database error removing the tombstone of module %s: %w", name, err)
+	}
+	return result, nil
+}
+
+// PurgeDeletedModules permanently removes the tombstones of the modules and module versions that were
+// deleted before olderThan, so they can no longer be restored, and returns the number of tombstones that
+// were removed.
+func (p *PostgresClient) PurgeDeletedModules(ctx context.Context, olderThan time.Time) (int, error) {
+	q := `DELETE FROM deleted_module WHERE deleted_at < $1`
+	p.log.Debug("purging deleted modules", "sql", q, "olderThan", olderThan)
+	res, err := p.db.ExecContext(ctx, q, olderThan)
+	if err != nil {
+		return 0, fmt.Errorf("database error purging deleted modules: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("error processing database command result: %w", err)
+	}
+	return int(n), nil
+}
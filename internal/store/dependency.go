@@ -1,7 +1,157 @@
 package store
 
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"golang.org/x/mod/semver"
+)
+
 // A Dependency represents a link between specific versions of 2 Go modules
 type Dependency struct {
 	DependentID string `json:"dependent_id" db:"dependent_id"`
 	DependeeID  string `json:"dependee_id" db:"dependee_id"`
 }
+
+// A DependencyHistory describes when a direct dependency of a module version was first and most recently
+// declared and, if it is no longer declared, when it was removed
+type DependencyHistory struct {
+	Version
+	FirstSeen time.Time    `json:"first_seen" db:"first_seen"`
+	LastSeen  time.Time    `json:"last_seen" db:"last_seen"`
+	RemovedAt sql.NullTime `json:"removed_at,omitempty" db:"removed_at"`
+}
+
+// GetDependencyHistory returns every direct dependency that the specified module version has declared,
+// including those that have since been removed, ordered by module name then by descending version.
+func (p *PostgresClient) GetDependencyHistory(ctx context.Context, module, version string) ([]DependencyHistory, error) {
+	if module == "" || version == "" {
+		return nil, fmt.Errorf("both the module name and version must be specified")
+	}
+	module, err := resolveModuleAlias(ctx, p.db, module)
+	if err != nil {
+		return nil, err
+	}
+	sql, args, err := psql.
+		Select("dv.id", "dm.name module_id", "dv.version", "md.first_seen", "md.last_seen", "md.removed_at").
+		From(tableModuleDependencies+" md").
+		Join("module_version lv ON (lv.id = md.dependent_id)").
+		Join("module lm ON (lm.id = lv.module_id)").
+		Join("module_version dv ON (dv.id = md.dependee_id)").
+		Join("module dm ON (dm.id = dv.module_id)").
+		Where(sq.Eq{"lm.name": module, "lv.version": version}).
+		OrderBy("dm.name", "dv.version DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("querying dependency history", "sql", sql, "args", args)
+	var deps []DependencyHistory
+	if err := p.db.SelectContext(ctx, &deps, sql, args...); err != nil {
+		return nil, fmt.Errorf("database error querying dependency history: %w", err)
+	}
+	return deps, nil
+}
+
+// DependencyChanges describes the direct dependencies of a module version that an update would add or
+// remove
+type DependencyChanges struct {
+	Added   []Version
+	Removed []Version
+}
+
+// PreviewModuleDependencies returns the direct dependencies of mod that would be added and removed by
+// saving deps using [PostgresClient.SaveModuleDependencies], or [PostgresClient.ReplaceModuleDependencies]
+// if replace is true, without changing the graph.  The update is performed in a transaction that is
+// always rolled back so that it is subject to the same validation as a real update.
+func (p *PostgresClient) PreviewModuleDependencies(ctx context.Context, mod Version, replace bool, deps ...Version) (changes DependencyChanges, err error) {
+	if mod.ModuleID == "" || mod.SemVer == "" {
+		return changes, fmt.Errorf("invalid module, both the module name and version must be specified")
+	}
+	txn, err := p.beginTx(ctx, nil)
+	if err != nil {
+		return changes, fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if e2 := txn.Rollback(); e2 != nil {
+			p.log.Error(e2, "error rolling back transaction")
+		}
+	}()
+
+	before, err := declaredDependencies(ctx, txn, mod)
+	if err != nil {
+		return changes, err
+	}
+	if err := writeDependencies(ctx, txn, mod, replace, deps, p.log); err != nil {
+		return changes, err
+	}
+	after, err := declaredDependencies(ctx, txn, mod)
+	if err != nil {
+		return changes, err
+	}
+	for k, v := range after {
+		if _, ok := before[k]; !ok {
+			changes.Added = append(changes.Added, v)
+		}
+	}
+	for k, v := range before {
+		if _, ok := after[k]; !ok {
+			changes.Removed = append(changes.Removed, v)
+		}
+	}
+	sortVersions(changes.Added)
+	sortVersions(changes.Removed)
+	return changes, nil
+}
+
+// declaredDependencies returns the current direct dependencies of mod, keyed by "module@version"
+func declaredDependencies(ctx context.Context, db database, mod Version) (map[string]Version, error) {
+	module, err := resolveModuleAlias(ctx, db, mod.ModuleID)
+	if err != nil {
+		return nil, err
+	}
+	sql, args, err := psql.
+		Select("dm.name", "dv.version").
+		From(tableModuleDependencies + " md").
+		Join("module_version lv ON (lv.id = md.dependent_id)").
+		Join("module lm ON (lm.id = lv.module_id)").
+		Join("module_version dv ON (dv.id = md.dependee_id)").
+		Join("module dm ON (dm.id = dv.module_id)").
+		Where(sq.Eq{"lm.name": module, "lv.version": mod.SemVer, "md.removed_at": nil}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error querying module dependencies: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	deps := make(map[string]Version)
+	for rows.Next() {
+		var v Version
+		if err := rows.Scan(&v.ModuleID, &v.SemVer); err != nil {
+			return nil, fmt.Errorf("error processing database query result: %w", err)
+		}
+		deps[v.ModuleID+"@"+v.SemVer] = v
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("database error reading module dependencies: %w", err)
+	}
+	return deps, nil
+}
+
+// sortVersions orders versions by module name then by ascending semantic version
+func sortVersions(versions []Version) {
+	slices.SortFunc(versions, func(a, b Version) int {
+		if c := strings.Compare(a.ModuleID, b.ModuleID); c != 0 {
+			return c
+		}
+		return semver.Compare("v"+a.SemVer, "v"+b.SemVer)
+	})
+}
@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// A DependencyEdge is a direct dependency of one module version on another
+type DependencyEdge struct {
+	Module            string `json:"module" db:"module"`
+	Version           string `json:"version" db:"version"`
+	DependencyModule  string `json:"dependency_module" db:"dependency_module"`
+	DependencyVersion string `json:"dependency_version" db:"dependency_version"`
+}
+
+// GraphDiff describes how the dependency graph changed between 2 points in time
+type GraphDiff struct {
+	// the modules that were part of at least 1 dependency edge at the end of the window but not at the
+	// start, and vice versa
+	AddedModules, RemovedModules []string
+	// the dependency edges that were declared at the end of the window but not at the start, and vice
+	// versa
+	AddedEdges, RemovedEdges []DependencyEdge
+}
+
+// DiffGraph compares the dependency graph at the specified times and returns the modules and dependency
+// edges that were added or removed in between.  If moduleFilter, a glob pattern, is not empty only
+// the matching modules and the edges from the matching modules to their dependencies are reported.
+func (p *PostgresClient) DiffGraph(ctx context.Context, moduleFilter string, from, to time.Time) (GraphDiff, error) {
+	var diff GraphDiff
+	if !from.Before(to) {
+		return diff, fmt.Errorf("the start of the window must be before the end")
+	}
+	var err error
+	if diff.AddedEdges, err = p.diffEdges(ctx, moduleFilter, to, from); err != nil {
+		return diff, err
+	}
+	if diff.RemovedEdges, err = p.diffEdges(ctx, moduleFilter, from, to); err != nil {
+		return diff, err
+	}
+	if diff.AddedModules, err = p.diffModules(ctx, moduleFilter, to, from); err != nil {
+		return diff, err
+	}
+	if diff.RemovedModules, err = p.diffModules(ctx, moduleFilter, from, to); err != nil {
+		return diff, err
+	}
+	return diff, nil
+}
+
+// diffEdges returns the dependency edges of the modules matching filter that were declared at t1 but not
+// at t2, ordered by module name and version then by dependency name and version
+func (p *PostgresClient) diffEdges(ctx context.Context, filter string, t1, t2 time.Time) ([]DependencyEdge, error) {
+	notAtT2, notAtT2Args, err := edgeDeclaredAt("md", t2).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	q := psql.
+		Select("lm.name module", "lv.version", "rm.name dependency_module", "rv.version dependency_version").
+		From(tableModuleDependencies+" md").
+		Join("module_version lv ON (lv.id = md.dependent_id)").
+		Join("module lm ON (lm.id = lv.module_id)").
+		Join("module_version rv ON (rv.id = md.dependee_id)").
+		Join("module rm ON (rm.id = rv.module_id)").
+		Where(edgeDeclaredAt("md", t1)).
+		Where("NOT ("+notAtT2+")", notAtT2Args...).
+		OrderBy("lm.name", "lv.version", "rm.name", "rv.version")
+	if filter != "" {
+		q = q.Where(matchModuleName("lm", filter))
+	}
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("diffing dependency edges", "sql", sql, "args", args)
+	var edges []DependencyEdge
+	if err := p.db.SelectContext(ctx, &edges, sql, args...); err != nil {
+		return nil, fmt.Errorf("database error comparing dependency edges: %w", err)
+	}
+	return edges, nil
+}
+
+// diffModules returns the names of the modules matching filter that were part of at least 1 dependency
+// edge at t1 but not at t2, ordered by name
+func (p *PostgresClient) diffModules(ctx context.Context, filter string, t1, t2 time.Time) ([]string, error) {
+	// a module is part of the graph at a given time if any of its versions is either end of an edge
+	// that was declared at that time.  the sub-queries are built with '?' placeholders, which are
+	// re-numbered when the outer query is rendered.
+	inGraph := func(t time.Time) (string, []any, error) {
+		return sq.
+			Select("mv.module_id").
+			From(tableModuleDependencies + " md").
+			Join("module_version mv ON (mv.id = md.dependent_id OR mv.id = md.dependee_id)").
+			Where(edgeDeclaredAt("md", t)).
+			ToSql()
+	}
+	atT1, atT1Args, err := inGraph(t1)
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	atT2, atT2Args, err := inGraph(t2)
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	q := psql.
+		Select("m.name").
+		From(tableModules+" m").
+		Where("m.id IN ("+atT1+")", atT1Args...).
+		Where("m.id NOT IN ("+atT2+")", atT2Args...).
+		OrderBy("m.name")
+	if filter != "" {
+		q = q.Where(matchModuleName("m", filter))
+	}
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("diffing modules", "sql", sql, "args", args)
+	var names []string
+	if err := p.db.SelectContext(ctx, &names, sql, args...); err != nil {
+		return nil, fmt.Errorf("database error comparing modules: %w", err)
+	}
+	return names, nil
+}
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// EdgeQuery encapsulates the available parameters for streaming dependency edges.
+//
+// A zero value matches every dependency edge that is currently declared.
+type EdgeQuery struct {
+	// a glob pattern specifying which dependent module(s) should be included
+	ModuleFilter string
+	// a glob pattern specifying which dependency module(s) should be included
+	DependencyFilter string
+	// if not the zero time, the edges that were declared at this time are returned instead of the
+	// current ones
+	AsOf time.Time
+}
+
+// StreamDependencyEdges invokes fn for each dependency edge that matches query, in primary key order,
+// without loading the full result set into memory.  Iteration stops at the first error returned by fn,
+// which is returned to the caller.
+func (p *PostgresClient) StreamDependencyEdges(ctx context.Context, query EdgeQuery, fn func(DependencyEdge) error) error {
+	q := psql.
+		Select("lm.name module", "lv.version", "rm.name dependency_module", "rv.version dependency_version").
+		From(tableModuleDependencies+" md").
+		Join("module_version lv ON (lv.id = md.dependent_id)").
+		Join("module lm ON (lm.id = lv.module_id)").
+		Join("module_version rv ON (rv.id = md.dependee_id)").
+		Join("module rm ON (rm.id = rv.module_id)").
+		Where(edgeDeclaredAt("md", query.AsOf)).
+		OrderBy("md.dependent_id", "md.dependee_id")
+	if query.ModuleFilter != "" {
+		q = q.Where(matchModuleName("lm", query.ModuleFilter))
+	}
+	if query.DependencyFilter != "" {
+		q = q.Where(matchModuleName("rm", query.DependencyFilter))
+	}
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("streaming dependency edges", "sql", sql, "args", args)
+
+	rows, err := p.db.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("database error querying dependency edges: %w", err)
+	}
+	defer func() {
+		if e := rows.Close(); e != nil {
+			p.log.Error(e, "error closing sql.Rows")
+		}
+	}()
+	for rows.Next() {
+		var edge DependencyEdge
+		if err := rows.StructScan(&edge); err != nil {
+			return fmt.Errorf("database error reading dependency edges: %w", err)
+		}
+		if err := fn(edge); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("database error reading dependency edges: %w", err)
+	}
+	return nil
+}
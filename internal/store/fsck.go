@@ -0,0 +1,233 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"golang.org/x/mod/module"
+)
+
+// the kinds of problems reported by [PostgresClient.CheckConsistency]
+const (
+	// a module_version row that references a module that does not exist
+	IssueOrphanedVersion = "ORPHANED_VERSION"
+	// a module_dependency row that references a module version that does not exist
+	IssueDanglingDependency = "DANGLING_DEPENDENCY"
+	// two or more modules whose names differ only in case
+	IssueDuplicateModule = "DUPLICATE_MODULE"
+	// a module version that is not in the canonical form required by Go modules
+	IssueNonCanonicalVersion = "NON_CANONICAL_VERSION"
+)
+
+// ConsistencyIssue describes a single problem found by [PostgresClient.CheckConsistency]
+type ConsistencyIssue struct {
+	// the kind of problem, one of the Issue* constants
+	Kind string
+	// a human-readable description of the problem
+	Description string
+	// true if the problem was repaired
+	Repaired bool
+}
+
+// CheckConsistency scans the database for orphaned module versions, dangling dependency edges, modules
+// whose names differ only in case, and versions that are not in Go's canonical semver form.
+//
+// If repair is true, problems that can be fixed without losing information are repaired within a single
+// transaction: orphaned versions and dangling edges are deleted and non-canonical versions are rewritten
+// to their canonical form if that version does not already exist.  Duplicate modules are only reported.
+func (p *PostgresClient) CheckConsistency(ctx context.Context, repair bool) (issues []ConsistencyIssue, err error) {
+	txn, err := p.beginTx(ctx, &sql.TxOptions{ReadOnly: !repair})
+	if err != nil {
+		return nil, fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if err == nil && repair {
+			err = txn.Commit()
+		} else {
+			_ = txn.Rollback()
+		}
+	}()
+
+	checks := []func(context.Context, dbTx, bool) ([]ConsistencyIssue, error){
+		p.checkOrphanedVersions,
+		p.checkDanglingDependencies,
+		p.checkDuplicateModules,
+		p.checkNonCanonicalVersions,
+	}
+	for _, check := range checks {
+		found, err := check(ctx, txn, repair)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, found...)
+	}
+	return issues, nil
+}
+
+// checkOrphanedVersions finds, and optionally deletes, module versions that reference a non-existent module
+func (p *PostgresClient) checkOrphanedVersions(ctx context.Context, txn dbTx, repair bool) ([]ConsistencyIssue, error) {
+	var rows []struct {
+		ID       int32  `db:"id"`
+		ModuleID int32  `db:"module_id"`
+		Version  string `db:"version"`
+	}
+	q := `SELECT mv.id, mv.module_id, mv.version::text AS version
+	        FROM module_version mv LEFT JOIN module m ON (m.id = mv.module_id)
+	       WHERE m.id IS NULL`
+	if err := txn.SelectContext(ctx, &rows, q); err != nil {
+		return nil, fmt.Errorf("database error querying for orphaned module versions: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	issues := make([]ConsistencyIssue, 0, len(rows))
+	ids := make([]int32, 0, len(rows))
+	for _, r := range rows {
+		issues = append(issues, ConsistencyIssue{
+			Kind:        IssueOrphanedVersion,
+			Description: fmt.Sprintf("version %s (id=%d) references non-existent module id %d", r.Version, r.ID, r.ModuleID),
+		})
+		ids = append(ids, r.ID)
+	}
+	if repair {
+		if err := p.execRepair(ctx, txn, psql.Delete(tableModuleVersions).Where(sq.Eq{"id": ids})); err != nil {
+			return nil, err
+		}
+		markRepaired(issues)
+	}
+	return issues, nil
+}
+
+// checkDanglingDependencies finds, and optionally deletes, dependency edges that reference a non-existent
+// module version
+func (p *PostgresClient) checkDanglingDependencies(ctx context.Context, txn dbTx, repair bool) ([]ConsistencyIssue, error) {
+	var rows []struct {
+		DependentID int32 `db:"dependent_id"`
+		DependeeID  int32 `db:"dependee_id"`
+	}
+	q := `SELECT d.dependent_id, d.dependee_id
+	        FROM module_dependency d
+	             LEFT JOIN module_version a ON (a.id = d.dependent_id)
+	             LEFT JOIN module_version b ON (b.id = d.dependee_id)
+	       WHERE a.id IS NULL OR b.id IS NULL`
+	if err := txn.SelectContext(ctx, &rows, q); err != nil {
+		return nil, fmt.Errorf("database error querying for dangling dependencies: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	issues := make([]ConsistencyIssue, 0, len(rows))
+	edges := make(sq.Or, 0, len(rows))
+	for _, r := range rows {
+		issues = append(issues, ConsistencyIssue{
+			Kind:        IssueDanglingDependency,
+			Description: fmt.Sprintf("dependency edge %d -> %d references a non-existent module version", r.DependentID, r.DependeeID),
+		})
+		edges = append(edges, sq.Eq{"dependent_id": r.DependentID, "dependee_id": r.DependeeID})
+	}
+	if repair {
+		if err := p.execRepair(ctx, txn, psql.Delete(tableModuleDependencies).Where(edges)); err != nil {
+			return nil, err
+		}
+		markRepaired(issues)
+	}
+	return issues, nil
+}
+
+// checkDuplicateModules finds modules whose names differ only in case.  These cannot be repaired
+// automatically because it is not possible to determine which name is correct.
+func (p *PostgresClient) checkDuplicateModules(ctx context.Context, txn dbTx, _ bool) ([]ConsistencyIssue, error) {
+	var rows []struct {
+		Names string `db:"names"`
+	}
+	q := `SELECT string_agg(name, ', ' ORDER BY name) AS names
+	        FROM module
+	       GROUP BY lower(name)
+	      HAVING COUNT(*) > 1`
+	if err := txn.SelectContext(ctx, &rows, q); err != nil {
+		return nil, fmt.Errorf("database error querying for duplicate modules: %w", err)
+	}
+
+	issues := make([]ConsistencyIssue, 0, len(rows))
+	for _, r := range rows {
+		issues = append(issues, ConsistencyIssue{
+			Kind:        IssueDuplicateModule,
+			Description: fmt.Sprintf("modules differ only in case: %s", r.Names),
+		})
+	}
+	return issues, nil
+}
+
+// checkNonCanonicalVersions finds, and optionally rewrites, module versions that are not in the canonical
+// form required by Go modules.  The semver column type already guarantees that each version is a valid
+// semantic version so the only possible violation is build metadata other than "+incompatible".
+func (p *PostgresClient) checkNonCanonicalVersions(ctx context.Context, txn dbTx, repair bool) ([]ConsistencyIssue, error) {
+	var rows []struct {
+		ID       int32  `db:"id"`
+		ModuleID int32  `db:"module_id"`
+		Module   string `db:"name"`
+		Version  string `db:"version"`
+	}
+	q := `SELECT mv.id, mv.module_id, m.name, mv.version::text AS version
+	        FROM module_version mv JOIN module m ON (m.id = mv.module_id)
+	       WHERE mv.version::text LIKE '%+%'`
+	if err := txn.SelectContext(ctx, &rows, q); err != nil {
+		return nil, fmt.Errorf("database error querying for non-canonical module versions: %w", err)
+	}
+
+	var issues []ConsistencyIssue
+	for _, r := range rows {
+		v := "v" + r.Version
+		canonical := module.CanonicalVersion(v)
+		if canonical == v {
+			continue
+		}
+		issue := ConsistencyIssue{
+			Kind:        IssueNonCanonicalVersion,
+			Description: fmt.Sprintf("%s@%s is not canonical, expected %s", r.Module, v, canonical),
+		}
+		if repair {
+			var n int
+			err := txn.GetContext(ctx, &n, `SELECT COUNT(*) FROM module_version WHERE module_id = $1 AND version = $2`, r.ModuleID, strings.TrimPrefix(canonical, "v"))
+			if err != nil {
+				return nil, fmt.Errorf("database error querying for canonical module version: %w", err)
+			}
+			if n == 0 {
+				cmd := psql.Update(tableModuleVersions).Set("version", strings.TrimPrefix(canonical, "v")).Where(sq.Eq{"id": r.ID})
+				if err := p.execRepair(ctx, txn, cmd); err != nil {
+					return nil, err
+				}
+				issue.Repaired = true
+			} else {
+				issue.Description += " which already exists and must be merged manually"
+			}
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+// execRepair executes the provided SQL command as part of a repair operation
+func (p *PostgresClient) execRepair(ctx context.Context, txn dbTx, cmd sq.Sqlizer) error {
+	sql, args, err := cmd.ToSql()
+	if err != nil {
+		return fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("repairing database consistency issue", "sql", sql, "args", args)
+	if _, err := txn.ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("database error repairing consistency issue: %w", err)
+	}
+	return nil
+}
+
+// markRepaired flags each of the provided issues as repaired
+func markRepaired(issues []ConsistencyIssue) {
+	for i := range issues {
+		issues[i].Repaired = true
+	}
+}
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var columnsIdempotencyKeys = []string{"procedure", "key", "request_hash", "response", "created_at", "completed_at"}
+
+// An IdempotencyKey records a write request that was sent with a caller-provided idempotency key so that
+// retries of the same request return the original response instead of being processed again.
+type IdempotencyKey struct {
+	// the RPC that the key was used with, ex: /crowdstrike.perseus.perseusapi.PerseusService/CreateModule
+	Procedure string `db:"procedure"`
+	Key       string `db:"key"`
+	// a hash of the request message, which identifies reuse of the key for a different request
+	RequestHash []byte `db:"request_hash"`
+	// the serialized response message
+	Response  []byte    `db:"response"`
+	CreatedAt time.Time `db:"created_at"`
+	// when the response was stored, which is not set while the request is still being processed
+	CompletedAt sql.NullTime `db:"completed_at"`
+}
+
+// ClaimIdempotencyKey records that the caller is processing the request identified by procedure and key,
+// returning nil if the key was claimed.  If the key has already been used, the existing record is returned
+// instead so that the caller can replay its response.  A claim on the same request that is still being
+// processed since before abandonedBefore is assumed to have been abandoned by a server that exited and is
+// claimed again.
+func (p *PostgresClient) ClaimIdempotencyKey(ctx context.Context, procedure, key string, requestHash []byte, abandonedBefore time.Time) (*IdempotencyKey, error) {
+	q := `INSERT INTO idempotency_key (procedure, key, request_hash) VALUES ($1, $2, $3)
+	      ON CONFLICT (procedure, key) DO UPDATE SET created_at = now()
+	       WHERE idempotency_key.completed_at IS NULL
+	         AND idempotency_key.request_hash = EXCLUDED.request_hash
+	         AND idempotency_key.created_at < $4
+	   RETURNING key`
+	p.log.Debug("claim idempotency key", "sql", q, "procedure", procedure, "key", key)
+	var claimed string
+	err := p.db.GetContext(ctx, &claimed, q, procedure, key, requestHash, abandonedBefore)
+	switch {
+	case err == nil:
+		return nil, nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("database error claiming idempotency key %s: %w", key, err)
+	}
+
+	// nothing is inserted or updated if the key is already in use
+	q = `SELECT ` + strings.Join(columnsIdempotencyKeys, ", ") + ` FROM idempotency_key WHERE procedure = $1 AND key = $2`
+	var existing IdempotencyKey
+	if err := p.db.GetContext(ctx, &existing, q, procedure, key); err != nil {
+		return nil, fmt.Errorf("database error reading idempotency key %s: %w", key, err)
+	}
+	return &existing, nil
+}
+
+// CompleteIdempotencyKey stores the serialized response to the request identified by procedure and key,
+// which was claimed by [PostgresClient.ClaimIdempotencyKey], so that it can be returned to retries.
+func (p *PostgresClient) CompleteIdempotencyKey(ctx context.Context, procedure, key string, response []byte) error {
+	q := `UPDATE idempotency_key SET response = $3, completed_at = now() WHERE procedure = $1 AND key = $2`
+	p.log.Debug("complete idempotency key", "sql", q, "procedure", procedure, "key", key)
+	if _, err := p.db.ExecContext(ctx, q, procedure, key, response); err != nil {
+		return fmt.Errorf("database error updating idempotency key %s: %w", key, err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey removes the claim on a request that failed so that a retry is processed again.
+// Keys whose response has already been stored are not affected.
+func (p *PostgresClient) ReleaseIdempotencyKey(ctx context.Context, procedure, key string) error {
+	q := `DELETE FROM idempotency_key WHERE procedure = $1 AND key = $2 AND completed_at IS NULL`
+	p.log.Debug("release idempotency key", "sql", q, "procedure", procedure, "key", key)
+	if _, err := p.db.ExecContext(ctx, q, procedure, key); err != nil {
+		return fmt.Errorf("database error releasing idempotency key %s: %w", key, err)
+	}
+	return nil
+}
+
+// PurgeIdempotencyKeys removes the idempotency keys that were claimed before olderThan, after which
+// requests that reuse them are processed again, and returns the number of keys that were removed.
+func (p *PostgresClient) PurgeIdempotencyKeys(ctx context.Context, olderThan time.Time) (int, error) {
+	q := `DELETE FROM idempotency_key WHERE created_at < $1`
+	p.log.Debug("purge idempotency keys", "sql", q, "olderThan", olderThan)
+	res, err := p.db.ExecContext(ctx, q, olderThan)
+	if err != nil {
+		return 0, fmt.Errorf("database error purging idempotency keys: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
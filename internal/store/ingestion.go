@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const tableIngestionJobs = "ingestion_job"
+
+// the possible values for [IngestionJob.Status]
+const (
+	IngestionJobPending   = "pending"
+	IngestionJobRunning   = "running"
+	IngestionJobSucceeded = "succeeded"
+	IngestionJobFailed    = "failed"
+)
+
+// ErrIngestionJobNotFound is returned, possibly wrapped, when an operation references an ingestion job
+// that does not exist.
+var ErrIngestionJobNotFound = errors.New("ingestion job not found")
+
+var columnsIngestionJobs = []string{"id", "module", "version", "source", "status", "attempts", "error", "created_at", "updated_at"}
+
+// An IngestionJob is a queued request to add a module version to the graph
+type IngestionJob struct {
+	ID        int64          `db:"id"`
+	Module    string         `db:"module"`
+	Version   string         `db:"version"`
+	Source    string         `db:"source"`
+	Status    string         `db:"status"`
+	Attempts  int32          `db:"attempts"`
+	Error     sql.NullString `db:"error"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+// EnqueueIngestionJobs adds a pending ingestion job for each of the specified module versions and returns
+// the new jobs.  Versions are stored as provided, including the "v" prefix.
+func (p *PostgresClient) EnqueueIngestionJobs(ctx context.Context, source string, mods ...Version) ([]IngestionJob, error) {
+	if len(mods) == 0 {
+		return nil, nil
+	}
+	cmd := psql.
+		Insert(tableIngestionJobs).
+		Columns("module", "version", "source")
+	for _, m := range mods {
+		if m.ModuleID == "" || m.SemVer == "" {
+			return nil, fmt.Errorf("invalid module, both the module name and version must be specified")
+		}
+		cmd = cmd.Values(m.ModuleID, m.SemVer, source)
+	}
+	sql, args, err := cmd.Suffix("RETURNING " + strings.Join(columnsIngestionJobs, ", ")).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("enqueue ingestion jobs", "sql", sql, "args", args)
+	var jobs []IngestionJob
+	if err := p.db.SelectContext(ctx, &jobs, sql, args...); err != nil {
+		return nil, fmt.Errorf("database error adding ingestion jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// ClaimIngestionJob marks the oldest pending ingestion job as running and returns it, or nil if there
+// are no pending jobs.  Jobs that have been running since before staleBefore are assumed to have been
+// abandoned by a server that exited and are claimed again.
+//
+// Rows are locked with SKIP LOCKED so multiple workers, and multiple servers, can claim jobs concurrently
+// without processing the same job twice.
+func (p *PostgresClient) ClaimIngestionJob(ctx context.Context, staleBefore time.Time) (*IngestionJob, error) {
+	q := `UPDATE ingestion_job
+	         SET status = $1, attempts = attempts + 1, updated_at = now()
+	       WHERE id = (SELECT id FROM ingestion_job
+	                    WHERE status = $2 OR (status = $1 AND updated_at < $3)
+	                    ORDER BY id
+	                    LIMIT 1
+	                    FOR UPDATE SKIP LOCKED)
+	   RETURNING ` + strings.Join(columnsIngestionJobs, ", ")
+	var job IngestionJob
+	err := p.db.GetContext(ctx, &job, q, IngestionJobRunning, IngestionJobPending, staleBefore)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("database error claiming an ingestion job: %w", err)
+	default:
+		return &job, nil
+	}
+}
+
+// CompleteIngestionJob records the outcome of processing the specified ingestion job.  If jobErr is nil
+// the job succeeded.  Otherwise, the job is returned to the queue if retry is true or marked as failed.
+func (p *PostgresClient) CompleteIngestionJob(ctx context.Context, id int64, jobErr error, retry bool) error {
+	status, errText := IngestionJobSucceeded, sql.NullString{}
+	if jobErr != nil {
+		status, errText = IngestionJobFailed, sql.NullString{String: jobErr.Error(), Valid: true}
+		if retry {
+			status = IngestionJobPending
+		}
+	}
+	sql, args, err := psql.
+		Update(tableIngestionJobs).
+		Set("status", status).
+		Set("error", errText).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("complete ingestion job", "sql", sql, "args", args)
+	if _, err := p.db.ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("database error updating ingestion job %d: %w", id, err)
+	}
+	return nil
+}
+
+// GetIngestionJob returns the ingestion job with the specified ID.  If there is no such job the returned
+// error wraps [ErrIngestionJobNotFound].
+func (p *PostgresClient) GetIngestionJob(ctx context.Context, id int64) (IngestionJob, error) {
+	var job IngestionJob
+	q := `SELECT ` + strings.Join(columnsIngestionJobs, ", ") + ` FROM ingestion_job WHERE id = $1`
+	err := p.db.GetContext(ctx, &job, q, id)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return job, fmt.Errorf("%w: %d", ErrIngestionJobNotFound, id)
+	case err != nil:
+		return job, fmt.Errorf("database error querying for ingestion job %d: %w", id, err)
+	default:
+		return job, nil
+	}
+}
+
+// QueryIngestionJobs returns a list of 0 to count ingestion jobs, most recent first, along with a paging
+// token.  If status is not empty only jobs with that status are returned.
+func (p *PostgresClient) QueryIngestionJobs(ctx context.Context, status string, pageToken string, count int) ([]IngestionJob, string, error) {
+	pageTokenKey := "ingestionjobs:" + status
+	offset := 0
+	if pageToken != "" {
+		var err error
+		if offset, err = decodePageToken(pageToken, pageTokenKey); err != nil {
+			return nil, "", err
+		}
+	}
+
+	q := psql.
+		Select(columnsIngestionJobs...).
+		From(tableIngestionJobs).
+		OrderBy("id DESC")
+	if status != "" {
+		q = q.Where(sq.Eq{"status": status})
+	}
+	if offset > 0 {
+		q = q.Offset(uint64(offset))
+	}
+	if count > 0 {
+		q = q.Limit(uint64(count))
+	}
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, "", fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("QueryIngestionJobs", "sql", sql, "args", args)
+	var jobs []IngestionJob
+	if err := p.db.SelectContext(ctx, &jobs, sql, args...); err != nil {
+		return nil, "", fmt.Errorf("database error querying for ingestion jobs: %w", err)
+	}
+	return jobs, encodePageToken(pageTokenKey, len(jobs), offset, count), nil
+}
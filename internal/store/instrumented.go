@@ -0,0 +1,450 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
+)
+
+var (
+	storeOperationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "perseus_store_operation_duration_seconds",
+		Help:    "The duration of each call to the data store, by method and outcome",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+	}, []string{"method", "status"})
+
+	tracer = otel.Tracer("github.com/CrowdStrike/perseus/internal/store")
+)
+
+// instrumentedStore is a [Store] that records a metric, a trace span, and a debug log for every call to
+// the Store that it wraps, so that any backend gets the same observability.
+type instrumentedStore struct {
+	next Store
+	log  Logger
+}
+
+// ensure the decorator satisfies the Store interface
+var _ Store = (*instrumentedStore)(nil)
+
+// NewInstrumentedStore returns a [Store] that records the duration and outcome of every call to s in the
+// perseus_store_operation_duration_seconds Prometheus histogram, in a span of the global OpenTelemetry
+// tracer, and in a debug log written to log.  The arguments of each call are not logged because some of
+// them, such as API key hashes, are sensitive.
+func NewInstrumentedStore(s Store, log Logger) Store {
+	if log == nil {
+		log = nopLogger{}
+	}
+	return &instrumentedStore{next: s, log: log}
+}
+
+// begin starts recording a call to method, returning the context to pass to the wrapped Store and a
+// function that must be called with the error returned by the call, if any, once it completes
+func (s *instrumentedStore) begin(ctx context.Context, method string) (context.Context, func(error)) {
+	ctx, span := tracer.Start(ctx, "store."+method)
+	start := time.Now()
+	return ctx, func(err error) {
+		elapsed := time.Since(start)
+		status := "ok"
+		if err != nil {
+			status = "error"
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+		storeOperationDuration.WithLabelValues(method, status).Observe(elapsed.Seconds())
+		s.log.Debug("store call completed", "method", method, "duration", elapsed, "err", err)
+	}
+}
+
+func (s *instrumentedStore) Ping(ctx context.Context) error {
+	ctx, end := s.begin(ctx, "Ping")
+	err := s.next.Ping(ctx)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) SaveModule(ctx context.Context, name, description string, versions ...string) error {
+	ctx, end := s.begin(ctx, "SaveModule")
+	err := s.next.SaveModule(ctx, name, description, versions...)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) SaveModuleDependencies(ctx context.Context, mod Version, deps ...Version) error {
+	ctx, end := s.begin(ctx, "SaveModuleDependencies")
+	err := s.next.SaveModuleDependencies(ctx, mod, deps...)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) ReplaceModuleDependencies(ctx context.Context, mod Version, deps ...Version) error {
+	ctx, end := s.begin(ctx, "ReplaceModuleDependencies")
+	err := s.next.ReplaceModuleDependencies(ctx, mod, deps...)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) PreviewModuleDependencies(ctx context.Context, mod Version, replace bool, deps ...Version) (DependencyChanges, error) {
+	ctx, end := s.begin(ctx, "PreviewModuleDependencies")
+	res, err := s.next.PreviewModuleDependencies(ctx, mod, replace, deps...)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) WithTx(ctx context.Context, fn func(Store) error) error {
+	ctx, end := s.begin(ctx, "WithTx")
+	err := s.next.WithTx(ctx, func(tx Store) error {
+		return fn(&instrumentedStore{next: tx, log: s.log})
+	})
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) QueryModules(ctx context.Context, nameFilter string, pageToken string, count int) ([]Module, string, error) {
+	ctx, end := s.begin(ctx, "QueryModules")
+	res, nextToken, err := s.next.QueryModules(ctx, nameFilter, pageToken, count)
+	end(err)
+	return res, nextToken, err
+}
+
+func (s *instrumentedStore) QueryModuleVersions(ctx context.Context, query ModuleVersionQuery) ([]ModuleVersionQueryResult, string, error) {
+	ctx, end := s.begin(ctx, "QueryModuleVersions")
+	res, nextToken, err := s.next.QueryModuleVersions(ctx, query)
+	end(err)
+	return res, nextToken, err
+}
+
+func (s *instrumentedStore) GetDependents(ctx context.Context, id, version string, asOf time.Time, pageToken string, count int) ([]Version, string, error) {
+	ctx, end := s.begin(ctx, "GetDependents")
+	res, nextToken, err := s.next.GetDependents(ctx, id, version, asOf, pageToken, count)
+	end(err)
+	return res, nextToken, err
+}
+
+func (s *instrumentedStore) GetDependees(ctx context.Context, id, version string, asOf time.Time, pageToken string, count int) ([]Version, string, error) {
+	ctx, end := s.begin(ctx, "GetDependees")
+	res, nextToken, err := s.next.GetDependees(ctx, id, version, asOf, pageToken, count)
+	end(err)
+	return res, nextToken, err
+}
+
+func (s *instrumentedStore) GetDependentsInRange(ctx context.Context, id string, versions modver.Range, asOf time.Time, pageToken string, count int) ([]DependencyEdge, string, error) {
+	ctx, end := s.begin(ctx, "GetDependentsInRange")
+	res, nextToken, err := s.next.GetDependentsInRange(ctx, id, versions, asOf, pageToken, count)
+	end(err)
+	return res, nextToken, err
+}
+
+func (s *instrumentedStore) GetDependencyHistory(ctx context.Context, module, version string) ([]DependencyHistory, error) {
+	ctx, end := s.begin(ctx, "GetDependencyHistory")
+	res, err := s.next.GetDependencyHistory(ctx, module, version)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) DiffGraph(ctx context.Context, moduleFilter string, from, to time.Time) (GraphDiff, error) {
+	ctx, end := s.begin(ctx, "DiffGraph")
+	res, err := s.next.DiffGraph(ctx, moduleFilter, from, to)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) StreamDependencyEdges(ctx context.Context, query EdgeQuery, fn func(DependencyEdge) error) error {
+	ctx, end := s.begin(ctx, "StreamDependencyEdges")
+	err := s.next.StreamDependencyEdges(ctx, query, fn)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) RankModules(ctx context.Context, query ModuleRankQuery) ([]ModuleRank, error) {
+	ctx, end := s.begin(ctx, "RankModules")
+	res, err := s.next.RankModules(ctx, query)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetModuleMetrics(ctx context.Context, name string) (ModuleMetrics, error) {
+	ctx, end := s.begin(ctx, "GetModuleMetrics")
+	res, err := s.next.GetModuleMetrics(ctx, name)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetModuleConsumers(ctx context.Context, name string, allMajors bool) ([]DependencyEdge, error) {
+	ctx, end := s.begin(ctx, "GetModuleConsumers")
+	res, err := s.next.GetModuleConsumers(ctx, name, allMajors)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetModuleStaleness(ctx context.Context, query StalenessQuery) ([]ModuleStaleness, error) {
+	ctx, end := s.begin(ctx, "GetModuleStaleness")
+	res, err := s.next.GetModuleStaleness(ctx, query)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetModuleLicenses(ctx context.Context, names []string) (map[string]string, error) {
+	ctx, end := s.begin(ctx, "GetModuleLicenses")
+	res, err := s.next.GetModuleLicenses(ctx, names)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetAbandonedModules(ctx context.Context, query AbandonedModuleQuery) ([]UpstreamStatus, error) {
+	ctx, end := s.begin(ctx, "GetAbandonedModules")
+	res, err := s.next.GetAbandonedModules(ctx, query)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetGhostVersions(ctx context.Context, moduleFilter string) ([]GhostVersion, error) {
+	ctx, end := s.begin(ctx, "GetGhostVersions")
+	res, err := s.next.GetGhostVersions(ctx, moduleFilter)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetModuleEdges(ctx context.Context) ([]ModuleEdge, error) {
+	ctx, end := s.begin(ctx, "GetModuleEdges")
+	res, err := s.next.GetModuleEdges(ctx)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetTimeSeries(ctx context.Context, query TimeSeriesQuery) ([]TimeSeriesPoint, error) {
+	ctx, end := s.begin(ctx, "GetTimeSeries")
+	res, err := s.next.GetTimeSeries(ctx, query)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetGraphStats(ctx context.Context) (GraphStats, error) {
+	ctx, end := s.begin(ctx, "GetGraphStats")
+	res, err := s.next.GetGraphStats(ctx)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) GetStoreStats(ctx context.Context) (StoreStats, error) {
+	ctx, end := s.begin(ctx, "GetStoreStats")
+	res, err := s.next.GetStoreStats(ctx)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) CountModulesByPrefix(ctx context.Context, prefixes []string) (map[string]int64, error) {
+	ctx, end := s.begin(ctx, "CountModulesByPrefix")
+	res, err := s.next.CountModulesByPrefix(ctx, prefixes)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) SaveModuleImportance(ctx context.Context, scores map[int32]float64) error {
+	ctx, end := s.begin(ctx, "SaveModuleImportance")
+	err := s.next.SaveModuleImportance(ctx, scores)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) CheckConsistency(ctx context.Context, repair bool) ([]ConsistencyIssue, error) {
+	ctx, end := s.begin(ctx, "CheckConsistency")
+	res, err := s.next.CheckConsistency(ctx, repair)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) MergeModules(ctx context.Context, source, target string) (MergeResult, error) {
+	ctx, end := s.begin(ctx, "MergeModules")
+	res, err := s.next.MergeModules(ctx, source, target)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) SaveModuleAlias(ctx context.Context, name, alias string) error {
+	ctx, end := s.begin(ctx, "SaveModuleAlias")
+	err := s.next.SaveModuleAlias(ctx, name, alias)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) RenameModule(ctx context.Context, oldName, newName string) error {
+	ctx, end := s.begin(ctx, "RenameModule")
+	err := s.next.RenameModule(ctx, oldName, newName)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) SetModuleLicense(ctx context.Context, name, license string) error {
+	ctx, end := s.begin(ctx, "SetModuleLicense")
+	err := s.next.SetModuleLicense(ctx, name, license)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) SaveUpstreamStatus(ctx context.Context, name string, archived bool, lastCommit time.Time) error {
+	ctx, end := s.begin(ctx, "SaveUpstreamStatus")
+	err := s.next.SaveUpstreamStatus(ctx, name, archived, lastCommit)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) SaveVersionAvailability(ctx context.Context, name string, unavailable map[string]string) error {
+	ctx, end := s.begin(ctx, "SaveVersionAvailability")
+	err := s.next.SaveVersionAvailability(ctx, name, unavailable)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) GetRenamedModules(ctx context.Context, name string) ([]string, error) {
+	ctx, end := s.begin(ctx, "GetRenamedModules")
+	res, err := s.next.GetRenamedModules(ctx, name)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) PrunePrereleaseVersions(ctx context.Context, olderThan time.Time) (int, error) {
+	ctx, end := s.begin(ctx, "PrunePrereleaseVersions")
+	res, err := s.next.PrunePrereleaseVersions(ctx, olderThan)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) EnqueueIngestionJobs(ctx context.Context, source string, mods ...Version) ([]IngestionJob, error) {
+	ctx, end := s.begin(ctx, "EnqueueIngestionJobs")
+	res, err := s.next.EnqueueIngestionJobs(ctx, source, mods...)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) ClaimIngestionJob(ctx context.Context, staleBefore time.Time) (*IngestionJob, error) {
+	ctx, end := s.begin(ctx, "ClaimIngestionJob")
+	res, err := s.next.ClaimIngestionJob(ctx, staleBefore)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) CompleteIngestionJob(ctx context.Context, id int64, jobErr error, retry bool) error {
+	ctx, end := s.begin(ctx, "CompleteIngestionJob")
+	err := s.next.CompleteIngestionJob(ctx, id, jobErr, retry)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) GetIngestionJob(ctx context.Context, id int64) (IngestionJob, error) {
+	ctx, end := s.begin(ctx, "GetIngestionJob")
+	res, err := s.next.GetIngestionJob(ctx, id)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) QueryIngestionJobs(ctx context.Context, status string, pageToken string, count int) ([]IngestionJob, string, error) {
+	ctx, end := s.begin(ctx, "QueryIngestionJobs")
+	res, nextToken, err := s.next.QueryIngestionJobs(ctx, status, pageToken, count)
+	end(err)
+	return res, nextToken, err
+}
+
+func (s *instrumentedStore) DeleteModule(ctx context.Context, name string) error {
+	ctx, end := s.begin(ctx, "DeleteModule")
+	err := s.next.DeleteModule(ctx, name)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) DeleteModuleVersion(ctx context.Context, name, version string) error {
+	ctx, end := s.begin(ctx, "DeleteModuleVersion")
+	err := s.next.DeleteModuleVersion(ctx, name, version)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) UndeleteModule(ctx context.Context, name, version string) (UndeleteResult, error) {
+	ctx, end := s.begin(ctx, "UndeleteModule")
+	res, err := s.next.UndeleteModule(ctx, name, version)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) PurgeDeletedModules(ctx context.Context, olderThan time.Time) (int, error) {
+	ctx, end := s.begin(ctx, "PurgeDeletedModules")
+	res, err := s.next.PurgeDeletedModules(ctx, olderThan)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) ClaimIdempotencyKey(ctx context.Context, procedure, key string, requestHash []byte, abandonedBefore time.Time) (*IdempotencyKey, error) {
+	ctx, end := s.begin(ctx, "ClaimIdempotencyKey")
+	res, err := s.next.ClaimIdempotencyKey(ctx, procedure, key, requestHash, abandonedBefore)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) CompleteIdempotencyKey(ctx context.Context, procedure, key string, response []byte) error {
+	ctx, end := s.begin(ctx, "CompleteIdempotencyKey")
+	err := s.next.CompleteIdempotencyKey(ctx, procedure, key, response)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) ReleaseIdempotencyKey(ctx context.Context, procedure, key string) error {
+	ctx, end := s.begin(ctx, "ReleaseIdempotencyKey")
+	err := s.next.ReleaseIdempotencyKey(ctx, procedure, key)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) PurgeIdempotencyKeys(ctx context.Context, olderThan time.Time) (int, error) {
+	ctx, end := s.begin(ctx, "PurgeIdempotencyKeys")
+	res, err := s.next.PurgeIdempotencyKeys(ctx, olderThan)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) CreateAPIKey(ctx context.Context, name, keyHash string) (APIKey, error) {
+	ctx, end := s.begin(ctx, "CreateAPIKey")
+	res, err := s.next.CreateAPIKey(ctx, name, keyHash)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	ctx, end := s.begin(ctx, "ListAPIKeys")
+	res, err := s.next.ListAPIKeys(ctx)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) RevokeAPIKey(ctx context.Context, name string) error {
+	ctx, end := s.begin(ctx, "RevokeAPIKey")
+	err := s.next.RevokeAPIKey(ctx, name)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) AuthenticateAPIKey(ctx context.Context, keyHash string) (APIKey, error) {
+	ctx, end := s.begin(ctx, "AuthenticateAPIKey")
+	res, err := s.next.AuthenticateAPIKey(ctx, keyHash)
+	end(err)
+	return res, err
+}
+
+func (s *instrumentedStore) AddAuditEntry(ctx context.Context, entry AuditEntry) error {
+	ctx, end := s.begin(ctx, "AddAuditEntry")
+	err := s.next.AddAuditEntry(ctx, entry)
+	end(err)
+	return err
+}
+
+func (s *instrumentedStore) QueryAuditLog(ctx context.Context, pageToken string, count int) ([]AuditEntry, string, error) {
+	ctx, end := s.begin(ctx, "QueryAuditLog")
+	res, nextToken, err := s.next.QueryAuditLog(ctx, pageToken, count)
+	end(err)
+	return res, nextToken, err
+}
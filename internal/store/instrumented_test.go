@@ -0,0 +1,387 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
+)
+
+// recordingStore is a [Store] that records each call made to it and returns err
+type recordingStore struct {
+	calls []recordedCall
+	err   error
+	// the store passed to the function given to WithTx
+	tx *recordingStore
+}
+
+// recordedCall is a single call to a [recordingStore]
+type recordedCall struct {
+	method string
+	ctx    context.Context
+	args   []any
+}
+
+// ensure the fake satisfies the Store interface
+var _ Store = (*recordingStore)(nil)
+
+func (f *recordingStore) record(ctx context.Context, method string, args ...any) error {
+	f.calls = append(f.calls, recordedCall{method: method, ctx: ctx, args: args})
+	return f.err
+}
+
+func (f *recordingStore) WithTx(ctx context.Context, fn func(Store) error) error {
+	if err := f.record(ctx, "WithTx"); err != nil {
+		return err
+	}
+	return fn(f.tx)
+}
+
+func (f *recordingStore) Ping(ctx context.Context) error {
+	return f.record(ctx, "Ping")
+}
+
+func (f *recordingStore) SaveModule(ctx context.Context, name, description string, versions ...string) error {
+	return f.record(ctx, "SaveModule", name, description, versions)
+}
+
+func (f *recordingStore) SaveModuleDependencies(ctx context.Context, mod Version, deps ...Version) error {
+	return f.record(ctx, "SaveModuleDependencies", mod, deps)
+}
+
+func (f *recordingStore) ReplaceModuleDependencies(ctx context.Context, mod Version, deps ...Version) error {
+	return f.record(ctx, "ReplaceModuleDependencies", mod, deps)
+}
+
+func (f *recordingStore) PreviewModuleDependencies(ctx context.Context, mod Version, replace bool, deps ...Version) (_ DependencyChanges, err error) {
+	err = f.record(ctx, "PreviewModuleDependencies", mod, replace, deps)
+	return
+}
+
+func (f *recordingStore) QueryModules(ctx context.Context, nameFilter string, pageToken string, count int) (_ []Module, _ string, err error) {
+	err = f.record(ctx, "QueryModules", nameFilter, pageToken, count)
+	return
+}
+
+func (f *recordingStore) QueryModuleVersions(ctx context.Context, query ModuleVersionQuery) (_ []ModuleVersionQueryResult, _ string, err error) {
+	err = f.record(ctx, "QueryModuleVersions", query)
+	return
+}
+
+func (f *recordingStore) GetDependents(ctx context.Context, id, version string, asOf time.Time, pageToken string, count int) (_ []Version, _ string, err error) {
+	err = f.record(ctx, "GetDependents", id, version, asOf, pageToken, count)
+	return
+}
+
+func (f *recordingStore) GetDependees(ctx context.Context, id, version string, asOf time.Time, pageToken string, count int) (_ []Version, _ string, err error) {
+	err = f.record(ctx, "GetDependees", id, version, asOf, pageToken, count)
+	return
+}
+
+func (f *recordingStore) GetDependentsInRange(ctx context.Context, id string, versions modver.Range, asOf time.Time, pageToken string, count int) (_ []DependencyEdge, _ string, err error) {
+	err = f.record(ctx, "GetDependentsInRange", id, versions, asOf, pageToken, count)
+	return
+}
+
+func (f *recordingStore) GetDependencyHistory(ctx context.Context, module, version string) (_ []DependencyHistory, err error) {
+	err = f.record(ctx, "GetDependencyHistory", module, version)
+	return
+}
+
+func (f *recordingStore) DiffGraph(ctx context.Context, moduleFilter string, from, to time.Time) (_ GraphDiff, err error) {
+	err = f.record(ctx, "DiffGraph", moduleFilter, from, to)
+	return
+}
+
+func (f *recordingStore) StreamDependencyEdges(ctx context.Context, query EdgeQuery, fn func(DependencyEdge) error) error {
+	return f.record(ctx, "StreamDependencyEdges", query, fn)
+}
+
+func (f *recordingStore) RankModules(ctx context.Context, query ModuleRankQuery) (_ []ModuleRank, err error) {
+	err = f.record(ctx, "RankModules", query)
+	return
+}
+
+func (f *recordingStore) GetModuleMetrics(ctx context.Context, name string) (_ ModuleMetrics, err error) {
+	err = f.record(ctx, "GetModuleMetrics", name)
+	return
+}
+
+func (f *recordingStore) GetModuleConsumers(ctx context.Context, name string, allMajors bool) (_ []DependencyEdge, err error) {
+	err = f.record(ctx, "GetModuleConsumers", name, allMajors)
+	return
+}
+
+func (f *recordingStore) GetModuleStaleness(ctx context.Context, query StalenessQuery) (_ []ModuleStaleness, err error) {
+	err = f.record(ctx, "GetModuleStaleness", query)
+	return
+}
+
+func (f *recordingStore) GetModuleLicenses(ctx context.Context, names []string) (_ map[string]string, err error) {
+	err = f.record(ctx, "GetModuleLicenses", names)
+	return
+}
+
+func (f *recordingStore) GetAbandonedModules(ctx context.Context, query AbandonedModuleQuery) (_ []UpstreamStatus, err error) {
+	err = f.record(ctx, "GetAbandonedModules", query)
+	return
+}
+
+func (f *recordingStore) GetGhostVersions(ctx context.Context, moduleFilter string) (_ []GhostVersion, err error) {
+	err = f.record(ctx, "GetGhostVersions", moduleFilter)
+	return
+}
+
+func (f *recordingStore) GetModuleEdges(ctx context.Context) (_ []ModuleEdge, err error) {
+	err = f.record(ctx, "GetModuleEdges")
+	return
+}
+
+func (f *recordingStore) GetTimeSeries(ctx context.Context, query TimeSeriesQuery) (_ []TimeSeriesPoint, err error) {
+	err = f.record(ctx, "GetTimeSeries", query)
+	return
+}
+
+func (f *recordingStore) GetGraphStats(ctx context.Context) (_ GraphStats, err error) {
+	err = f.record(ctx, "GetGraphStats")
+	return
+}
+
+func (f *recordingStore) GetStoreStats(ctx context.Context) (_ StoreStats, err error) {
+	err = f.record(ctx, "GetStoreStats")
+	return
+}
+
+func (f *recordingStore) CountModulesByPrefix(ctx context.Context, prefixes []string) (_ map[string]int64, err error) {
+	err = f.record(ctx, "CountModulesByPrefix", prefixes)
+	return
+}
+
+func (f *recordingStore) SaveModuleImportance(ctx context.Context, scores map[int32]float64) error {
+	return f.record(ctx, "SaveModuleImportance", scores)
+}
+
+func (f *recordingStore) CheckConsistency(ctx context.Context, repair bool) (_ []ConsistencyIssue, err error) {
+	err = f.record(ctx, "CheckConsistency", repair)
+	return
+}
+
+func (f *recordingStore) MergeModules(ctx context.Context, source, target string) (_ MergeResult, err error) {
+	err = f.record(ctx, "MergeModules", source, target)
+	return
+}
+
+func (f *recordingStore) SaveModuleAlias(ctx context.Context, name, alias string) error {
+	return f.record(ctx, "SaveModuleAlias", name, alias)
+}
+
+func (f *recordingStore) RenameModule(ctx context.Context, oldName, newName string) error {
+	return f.record(ctx, "RenameModule", oldName, newName)
+}
+
+func (f *recordingStore) SetModuleLicense(ctx context.Context, name, license string) error {
+	return f.record(ctx, "SetModuleLicense", name, license)
+}
+
+func (f *recordingStore) SaveUpstreamStatus(ctx context.Context, name string, archived bool, lastCommit time.Time) error {
+	return f.record(ctx, "SaveUpstreamStatus", name, archived, lastCommit)
+}
+
+func (f *recordingStore) SaveVersionAvailability(ctx context.Context, name string, unavailable map[string]string) error {
+	return f.record(ctx, "SaveVersionAvailability", name, unavailable)
+}
+
+func (f *recordingStore) GetRenamedModules(ctx context.Context, name string) (_ []string, err error) {
+	err = f.record(ctx, "GetRenamedModules", name)
+	return
+}
+
+func (f *recordingStore) PrunePrereleaseVersions(ctx context.Context, olderThan time.Time) (_ int, err error) {
+	err = f.record(ctx, "PrunePrereleaseVersions", olderThan)
+	return
+}
+
+func (f *recordingStore) EnqueueIngestionJobs(ctx context.Context, source string, mods ...Version) (_ []IngestionJob, err error) {
+	err = f.record(ctx, "EnqueueIngestionJobs", source, mods)
+	return
+}
+
+func (f *recordingStore) ClaimIngestionJob(ctx context.Context, staleBefore time.Time) (_ *IngestionJob, err error) {
+	err = f.record(ctx, "ClaimIngestionJob", staleBefore)
+	return
+}
+
+func (f *recordingStore) CompleteIngestionJob(ctx context.Context, id int64, jobErr error, retry bool) error {
+	return f.record(ctx, "CompleteIngestionJob", id, jobErr, retry)
+}
+
+func (f *recordingStore) GetIngestionJob(ctx context.Context, id int64) (_ IngestionJob, err error) {
+	err = f.record(ctx, "GetIngestionJob", id)
+	return
+}
+
+func (f *recordingStore) QueryIngestionJobs(ctx context.Context, status string, pageToken string, count int) (_ []IngestionJob, _ string, err error) {
+	err = f.record(ctx, "QueryIngestionJobs", status, pageToken, count)
+	return
+}
+
+func (f *recordingStore) DeleteModule(ctx context.Context, name string) error {
+	return f.record(ctx, "DeleteModule", name)
+}
+
+func (f *recordingStore) DeleteModuleVersion(ctx context.Context, name, version string) error {
+	return f.record(ctx, "DeleteModuleVersion", name, version)
+}
+
+func (f *recordingStore) UndeleteModule(ctx context.Context, name, version string) (_ UndeleteResult, err error) {
+	err = f.record(ctx, "UndeleteModule", name, version)
+	return
+}
+
+func (f *recordingStore) PurgeDeletedModules(ctx context.Context, olderThan time.Time) (_ int, err error) {
+	err = f.record(ctx, "PurgeDeletedModules", olderThan)
+	return
+}
+
+func (f *recordingStore) ClaimIdempotencyKey(ctx context.Context, procedure, key string, requestHash []byte, abandonedBefore time.Time) (_ *IdempotencyKey, err error) {
+	err = f.record(ctx, "ClaimIdempotencyKey", procedure, key, requestHash, abandonedBefore)
+	return
+}
+
+func (f *recordingStore) CompleteIdempotencyKey(ctx context.Context, procedure, key string, response []byte) error {
+	return f.record(ctx, "CompleteIdempotencyKey", procedure, key, response)
+}
+
+func (f *recordingStore) ReleaseIdempotencyKey(ctx context.Context, procedure, key string) error {
+	return f.record(ctx, "ReleaseIdempotencyKey", procedure, key)
+}
+
+func (f *recordingStore) PurgeIdempotencyKeys(ctx context.Context, olderThan time.Time) (_ int, err error) {
+	err = f.record(ctx, "PurgeIdempotencyKeys", olderThan)
+	return
+}
+
+func (f *recordingStore) CreateAPIKey(ctx context.Context, name, keyHash string) (_ APIKey, err error) {
+	err = f.record(ctx, "CreateAPIKey", name, keyHash)
+	return
+}
+
+func (f *recordingStore) ListAPIKeys(ctx context.Context) (_ []APIKey, err error) {
+	err = f.record(ctx, "ListAPIKeys")
+	return
+}
+
+func (f *recordingStore) RevokeAPIKey(ctx context.Context, name string) error {
+	return f.record(ctx, "RevokeAPIKey", name)
+}
+
+func (f *recordingStore) AuthenticateAPIKey(ctx context.Context, keyHash string) (_ APIKey, err error) {
+	err = f.record(ctx, "AuthenticateAPIKey", keyHash)
+	return
+}
+
+func (f *recordingStore) AddAuditEntry(ctx context.Context, entry AuditEntry) error {
+	return f.record(ctx, "AddAuditEntry", entry)
+}
+
+func (f *recordingStore) QueryAuditLog(ctx context.Context, pageToken string, count int) (_ []AuditEntry, _ string, err error) {
+	err = f.record(ctx, "QueryAuditLog", pageToken, count)
+	return
+}
+
+// instrumentedTestKey is the key of a context value that is used to check that the caller's context is
+// passed to the wrapped store
+type instrumentedTestKey struct{}
+
+// testArg returns a non-zero value of type t, where possible, that is distinct for each n so that the test
+// can check that each argument is passed to the wrapped store in the right position
+func testArg(t reflect.Type, n int) reflect.Value {
+	v := reflect.New(t).Elem()
+	switch {
+	case t == reflect.TypeOf(time.Time{}):
+		v.Set(reflect.ValueOf(time.Unix(int64(n), 0)))
+	case t == reflect.TypeOf(modver.Range{}):
+		// ranges are compared by value, so the zero value is sufficient
+	case t.Kind() == reflect.String:
+		v.SetString("arg" + string(rune('a'+n)))
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+		v.SetInt(int64(n))
+	case t.Kind() == reflect.Bool:
+		v.SetBool(true)
+	case t.Kind() == reflect.Slice:
+		v = reflect.Append(v, testArg(t.Elem(), n))
+	}
+	return v
+}
+
+func TestInstrumentedStoreDelegates(t *testing.T) {
+	errTest := errors.New("store error")
+	storeType := reflect.TypeOf((*Store)(nil)).Elem()
+	for i := 0; i < storeType.NumMethod(); i++ {
+		m := storeType.Method(i)
+		if m.Name == "WithTx" {
+			// the callback is checked by TestInstrumentedStoreWithTx
+			continue
+		}
+		t.Run(m.Name, func(t *testing.T) {
+			inner := &recordingStore{err: errTest}
+			s := NewInstrumentedStore(inner, nil)
+			ctx := context.WithValue(context.Background(), instrumentedTestKey{}, m.Name)
+
+			args := []reflect.Value{reflect.ValueOf(ctx)}
+			for j := 1; j < m.Type.NumIn(); j++ {
+				args = append(args, testArg(m.Type.In(j), j))
+			}
+			fn := reflect.ValueOf(s).MethodByName(m.Name)
+			var results []reflect.Value
+			if m.Type.IsVariadic() {
+				results = fn.CallSlice(args)
+			} else {
+				results = fn.Call(args)
+			}
+
+			err, _ := results[len(results)-1].Interface().(error)
+			assert.ErrorIs(t, err, errTest, "the error from the wrapped store should be returned")
+			require.Len(t, inner.calls, 1, "the wrapped store should be called once")
+			call := inner.calls[0]
+			assert.Equal(t, m.Name, call.method, "the call should be passed to the same method of the wrapped store")
+			assert.Equal(t, m.Name, call.ctx.Value(instrumentedTestKey{}), "the caller's context should be passed to the wrapped store")
+			var want []any
+			for _, a := range args[1:] {
+				want = append(want, a.Interface())
+			}
+			assert.Equal(t, want, call.args, "the arguments should be passed to the wrapped store")
+		})
+	}
+}
+
+func TestInstrumentedStoreWithTx(t *testing.T) {
+	errTest := errors.New("callback error")
+	tx := &recordingStore{}
+	inner := &recordingStore{tx: tx}
+	s := NewInstrumentedStore(inner, nil)
+	ctx := context.WithValue(context.Background(), instrumentedTestKey{}, "WithTx")
+
+	err := s.WithTx(ctx, func(txs Store) error {
+		is, ok := txs.(*instrumentedStore)
+		if assert.True(t, ok, "the callback should be passed an instrumented store, got %T", txs) {
+			assert.Same(t, tx, is.next, "the instrumented store should wrap the transaction-scoped store")
+		}
+		assert.NoError(t, txs.Ping(ctx))
+		return errTest
+	})
+	assert.ErrorIs(t, err, errTest, "the error from the callback should be returned")
+	if assert.Len(t, inner.calls, 1) {
+		assert.Equal(t, "WithTx", inner.calls[0].method)
+		assert.Equal(t, "WithTx", inner.calls[0].ctx.Value(instrumentedTestKey{}))
+	}
+	if assert.Len(t, tx.calls, 1, "calls made within the transaction should go to the transaction-scoped store") {
+		assert.Equal(t, "Ping", tx.calls[0].method)
+	}
+}
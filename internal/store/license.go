@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// SetModuleLicense records the SPDX identifier of the license of the named module, such as "Apache-2.0".
+// An empty license clears any previously recorded value.  If the module does not exist, the returned error
+// wraps [ErrModuleNotFound].
+func (p *PostgresClient) SetModuleLicense(ctx context.Context, name, license string) error {
+	name, err := resolveModuleAlias(ctx, p.db, name)
+	if err != nil {
+		return err
+	}
+	id, err := getModuleID(ctx, p.db, name)
+	if err != nil {
+		return err
+	}
+	p.log.Debug("setting module license", "module", name, "license", license)
+	if _, err := p.db.ExecContext(ctx, `UPDATE module SET license = NULLIF($1, '') WHERE id = $2`, license, id); err != nil {
+		return fmt.Errorf("database error setting module license: %w", err)
+	}
+	return nil
+}
+
+// GetModuleLicenses returns the recorded license of each of the named modules that has one, keyed by
+// module name.  Modules that do not exist or have no recorded license are omitted.
+func (p *PostgresClient) GetModuleLicenses(ctx context.Context, names []string) (map[string]string, error) {
+	licenses := make(map[string]string)
+	if len(names) == 0 {
+		return licenses, nil
+	}
+	sql, args, err := psql.
+		Select("name", "license").
+		From(tableModules).
+		Where(sq.Eq{"name": names}).
+		Where("license IS NOT NULL").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("querying module licenses", "sql", sql, "modules", len(names))
+	var rows []struct {
+		Name    string `db:"name"`
+		License string `db:"license"`
+	}
+	if err := p.db.SelectContext(ctx, &rows, sql, args...); err != nil {
+		return nil, fmt.Errorf("database error querying module licenses: %w", err)
+	}
+	for _, r := range rows {
+		licenses[r.Name] = r.License
+	}
+	return licenses, nil
+}
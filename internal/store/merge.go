@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// MergeResult summarizes the changes made by [PostgresClient.MergeModules]
+type MergeResult struct {
+	// the number of versions that were moved from the source module to the target module
+	MovedVersions int
+	// the number of versions that existed in both modules and were combined
+	MergedVersions int
+}
+
+// MergeModules merges the source module into the target module within a single transaction.
+//
+// Versions of source that do not exist in target are moved to target.  For versions that exist in both,
+// all dependency edges that reference the source version are re-pointed to the target version and the
+// source version is removed.  Renames of and to source are re-pointed to target.  Finally, source is
+// deleted and its name is recorded as an alias of target, along with any existing aliases of source.
+//
+// If either module does not exist, the returned error wraps [ErrModuleNotFound].
+func (p *PostgresClient) MergeModules(ctx context.Context, source, target string) (result MergeResult, err error) {
+	if source == "" || target == "" {
+		return result, fmt.Errorf("both the source and target module names must be specified")
+	}
+	if source == target {
+		return result, fmt.Errorf("a module cannot be merged into itself")
+	}
+
+	txn, err := p.beginTx(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = txn.Commit()
+		} else {
+			if e2 := txn.Rollback(); e2 != nil {
+				p.log.Error(e2, "error rolling back transaction after error")
+			}
+		}
+	}()
+
+	sourceID, err := getModuleID(ctx, txn, source)
+	if err != nil {
+		return result, err
+	}
+	targetID, err := getModuleID(ctx, txn, target)
+	if err != nil {
+		return result, err
+	}
+
+	// versions that exist in both modules, mapped from the source version ID to the target version ID
+	var overlap []struct {
+		SourceID int32 `db:"source_id"`
+		TargetID int32 `db:"target_id"`
+	}
+	q := `SELECT s.id AS source_id, t.id AS target_id
+	        FROM module_version s JOIN module_version t ON (t.version = s.version AND t.module_id = $2)
+	       WHERE s.module_id = $1`
+	if err = txn.SelectContext(ctx, &overlap, q, sourceID, targetID); err != nil {
+		return result, fmt.Errorf("database error querying for overlapping module versions: %w", err)
+	}
+	result.MergedVersions = len(overlap)
+
+	for _, v := range overlap {
+		// copy each edge that references the source version to the target version, along with the gaps in
+		// its history, then remove the source version, which also removes the original edges.  Edges that
+		// the target version already has are kept as-is.
+		cmds := []string{
+			`WITH copied AS (
+			     INSERT INTO module_dependency (dependent_id, dependee_id, first_seen, last_seen, removed_at)
+			     SELECT $2, dependee_id, first_seen, last_seen, removed_at FROM module_dependency WHERE dependent_id = $1 AND dependee_id <> $2
+			     ON CONFLICT (dependent_id, dependee_id) DO NOTHING
+			     RETURNING dependee_id)
+			 INSERT INTO module_dependency_gap (dependent_id, dependee_id, removed_at, restored_at)
+			 SELECT $2, g.dependee_id, g.removed_at, g.restored_at
+			   FROM copied c JOIN module_dependency_gap g ON (g.dependent_id = $1 AND g.dependee_id = c.dependee_id)`,
+			`WITH copied AS (
+			     INSERT INTO module_dependency (dependent_id, dependee_id, first_seen, last_seen, removed_at)
+			     SELECT dependent_id, $2, first_seen, last_seen, removed_at FROM module_dependency WHERE dependee_id = $1 AND dependent_id <> $2
+			     ON CONFLICT (dependent_id, dependee_id) DO NOTHING
+			     RETURNING dependent_id)
+			 INSERT INTO module_dependency_gap (dependent_id, dependee_id, removed_at, restored_at)
+			 SELECT g.dependent_id, $2, g.removed_at, g.restored_at
+			   FROM copied c JOIN module_dependency_gap g ON (g.dependent_id = c.dependent_id AND g.dependee_id = $1)`,
+		}
+		for _, cmd := range cmds {
+			if _, err = txn.ExecContext(ctx, cmd, v.SourceID, v.TargetID); err != nil {
+				return result, fmt.Errorf("database error re-pointing module dependencies: %w", err)
+			}
+		}
+		if err = p.execMerge(ctx, txn, psql.Delete(tableModuleVersions).Where(sq.Eq{"id": v.SourceID})); err != nil {
+			return result, err
+		}
+	}
+
+	res, err := txn.ExecContext(ctx, `UPDATE module_version SET module_id = $2 WHERE module_id = $1`, sourceID, targetID)
+	if err != nil {
+		return result, fmt.Errorf("database error moving module versions: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return result, fmt.Errorf("error processing database command result: %w", err)
+	}
+	result.MovedVersions = int(n)
+
+	cmds := []sq.Sqlizer{
+		// keep the description of the source module if the target does not have one
+		psql.Update(tableModules).
+			Set("description", sq.Expr("(SELECT description FROM module WHERE id = ?)", sourceID)).
+			Where(sq.Eq{"id": targetID, "description": nil}),
+		psql.Update(tableModuleAliases).Set("module_id", targetID).Where(sq.Eq{"module_id": sourceID}),
+		// re-point the renames of and to the source module so they aren't removed along with it, dropping
+		// any that would rename the target to itself and, since a module can only be renamed once, the
+		// rename of the source if the target has already been renamed
+		psql.Delete(tableModuleRenames).Where(sq.Or{
+			sq.Eq{"old_module_id": sourceID, "new_module_id": targetID},
+			sq.Eq{"old_module_id": targetID, "new_module_id": sourceID},
+		}),
+		psql.Delete(tableModuleRenames).
+			Where(sq.Eq{"old_module_id": sourceID}).
+			Where(sq.Expr("EXISTS (SELECT 1 FROM module_rename WHERE old_module_id = ?)", targetID)),
+		psql.Update(tableModuleRenames).Set("old_module_id", targetID).Where(sq.Eq{"old_module_id": sourceID}),
+		psql.Update(tableModuleRenames).Set("new_module_id", targetID).Where(sq.Eq{"new_module_id": sourceID}),
+		psql.Delete(tableModules).Where(sq.Eq{"id": sourceID}),
+		psql.Insert(tableModuleAliases).
+			Columns("alias", "module_id").
+			Values(source, targetID).
+			Suffix("ON CONFLICT (alias) DO UPDATE SET module_id = EXCLUDED.module_id"),
+		// the target may itself have been recorded as an alias, which would now be a cycle
+		psql.Delete(tableModuleAliases).Where(sq.Eq{"alias": target}),
+	}
+	for _, cmd := range cmds {
+		if err = p.execMerge(ctx, txn, cmd); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// execMerge executes the provided SQL command as part of a merge operation
+func (p *PostgresClient) execMerge(ctx context.Context, txn dbTx, cmd sq.Sqlizer) error {
+	sql, args, err := cmd.ToSql()
+	if err != nil {
+		return fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("merging modules", "sql", sql, "args", args)
+	if _, err := txn.ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("database error merging modules: %w", err)
+	}
+	return nil
+}
+
+// getModuleID returns the primary key of the module with the specified name.  If there is no such
+// module the returned error wraps [ErrModuleNotFound].
+func getModuleID(ctx context.Context, q sqlx.QueryerContext, name string) (int32, error) {
+	var id int32
+	err := sqlx.GetContext(ctx, q, &id, `SELECT id FROM module WHERE name = $1`, name)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, fmt.Errorf("%w: %s", ErrModuleNotFound, name)
+	case err != nil:
+		return 0, fmt.Errorf("database error querying for module %s: %w", name, err)
+	default:
+		return id, nil
+	}
+}
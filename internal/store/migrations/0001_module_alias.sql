@@ -0,0 +1,12 @@
+/* adds the module_alias table used to record the names of merged modules */
+
+CREATE TABLE IF NOT EXISTS module_alias (
+    alias       TEXT NOT NULL,
+    module_id   INTEGER NOT NULL,
+    CONSTRAINT pk_module_alias
+        PRIMARY KEY(alias),
+    CONSTRAINT fk_module_alias_module_id_module_id
+        FOREIGN KEY(module_id) REFERENCES module (id)
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
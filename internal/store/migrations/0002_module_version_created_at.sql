@@ -0,0 +1,4 @@
+/* records when each module version was added so that old pre-release versions can be pruned */
+
+ALTER TABLE module_version
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
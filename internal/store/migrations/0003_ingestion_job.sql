@@ -0,0 +1,19 @@
+/* adds the ingestion_job table that backs the asynchronous ingestion queue */
+
+CREATE TABLE IF NOT EXISTS ingestion_job (
+    id          BIGSERIAL,
+    module      TEXT NOT NULL,
+    version     TEXT NOT NULL,
+    source      TEXT NOT NULL,
+    status      TEXT NOT NULL DEFAULT 'pending',
+    attempts    INTEGER NOT NULL DEFAULT 0,
+    error       TEXT,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT pk_ingestion_job
+        PRIMARY KEY(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ingestion_job_status
+    ON ingestion_job USING btree
+    (status, id);
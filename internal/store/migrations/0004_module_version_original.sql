@@ -0,0 +1,4 @@
+/* records the version string that was reported for a module version when it differs from the canonical version */
+
+ALTER TABLE module_version
+    ADD COLUMN IF NOT EXISTS original_version TEXT NULL;
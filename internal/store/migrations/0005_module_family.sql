@@ -0,0 +1,18 @@
+/* records the module family, the module path without its major version suffix, that links the major versions of a project */
+
+ALTER TABLE module
+    ADD COLUMN IF NOT EXISTS family TEXT NULL;
+
+UPDATE module
+   SET family = CASE
+           WHEN name LIKE 'gopkg.in/%' THEN regexp_replace(name, '\.v[0-9]+(-unstable)?$', '')
+           ELSE regexp_replace(name, '/v([2-9]|[1-9][0-9]+)$', '')
+       END
+ WHERE family IS NULL;
+
+ALTER TABLE module
+    ALTER COLUMN family SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_module_family
+    ON module USING btree
+    (family);
@@ -0,0 +1,17 @@
+/* adds the module_rename table that records modules that were renamed, such as after an organization migration */
+
+CREATE TABLE IF NOT EXISTS module_rename (
+    old_module_id   INTEGER NOT NULL,
+    new_module_id   INTEGER NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT pk_module_rename
+        PRIMARY KEY(old_module_id),
+    CONSTRAINT fk_module_rename_old_module_id_module_id
+        FOREIGN KEY(old_module_id) REFERENCES module (id)
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE,
+    CONSTRAINT fk_module_rename_new_module_id_module_id
+        FOREIGN KEY(new_module_id) REFERENCES module (id)
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
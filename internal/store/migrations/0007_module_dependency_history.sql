@@ -0,0 +1,7 @@
+/* records when each dependency edge was first and most recently declared and when it was removed so that
+   replacing the dependencies of a module version keeps the history of the edges that were dropped */
+
+ALTER TABLE module_dependency
+    ADD COLUMN IF NOT EXISTS first_seen TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS last_seen TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS removed_at TIMESTAMPTZ NULL;
@@ -0,0 +1,4 @@
+/* adds the importance score that is periodically computed for each module by the score-modules job */
+
+ALTER TABLE module
+    ADD COLUMN IF NOT EXISTS importance DOUBLE PRECISION NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+/* adds the SPDX license identifier of each module, which is evaluated by the license rules of the dependency policy */
+
+ALTER TABLE module
+    ADD COLUMN IF NOT EXISTS license TEXT NULL;
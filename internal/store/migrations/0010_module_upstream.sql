@@ -0,0 +1,6 @@
+/* adds the state of each module's source repository, which is periodically refreshed by the check-upstreams job */
+
+ALTER TABLE module
+    ADD COLUMN IF NOT EXISTS archived BOOLEAN NULL,
+    ADD COLUMN IF NOT EXISTS last_commit_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS upstream_checked_at TIMESTAMPTZ NULL;
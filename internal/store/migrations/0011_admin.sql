@@ -0,0 +1,27 @@
+/* adds the api_key and audit_log tables that back authentication of administrative operations and their audit trail */
+
+CREATE TABLE IF NOT EXISTS api_key (
+    id              SERIAL,
+    name            TEXT NOT NULL,
+    key_hash        TEXT NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at    TIMESTAMPTZ NULL,
+    revoked_at      TIMESTAMPTZ NULL,
+    CONSTRAINT pk_api_key
+        PRIMARY KEY(id),
+    CONSTRAINT uc_api_key_name
+        UNIQUE(name),
+    CONSTRAINT uc_api_key_key_hash
+        UNIQUE(key_hash)
+);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id          BIGSERIAL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    actor       TEXT NOT NULL,
+    action      TEXT NOT NULL,
+    target      TEXT NOT NULL,
+    details     TEXT NULL,
+    CONSTRAINT pk_audit_log
+        PRIMARY KEY(id)
+);
@@ -0,0 +1,5 @@
+/* adds whether each module version can still be fetched from the Go module proxy, which is periodically refreshed by the verify-versions job */
+
+ALTER TABLE module_version
+    ADD COLUMN IF NOT EXISTS unavailable_reason TEXT NULL,
+    ADD COLUMN IF NOT EXISTS unavailable_since TIMESTAMPTZ NULL;
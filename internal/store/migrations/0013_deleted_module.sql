@@ -0,0 +1,15 @@
+/* adds the deleted_module table, which keeps a tombstone of each deleted module or module version so that it can be restored */
+
+CREATE TABLE IF NOT EXISTS deleted_module (
+    id          BIGSERIAL,
+    module_name TEXT NOT NULL,
+    version     SEMVER NULL,
+    deleted_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    snapshot    JSONB NOT NULL,
+    CONSTRAINT pk_deleted_module
+        PRIMARY KEY(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_deleted_module_module_name
+    ON deleted_module USING btree
+    (module_name, deleted_at DESC);
@@ -0,0 +1,16 @@
+/* adds the idempotency_key table, which records the outcome of write requests so that retries are not processed twice */
+
+CREATE TABLE IF NOT EXISTS idempotency_key (
+    procedure       TEXT NOT NULL,
+    key             TEXT NOT NULL,
+    request_hash    BYTEA NOT NULL,
+    response        BYTEA NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    completed_at    TIMESTAMPTZ NULL,
+    CONSTRAINT pk_idempotency_key
+        PRIMARY KEY(procedure, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_key_created_at
+    ON idempotency_key USING btree
+    (created_at);
@@ -0,0 +1,15 @@
+/* adds the module_dependency_gap table, which records the periods when a dependency edge was removed before it
+   was declared again so that point-in-time queries don't report the edge during those periods */
+
+CREATE TABLE IF NOT EXISTS module_dependency_gap (
+    dependent_id    INTEGER NOT NULL,
+    dependee_id     INTEGER NOT NULL,
+    removed_at      TIMESTAMPTZ NOT NULL,
+    restored_at     TIMESTAMPTZ NOT NULL,
+    CONSTRAINT pk_module_dependency_gap
+        PRIMARY KEY(dependent_id, dependee_id, removed_at),
+    CONSTRAINT fk_module_dependency_gap_module_dependency
+        FOREIGN KEY(dependent_id, dependee_id) REFERENCES module_dependency (dependent_id, dependee_id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
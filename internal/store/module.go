@@ -7,4 +7,7 @@ type Module struct {
 	ID          int32          `json:"id" db:"id"`
 	Name        string         `json:"name,omitempty" db:"name"`
 	Description sql.NullString `json:"description,omitempty" db:"description"`
+	// the module path without its major version suffix, which is shared by all major versions of the
+	// same project, ex: example.com/foo for both example.com/foo and example.com/foo/v2
+	Family string `json:"family,omitempty" db:"family"`
 }
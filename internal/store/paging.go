@@ -3,6 +3,7 @@ package store
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"hash"
 	"hash/fnv"
@@ -10,6 +11,10 @@ import (
 	"sync"
 )
 
+// ErrInvalidPageToken is returned, possibly wrapped, when a query is passed a paging token that is
+// malformed or was generated by a different query.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
 type pageToken struct {
 	FilterID uint32
 	Offset   int
@@ -45,13 +50,13 @@ func encodePageToken(key string, n, offset, page int) string {
 func decodePageToken(s, key string) (offset int, err error) {
 	var data []byte
 	if data, err = base64.StdEncoding.DecodeString(s); err != nil {
-		return 0, fmt.Errorf("token must be a base64-encoded string: %w", err)
+		return 0, fmt.Errorf("%w: token must be a base64-encoded string: %v", ErrInvalidPageToken, err)
 	}
 	var tok pageToken
 	if err = json.Unmarshal(data, &tok); err != nil {
 		// log the JSON error but don't return it to the caller so that the page token can remain opaque
 		log.Printf("error (%v) decoding JSON from page token: %q\n", err, string(data))
-		return 0, fmt.Errorf("the token contents were invalid")
+		return 0, fmt.Errorf("%w: the token contents were invalid", ErrInvalidPageToken)
 	}
 
 	h := tokenHasherPool.Get().(hash.Hash32)
@@ -62,7 +67,7 @@ func decodePageToken(s, key string) (offset int, err error) {
 
 	_, _ = h.Write([]byte(key))
 	if h.Sum32() != tok.FilterID {
-		return 0, fmt.Errorf("the provided token was for a different operation")
+		return 0, fmt.Errorf("%w: the provided token was for a different operation", ErrInvalidPageToken)
 	}
 	return tok.Offset, nil
 }
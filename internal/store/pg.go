@@ -5,23 +5,34 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	_ "github.com/jackc/pgx/v4/stdlib" //nolint: revive // intentional blank import b/c that's how pgx works
 	"github.com/jmoiron/sqlx"
+	"golang.org/x/mod/semver"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
 )
 
 const (
-	tableModules            = "module"
-	tableModuleVersions     = "module_version"
-	tableModuleDependencies = "module_dependency"
+	tableModules              = "module"
+	tableModuleVersions       = "module_version"
+	tableModuleDependencies   = "module_dependency"
+	tableModuleDependencyGaps = "module_dependency_gap"
+	tableModuleAliases        = "module_alias"
+	tableModuleRenames        = "module_rename"
 
 	joinTargetDependents = `dependee_id`
 	joinTargetDependees  = `dependent_id`
+
+	// pseudoVersionPattern is a POSIX regular expression that matches a stored pseudo-version, which has
+	// no leading "v".  It is equivalent to the pattern used by golang.org/x/mod/module.IsPseudoVersion().
+	pseudoVersionPattern = `^[0-9]+\.(0\.0-|[0-9]+\.[0-9]+-([^+]*\.)?0\.)[0-9]{14}-[A-Za-z0-9]+(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`
 )
 
 var (
-	columnsModules = []string{"id", "name", "description"}
+	columnsModules = []string{"id", "name", "description", "family"}
 
 	psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 )
@@ -29,7 +40,11 @@ var (
 // PostgresClient performs store-related operations against a postgres backend
 // database.
 type PostgresClient struct {
-	db  *sqlx.DB
+	db   dbConn
+	pool *sqlx.DB
+	// the transaction shared by all operations, which is only set for the client passed to the function
+	// given to WithTx
+	tx  *sharedTx
 	log Logger
 }
 
@@ -49,7 +64,8 @@ func NewPostgresClient(ctx context.Context, url string, opts ...PGOption) (*Post
 		return nil, err
 	}
 	p := &PostgresClient{
-		db: db,
+		db:   db,
+		pool: db,
 	}
 	for _, fn := range opts {
 		if err = fn(p); err != nil {
@@ -64,7 +80,7 @@ func NewPostgresClient(ctx context.Context, url string, opts ...PGOption) (*Post
 
 // Ping verifies that the database connection is available
 func (p *PostgresClient) Ping(ctx context.Context) error {
-	return p.db.PingContext(ctx)
+	return p.pool.PingContext(ctx)
 }
 
 // SaveModule upserts module metadata. If there is an existing module with the provided name the
@@ -74,8 +90,8 @@ func (p *PostgresClient) SaveModule(ctx context.Context, name, description strin
 		return fmt.Errorf("module name must be provided")
 	}
 
-	var txn *sql.Tx
-	txn, err = p.db.BeginTx(ctx, nil)
+	var txn dbTx
+	txn, err = p.beginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("unable to start a database transaction: %w", err)
 	}
@@ -101,12 +117,24 @@ func (p *PostgresClient) SaveModule(ctx context.Context, name, description strin
 }
 
 // SaveModuleDependencies writes the specified set of direct dependencies of mod to the database.
-func (p *PostgresClient) SaveModuleDependencies(ctx context.Context, mod Version, deps ...Version) (err error) {
+func (p *PostgresClient) SaveModuleDependencies(ctx context.Context, mod Version, deps ...Version) error {
+	return p.saveModuleDependencies(ctx, mod, false, deps...)
+}
+
+// ReplaceModuleDependencies writes the specified set of direct dependencies of mod to the database,
+// marking any existing dependencies of mod that are not in deps as removed.
+func (p *PostgresClient) ReplaceModuleDependencies(ctx context.Context, mod Version, deps ...Version) error {
+	return p.saveModuleDependencies(ctx, mod, true, deps...)
+}
+
+// saveModuleDependencies writes the specified set of direct dependencies of mod to the database.  If
+// replace is true, any existing dependencies of mod that are not in deps are marked as removed.
+func (p *PostgresClient) saveModuleDependencies(ctx context.Context, mod Version, replace bool, deps ...Version) (err error) {
 	if mod.ModuleID == "" || mod.SemVer == "" {
 		return fmt.Errorf("invalid module, both the module name and version must be specified")
 	}
-	var txn *sql.Tx
-	txn, err = p.db.BeginTx(ctx, nil)
+	var txn dbTx
+	txn, err = p.beginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("unable to start a database transaction: %w", err)
 	}
@@ -120,7 +148,13 @@ func (p *PostgresClient) SaveModuleDependencies(ctx context.Context, mod Version
 		}
 	}()
 
-	p.log.Debug("saving module", "moduleName", mod.ModuleID, "version", mod.SemVer)
+	return writeDependencies(ctx, txn, mod, replace, deps, p.log)
+}
+
+// writeDependencies writes the specified set of direct dependencies of mod using txn.  If replace is
+// true, any existing dependencies of mod that are not in deps are marked as removed.
+func writeDependencies(ctx context.Context, txn database, mod Version, replace bool, deps []Version, log Logger) error {
+	log.Debug("saving module", "moduleName", mod.ModuleID, "version", mod.SemVer)
 	pkey, err := writeModule(ctx, txn, mod.ModuleID, "")
 	if err != nil {
 		return err
@@ -129,6 +163,36 @@ func (p *PostgresClient) SaveModuleDependencies(ctx context.Context, mod Version
 	if err != nil {
 		return err
 	}
+	if err := writeOriginalVersion(ctx, txn, versionIDs[0], mod.Original); err != nil {
+		return err
+	}
+	if err := writeModuleDependencies(ctx, txn, versionIDs[0], deps, log); err != nil {
+		return err
+	}
+	if replace {
+		// now() is the start time of the transaction, so every edge that is still declared was seen
+		// during this transaction and any that weren't have been dropped
+		sql, args, err := psql.
+			Update(tableModuleDependencies).
+			Set("removed_at", sq.Expr("now()")).
+			Where(sq.Eq{"dependent_id": versionIDs[0], "removed_at": nil}).
+			Where("last_seen < now()").
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("error constructing SQL query: %w", err)
+		}
+		log.Debug("mark removed module dependencies", "sql", sql, "args", args)
+		if _, err = txn.ExecContext(ctx, sql, args...); err != nil {
+			return fmt.Errorf("database error removing existing module dependencies: %w", err)
+		}
+	}
+	return nil
+}
+
+// writeModuleDependencies upserts the dependency edges from the module version with the specified ID to
+// each of deps, recording that each edge was seen now and restoring any that had been removed.  The period
+// during which a restored edge was removed is kept in the module_dependency_gap table.
+func writeModuleDependencies(ctx context.Context, txn database, versionID int32, deps []Version, log Logger) error {
 	if len(deps) == 0 {
 		return nil
 	}
@@ -139,8 +203,9 @@ func (p *PostgresClient) SaveModuleDependencies(ctx context.Context, mod Version
 	// been 'go mod tidy'-ed, so we skip any duplicates here to avoid updating the same row in the
 	// database multiple times in a single command
 	uniqueDeps := map[string]struct{}{}
+	var dependeeIDs []int32
 	for _, d := range deps {
-		p.log.Debug("saving dependency", "moduleName", d.ModuleID, "version", d.SemVer)
+		log.Debug("saving dependency", "moduleName", d.ModuleID, "version", d.SemVer)
 		pkey, err := writeModule(ctx, txn, d.ModuleID, "")
 		if err != nil {
 			return err
@@ -149,19 +214,43 @@ func (p *PostgresClient) SaveModuleDependencies(ctx context.Context, mod Version
 		if err != nil {
 			return err
 		}
-		k := fmt.Sprintf("%d-%d", versionIDs[0], vids[0])
+		if err := writeOriginalVersion(ctx, txn, vids[0], d.Original); err != nil {
+			return err
+		}
+		k := fmt.Sprintf("%d-%d", versionID, vids[0])
 		if _, found := uniqueDeps[k]; found {
-			p.log.Debug("skipping duplicate dependency", "dependency", d.ModuleID+"@"+d.SemVer)
+			log.Debug("skipping duplicate dependency", "dependency", d.ModuleID+"@"+d.SemVer)
 			continue
 		}
-		cmd = cmd.Values(versionIDs[0], vids[0])
+		cmd = cmd.Values(versionID, vids[0])
 		uniqueDeps[k] = struct{}{}
+		dependeeIDs = append(dependeeIDs, vids[0])
+	}
+
+	// record the gaps in any edges that are being restored before the upsert clears removed_at
+	gaps := psql.
+		Insert(tableModuleDependencyGaps).
+		Columns("dependent_id", "dependee_id", "removed_at", "restored_at").
+		Select(sq.
+			Select("dependent_id", "dependee_id", "removed_at", "now()").
+			From(tableModuleDependencies).
+			Where(sq.Eq{"dependent_id": versionID, "dependee_id": dependeeIDs}).
+			Where(sq.NotEq{"removed_at": nil})).
+		Suffix("ON CONFLICT DO NOTHING")
+	sql, args, err := gaps.ToSql()
+	if err != nil {
+		return fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	log.Debug("record restored module dependencies", "sql", sql, "args", args)
+	if _, err = txn.ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("database error recording restored module dependencies: %w", err)
 	}
-	sql, args, err := cmd.Suffix("ON CONFLICT (dependent_id, dependee_id) DO UPDATE SET dependent_id = EXCLUDED.dependent_id").ToSql()
+
+	sql, args, err = cmd.Suffix("ON CONFLICT (dependent_id, dependee_id) DO UPDATE SET last_seen = now(), removed_at = NULL").ToSql()
 	if err != nil {
 		return fmt.Errorf("error constructing SQL query: %w", err)
 	}
-	p.log.Debug("upsert module dependencies", "sql", sql, "args", args)
+	log.Debug("upsert module dependencies", "sql", sql, "args", args)
 	if _, err = txn.ExecContext(ctx, sql, args...); err != nil {
 		return fmt.Errorf("database error saving new module dependency: %w", err)
 	}
@@ -180,7 +269,7 @@ func (p *PostgresClient) QueryModules(ctx context.Context, nameFilter string, pa
 		var err error
 		offset, err = decodePageToken(pageToken, nameFilter)
 		if err != nil {
-			return nil, "", fmt.Errorf("invalid page token: %w", err)
+			return nil, "", err
 		}
 	}
 	q := psql.
@@ -221,7 +310,7 @@ func (p *PostgresClient) QueryModuleVersions(ctx context.Context, query ModuleVe
 		var err error
 		offset, err = decodePageToken(query.PageToken, query.pageTokenString())
 		if err != nil {
-			return nil, "", fmt.Errorf("invalid page token: %w", err)
+			return nil, "", err
 		}
 	}
 
@@ -230,18 +319,25 @@ func (p *PostgresClient) QueryModuleVersions(ctx context.Context, query ModuleVe
 	}
 	var columnList []string
 	if query.LatestOnly {
-		columnList = []string{"m.name", "MAX(mv.version) AS version"}
+		// like the go command, +incompatible versions are only the latest if there are no compatible versions
+		columnList = []string{"m.name", "COALESCE(MAX(mv.version) FILTER (WHERE mv.version::text NOT LIKE '%+incompatible'), MAX(mv.version)) AS version"}
 	} else {
-		columnList = []string{"m.name", "mv.version AS version"}
+		columnList = []string{"m.name", "mv.version AS version", "mv.created_at"}
 	}
 	q := psql.
 		Select(columnList...).
 		From(tableModuleVersions + " mv").
 		Join(tableModules + " m ON (m.id = mv.module_id)")
-	if strings.ContainsAny(query.ModuleFilter, "*?") {
-		q = q.Where(sq.Like{"m.name": globToLike(query.ModuleFilter)})
+	if query.AllMajors {
+		// match every module in the same family as the module(s) matching the filter.  the sub-query
+		// is built with '?' placeholders, which are re-numbered when the outer query is rendered.
+		familySQL, familyArgs, err := sq.Select("family").From(tableModules).Where(matchModuleName("", query.ModuleFilter)).ToSql()
+		if err != nil {
+			return nil, "", fmt.Errorf("error constructing SQL query: %w", err)
+		}
+		q = q.Where("m.family IN ("+familySQL+")", familyArgs...)
 	} else {
-		q = q.Where(sq.Eq{"m.name": query.ModuleFilter})
+		q = q.Where(matchModuleName("m", query.ModuleFilter))
 	}
 	if query.VersionFilter != "" {
 		if strings.ContainsAny(query.VersionFilter, "*?") {
@@ -250,13 +346,39 @@ func (p *PostgresClient) QueryModuleVersions(ctx context.Context, query ModuleVe
 			q = q.Where(sq.Eq{"mv.version": query.VersionFilter})
 		}
 	}
+	for _, c := range query.VersionRange.Comparisons() {
+		q = q.Where(compareVersion("mv.version", c))
+	}
 	if !query.IncludePrerelease {
 		q = q.Where(sq.Eq{"get_semver_prerelease(mv.version)": ""})
 	}
-	if query.LatestOnly {
-		q = q.GroupBy("m.name")
+	if query.ExcludePseudo {
+		q = q.Where("mv.version::text !~ ?", pseudoVersionPattern)
+	}
+	if !query.AsOf.IsZero() {
+		q = q.Where(sq.LtOrEq{"mv.created_at": query.AsOf})
 	}
-	q = q.OrderBy("1, 2 DESC")
+	if !query.Since.IsZero() {
+		q = q.Where(sq.GtOrEq{"mv.created_at": query.Since})
+	}
+	// the latest version is determined by semantic version so the sort order and limit only apply when
+	// all matching versions are queried
+	versionOrder, rankOrder := "version DESC", "mv.version DESC"
+	if query.SortByDate && !query.LatestOnly {
+		versionOrder, rankOrder = "created_at DESC, version DESC", "mv.created_at DESC, mv.version DESC"
+	}
+	switch {
+	case query.LatestOnly:
+		q = q.GroupBy("m.name")
+	case query.Limit > 0:
+		// number the versions of each module in the requested order then keep the first N of each
+		q = q.Column("ROW_NUMBER() OVER (PARTITION BY m.name ORDER BY " + rankOrder + ") AS version_rank")
+		q = psql.
+			Select("name", "version", "created_at").
+			FromSelect(q, "ranked").
+			Where(sq.LtOrEq{"version_rank": query.Limit})
+	}
+	q = q.OrderBy("name", versionOrder)
 	if offset > 0 {
 		q = q.Offset(uint64(offset))
 	}
@@ -278,6 +400,8 @@ func (p *PostgresClient) QueryModuleVersions(ctx context.Context, query ModuleVe
 		ModuleID string `db:"module_id"`
 		Module   string `db:"name"`
 		SemVer   string `db:"version"`
+		// only selected when all matching versions are queried
+		CreatedAt *time.Time `db:"created_at"`
 	}
 	var rows []queryResult
 	p.log.Debug("QueryModuleVersions", "sql", sql, "args", args)
@@ -287,22 +411,85 @@ func (p *PostgresClient) QueryModuleVersions(ctx context.Context, query ModuleVe
 	}
 
 	for _, row := range rows {
-		results = append(results, ModuleVersionQueryResult{Module: row.Module, Version: row.SemVer})
+		res := ModuleVersionQueryResult{Module: row.Module, Version: row.SemVer}
+		if row.CreatedAt != nil {
+			res.CreatedAt = *row.CreatedAt
+		}
+		results = append(results, res)
 	}
 
 	return results, encodePageToken(query.pageTokenString(), len(results), offset, query.Count), nil
 }
 
 // GetDependents retrieves all known module versions that depend on the given
-// module id and version pair.
-func (p *PostgresClient) GetDependents(ctx context.Context, id, version string, pageToken string, count int) ([]Version, string, error) {
-	return getDependx(ctx, p.db, id, version, joinTargetDependents, pageToken, count, p.log)
+// module id and version pair.  If asOf is not the zero time, the results are the
+// dependents that were declared at that time rather than the current ones.
+func (p *PostgresClient) GetDependents(ctx context.Context, id, version string, asOf time.Time, pageToken string, count int) ([]Version, string, error) {
+	return getDependx(ctx, p.db, id, version, asOf, joinTargetDependents, pageToken, count, p.log)
 }
 
 // GetDependees retrieves all known module versions that the given module id
-// and version pair depend on.
-func (p *PostgresClient) GetDependees(ctx context.Context, id, version string, pageToken string, count int) ([]Version, string, error) {
-	return getDependx(ctx, p.db, id, version, joinTargetDependees, pageToken, count, p.log)
+// and version pair depend on.  If asOf is not the zero time, the results are the
+// dependencies that were declared at that time rather than the current ones.
+func (p *PostgresClient) GetDependees(ctx context.Context, id, version string, asOf time.Time, pageToken string, count int) ([]Version, string, error) {
+	return getDependx(ctx, p.db, id, version, asOf, joinTargetDependees, pageToken, count, p.log)
+}
+
+// GetDependentsInRange retrieves the module versions that depend on any version of the given module in
+// the specified range, along with the version that each one depends on, ordered by dependent module name
+// and descending version.  If asOf is not the zero time, the results are the dependents that were
+// declared at that time rather than the current ones.
+func (p *PostgresClient) GetDependentsInRange(ctx context.Context, id string, versions modver.Range, asOf time.Time, pageToken string, count int) ([]DependencyEdge, string, error) {
+	pageTokenKey := "dependentsinrange:" + id + ":" + versions.String()
+	if !asOf.IsZero() {
+		pageTokenKey += ":" + asOf.UTC().Format(time.RFC3339Nano)
+	}
+	offset := 0
+	if pageToken != "" {
+		var err error
+		offset, err = decodePageToken(pageToken, pageTokenKey)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if id == "" {
+		return nil, "", fmt.Errorf("module must not be blank")
+	}
+	// queries using an alias, such as the repository path of a vanity import path, match the module
+	module, err := resolveModuleAlias(ctx, p.db, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	q := psql.
+		Select("lm.name module", "lv.version", "rm.name dependency_module", "rv.version dependency_version").
+		From(tableModuleDependencies+" md").
+		Join("module_version lv ON (lv.id = md.dependent_id)").
+		Join("module lm ON (lm.id = lv.module_id)").
+		Join("module_version rv ON (rv.id = md.dependee_id)").
+		Join("module rm ON (rm.id = rv.module_id)").
+		Where(sq.Eq{"rm.name": module}).
+		Where(edgeDeclaredAt("md", asOf)).
+		OrderBy("lm.name", "lv.version DESC", "rv.version DESC")
+	for _, c := range versions.Comparisons() {
+		q = q.Where(compareVersion("rv.version", c))
+	}
+	if offset > 0 {
+		q = q.Offset(uint64(offset))
+	}
+	if count > 0 {
+		q = q.Limit(uint64(count)) //nolint: gosec // no overflow occurs
+	}
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, "", fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("GetDependentsInRange()", "sql", sql, "args", args)
+	var edges []DependencyEdge
+	if err := p.db.SelectContext(ctx, &edges, sql, args...); err != nil {
+		return nil, "", err
+	}
+	return edges, encodePageToken(pageTokenKey, len(edges), offset, count), nil
 }
 
 // getModuleVersionID executes a database query to translate the specified module and version to the
@@ -348,7 +535,8 @@ func getModuleVersionID(ctx context.Context, db database, mod, ver string, log f
 // provided sq.SelectBuilder.
 //
 // The filter string should be a glob pattern ('*' and '?' for wildcards).  If the filter doesn't contain
-// any wildcards it is treated as a substring match.
+// any wildcards it is treated as a substring match.  Modules with an alias that matches the filter are
+// also returned.
 func applyNameFilter(q sq.SelectBuilder, nameFilter string) sq.SelectBuilder {
 	if nameFilter == "" {
 		return q
@@ -360,7 +548,10 @@ func applyNameFilter(q sq.SelectBuilder, nameFilter string) sq.SelectBuilder {
 	if !hasWildcards {
 		where = "%" + where + "%"
 	}
-	return q.Where(sq.Like{"name": where})
+	return q.Where(sq.Or{
+		sq.Like{"name": where},
+		sq.Expr("id IN (SELECT module_id FROM "+tableModuleAliases+" WHERE alias LIKE ?)", where),
+	})
 }
 
 // database defines a type that can execute SQL commands against a database.
@@ -371,8 +562,13 @@ type database interface {
 	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
 }
 
-// writeModule upserts a module into the database
+// writeModule upserts a module into the database.  If name is an alias of an existing module, that
+// module is updated instead.
 func writeModule(ctx context.Context, db database, name, description string) (int32, error) {
+	name, err := resolveModuleAlias(ctx, db, name)
+	if err != nil {
+		return 0, err
+	}
 	var desc interface{}
 	if description != "" {
 		desc = description
@@ -380,7 +576,7 @@ func writeModule(ctx context.Context, db database, name, description string) (in
 	sql, args, err := psql.
 		Insert(tableModules).
 		Columns(columnsModules[1:]...). // don't provide ID on an insert
-		Values(name, desc).
+		Values(name, desc, modver.Family(name)).
 		Suffix(`ON CONFLICT (name) DO UPDATE SET description = ? RETURNING id`, desc).
 		ToSql()
 	if err != nil {
@@ -405,6 +601,56 @@ func writeModule(ctx context.Context, db database, name, description string) (in
 	return moduleID, err
 }
 
+// resolveModuleAlias returns the name of the module that name is an alias of, or name itself if it is
+// not an alias
+func resolveModuleAlias(ctx context.Context, db database, name string) (string, error) {
+	sql, args, err := psql.
+		Select("m.name").
+		From(tableModuleAliases + " a").
+		Join(tableModules + " m ON (m.id = a.module_id)").
+		Where(sq.Eq{"a.alias": name}).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return "", fmt.Errorf("database error resolving module alias: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	resolved := name
+	if rows.Next() {
+		if err := rows.Scan(&resolved); err != nil {
+			return "", fmt.Errorf("error processing database query results: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("error processing database query results: %w", err)
+	}
+	return resolved, nil
+}
+
+// writeOriginalVersion records original as the version string that was reported for the module version
+// with the specified id, if it is not empty.  The first reported value is kept.
+func writeOriginalVersion(ctx context.Context, db database, versionID int32, original string) error {
+	if original == "" {
+		return nil
+	}
+	sql, args, err := psql.
+		Update(tableModuleVersions).
+		Set("original_version", original).
+		Where(sq.Eq{"id": versionID, "original_version": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, sql, args...); err != nil {
+		return fmt.Errorf("database error recording the original module version: %w", err)
+	}
+	return nil
+}
+
 // writeModuleVersions upserts module versions into the database
 func writeModuleVersions(ctx context.Context, db database, moduleID int32, versions ...string) (ids []int32, err error) {
 	for i, ver := range versions {
@@ -442,14 +688,17 @@ func writeModuleVersions(ctx context.Context, db database, moduleID int32, versi
 
 // getDependx is a shared query for dependency gathering in either direction,
 // dependent on the joinType.
-func getDependx(ctx context.Context, db *sqlx.DB, module, version, joinType string, pageToken string, count int, log Logger) ([]Version, string, error) {
+func getDependx(ctx context.Context, db dbConn, module, version string, asOf time.Time, joinType string, pageToken string, count int, log Logger) ([]Version, string, error) {
 	pageTokenKey := "moduleversions:" + module + version + ":" + joinType
+	if !asOf.IsZero() {
+		pageTokenKey += ":" + asOf.UTC().Format(time.RFC3339Nano)
+	}
 	offset := 0
 	if pageToken != "" {
 		var err error
 		offset, err = decodePageToken(pageToken, pageTokenKey)
 		if err != nil {
-			return nil, "", fmt.Errorf("invalid page token: %w", err)
+			return nil, "", err
 		}
 	}
 	if module == "" {
@@ -458,6 +707,11 @@ func getDependx(ctx context.Context, db *sqlx.DB, module, version, joinType stri
 	if version == "" {
 		return nil, "", fmt.Errorf("version mut not be blank")
 	}
+	// queries using an alias, such as the repository path of a vanity import path, match the module
+	module, err := resolveModuleAlias(ctx, db, module)
+	if err != nil {
+		return nil, "", err
+	}
 
 	q := psql.
 		Select("rhs.version_id id", "rhs.name module_id", "rhs.version").
@@ -475,6 +729,7 @@ func getDependx(ctx context.Context, db *sqlx.DB, module, version, joinType stri
 	q = q.
 		Where(sq.Eq{"lhs.name": module}).
 		Where(sq.Eq{"lhs.version": version}).
+		Where(edgeDeclaredAt("md", asOf)).
 		OrderBy("2", "3 DESC")
 	if offset > 0 {
 		q = q.Offset(uint64(offset))
@@ -496,6 +751,33 @@ func getDependx(ctx context.Context, db *sqlx.DB, module, version, joinType stri
 	return dependents, encodePageToken(pageTokenKey, len(dependents), offset, count), nil
 }
 
+// edgeDeclaredAt returns a filter that matches the dependency edges in the specified table, which is
+// usually an alias, that were declared at asOf.  If asOf is the zero time, the filter matches the edges
+// that are currently declared.  Edges that were removed at asOf and later declared again are excluded
+// using their gaps in the module_dependency_gap table.
+func edgeDeclaredAt(table string, asOf time.Time) sq.Sqlizer {
+	if asOf.IsZero() {
+		return sq.Eq{table + ".removed_at": nil}
+	}
+	return sq.And{
+		sq.LtOrEq{table + ".first_seen": asOf},
+		sq.Or{sq.Eq{table + ".removed_at": nil}, sq.Gt{table + ".removed_at": asOf}},
+		sq.Expr(`NOT EXISTS (SELECT 1 FROM `+tableModuleDependencyGaps+` g
+		          WHERE g.dependent_id = `+table+`.dependent_id AND g.dependee_id = `+table+`.dependee_id
+		            AND g.removed_at <= ? AND g.restored_at > ?)`, asOf, asOf),
+	}
+}
+
+// compareVersion returns a SQL expression that compares the SEMVER column col to the version in c.  Build
+// metadata is removed from the version since it doesn't affect the precedence of versions.
+func compareVersion(col string, c modver.Comparison) sq.Sqlizer {
+	op := c.Op
+	if op == "!=" {
+		op = "<>"
+	}
+	return sq.Expr(col+" "+op+" ?::semver", strings.TrimPrefix(semver.Canonical(c.Version), "v"))
+}
+
 // globToLike converts a string containing a glob pattern to a SQL LIKE clause.
 func globToLike(glob string) string {
 	var res strings.Builder
@@ -0,0 +1,198 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDBEnvVar is the environment variable that holds the URL of a PostgreSQL server, with the semver
+// extension installed, that the store tests can create databases on.  The tests are skipped if it's not set.
+const testDBEnvVar = "PERSEUS_TEST_DB"
+
+// newTestClient creates an empty database with the current schema on the server at $PERSEUS_TEST_DB and
+// returns a client for it.  The database is dropped when the test completes.
+func newTestClient(t *testing.T) *PostgresClient {
+	t.Helper()
+	serverURL := os.Getenv(testDBEnvVar)
+	if serverURL == "" {
+		t.Skipf("set %s to the URL of a PostgreSQL server to run the store tests", testDBEnvVar)
+	}
+	ctx := context.Background()
+
+	admin, err := sqlx.ConnectContext(ctx, "pgx", serverURL)
+	require.NoError(t, err, "unable to connect to the test database server")
+	t.Cleanup(func() { _ = admin.Close() })
+	name := fmt.Sprintf("perseus_test_%d", time.Now().UnixNano())
+	_, err = admin.ExecContext(ctx, "CREATE DATABASE "+name)
+	require.NoError(t, err, "unable to create the test database")
+	t.Cleanup(func() {
+		if _, err := admin.ExecContext(context.Background(), "DROP DATABASE IF EXISTS "+name+" WITH (FORCE)"); err != nil {
+			t.Logf("unable to drop the test database %s: %v", name, err)
+		}
+	})
+
+	u, err := url.Parse(serverURL)
+	require.NoError(t, err, "invalid test database server URL")
+	u.Path = "/" + name
+	p, err := NewPostgresClient(ctx, u.String())
+	require.NoError(t, err, "unable to connect to the test database")
+	t.Cleanup(func() { _ = p.pool.Close() })
+	_, err = p.CreateSchema(ctx)
+	require.NoError(t, err, "unable to create the database schema")
+	return p
+}
+
+// dbNow returns the current time according to the database, which is used to take timestamps between
+// operations that are compared to the ones the database records
+func dbNow(t *testing.T, p *PostgresClient) time.Time {
+	t.Helper()
+	var now time.Time
+	require.NoError(t, p.db.GetContext(context.Background(), &now, "SELECT clock_timestamp()"))
+	return now
+}
+
+func TestRestoredDependencyKeepsGap(t *testing.T) {
+	p := newTestClient(t)
+	ctx := context.Background()
+	mod := Version{ModuleID: "example.com/app", SemVer: "v1.0.0"}
+	dep := Version{ModuleID: "example.com/lib", SemVer: "v1.2.0"}
+
+	require.NoError(t, p.ReplaceModuleDependencies(ctx, mod, dep))
+	added := dbNow(t, p)
+	require.NoError(t, p.ReplaceModuleDependencies(ctx, mod))
+	removed := dbNow(t, p)
+	require.NoError(t, p.ReplaceModuleDependencies(ctx, mod, dep))
+	restored := dbNow(t, p)
+
+	history, err := p.GetDependencyHistory(ctx, mod.ModuleID, mod.SemVer)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.True(t, history[0].FirstSeen.Before(added), "the edge should keep its original first seen time")
+	assert.False(t, history[0].RemovedAt.Valid, "the edge should be restored")
+
+	var gaps []struct {
+		RemovedAt  time.Time `db:"removed_at"`
+		RestoredAt time.Time `db:"restored_at"`
+	}
+	err = p.db.SelectContext(ctx, &gaps, `SELECT g.removed_at, g.restored_at
+	    FROM module_dependency_gap g
+	    JOIN module_version v ON (v.id = g.dependent_id)
+	    JOIN module m ON (m.id = v.module_id)
+	   WHERE m.name = $1`, mod.ModuleID)
+	require.NoError(t, err)
+	require.Len(t, gaps, 1, "the period when the edge was removed should be recorded")
+	assert.True(t, gaps[0].RemovedAt.After(added) && gaps[0].RemovedAt.Before(removed), "unexpected removal time %v", gaps[0].RemovedAt)
+	assert.True(t, gaps[0].RestoredAt.After(removed) && gaps[0].RestoredAt.Before(restored), "unexpected restore time %v", gaps[0].RestoredAt)
+
+	// re-declaring an edge that was never removed doesn't record a gap
+	require.NoError(t, p.ReplaceModuleDependencies(ctx, mod, dep))
+	var n int
+	require.NoError(t, p.db.GetContext(ctx, &n, "SELECT count(*) FROM module_dependency_gap"))
+	assert.Equal(t, 1, n)
+}
+
+func TestRestoredDependencyAsOf(t *testing.T) {
+	p := newTestClient(t)
+	ctx := context.Background()
+	mod := Version{ModuleID: "example.com/app", SemVer: "v1.0.0"}
+	dep := Version{ModuleID: "example.com/lib", SemVer: "v1.2.0"}
+	wantEdge := DependencyEdge{Module: mod.ModuleID, Version: mod.SemVer, DependencyModule: dep.ModuleID, DependencyVersion: dep.SemVer}
+
+	require.NoError(t, p.ReplaceModuleDependencies(ctx, mod, dep))
+	beforeGap := dbNow(t, p)
+	require.NoError(t, p.ReplaceModuleDependencies(ctx, mod))
+	inGap := dbNow(t, p)
+	require.NoError(t, p.ReplaceModuleDependencies(ctx, mod, dep))
+	afterGap := dbNow(t, p)
+
+	testCases := []struct {
+		name string
+		asOf time.Time
+		want []string
+	}{
+		{name: "before removal", asOf: beforeGap, want: []string{dep.ModuleID}},
+		{name: "while removed", asOf: inGap, want: nil},
+		{name: "after restore", asOf: afterGap, want: []string{dep.ModuleID}},
+		{name: "current", want: []string{dep.ModuleID}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, _, err := p.GetDependees(ctx, mod.ModuleID, mod.SemVer, tc.asOf, "", 10)
+			require.NoError(t, err)
+			var names []string
+			for _, v := range got {
+				names = append(names, v.ModuleID)
+			}
+			assert.Equal(t, tc.want, names)
+		})
+	}
+
+	t.Run("diff into gap", func(t *testing.T) {
+		diff, err := p.DiffGraph(ctx, "", beforeGap, inGap)
+		require.NoError(t, err)
+		assert.Equal(t, []DependencyEdge{wantEdge}, diff.RemovedEdges)
+		assert.Empty(t, diff.AddedEdges)
+	})
+	t.Run("diff out of gap", func(t *testing.T) {
+		diff, err := p.DiffGraph(ctx, "", inGap, afterGap)
+		require.NoError(t, err)
+		assert.Equal(t, []DependencyEdge{wantEdge}, diff.AddedEdges)
+		assert.Empty(t, diff.RemovedEdges)
+	})
+	t.Run("diff across gap", func(t *testing.T) {
+		diff, err := p.DiffGraph(ctx, "", beforeGap, afterGap)
+		require.NoError(t, err)
+		assert.Empty(t, diff.AddedEdges)
+		assert.Empty(t, diff.RemovedEdges)
+	})
+}
+
+func TestMergeModulesKeepsRenames(t *testing.T) {
+	p := newTestClient(t)
+	ctx := context.Background()
+	const (
+		source = "example.com/old/lib"
+		target = "example.com/lib"
+		legacy = "example.com/legacy/lib"
+		next   = "example.com/next/lib"
+	)
+	require.NoError(t, p.SaveModule(ctx, source, "", "v1.0.0", "v1.1.0"))
+	require.NoError(t, p.SaveModule(ctx, target, "", "v1.1.0"))
+	require.NoError(t, p.SaveModule(ctx, legacy, "", "v0.9.0"))
+	// legacy -> source -> next, plus source -> target, which would become a rename of target to itself
+	require.NoError(t, p.RenameModule(ctx, legacy, source))
+	require.NoError(t, p.RenameModule(ctx, source, next))
+	require.NoError(t, p.RenameModule(ctx, target, source))
+
+	result, err := p.MergeModules(ctx, source, target)
+	require.NoError(t, err)
+	assert.Equal(t, MergeResult{MovedVersions: 1, MergedVersions: 1}, result)
+
+	var renames []struct {
+		Old string `db:"old_name"`
+		New string `db:"new_name"`
+	}
+	err = p.db.SelectContext(ctx, &renames, `SELECT o.name AS old_name, n.name AS new_name
+	    FROM module_rename r
+	    JOIN module o ON (o.id = r.old_module_id)
+	    JOIN module n ON (n.id = r.new_module_id)
+	   ORDER BY o.name`)
+	require.NoError(t, err)
+	require.Len(t, renames, 2)
+	assert.Equal(t, legacy, renames[0].Old)
+	assert.Equal(t, target, renames[0].New)
+	assert.Equal(t, target, renames[1].Old)
+	assert.Equal(t, next, renames[1].New)
+
+	names, err := p.GetRenamedModules(ctx, target)
+	require.NoError(t, err)
+	assert.Equal(t, []string{legacy, target, next}, names)
+}
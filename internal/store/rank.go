@@ -0,0 +1,297 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// the supported metrics for ranking modules
+const (
+	// RankByDependents ranks modules by their number of distinct direct and transitive dependents
+	RankByDependents = "dependents"
+	// RankByImportance ranks modules by the importance score computed by [PostgresClient.SaveModuleImportance]
+	RankByImportance = "importance"
+)
+
+// ModuleRankQuery encapsulates the available parameters for ranking modules.
+type ModuleRankQuery struct {
+	// an optional glob pattern specifying which module(s) should be ranked
+	ModuleFilter string
+	// the metric used to rank the modules, either [RankByDependents] or [RankByImportance]
+	By string
+	// the maximum number of modules to return, or all of them if zero
+	Limit int
+}
+
+// ModuleRank is a single module in the results of [PostgresClient.RankModules]
+type ModuleRank struct {
+	Module string `db:"module"`
+	// the module's PageRank within the module-level dependency graph, scaled so that the average is 1
+	Importance float64 `db:"importance"`
+	// the number of distinct modules that depend directly on any version of the module
+	DirectDependents int `db:"direct_dependents"`
+	// the number of distinct modules that depend directly or transitively on any version of the module
+	TransitiveDependents int `db:"transitive_dependents"`
+}
+
+// A ModuleEdge indicates that any version of one module currently depends on any version of another
+type ModuleEdge struct {
+	DependentID int32 `db:"dependent"`
+	DependeeID  int32 `db:"dependee"`
+}
+
+// moduleEdgesCTE is a common table expression named "edges" that contains the distinct pairs of modules
+// where any version of the dependent module currently declares a dependency on any version of the dependee
+const moduleEdgesCTE = `edges AS (
+	SELECT DISTINCT lv.module_id AS dependent, rv.module_id AS dependee
+	  FROM module_dependency md
+	       JOIN module_version lv ON (lv.id = md.dependent_id)
+	       JOIN module_version rv ON (rv.id = md.dependee_id)
+	 WHERE md.removed_at IS NULL AND lv.module_id <> rv.module_id
+)`
+
+// RankModules returns the modules matching the query ordered by the specified metric, which answers
+// "which libraries are most critical."  When ranking by dependents only modules with at least 1 dependent
+// are returned, ordered by the number of distinct modules that depend on them, directly or transitively.
+//
+// Dependencies are evaluated at the module level, so a module is counted as a dependent if any of its
+// versions currently declares a dependency on any version of the ranked module.
+func (p *PostgresClient) RankModules(ctx context.Context, query ModuleRankQuery) ([]ModuleRank, error) {
+	if query.By == "" {
+		query.By = RankByDependents
+	}
+	if query.By != RankByDependents && query.By != RankByImportance {
+		return nil, fmt.Errorf("unsupported ranking metric %q", query.By)
+	}
+	roots := sq.Select("m.id").From(tableModules + " m")
+	if query.ModuleFilter != "" {
+		roots = roots.Where(matchModuleName("m", query.ModuleFilter))
+	}
+	if query.By == RankByImportance && query.Limit > 0 {
+		// the scores are already known so only the dependents of the top modules need to be counted
+		roots = roots.OrderBy("m.importance DESC", "m.name").Limit(uint64(query.Limit)) //nolint: gosec // no overflow occurs
+	}
+	rootsSQL, rootsArgs, err := roots.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	// the UNION in the recursive term discards (root, dependent) pairs that were already found, which
+	// both de-duplicates the dependents and stops the recursion if the graph contains a cycle
+	cte := `WITH RECURSIVE ` + moduleEdgesCTE + `, reach (root, dependent) AS (
+	            SELECT e.dependee, e.dependent FROM edges e WHERE e.dependee IN (` + rootsSQL + `)
+	            UNION
+	            SELECT r.root, e.dependent FROM reach r JOIN edges e ON (e.dependee = r.dependent)
+	        ), reach_count AS (
+	            SELECT root, count(*) AS n FROM reach WHERE dependent <> root GROUP BY root
+	        )`
+	q := psql.
+		Select(
+			"m.name module",
+			"m.importance",
+			"(SELECT count(*) FROM edges e WHERE e.dependee = m.id) direct_dependents",
+			"COALESCE(rc.n, 0) transitive_dependents").
+		Prefix(cte, rootsArgs...).
+		From(tableModules + " m")
+	if query.By == RankByImportance {
+		q = q.
+			LeftJoin("reach_count rc ON (rc.root = m.id)").
+			Where("m.id IN ("+rootsSQL+")", rootsArgs...).
+			OrderBy("2 DESC", "1")
+	} else {
+		q = q.
+			Join("reach_count rc ON (rc.root = m.id)").
+			OrderBy("4 DESC", "3 DESC", "1")
+	}
+	if query.Limit > 0 {
+		q = q.Limit(uint64(query.Limit)) //nolint: gosec // no overflow occurs
+	}
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("ranking modules", "sql", sql, "args", args)
+	var results []ModuleRank
+	if err := p.db.SelectContext(ctx, &results, sql, args...); err != nil {
+		return nil, fmt.Errorf("database error ranking modules: %w", err)
+	}
+	return results, nil
+}
+
+// GetModuleEdges returns every pair of modules where any version of one currently depends on any version
+// of the other, which is the input for computing the importance of each module.
+func (p *PostgresClient) GetModuleEdges(ctx context.Context) ([]ModuleEdge, error) {
+	q := `WITH ` + moduleEdgesCTE + ` SELECT dependent, dependee FROM edges`
+	p.log.Debug("querying module edges", "sql", q)
+	var edges []ModuleEdge
+	if err := p.db.SelectContext(ctx, &edges, q); err != nil {
+		return nil, fmt.Errorf("database error querying module edges: %w", err)
+	}
+	return edges, nil
+}
+
+// SaveModuleImportance replaces the importance score of every module with the values in scores, which
+// maps module IDs to scores.  Modules that are not in scores are reset to 0.
+func (p *PostgresClient) SaveModuleImportance(ctx context.Context, scores map[int32]float64) (err error) {
+	txn, err := p.beginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = txn.Commit()
+		} else {
+			if e2 := txn.Rollback(); e2 != nil {
+				p.log.Error(e2, "error rolling back transaction after error")
+			}
+		}
+	}()
+
+	if _, err = txn.ExecContext(ctx, `UPDATE module SET importance = 0 WHERE importance <> 0`); err != nil {
+		return fmt.Errorf("database error resetting module importance: %w", err)
+	}
+	// update the scores in batches to stay well below Postgres' limit of 65535 parameters per statement
+	const batchSize = 1000
+	var (
+		values []string
+		args   []any
+	)
+	flush := func() error {
+		if len(values) == 0 {
+			return nil
+		}
+		q := `UPDATE module m SET importance = v.score
+		        FROM (VALUES ` + strings.Join(values, ", ") + `) AS v (id, score)
+		       WHERE m.id = v.id`
+		p.log.Debug("saving module importance", "modules", len(values))
+		if _, err := txn.ExecContext(ctx, q, args...); err != nil {
+			return fmt.Errorf("database error saving module importance: %w", err)
+		}
+		values, args = values[:0], args[:0]
+		return nil
+	}
+	for id, score := range scores {
+		values = append(values, fmt.Sprintf("($%d::integer, $%d::double precision)", len(args)+1, len(args)+2))
+		args = append(args, id, score)
+		if len(values) == batchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// ModuleMetrics summarizes how connected a module is to the rest of the dependency graph
+type ModuleMetrics struct {
+	// the module's PageRank within the module-level dependency graph, scaled so that the average is 1
+	Importance float64 `db:"importance"`
+	// the number of distinct modules that depend directly on any version of the module
+	DirectDependents int `db:"direct_dependents"`
+	// the number of distinct modules that any version of the module depends on directly
+	DirectDependencies int `db:"direct_dependencies"`
+	// the number of distinct modules that depend directly or transitively on any version of the module
+	TransitiveDependents int `db:"transitive_dependents"`
+	// the number of distinct modules that any version of the module depends on directly or transitively
+	TransitiveDependencies int `db:"transitive_dependencies"`
+	// whether the module's source repository has been archived and the time of its most recent commit,
+	// which are only valid if the check-upstreams job has checked the module
+	Archived     sql.NullBool `db:"archived"`
+	LastCommitAt sql.NullTime `db:"last_commit_at"`
+}
+
+// GetModuleMetrics returns the fan-in and fan-out of the named module, which is its "blast radius."  Like
+// [PostgresClient.RankModules], dependencies are evaluated at the module level.  If the module does not
+// exist, the returned error wraps [ErrModuleNotFound].
+func (p *PostgresClient) GetModuleMetrics(ctx context.Context, name string) (ModuleMetrics, error) {
+	var metrics ModuleMetrics
+	name, err := resolveModuleAlias(ctx, p.db, name)
+	if err != nil {
+		return metrics, err
+	}
+	id, err := getModuleID(ctx, p.db, name)
+	if err != nil {
+		return metrics, err
+	}
+	// UNION discards modules that were already found, which also stops the recursion if the graph
+	// contains a cycle
+	q := `WITH RECURSIVE ` + moduleEdgesCTE + `, down (id) AS (
+	          SELECT dependent FROM edges WHERE dependee = $1
+	          UNION
+	          SELECT e.dependent FROM down d JOIN edges e ON (e.dependee = d.id)
+	      ), up (id) AS (
+	          SELECT dependee FROM edges WHERE dependent = $1
+	          UNION
+	          SELECT e.dependee FROM up u JOIN edges e ON (e.dependent = u.id)
+	      )
+	      SELECT (SELECT importance FROM module WHERE id = $1) AS importance,
+	             (SELECT archived FROM module WHERE id = $1) AS archived,
+	             (SELECT last_commit_at FROM module WHERE id = $1) AS last_commit_at,
+	             (SELECT count(*) FROM edges WHERE dependee = $1) AS direct_dependents,
+	             (SELECT count(*) FROM edges WHERE dependent = $1) AS direct_dependencies,
+	             (SELECT count(*) FROM down WHERE id <> $1) AS transitive_dependents,
+	             (SELECT count(*) FROM up WHERE id <> $1) AS transitive_dependencies`
+	p.log.Debug("querying module metrics", "sql", q, "module", name)
+	if err := p.db.GetContext(ctx, &metrics, q, id); err != nil {
+		return metrics, fmt.Errorf("database error querying module metrics: %w", err)
+	}
+	return metrics, nil
+}
+
+// latestVersionOrder is an ORDER BY expression that sorts the versions in the module_version table aliased
+// as "mv" from latest to oldest.  Like the go command, releases are preferred over pre-release versions
+// and +incompatible versions are only the latest if there are no compatible versions.
+const latestVersionOrder = `get_semver_prerelease(mv.version) = '' DESC,
+	mv.version::text NOT LIKE '%+incompatible' DESC,
+	mv.version DESC`
+
+// GetModuleConsumers returns the edges from the latest version of each module that currently depends on
+// the named module to the version of the named module that it consumes, ordered by the consumed module
+// name and descending version then by dependent module name.  If allMajors is true the dependents of
+// every module in the same family, which are the other major versions of the same project, are also
+// returned.  If the module does not exist, the returned error wraps [ErrModuleNotFound].
+func (p *PostgresClient) GetModuleConsumers(ctx context.Context, name string, allMajors bool) ([]DependencyEdge, error) {
+	name, err := resolveModuleAlias(ctx, p.db, name)
+	if err != nil {
+		return nil, err
+	}
+	id, err := getModuleID(ctx, p.db, name)
+	if err != nil {
+		return nil, err
+	}
+	target := `SELECT id FROM module WHERE id = $1`
+	if allMajors {
+		target = `SELECT id FROM module WHERE family = (SELECT family FROM module WHERE id = $1)`
+	}
+	q := `WITH target AS (` + target + `), consumers AS (
+	          SELECT DISTINCT lv.module_id
+	            FROM module_dependency md
+	                 JOIN module_version lv ON (lv.id = md.dependent_id)
+	                 JOIN module_version rv ON (rv.id = md.dependee_id)
+	           WHERE md.removed_at IS NULL
+	             AND rv.module_id IN (SELECT id FROM target)
+	             AND lv.module_id NOT IN (SELECT id FROM target)
+	      ), latest AS (
+	          SELECT DISTINCT ON (mv.module_id) mv.id, mv.module_id, mv.version
+	            FROM module_version mv
+	           WHERE mv.module_id IN (SELECT module_id FROM consumers)
+	           ORDER BY mv.module_id, ` + latestVersionOrder + `
+	      )
+	      SELECT lm.name module, l.version, rm.name dependency_module, rv.version dependency_version
+	        FROM latest l
+	             JOIN module lm ON (lm.id = l.module_id)
+	             JOIN module_dependency md ON (md.dependent_id = l.id AND md.removed_at IS NULL)
+	             JOIN module_version rv ON (rv.id = md.dependee_id)
+	             JOIN module rm ON (rm.id = rv.module_id)
+	       WHERE rv.module_id IN (SELECT id FROM target)
+	       ORDER BY rm.name, rv.version DESC, lm.name`
+	p.log.Debug("querying module consumers", "sql", q, "module", name, "allMajors", allMajors)
+	var edges []DependencyEdge
+	if err := p.db.SelectContext(ctx, &edges, q, id); err != nil {
+		return nil, fmt.Errorf("database error querying module consumers: %w", err)
+	}
+	return edges, nil
+}
@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
+)
+
+// RenameModule records that the module oldName was renamed to newName, such as when a project moves from
+// one GitHub organization to another, creating newName if it does not exist.  Unlike [PostgresClient.MergeModules]
+// both modules are kept, along with their versions and dependencies, so the graph still reflects what each
+// go.mod file declares.
+//
+// A module can only be renamed once, so recording a new name for oldName replaces the existing one.  If
+// oldName does not exist, the returned error wraps [ErrModuleNotFound].
+func (p *PostgresClient) RenameModule(ctx context.Context, oldName, newName string) (err error) {
+	if oldName == "" || newName == "" {
+		return fmt.Errorf("both the old and new module names must be specified")
+	}
+	if oldName == newName {
+		return fmt.Errorf("a module cannot be renamed to itself")
+	}
+
+	txn, err := p.beginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = txn.Commit()
+		} else {
+			if e2 := txn.Rollback(); e2 != nil {
+				p.log.Error(e2, "error rolling back transaction after error")
+			}
+		}
+	}()
+
+	oldID, err := getModuleID(ctx, txn, oldName)
+	if err != nil {
+		return err
+	}
+	// the existing description, if any, of the new module is kept
+	q := `INSERT INTO module (name, family) VALUES ($1, $2) ON CONFLICT (name) DO NOTHING`
+	if _, err = txn.ExecContext(ctx, q, newName, modver.Family(newName)); err != nil {
+		return fmt.Errorf("database error saving module %s: %w", newName, err)
+	}
+	newID, err := getModuleID(ctx, txn, newName)
+	if err != nil {
+		return err
+	}
+
+	q = `INSERT INTO module_rename (old_module_id, new_module_id) VALUES ($1, $2)
+	     ON CONFLICT (old_module_id) DO UPDATE SET new_module_id = EXCLUDED.new_module_id, created_at = now()`
+	p.log.Debug("renaming module", "sql", q, "oldName", oldName, "newName", newName)
+	if _, err = txn.ExecContext(ctx, q, oldID, newID); err != nil {
+		return fmt.Errorf("database error saving module rename: %w", err)
+	}
+	return nil
+}
+
+// GetRenamedModules returns the names of all modules that are linked to the module name by one or more
+// renames, in either direction, including name itself, ordered by name.  If name has never been renamed,
+// the result only contains name.
+func (p *PostgresClient) GetRenamedModules(ctx context.Context, name string) ([]string, error) {
+	// the renames are treated as an undirected graph so that the complete chain of names is returned
+	// no matter which name was specified.  UNION discards duplicate rows, which stops the recursion
+	// if the renames contain a cycle.
+	q := `WITH RECURSIVE edges AS (
+	          SELECT old_module_id AS a, new_module_id AS b FROM module_rename
+	          UNION ALL
+	          SELECT new_module_id, old_module_id FROM module_rename
+	      ), linked AS (
+	          SELECT id FROM module WHERE name = $1
+	          UNION
+	          SELECT e.b FROM edges e JOIN linked l ON (e.a = l.id)
+	      )
+	      SELECT m.name FROM module m JOIN linked l ON (l.id = m.id) ORDER BY m.name`
+	p.log.Debug("querying renamed modules", "sql", q, "module", name)
+	var names []string
+	if err := p.db.SelectContext(ctx, &names, q, name); err != nil {
+		return nil, fmt.Errorf("database error querying module renames: %w", err)
+	}
+	if len(names) == 0 {
+		names = []string{name}
+	}
+	return names, nil
+}
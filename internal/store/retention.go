@@ -0,0 +1,36 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PrunePrereleaseVersions deletes pre-release module versions that were added before olderThan and
+// that have been superseded by a stable release of the same module with the same or a higher base
+// version, returning the number of versions that were removed.
+//
+// Pre-release versions that other module versions depend on are kept so that pruning never removes
+// edges from the graph.
+func (p *PostgresClient) PrunePrereleaseVersions(ctx context.Context, olderThan time.Time) (int, error) {
+	// a stable version always sorts above any pre-release of the same base version so "same or higher
+	// base version" is equivalent to "higher version"
+	q := `DELETE FROM module_version pv
+	       WHERE pv.created_at < $1
+	         AND get_semver_prerelease(pv.version) <> ''
+	         AND EXISTS (SELECT 1 FROM module_version s
+	                      WHERE s.module_id = pv.module_id
+	                        AND get_semver_prerelease(s.version) = ''
+	                        AND s.version > pv.version)
+	         AND NOT EXISTS (SELECT 1 FROM module_dependency d WHERE d.dependee_id = pv.id)`
+	p.log.Debug("pruning pre-release module versions", "sql", q, "olderThan", olderThan)
+	res, err := p.db.ExecContext(ctx, q, olderThan)
+	if err != nil {
+		return 0, fmt.Errorf("database error pruning pre-release module versions: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("error processing database command result: %w", err)
+	}
+	return int(n), nil
+}
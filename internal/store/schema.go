@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// createScript is the script that creates a new, empty database with the current schema
+//
+//go:embed create_database.sql
+var createScript string
+
+// migrations holds the scripts that upgrade an existing database to the current schema, whose names start
+// with a sequence number, ex: 0011_admin.sql
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// SchemaVersion returns the version of the database schema that this version of Perseus requires, which
+// is the sequence number of the most recent migration script.
+func SchemaVersion() int {
+	entries, _ := migrations.ReadDir("migrations")
+	version := 0
+	for _, e := range entries {
+		prefix, _, _ := strings.Cut(e.Name(), "_")
+		if n, err := strconv.Atoi(prefix); err == nil && n > version {
+			version = n
+		}
+	}
+	return version
+}
+
+// CreateSchema creates the tables, indexes, and functions of a new database, in a single transaction, if
+// the database is empty.  It returns true if the schema was created and false if the database already has
+// one, which is left as-is.  It is meant for local development; production databases should be created
+// and upgraded by a DBA using create_database.sql and the migration scripts.
+func (p *PostgresClient) CreateSchema(ctx context.Context) (created bool, err error) {
+	var exists bool
+	if err := p.db.GetContext(ctx, &exists, "SELECT to_regclass('module') IS NOT NULL"); err != nil {
+		return false, fmt.Errorf("unable to check for an existing schema: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	txn, err := p.beginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if e2 := txn.Rollback(); e2 != nil {
+				p.log.Error(e2, "error rolling back transaction after error")
+			}
+		}
+	}()
+	// the script contains many statements, which are sent together using the simple query protocol
+	if _, err = txn.ExecContext(ctx, createScript); err != nil {
+		return false, fmt.Errorf("unable to create the database schema: %w", err)
+	}
+	if err = txn.Commit(); err != nil {
+		return false, fmt.Errorf("unable to commit the database schema: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// StalenessQuery encapsulates the available parameters for computing the staleness of module versions.
+type StalenessQuery struct {
+	// a glob pattern specifying which module(s) should be scored
+	ModuleFilter string
+	// if true, every version of the matching modules is scored rather than only the latest version
+	AllVersions bool
+}
+
+// ModuleStaleness summarizes how far the direct dependencies of a module version are behind the latest
+// versions of those dependencies
+type ModuleStaleness struct {
+	Module  string `db:"module"`
+	Version string `db:"version"`
+	// the number of direct dependencies that are currently declared by the module version
+	Dependencies int `db:"dependencies"`
+	// the number of direct dependencies that are older than the latest version of the dependency
+	OutdatedDependencies int `db:"outdated_dependencies"`
+	// the average, across all direct dependencies, of the number of years between when the consumed version
+	// and the latest version of each dependency were added to the graph.  Dependencies that are up to date
+	// contribute 0, so a module version with no outdated dependencies has a score of 0.
+	Score float64 `db:"score"`
+}
+
+// GetModuleStaleness computes the staleness score of the module versions matching the query, ordered by
+// descending score then by module name and descending version.
+//
+// The score weights each outdated dependency by its age rather than by the number of versions that it is
+// behind, so a dependency that is 1 patch behind a release from last week contributes much less than one
+// that is 1 minor version behind a release from 2 years ago.  Ages are based on when each version was
+// added to the graph, which approximates its publish time for modules that are ingested continuously.
+func (p *PostgresClient) GetModuleStaleness(ctx context.Context, query StalenessQuery) ([]ModuleStaleness, error) {
+	if query.ModuleFilter == "" {
+		return nil, fmt.Errorf("the module filter must be specified")
+	}
+	targets := sq.Select("mv.id", "m.name AS module", "mv.version").
+		From(tableModuleVersions + " mv").
+		Join(tableModules + " m ON (m.id = mv.module_id)").
+		Where(matchModuleName("m", query.ModuleFilter))
+	if !query.AllVersions {
+		targets = targets.Options("DISTINCT ON (mv.module_id)").OrderBy("mv.module_id", latestVersionOrder)
+	}
+	targetsSQL, targetsArgs, err := targets.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	// only the latest versions of the modules that the targets depend on are needed
+	cte := `WITH targets AS (` + targetsSQL + `), latest AS (
+	            SELECT DISTINCT ON (mv.module_id) mv.module_id, mv.version, mv.created_at
+	              FROM module_version mv
+	             WHERE mv.module_id IN (
+	                       SELECT dv.module_id
+	                         FROM module_dependency md
+	                              JOIN module_version dv ON (dv.id = md.dependee_id)
+	                        WHERE md.dependent_id IN (SELECT id FROM targets) AND md.removed_at IS NULL)
+	             ORDER BY mv.module_id, ` + latestVersionOrder + `
+	        )`
+	q := psql.
+		Select(
+			"t.module",
+			"t.version",
+			"count(dv.id) AS dependencies",
+			"count(dv.id) FILTER (WHERE dv.version < l.version) AS outdated_dependencies",
+			"(COALESCE(avg(CASE WHEN dv.version < l.version THEN GREATEST(EXTRACT(EPOCH FROM l.created_at - dv.created_at), 0) ELSE 0 END), 0) / 31557600)::double precision AS score").
+		Prefix(cte, targetsArgs...).
+		From("targets t").
+		LeftJoin(tableModuleDependencies+" md ON (md.dependent_id = t.id AND md.removed_at IS NULL)").
+		LeftJoin(tableModuleVersions+" dv ON (dv.id = md.dependee_id)").
+		LeftJoin("latest l ON (l.module_id = dv.module_id)").
+		GroupBy("t.module", "t.version").
+		OrderBy("5 DESC", "1", "2 DESC")
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("computing module staleness", "sql", sql, "args", args)
+	var results []ModuleStaleness
+	if err := p.db.SelectContext(ctx, &results, sql, args...); err != nil {
+		return nil, fmt.Errorf("database error computing module staleness: %w", err)
+	}
+	return results, nil
+}
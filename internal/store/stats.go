@@ -0,0 +1,168 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// GraphStats summarizes the contents of the dependency graph
+type GraphStats struct {
+	Modules  int64 `db:"modules"`
+	Versions int64 `db:"versions"`
+	// the number of dependency edges between module versions that are currently declared
+	Edges int64 `db:"edges"`
+	// the most recent time that a module version was added or a dependency edge was saved, which is not
+	// valid if the graph is empty
+	LastIngest sql.NullTime `db:"last_ingest"`
+}
+
+const (
+	// deadRowHintRatio is the fraction of a table's rows that must be dead before a VACUUM is suggested
+	deadRowHintRatio = 0.2
+	// minHintBytes is the size below which tables and indexes are too small for maintenance to matter
+	minHintBytes = 8 << 20
+)
+
+// StoreStats describes the storage used by the database
+type StoreStats struct {
+	// the total size of the database, in bytes
+	DatabaseBytes int64
+	Tables        []TableStats
+	Indexes       []IndexStats
+}
+
+// TableStats describes the storage used by a database table.  The row counts are estimates from the
+// database's statistics, which are much cheaper to read than counting the rows of a large table.
+type TableStats struct {
+	Name     string `db:"name"`
+	Rows     int64  `db:"rows"`
+	DeadRows int64  `db:"dead_rows"`
+	// the size of the table, including its indexes and TOAST data, in bytes
+	TotalBytes int64 `db:"total_bytes"`
+	IndexBytes int64 `db:"index_bytes"`
+	// the most recent manual or automatic vacuum and analyze, which are not valid if they never ran
+	LastVacuum  sql.NullTime `db:"last_vacuum"`
+	LastAnalyze sql.NullTime `db:"last_analyze"`
+	// a suggested maintenance operation, empty if none
+	Hint string `db:"-"`
+}
+
+// IndexStats describes the storage used by a database index
+type IndexStats struct {
+	Name  string `db:"name"`
+	Table string `db:"table_name"`
+	Bytes int64  `db:"bytes"`
+	// the number of scans of the index since the database's statistics were last reset
+	Scans int64 `db:"scans"`
+	// the size of the table's own data, excluding its indexes, in bytes
+	TableBytes int64 `db:"table_bytes"`
+	// true for primary key and unique indexes, which are needed even if they are never scanned
+	Unique bool `db:"is_unique"`
+	// a suggested maintenance operation, empty if none
+	Hint string `db:"-"`
+}
+
+// GetStoreStats returns the size of the database and the estimated number of rows and the size of each of
+// its tables and indexes, along with hints for tables and indexes that may need maintenance.
+func (p *PostgresClient) GetStoreStats(ctx context.Context) (StoreStats, error) {
+	var stats StoreStats
+	q := `SELECT pg_database_size(current_database())`
+	p.log.Debug("querying database size", "sql", q)
+	if err := p.db.GetContext(ctx, &stats.DatabaseBytes, q); err != nil {
+		return stats, fmt.Errorf("database error querying the database size: %w", err)
+	}
+
+	// GREATEST() ignores NULLs, so the result is only NULL if neither operation has run
+	q = `SELECT relname AS name, n_live_tup AS rows, n_dead_tup AS dead_rows,
+	            pg_total_relation_size(relid) AS total_bytes, pg_indexes_size(relid) AS index_bytes,
+	            GREATEST(last_vacuum, last_autovacuum) AS last_vacuum,
+	            GREATEST(last_analyze, last_autoanalyze) AS last_analyze
+	       FROM pg_stat_user_tables
+	      WHERE schemaname = current_schema()
+	      ORDER BY relname`
+	p.log.Debug("querying table stats", "sql", q)
+	if err := p.db.SelectContext(ctx, &stats.Tables, q); err != nil {
+		return stats, fmt.Errorf("database error querying table stats: %w", err)
+	}
+	for i, t := range stats.Tables {
+		total := t.Rows + t.DeadRows
+		if t.TotalBytes >= minHintBytes && total > 0 && float64(t.DeadRows)/float64(total) >= deadRowHintRatio {
+			stats.Tables[i].Hint = fmt.Sprintf("%d%% of rows are dead, consider a VACUUM", t.DeadRows*100/total)
+		}
+	}
+
+	q = `SELECT s.indexrelname AS name, s.relname AS table_name, pg_relation_size(s.indexrelid) AS bytes,
+	            s.idx_scan AS scans, pg_relation_size(s.relid) AS table_bytes,
+	            (i.indisunique OR i.indisprimary) AS is_unique
+	       FROM pg_stat_user_indexes s
+	            JOIN pg_index i ON (i.indexrelid = s.indexrelid)
+	      WHERE s.schemaname = current_schema()
+	      ORDER BY s.relname, s.indexrelname`
+	p.log.Debug("querying index stats", "sql", q)
+	if err := p.db.SelectContext(ctx, &stats.Indexes, q); err != nil {
+		return stats, fmt.Errorf("database error querying index stats: %w", err)
+	}
+	for i, idx := range stats.Indexes {
+		switch {
+		case idx.Bytes >= minHintBytes && idx.Bytes > idx.TableBytes:
+			stats.Indexes[i].Hint = "larger than its table, which suggests bloat, consider a REINDEX"
+		case idx.Bytes >= minHintBytes && idx.Scans == 0 && !idx.Unique:
+			stats.Indexes[i].Hint = "never scanned, consider whether it is needed"
+		}
+	}
+	return stats, nil
+}
+
+// GetGraphStats returns the number of modules, module versions, and dependency edges in the graph, along with
+// the time of the most recent ingestion.
+func (p *PostgresClient) GetGraphStats(ctx context.Context) (GraphStats, error) {
+	var stats GraphStats
+	q := `SELECT (SELECT count(*) FROM module) AS modules,
+	             (SELECT count(*) FROM module_version) AS versions,
+	             (SELECT count(*) FROM module_dependency WHERE removed_at IS NULL) AS edges,
+	             GREATEST((SELECT max(created_at) FROM module_version),
+	                      (SELECT max(last_seen) FROM module_dependency)) AS last_ingest`
+	p.log.Debug("querying graph stats", "sql", q)
+	if err := p.db.GetContext(ctx, &stats, q); err != nil {
+		return stats, fmt.Errorf("database error querying graph stats: %w", err)
+	}
+	return stats, nil
+}
+
+// CountModulesByPrefix returns the number of modules whose paths are equal to or nested under each of
+// prefixes, ex: github.com/CrowdStrike, keyed by prefix.  If prefixes is empty, modules are counted by the
+// first element of their paths, which is usually the code hosting site, ex: github.com.
+func (p *PostgresClient) CountModulesByPrefix(ctx context.Context, prefixes []string) (map[string]int64, error) {
+	var (
+		q    string
+		args []any
+	)
+	if len(prefixes) == 0 {
+		q = `SELECT split_part(name, '/', 1) AS prefix, count(*) AS modules FROM module GROUP BY 1`
+	} else {
+		values := make([]string, len(prefixes))
+		for i, prefix := range prefixes {
+			values[i] = fmt.Sprintf("($%d::text)", i+1)
+			args = append(args, strings.TrimSuffix(prefix, "/"))
+		}
+		q = `SELECT pfx.prefix, count(m.id) AS modules
+		       FROM (VALUES ` + strings.Join(values, ", ") + `) AS pfx (prefix)
+		            LEFT JOIN module m ON (m.name = pfx.prefix OR left(m.name, length(pfx.prefix) + 1) = pfx.prefix || '/')
+		      GROUP BY pfx.prefix`
+	}
+	p.log.Debug("counting modules by prefix", "sql", q, "prefixes", prefixes)
+	var rows []struct {
+		Prefix  string `db:"prefix"`
+		Modules int64  `db:"modules"`
+	}
+	if err := p.db.SelectContext(ctx, &rows, q, args...); err != nil {
+		return nil, fmt.Errorf("database error counting modules by prefix: %w", err)
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, r := range rows {
+		counts[r.Prefix] = r.Modules
+	}
+	return counts, nil
+}
@@ -2,21 +2,80 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/CrowdStrike/perseus/internal/modver"
 )
 
+// ErrModuleNotFound is returned, possibly wrapped, when an operation references a module that does
+// not exist.
+var ErrModuleNotFound = errors.New("module not found")
+
 // Store defines the operations available on a Perseus data store
 type Store interface {
 	Ping(ctx context.Context) error
 
 	SaveModule(ctx context.Context, name, description string, versions ...string) error
 	SaveModuleDependencies(ctx context.Context, mod Version, deps ...Version) error
+	ReplaceModuleDependencies(ctx context.Context, mod Version, deps ...Version) error
+	PreviewModuleDependencies(ctx context.Context, mod Version, replace bool, deps ...Version) (DependencyChanges, error)
+	WithTx(ctx context.Context, fn func(Store) error) error
 
 	QueryModules(ctx context.Context, nameFilter string, pageToken string, count int) ([]Module, string, error)
 	QueryModuleVersions(ctx context.Context, query ModuleVersionQuery) (results []ModuleVersionQueryResult, nextPageToken string, err error)
 
-	GetDependents(ctx context.Context, id, version string, pageToken string, count int) ([]Version, string, error)
-	GetDependees(ctx context.Context, id, version string, pageToken string, count int) ([]Version, string, error)
+	GetDependents(ctx context.Context, id, version string, asOf time.Time, pageToken string, count int) ([]Version, string, error)
+	GetDependees(ctx context.Context, id, version string, asOf time.Time, pageToken string, count int) ([]Version, string, error)
+	GetDependentsInRange(ctx context.Context, id string, versions modver.Range, asOf time.Time, pageToken string, count int) ([]DependencyEdge, string, error)
+	GetDependencyHistory(ctx context.Context, module, version string) ([]DependencyHistory, error)
+	DiffGraph(ctx context.Context, moduleFilter string, from, to time.Time) (GraphDiff, error)
+	StreamDependencyEdges(ctx context.Context, query EdgeQuery, fn func(DependencyEdge) error) error
+	RankModules(ctx context.Context, query ModuleRankQuery) ([]ModuleRank, error)
+	GetModuleMetrics(ctx context.Context, name string) (ModuleMetrics, error)
+	GetModuleConsumers(ctx context.Context, name string, allMajors bool) ([]DependencyEdge, error)
+	GetModuleStaleness(ctx context.Context, query StalenessQuery) ([]ModuleStaleness, error)
+	GetModuleLicenses(ctx context.Context, names []string) (map[string]string, error)
+	GetAbandonedModules(ctx context.Context, query AbandonedModuleQuery) ([]UpstreamStatus, error)
+	GetGhostVersions(ctx context.Context, moduleFilter string) ([]GhostVersion, error)
+	GetModuleEdges(ctx context.Context) ([]ModuleEdge, error)
+	GetTimeSeries(ctx context.Context, query TimeSeriesQuery) ([]TimeSeriesPoint, error)
+	GetGraphStats(ctx context.Context) (GraphStats, error)
+	GetStoreStats(ctx context.Context) (StoreStats, error)
+	CountModulesByPrefix(ctx context.Context, prefixes []string) (map[string]int64, error)
+	SaveModuleImportance(ctx context.Context, scores map[int32]float64) error
+
+	CheckConsistency(ctx context.Context, repair bool) ([]ConsistencyIssue, error)
+	MergeModules(ctx context.Context, source, target string) (MergeResult, error)
+	SaveModuleAlias(ctx context.Context, name, alias string) error
+	RenameModule(ctx context.Context, oldName, newName string) error
+	SetModuleLicense(ctx context.Context, name, license string) error
+	SaveUpstreamStatus(ctx context.Context, name string, archived bool, lastCommit time.Time) error
+	SaveVersionAvailability(ctx context.Context, name string, unavailable map[string]string) error
+	GetRenamedModules(ctx context.Context, name string) ([]string, error)
+	PrunePrereleaseVersions(ctx context.Context, olderThan time.Time) (int, error)
+
+	EnqueueIngestionJobs(ctx context.Context, source string, mods ...Version) ([]IngestionJob, error)
+	ClaimIngestionJob(ctx context.Context, staleBefore time.Time) (*IngestionJob, error)
+	CompleteIngestionJob(ctx context.Context, id int64, jobErr error, retry bool) error
+	GetIngestionJob(ctx context.Context, id int64) (IngestionJob, error)
+	QueryIngestionJobs(ctx context.Context, status string, pageToken string, count int) ([]IngestionJob, string, error)
+
+	DeleteModule(ctx context.Context, name string) error
+	DeleteModuleVersion(ctx context.Context, name, version string) error
+	UndeleteModule(ctx context.Context, name, version string) (UndeleteResult, error)
+	PurgeDeletedModules(ctx context.Context, olderThan time.Time) (int, error)
+	ClaimIdempotencyKey(ctx context.Context, procedure, key string, requestHash []byte, abandonedBefore time.Time) (*IdempotencyKey, error)
+	CompleteIdempotencyKey(ctx context.Context, procedure, key string, response []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, procedure, key string) error
+	PurgeIdempotencyKeys(ctx context.Context, olderThan time.Time) (int, error)
+	CreateAPIKey(ctx context.Context, name, keyHash string) (APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]APIKey, error)
+	RevokeAPIKey(ctx context.Context, name string) error
+	AuthenticateAPIKey(ctx context.Context, keyHash string) (APIKey, error)
+	AddAuditEntry(ctx context.Context, entry AuditEntry) error
+	QueryAuditLog(ctx context.Context, pageToken string, count int) ([]AuditEntry, string, error)
 }
 
 // ModuleVersionQuery encapsulates the available parameters for querying for module versions.
@@ -25,12 +84,29 @@ type Store interface {
 type ModuleVersionQuery struct {
 	// a glob pattern specifying which module(s) should be returned
 	ModuleFilter string
+	// if true, the query will return versions of every module in the same family as the module(s)
+	// matched by ModuleFilter, which are the other major versions of the same project
+	AllMajors bool
 	// a glob pattern specifying which version(s) should be returned
 	VersionFilter string
+	// a range of semantic versions, ex: ">=v1.4.0 <v2.0.0", that the returned versions must be in
+	VersionRange modver.Range
 	// if true, the query will also return pre-release versions
 	IncludePrerelease bool
+	// if true, the query will not return pseudo-versions, such as v0.0.0-20240101120000-abcdef123456,
+	// so that only tagged versions are considered when LatestOnly is also true
+	ExcludePseudo bool
 	// if true, the query will only return the most current version
 	LatestOnly bool
+	// if not the zero time, the query will only return versions that were added at or before this time
+	AsOf time.Time
+	// if not the zero time, the query will only return versions that were added at or after this time
+	Since time.Time
+	// if true, the versions of each module are ordered from most to least recently added rather than from
+	// highest to lowest semantic version
+	SortByDate bool
+	// if non-zero, the query will only return the first Limit versions of each module in the requested order
+	Limit int
 
 	PageToken string
 	Count     int
@@ -39,13 +115,16 @@ type ModuleVersionQuery struct {
 // pageTokenString returns the string that should be used to construct the page token returned to the
 // API client for this request.
 //
-// The result is a concatenation of the four user-provided filters so that the generated token will be
+// The result is a concatenation of the user-provided filters so that the generated token will be
 // specific to this particular query.
 func (q *ModuleVersionQuery) pageTokenString() string {
-	return fmt.Sprintf("moduleversions:%s+%s+%v+%v", q.ModuleFilter, q.VersionFilter, q.IncludePrerelease, q.LatestOnly)
+	return fmt.Sprintf("moduleversions:%s+%v+%s+%s+%v+%v+%v+%s+%s+%v+%d", q.ModuleFilter, q.AllMajors, q.VersionFilter, q.VersionRange, q.IncludePrerelease, q.ExcludePseudo, q.LatestOnly,
+		q.AsOf.UTC().Format(time.RFC3339Nano), q.Since.UTC().Format(time.RFC3339Nano), q.SortByDate, q.Limit)
 }
 
 // ModuleVersionQueryResult is represents a set of modules each having a list of versions
 type ModuleVersionQueryResult struct {
 	Module, Version string
+	// the time the version was added to the graph, which is not set when only the latest versions are queried
+	CreatedAt time.Time
 }
@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// the metrics supported by [PostgresClient.GetTimeSeries]
+const (
+	// the total number of modules, based on when the first version of each was added to the graph
+	SeriesModules = "modules"
+	// the total number of module versions
+	SeriesVersions = "versions"
+	// the number of ingestion jobs that succeeded in each interval
+	SeriesIngestions = "ingestions"
+	// the number of ingestion jobs that failed permanently in each interval
+	SeriesIngestionFailures = "ingestion-failures"
+	// the number of distinct modules that depended on a specific module
+	SeriesDependents = "dependents"
+)
+
+// TimeSeriesQuery encapsulates the available parameters for [PostgresClient.GetTimeSeries]
+type TimeSeriesQuery struct {
+	// one of the Series* constants
+	Metric string
+	// the module whose dependents are counted, required for SeriesDependents
+	Module string
+	// the time of the first and last points, the last point may be earlier if the time between them is
+	// not a multiple of Step
+	From, To time.Time
+	// the time between points
+	Step time.Duration
+}
+
+// TimeSeriesPoint is the value of a metric at a point in time
+type TimeSeriesPoint struct {
+	Time  time.Time `db:"time"`
+	Value int64     `db:"value"`
+}
+
+// timeSeriesValues are the correlated subqueries that compute the value of each metric at the point in
+// time "b.t".  $3 is the step in seconds and $4, if used, is the ID of the module being queried.
+var timeSeriesValues = map[string]string{
+	SeriesModules:  `(SELECT count(*) FROM firsts WHERE first_seen <= b.t)`,
+	SeriesVersions: `(SELECT count(*) FROM module_version WHERE created_at <= b.t)`,
+	SeriesIngestions: `(SELECT count(*) FROM ingestion_job
+	                     WHERE status = '` + IngestionJobSucceeded + `'
+	                       AND updated_at > b.t - make_interval(secs => $3) AND updated_at <= b.t)`,
+	SeriesIngestionFailures: `(SELECT count(*) FROM ingestion_job
+	                            WHERE status = '` + IngestionJobFailed + `'
+	                              AND updated_at > b.t - make_interval(secs => $3) AND updated_at <= b.t)`,
+	SeriesDependents: `(SELECT count(DISTINCT lv.module_id)
+	                      FROM module_dependency md
+	                           JOIN module_version lv ON (lv.id = md.dependent_id)
+	                           JOIN module_version rv ON (rv.id = md.dependee_id)
+	                     WHERE rv.module_id = $4 AND lv.module_id <> rv.module_id
+	                       AND md.first_seen <= b.t AND (md.removed_at IS NULL OR md.removed_at > b.t)
+	                       AND NOT EXISTS (SELECT 1 FROM module_dependency_gap g
+	                                        WHERE g.dependent_id = md.dependent_id AND g.dependee_id = md.dependee_id
+	                                          AND g.removed_at <= b.t AND g.restored_at > b.t))`,
+}
+
+// GetTimeSeries returns the value of the requested metric at each step between query.From and query.To,
+// in ascending time order.  Dependency edges are evaluated at the module level, like [moduleEdgesCTE], so a
+// module is counted as a dependent if any of its versions declared a dependency on any version of the
+// queried module at that time.  If the queried module does not exist, the returned error wraps
+// [ErrModuleNotFound].
+func (p *PostgresClient) GetTimeSeries(ctx context.Context, query TimeSeriesQuery) ([]TimeSeriesPoint, error) {
+	value, ok := timeSeriesValues[query.Metric]
+	if !ok {
+		return nil, fmt.Errorf("unsupported metric %q", query.Metric)
+	}
+	if query.Step <= 0 || query.To.Before(query.From) {
+		return nil, fmt.Errorf("the time range and step must be positive")
+	}
+	args := []any{query.From, query.To, query.Step.Seconds()}
+	if query.Metric == SeriesDependents {
+		name, err := resolveModuleAlias(ctx, p.db, query.Module)
+		if err != nil {
+			return nil, err
+		}
+		id, err := getModuleID(ctx, p.db, name)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, id)
+	}
+	q := `WITH firsts AS (
+	          SELECT min(created_at) AS first_seen FROM module_version GROUP BY module_id
+	      )
+	      SELECT b.t AS time, ` + value + ` AS value
+	        FROM generate_series($1::timestamptz, $2::timestamptz, make_interval(secs => $3)) AS b(t)
+	       ORDER BY b.t`
+	p.log.Debug("querying time series", "sql", q, "metric", query.Metric, "module", query.Module)
+	var points []TimeSeriesPoint
+	if err := p.db.SelectContext(ctx, &points, q, args...); err != nil {
+		return nil, fmt.Errorf("database error querying the %s time series: %w", query.Metric, err)
+	}
+	return points, nil
+}
@@ -0,0 +1,96 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// dbConn defines the operations that [PostgresClient] uses to query the database, which are provided by
+// both the connection pool and a transaction.
+type dbConn interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
+// dbTx is a database transaction started by [PostgresClient.beginTx], which is either a new transaction
+// or a savepoint within the transaction of a [PostgresClient.WithTx] call.
+type dbTx interface {
+	dbConn
+	Commit() error
+	Rollback() error
+}
+
+// sharedTx is the transaction that is used by all operations of the [PostgresClient] passed to the
+// function given to [PostgresClient.WithTx]
+type sharedTx struct {
+	*sqlx.Tx
+	// the number of savepoints that have been created, which is used to give each one a unique name
+	savepoints int
+}
+
+// savepoint is a [dbTx] that is nested within a [sharedTx].  Committing it releases the savepoint and
+// rolling it back undoes only the changes made since it was created, leaving the enclosing transaction
+// usable.
+type savepoint struct {
+	*sqlx.Tx
+	name string
+}
+
+// Commit releases the savepoint, which keeps its changes as part of the enclosing transaction
+func (s savepoint) Commit() error {
+	_, err := s.Exec("RELEASE SAVEPOINT " + s.name)
+	return err
+}
+
+// Rollback undoes the changes made since the savepoint was created
+func (s savepoint) Rollback() error {
+	_, err := s.Exec("ROLLBACK TO SAVEPOINT " + s.name)
+	return err
+}
+
+// beginTx starts a database transaction.  Within a [PostgresClient.WithTx] call, a savepoint in the
+// shared transaction is created instead and opts is ignored.
+func (p *PostgresClient) beginTx(ctx context.Context, opts *sql.TxOptions) (dbTx, error) {
+	if p.tx == nil {
+		return p.pool.BeginTxx(ctx, opts)
+	}
+	p.tx.savepoints++
+	sp := savepoint{Tx: p.tx.Tx, name: fmt.Sprintf("perseus_sp%d", p.tx.savepoints)}
+	if _, err := sp.ExecContext(ctx, "SAVEPOINT "+sp.name); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+// WithTx calls fn with a [Store] whose operations are all performed in a single database transaction,
+// which is committed if fn returns nil and rolled back otherwise.  This allows a multi-module ingest to
+// be applied atomically.
+//
+// Each write operation of the Store is applied within a savepoint so that, if fn handles the error
+// returned by an operation rather than returning it, the changes made by that operation are undone and
+// the transaction can continue.  The Store must not be used concurrently or after fn returns.
+func (p *PostgresClient) WithTx(ctx context.Context, fn func(Store) error) (err error) {
+	txn, err := p.beginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start a database transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = txn.Commit()
+		} else {
+			if e2 := txn.Rollback(); e2 != nil {
+				p.log.Error(e2, "error rolling back transaction after error")
+			}
+		}
+	}()
+
+	tp := &PostgresClient{db: txn, pool: p.pool, tx: p.tx, log: p.log}
+	if stx, ok := txn.(*sqlx.Tx); ok {
+		tp.tx = &sharedTx{Tx: stx}
+	}
+	return fn(tp)
+}
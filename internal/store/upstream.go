@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// UpstreamStatus describes the state of the source repository of a module as reported by its code host
+type UpstreamStatus struct {
+	Module string `db:"module"`
+	// true if the repository has been archived by its owner
+	Archived bool `db:"archived"`
+	// the time of the most recent commit to the repository, if it is known
+	LastCommitAt sql.NullTime `db:"last_commit_at"`
+	// when the repository was last checked
+	CheckedAt time.Time `db:"upstream_checked_at"`
+	// the number of distinct modules that currently depend directly on any version of the module
+	Dependents int `db:"dependents"`
+}
+
+// AbandonedModuleQuery encapsulates the available parameters for querying for modules whose source
+// repositories appear to be abandoned
+type AbandonedModuleQuery struct {
+	// a glob pattern specifying which module(s) should be returned
+	ModuleFilter string
+	// modules whose most recent commit was before this time are returned along with archived modules
+	InactiveSince time.Time
+}
+
+// SaveUpstreamStatus records the state of the source repository of the named module and the time that it
+// was checked.  A zero lastCommit clears any previously recorded commit time.  If the module does not
+// exist, the returned error wraps [ErrModuleNotFound].
+func (p *PostgresClient) SaveUpstreamStatus(ctx context.Context, name string, archived bool, lastCommit time.Time) error {
+	name, err := resolveModuleAlias(ctx, p.db, name)
+	if err != nil {
+		return err
+	}
+	id, err := getModuleID(ctx, p.db, name)
+	if err != nil {
+		return err
+	}
+	commit := sql.NullTime{Time: lastCommit, Valid: !lastCommit.IsZero()}
+	p.log.Debug("saving module upstream status", "module", name, "archived", archived, "lastCommit", lastCommit)
+	cmd := `UPDATE module SET archived = $1, last_commit_at = $2, upstream_checked_at = now() WHERE id = $3`
+	if _, err := p.db.ExecContext(ctx, cmd, archived, commit, id); err != nil {
+		return fmt.Errorf("database error saving module upstream status: %w", err)
+	}
+	return nil
+}
+
+// GetAbandonedModules returns the modules matching the query whose source repositories have been archived
+// or have not had a commit since query.InactiveSince, ordered by descending number of dependents then by
+// module name.  Modules that have not been checked by the check-upstreams job are never returned.
+func (p *PostgresClient) GetAbandonedModules(ctx context.Context, query AbandonedModuleQuery) ([]UpstreamStatus, error) {
+	if query.ModuleFilter == "" {
+		return nil, fmt.Errorf("the module filter must be specified")
+	}
+	q := psql.
+		Select(
+			"m.name AS module",
+			"m.archived",
+			"m.last_commit_at",
+			"m.upstream_checked_at",
+			"(SELECT count(*) FROM edges e WHERE e.dependee = m.id) AS dependents").
+		Prefix("WITH "+moduleEdgesCTE).
+		From(tableModules+" m").
+		Where(matchModuleName("m", query.ModuleFilter)).
+		Where("m.upstream_checked_at IS NOT NULL").
+		Where(sq.Or{
+			sq.Eq{"m.archived": true},
+			sq.Lt{"m.last_commit_at": query.InactiveSince},
+		}).
+		OrderBy("dependents DESC", "m.name")
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error constructing SQL query: %w", err)
+	}
+	p.log.Debug("querying abandoned modules", "sql", sql, "args", args)
+	var results []UpstreamStatus
+	if err := p.db.SelectContext(ctx, &results, sql, args...); err != nil {
+		return nil, fmt.Errorf("database error querying abandoned modules: %w", err)
+	}
+	return results, nil
+}
@@ -5,4 +5,7 @@ type Version struct {
 	ID       int32  `json:"id" db:"id"`
 	ModuleID string `json:"module_id" db:"module_id"`
 	SemVer   string `json:"semver" db:"version"`
+	// the version as it was reported, such as a Git tag with build metadata, if it differs from the
+	// canonical version in SemVer
+	Original string `json:"original,omitempty" db:"original_version"`
 }
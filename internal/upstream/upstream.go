@@ -0,0 +1,140 @@
+// Package upstream queries the APIs of well-known code hosting sites for the state of the source
+// repositories of Go modules, such as whether a repository has been archived by its owner.
+package upstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// the base URLs of the supported code hosting APIs
+const (
+	defaultGitHubURL = "https://api.github.com"
+	defaultGitLabURL = "https://gitlab.com/api/v4"
+)
+
+// ErrUnsupportedHost is returned when a module is not hosted on a code hosting site whose API is supported
+var ErrUnsupportedHost = errors.New("the module is not hosted on GitHub or GitLab")
+
+// ErrRepositoryNotFound is returned, possibly wrapped, when the code host reports that a module's
+// repository does not exist, which may mean that it was deleted or that it is private
+var ErrRepositoryNotFound = errors.New("repository not found")
+
+// Status describes the state of the source repository of a module
+type Status struct {
+	// true if the repository has been archived, which makes it read-only
+	Archived bool
+	// the time of the most recent push to the repository, or of the most recent activity for GitLab,
+	// which is the zero time if the code host did not report it
+	LastCommit time.Time
+}
+
+// Client retrieves the state of module repositories from the GitHub and GitLab REST APIs
+type Client struct {
+	c *http.Client
+
+	githubURL, githubToken string
+	gitlabURL, gitlabToken string
+}
+
+// NewFromEnv returns a Client that uses c to send requests to the public GitHub and GitLab APIs.
+// Requests are authenticated using the tokens in $GITHUB_TOKEN and $GITLAB_TOKEN, if set, which
+// significantly raises the API rate limits.
+func NewFromEnv(c *http.Client) *Client {
+	return &Client{
+		c:           c,
+		githubURL:   defaultGitHubURL,
+		githubToken: os.Getenv("GITHUB_TOKEN"),
+		gitlabURL:   defaultGitLabURL,
+		gitlabToken: os.Getenv("GITLAB_TOKEN"),
+	}
+}
+
+// Repository returns the path of the repository that contains mod, ex: github.com/CrowdStrike/perseus for
+// github.com/CrowdStrike/perseus/v2, or an empty string if the repository cannot be checked by
+// [Client.Check].  Modules with the same repository path always have the same [Status].
+func Repository(mod string) string {
+	host, repo, err := splitRepo(mod)
+	if err != nil {
+		return ""
+	}
+	return host + "/" + repo
+}
+
+// Check returns the state of the repository that contains mod.  The first 3 elements of the module path
+// identify the repository, ex: github.com/CrowdStrike/perseus for github.com/CrowdStrike/perseus/v2.  If
+// mod is not hosted on GitHub or GitLab, the returned error wraps [ErrUnsupportedHost].
+func (c *Client) Check(ctx context.Context, mod string) (Status, error) {
+	host, repo, err := splitRepo(mod)
+	if err != nil {
+		return Status{}, err
+	}
+	switch host {
+	case "github.com":
+		var resp struct {
+			Archived bool      `json:"archived"`
+			PushedAt time.Time `json:"pushed_at"`
+		}
+		if err := c.get(ctx, c.githubURL+"/repos/"+repo, c.githubToken, &resp); err != nil {
+			return Status{}, fmt.Errorf("unable to query the GitHub repository %s: %w", repo, err)
+		}
+		return Status{Archived: resp.Archived, LastCommit: resp.PushedAt}, nil
+
+	default:
+		var resp struct {
+			Archived       bool      `json:"archived"`
+			LastActivityAt time.Time `json:"last_activity_at"`
+		}
+		if err := c.get(ctx, c.gitlabURL+"/projects/"+url.PathEscape(repo), c.gitlabToken, &resp); err != nil {
+			return Status{}, fmt.Errorf("unable to query the GitLab project %s: %w", repo, err)
+		}
+		return Status{Archived: resp.Archived, LastCommit: resp.LastActivityAt}, nil
+	}
+}
+
+// get sends a GET request to u, authenticated with token if it is not empty, and decodes the JSON response
+// body into dest.
+func (c *Client) get(ctx context.Context, u, token string, dest any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return ErrRepositoryNotFound
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	return nil
+}
+
+// splitRepo returns the code host and the owner/name of the repository that contains mod
+func splitRepo(mod string) (host, repo string, err error) {
+	parts := strings.SplitN(mod, "/", 4)
+	if len(parts) < 3 || (parts[0] != "github.com" && parts[0] != "gitlab.com") {
+		return "", "", ErrUnsupportedHost
+	}
+	return parts[0], parts[1] + "/" + strings.TrimSuffix(parts[2], ".git"), nil
+}
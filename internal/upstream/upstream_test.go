@@ -0,0 +1,109 @@
+package upstream
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/github/repos/example/archived", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer gh-token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"archived":true,"pushed_at":"2021-03-04T05:06:07Z"}`))
+	})
+	mux.HandleFunc("/github/repos/example/active", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"archived":false,"pushed_at":"2024-01-02T03:04:05Z"}`))
+	})
+	mux.HandleFunc("/github/repos/example/broken", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "rate limit exceeded", http.StatusForbidden)
+	})
+	mux.HandleFunc("/gitlab/projects/", func(w http.ResponseWriter, r *http.Request) {
+		// the project path is URL-encoded as a single path segment
+		assert.Equal(t, "/gitlab/projects/example%2Fproject", r.URL.EscapedPath())
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"archived":false,"last_activity_at":"2019-10-11T12:13:14Z"}`))
+	})
+	svr := httptest.NewServer(mux)
+	t.Cleanup(svr.Close)
+
+	c := &Client{
+		c:           svr.Client(),
+		githubURL:   svr.URL + "/github",
+		githubToken: "gh-token",
+		gitlabURL:   svr.URL + "/gitlab",
+	}
+	type testCase struct {
+		name     string
+		mod      string
+		expected Status
+		wantErr  error
+		anyErr   bool
+	}
+	cases := []testCase{
+		{
+			name:     "archived GitHub repository",
+			mod:      "github.com/example/archived",
+			expected: Status{Archived: true, LastCommit: time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)},
+		},
+		{
+			name:     "major version suffix",
+			mod:      "github.com/example/active/v3",
+			expected: Status{LastCommit: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+		{
+			name:     "GitLab project",
+			mod:      "gitlab.com/example/project/sub/pkg",
+			expected: Status{LastCommit: time.Date(2019, 10, 11, 12, 13, 14, 0, time.UTC)},
+		},
+		{
+			name:    "missing repository",
+			mod:     "github.com/example/missing",
+			wantErr: ErrRepositoryNotFound,
+		},
+		{
+			name:   "API error",
+			mod:    "github.com/example/broken",
+			anyErr: true,
+		},
+		{
+			name:    "unsupported host",
+			mod:     "golang.org/x/mod",
+			wantErr: ErrUnsupportedHost,
+		},
+		{
+			name:    "no repository name",
+			mod:     "github.com/example",
+			wantErr: ErrUnsupportedHost,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := c.Check(context.Background(), tc.mod)
+			switch {
+			case tc.wantErr != nil:
+				assert.ErrorIs(t, err, tc.wantErr)
+			case tc.anyErr:
+				assert.Error(t, err)
+			default:
+				if assert.NoError(t, err) {
+					assert.Equal(t, tc.expected.Archived, got.Archived)
+					assert.True(t, tc.expected.LastCommit.Equal(got.LastCommit), "expected %s, got %s", tc.expected.LastCommit, got.LastCommit)
+				}
+			}
+		})
+	}
+}
+
+func TestRepository(t *testing.T) {
+	assert.Equal(t, "github.com/CrowdStrike/perseus", Repository("github.com/CrowdStrike/perseus/v2"))
+	assert.Equal(t, "gitlab.com/example/project", Repository("gitlab.com/example/project.git/sub"))
+	assert.Equal(t, "", Repository("golang.org/x/mod"))
+	assert.Equal(t, "", Repository("github.com/example"))
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const jobsStatusExampleUsage = `  # show the 20 most recent ingestion jobs
+  perseus jobs status
+
+  # show the jobs that failed
+  perseus jobs status --status failed --limit 100
+
+  # show specific jobs
+  perseus jobs status 1138 1139`
+
+// createJobsCommand initializes and returns a *cobra.Command that implements the 'jobs' CLI sub-command
+func createJobsCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:          "jobs ...",
+		Short:        "Tracks the module versions that are queued for ingestion by the Perseus server",
+		SilenceUsage: true,
+	}
+	fset := cmd.PersistentFlags()
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
+	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.Duration("timeout", 0, "the maximum amount of time to wait for the Perseus server, ex: 30s or 2m (default is $PERSEUS_TIMEOUT environment variable or no limit)")
+	addRetryFlags(fset)
+	addConnectionFlags(fset)
+
+	statusCmd := cobra.Command{
+		Use:          "status [job id ...]",
+		Example:      jobsStatusExampleUsage,
+		Short:        "Shows the status of the specified ingestion jobs or of the most recent jobs",
+		RunE:         runJobsStatusCmd,
+		SilenceUsage: true,
+	}
+	statusCmd.Flags().String("status", "", "only show jobs with the specified status: pending, running, succeeded, or failed")
+	statusCmd.Flags().Int("limit", 20, "the maximum number of jobs to show")
+	cmd.AddCommand(&statusCmd)
+
+	return &cmd
+}
+
+// runJobsStatusCmd implements the logic behind the 'jobs status' CLI sub-command
+func runJobsStatusCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, len(args))
+	for i, a := range args {
+		if ids[i], err = strconv.ParseInt(a, 10, 64); err != nil || ids[i] <= 0 {
+			return fmt.Errorf("Invalid job ID %q", a)
+		}
+	}
+	status, _ := cmd.Flags().GetString("status")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	var jobs []*perseusapi.IngestionJob
+	if len(ids) > 0 {
+		for _, id := range ids {
+			resp, err := ps.API().GetIngestionJob(ctx, connect.NewRequest(&perseusapi.GetIngestionJobRequest{Id: id}))
+			if err != nil {
+				return fmt.Errorf("Unable to retrieve ingestion job %d: %w", id, err)
+			}
+			jobs = append(jobs, resp.Msg.GetJob())
+		}
+	} else {
+		resp, err := ps.API().ListIngestionJobs(ctx, connect.NewRequest(&perseusapi.ListIngestionJobsRequest{
+			Status:   status,
+			PageSize: int32(limit), //nolint: gosec // validated by the server
+		}))
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve the ingestion jobs: %w", err)
+		}
+		jobs = resp.Msg.GetJobs()
+	}
+
+	if len(jobs) == 0 {
+		infof("no matching ingestion jobs\n")
+		return nil
+	}
+	failed := 0
+	tw := tabwriter.NewWriter(os.Stdout, 10, 4, 2, ' ', 0)
+	if _, err := tw.Write([]byte("ID\tModule\tStatus\tAttempts\tSource\tUpdated\tError\n")); err != nil {
+		return fmt.Errorf("Error writing tabular output: %w", err)
+	}
+	for _, j := range jobs {
+		if j.GetStatus() == "failed" {
+			failed++
+		}
+		updated := j.GetUpdateTime().AsTime().Local().Format(time.DateTime)
+		if _, err := fmt.Fprintf(tw, "%d\t%s@%s\t%s\t%d\t%s\t%s\t%s\n", j.GetId(), j.GetModuleName(), j.GetVersion(), j.GetStatus(), j.GetAttempts(), j.GetSource(), updated, j.GetError()); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("Error writing tabular output: %w", err)
+	}
+	// only fail when tracking specific jobs so that scripts can wait on a submission
+	if len(ids) > 0 && failed > 0 {
+		return fmt.Errorf("%d of %d ingestion job(s) failed", failed, len(jobs))
+	}
+	return nil
+}
+
+// submitIngestionJobs adds the specified versions of modulePath to the server's ingestion queue and
+// reports the IDs of the new jobs.
+func submitIngestionJobs(conf clientConfig, modulePath string, versions []string) error {
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if err := requireFeature(ctx, ps, client.FeatureIngestionJobs, "ingestion jobs"); err != nil {
+		return err
+	}
+
+	// the server accepts at most 1000 modules per request, each of which can list multiple versions,
+	// so we send the versions in batches to keep each request small
+	const batchSize = 1000
+	var ids []int64
+	for len(versions) > 0 {
+		n := min(batchSize, len(versions))
+		req := connect.NewRequest(&perseusapi.SubmitIngestionJobsRequest{
+			Modules: []*perseusapi.Module{{Name: modulePath, Versions: versions[:n]}},
+			Source:  "cli",
+		})
+		resp, err := ps.API().SubmitIngestionJobs(ctx, req)
+		if err != nil {
+			return fmt.Errorf("Unable to submit the ingestion jobs: %w", err)
+		}
+		for _, j := range resp.Msg.GetJobs() {
+			ids = append(ids, j.GetId())
+		}
+		versions = versions[n:]
+	}
+
+	fmt.Printf("Queued %d ingestion job(s) for %s\n", len(ids), modulePath)
+	for _, id := range ids {
+		fmt.Println(id)
+	}
+	infof("use 'perseus jobs status <id> ...' to track their progress\n")
+	return nil
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryLaggardsExampleUsage = `  # show which versions of a module its dependents are using
+  perseus query laggards github.com/example/foo -o table
+
+  # include the dependents of every major version and only show the ones more than 5 minor versions behind
+  perseus query laggards github.com/example/foo --all-majors --max-minors-behind 5 --laggards-only`
+
+// laggardColor is used to highlight the consumed versions that are too far behind the latest version
+// when rendering the table output of the 'query laggards' CLI sub-command
+var laggardColor = color.New(color.FgRed, color.Bold)
+
+// laggardReport defines the information returned by the 'query laggards' CLI sub-command
+type laggardReport struct {
+	// the module path, ex: github.com/CrowdStrike/perseus
+	Module string `yaml:"module"`
+	// the latest version of the module, which is the highest version of the highest major version if
+	// --all-majors is specified
+	Latest string `yaml:"latest"`
+	// the versions of the module that are consumed by at least 1 dependent, ordered by module path then
+	// by descending version
+	Versions []consumedVersion `yaml:"versions"`
+}
+
+// consumedVersion is a single module version in a [laggardReport] and the modules that depend on it
+type consumedVersion struct {
+	Path    string `yaml:"path"`
+	Version string `yaml:"version"`
+	// the number of major versions that this version is behind the latest version
+	MajorsBehind int `yaml:"majorsBehind"`
+	// the number of minor versions that this version is behind the latest version, which is only
+	// computed if the major versions are the same
+	MinorsBehind int `yaml:"minorsBehind"`
+	// true if this version is further behind the latest version than the allowed thresholds
+	Laggard bool `yaml:"laggard"`
+	// the latest version of each module that depends on this version
+	Dependents []string `yaml:"dependents"`
+}
+
+// runQueryLaggardsCmd implements the logic behind the 'query laggards' CLI sub-command
+func runQueryLaggardsCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	modulePath := args[0]
+	if err := module.CheckPath(modulePath); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", modulePath, err)
+	}
+	allMajors, _ := cmd.Flags().GetBool("all-majors")
+	laggardsOnly, _ := cmd.Flags().GetBool("laggards-only")
+	maxMinors, _ := cmd.Flags().GetInt("max-minors-behind")
+	maxMajors, _ := cmd.Flags().GetInt("max-majors-behind")
+	if maxMinors < 0 || maxMajors < 0 {
+		return fmt.Errorf("The --max-minors-behind and --max-majors-behind flags must not be negative")
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("querying dependents of " + modulePath)
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	report := laggardReport{Module: modulePath}
+	if allMajors {
+		latest, err := ps.LatestFamilyVersions(ctx, modulePath, asOf)
+		if err != nil {
+			return err
+		}
+		for _, mv := range latest {
+			if report.Latest == "" || semver.Compare(mv.Version, report.Latest) > 0 {
+				report.Latest = mv.Version
+			}
+		}
+	} else if report.Latest, err = ps.LatestVersion(ctx, modulePath, asOf); err != nil {
+		return err
+	}
+	resp, err := ps.API().ListModuleConsumers(ctx, connect.NewRequest(&perseusapi.ListModuleConsumersRequest{
+		ModuleName: modulePath,
+		AllMajors:  allMajors,
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to query the dependents of %s: %w", modulePath, err)
+	}
+	for _, cv := range resp.Msg.GetVersions() {
+		v := consumedVersion{
+			Path:    cv.GetModule().GetName(),
+			Version: cv.GetModule().GetVersions()[0],
+		}
+		v.MajorsBehind, v.MinorsBehind = versionsBehind(report.Latest, v.Version)
+		v.Laggard = v.MajorsBehind > maxMajors || v.MinorsBehind > maxMinors
+		if laggardsOnly && !v.Laggard {
+			continue
+		}
+		for _, d := range cv.GetDependents() {
+			v.Dependents = append(v.Dependents, d.GetName()+"@"+d.GetVersions()[0])
+		}
+		report.Versions = append(report.Versions, v)
+	}
+	if len(report.Versions) == 0 {
+		infof("no matching dependents found for %s\n", modulePath)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeLaggards(out, format, report); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// versionsBehind returns the number of major versions that v is behind latest and, if they have the same
+// major version, the number of minor versions.  Neither value is negative, so a version that is ahead of
+// latest, such as a pre-release of the next version, is reported as 0 versions behind.
+func versionsBehind(latest, v string) (majors, minors int) {
+	latestMajor, latestMinor := majorMinor(latest)
+	major, minor := majorMinor(v)
+	if latestMajor != major {
+		return max(latestMajor-major, 0), 0
+	}
+	return 0, max(latestMinor-minor, 0)
+}
+
+// majorMinor returns the numeric major and minor versions of the semantic version v, or zeros if v is
+// not valid
+func majorMinor(v string) (major, minor int) {
+	maj, mnr, _ := strings.Cut(strings.TrimPrefix(semver.MajorMinor(v), "v"), ".")
+	major, _ = strconv.Atoi(maj)
+	minor, _ = strconv.Atoi(mnr)
+	return major, minor
+}
+
+// writeLaggards writes the contents of report to the provided io.Writer in the specified format
+func writeLaggards(w io.Writer, format string, report laggardReport) error {
+	switch format {
+	case outputTable:
+		fmt.Fprintf(w, "%s (latest %s)\n", report.Module, report.Latest)
+		for _, v := range report.Versions {
+			fmt.Fprintln(w)
+			line := fmt.Sprintf("%s@%s", v.Path, v.Version)
+			switch {
+			case v.MajorsBehind > 0:
+				line += fmt.Sprintf(" - %d major version(s) behind", v.MajorsBehind)
+			case v.MinorsBehind > 0:
+				line += fmt.Sprintf(" - %d minor version(s) behind", v.MinorsBehind)
+			}
+			line += fmt.Sprintf(" - %d dependent(s)", len(v.Dependents))
+			if v.Laggard {
+				line = laggardColor.Sprint(line)
+			}
+			fmt.Fprintln(w, line)
+			for _, d := range v.Dependents {
+				fmt.Fprintf(w, "  %s\n", d)
+			}
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(report)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(report)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
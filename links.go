@@ -0,0 +1,76 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/fatih/color"
+	"golang.org/x/mod/module"
+)
+
+// pkgsiteURL is the base URL of the Go package documentation site
+const pkgsiteURL = "https://pkg.go.dev"
+
+// publicCodeHosts are the code hosting sites whose repositories are identified by the first 3 elements
+// of a module path, ex: github.com/CrowdStrike/perseus
+var publicCodeHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// docsURL returns the pkg.go.dev URL for mod, which includes the version if one is specified, or an empty
+// string if mod matches $GOPRIVATE and so is not published there.
+func docsURL(mod module.Version) string {
+	if isPrivateModule(mod.Path) {
+		return ""
+	}
+	u := pkgsiteURL + "/" + mod.Path
+	if mod.Version != "" {
+		u += "@" + mod.Version
+	}
+	return u
+}
+
+// sourceURL returns the web URL of the repository that contains the module at path, ex:
+// https://github.com/CrowdStrike/perseus for github.com/CrowdStrike/perseus/v2, or an empty string if it
+// cannot be determined from the path.
+//
+// Modules on well-known code hosts and modules matching $GOPRIVATE, which are assumed to be hosted on an
+// internal code host that serves repositories at https://[host]/[owner]/[repo], are supported.  Vanity
+// import paths, ex: go.uber.org/zap, are not.
+func sourceURL(path string) string {
+	if name, ok := strings.CutPrefix(path, "golang.org/x/"); ok {
+		name, _, _ = strings.Cut(name, "/")
+		return "https://cs.opensource.google/go/x/" + name
+	}
+	toks := strings.SplitN(path, "/", 4)
+	if len(toks) < 3 {
+		return ""
+	}
+	if !isPrivateModule(path) && !slices.Contains(publicCodeHosts, toks[0]) {
+		return ""
+	}
+	return "https://" + strings.Join(toks[:3], "/")
+}
+
+// isPrivateModule returns true if path matches the patterns in $GOPRIVATE
+func isPrivateModule(path string) bool {
+	patterns := os.Getenv("GOPRIVATE")
+	return patterns != "" && module.MatchPrefixPatterns(patterns, path)
+}
+
+// hyperlink returns text wrapped in an OSC 8 escape sequence that terminals which support it render as a
+// clickable link to url.  The text is returned as-is if url is empty or color output is disabled, which is
+// the case when stdout is not a terminal.
+func hyperlink(url, text string) string {
+	if url == "" || color.NoColor {
+		return text
+	}
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// addLinks populates the documentation and source repository URLs of each of items
+func addLinks(items []dependencyItem) {
+	for i := range items {
+		items[i].DocsURL = docsURL(module.Version{Path: items[i].Path, Version: items[i].Version})
+		items[i].SourceURL = sourceURL(items[i].Path)
+	}
+}
@@ -1,7 +1,13 @@
 package main
 
 import (
+	"fmt"
+	"io"
 	"log/slog"
+	"os"
+	"sync"
+
+	"github.com/spf13/cobra"
 
 	"github.com/CrowdStrike/perseus/internal/log"
 )
@@ -11,19 +17,58 @@ var (
 	logLevel logLevelVar
 	// the logger
 	logger = log.New(&logLevel)
+
+	// the log format and destination, set by the --log-format and --log-output CLI flags
+	logFormat, logOutput string
 )
 
+// configureLogging applies the --log-format and --log-output CLI flags to the logger before any command
+// runs
+func configureLogging(_ *cobra.Command, _ []string) error {
+	format, err := log.ParseFormat(logFormat)
+	if err != nil {
+		return err
+	}
+	var w io.Writer
+	switch logOutput {
+	case "", "stdout":
+		w = os.Stdout
+	case "stderr":
+		w = os.Stderr
+	default:
+		// the file is intentionally left open for the life of the process
+		f, err := os.OpenFile(logOutput, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+		if err != nil {
+			return fmt.Errorf("unable to open the log file: %w", err)
+		}
+		w = f
+	}
+	logger.SetOutput(format, w)
+	return nil
+}
+
 // logLevelVar wraps a boolean value that controls logging verbosity and satisfies the [slog.Leveler]
 // interface to translate that boolean to the equivalent [slog.Level], either [slog.LevelDebug] or [slog.LevelInfo].
 type logLevelVar struct {
 	debugMode bool
+	// guards debugMode after CLI flag parsing since the server can toggle it at runtime
+	mu sync.RWMutex
 }
 
 // Level satisfies the [slog.Leveler] interface and returns either [slog.LevelDebug] or [slog.LevelInfo]
 // depending on whether or not debug verbosity was enabled.
 func (v *logLevelVar) Level() slog.Level {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
 	if v.debugMode {
 		return slog.LevelDebug
 	}
 	return slog.LevelInfo
 }
+
+// SetDebug enables or disables debug verbosity at runtime.
+func (v *logLevelVar) SetDebug(enabled bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.debugMode = enabled
+}
@@ -14,15 +14,26 @@ func main() {
 	// we pass the debugMode field on the package-level logLevel variable here to simplify the CLI
 	// argument management.
 	rootCommand.PersistentFlags().BoolVarP(&(logLevel.debugMode), "debug", "x", os.Getenv("LOG_VERBOSITY") == "debug", "enable verbose logging")
+	rootCommand.PersistentFlags().BoolVarP(&quietMode, "quiet", "q", false, "suppress progress indicators and informational messages")
+	rootCommand.PersistentFlags().StringVar(&logFormat, "log-format", os.Getenv("LOG_FORMAT"), "the format of log entries: json, logfmt, or text (default is json when running in Kubernetes and logfmt otherwise)")
+	rootCommand.PersistentFlags().StringVar(&logOutput, "log-output", os.Getenv("LOG_OUTPUT"), "where log entries are written: stdout, stderr, or the path to a file (default stdout)")
+	rootCommand.PersistentFlags().BoolVar(&noSpinner, "no-spinner", false, "do not display a progress spinner (also disabled if the $CI or $NO_COLOR environment variables are set)")
 
-	rootCommand.AddCommand(server.CreateServerCommand(logger))
+	rootCommand.AddCommand(server.CreateServerCommand(logger, BuildVersion))
 	rootCommand.AddCommand(createUpdateCommand())
 	rootCommand.AddCommand(createQueryCommand())
 	rootCommand.AddCommand(createFindPathsCommand())
+	rootCommand.AddCommand(createBrowseCommand())
+	rootCommand.AddCommand(createDoctorCommand())
+	rootCommand.AddCommand(createAdminCommand())
+	rootCommand.AddCommand(createJobsCommand())
+	rootCommand.AddCommand(createGenCommand())
+	rootCommand.AddCommand(createExportCommand())
+	rootCommand.AddCommand(createInfoCommand())
 	rootCommand.AddCommand(versionCommand)
 
 	if err := rootCommand.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "%s\n", err)
+		fmt.Fprintf(os.Stderr, "%s\n", formatError(err))
 		os.Exit(1)
 	}
 }
@@ -33,6 +44,8 @@ var (
 		Short:         "perseus - Defeating krakens since 2022",
 		SilenceErrors: true, // don't print errors, we're handling it in main()
 		SilenceUsage:  true, // don't print usage on error
+		// subcommands must not define their own PersistentPreRunE, which would replace this one
+		PersistentPreRunE: configureLogging,
 	}
 
 	BuildDate    = "unknown"
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/pflag"
+)
+
+// supported output formats for the -o/--output-format CLI flag
+const (
+	outputJSON     = "json"
+	outputYAML     = "yaml"
+	outputTable    = "table"
+	outputDot      = "dot"
+	outputTree     = "tree"
+	outputTemplate = "template"
+	// govulncheck-compatible JSON, only supported by 'query vulns'
+	outputGovulncheck = "govulncheck"
+)
+
+// outputFormat is a [pflag.Value] that holds the requested output format for the client CLI commands.
+//
+// The value is one of "json", "yaml", "table", "tree", "dot", "govulncheck", or "template=[Go text template]".
+type outputFormat struct {
+	// the selected format, one of the output* constants or "" if none was specified
+	kind string
+	// the Go text template if kind is "template"
+	template string
+	// the number of times the value was assigned, used to detect conflicting flags
+	n int
+}
+
+// String returns the string representation of the output format
+func (f *outputFormat) String() string {
+	if f.kind == outputTemplate {
+		return outputTemplate + "=" + f.template
+	}
+	return f.kind
+}
+
+// Set parses s and assigns the output format.
+func (f *outputFormat) Set(s string) error {
+	kind, tmpl, hasTemplate := strings.Cut(s, "=")
+	switch kind {
+	case outputJSON, outputYAML, outputTable, outputTree, outputDot, outputGovulncheck:
+		if hasTemplate {
+			return fmt.Errorf("unexpected '=' in output format %q", s)
+		}
+	case "list":
+		// support the name of the deprecated --list flag
+		kind = outputTable
+	case outputTemplate:
+		if tmpl == "" {
+			return fmt.Errorf("a Go text template must be provided, ex: -o 'template={{.Path}}'")
+		}
+	default:
+		return fmt.Errorf("invalid output format %q, must be one of json, yaml, table, tree, dot, govulncheck, or template=...", s)
+	}
+	f.kind, f.template = kind, tmpl
+	f.n++
+	return nil
+}
+
+// Type returns a string description of the value type
+func (f *outputFormat) Type() string {
+	return "format"
+}
+
+// resolve validates the selected output format against the list of formats supported by the current
+// command and returns the format to use.  If no format was specified, defaultFormat is used if the command
+// supports it, otherwise the first supported format is used.
+func (f *outputFormat) resolve(defaultFormat string, supported ...string) (string, error) {
+	if f.n > 1 {
+		return "", fmt.Errorf("only one output format may be specified")
+	}
+	kind := f.kind
+	if kind == "" {
+		kind = supported[0]
+		if slices.Contains(supported, defaultFormat) {
+			kind = defaultFormat
+		}
+	}
+	if slices.Contains(supported, kind) {
+		return kind, nil
+	}
+	return "", fmt.Errorf("the %q output format is not supported for this command, must be one of %s", kind, strings.Join(supported, ", "))
+}
+
+// outputFormatAlias is a boolean [pflag.Value] that assigns a specific output format when set.  This is
+// used to implement the --tree shorthand and the deprecated --json, --list, and --dot flags.
+type outputFormatAlias struct {
+	target *outputFormat
+	kind   string
+}
+
+// String returns the string representation of the flag value
+func (a outputFormatAlias) String() string {
+	return fmt.Sprintf("%v", a.target.kind == a.kind)
+}
+
+// Set assigns the aliased output format if s is "true"
+func (a outputFormatAlias) Set(s string) error {
+	if s != "true" {
+		return nil
+	}
+	return a.target.Set(a.kind)
+}
+
+// Type returns a string description of the value type
+func (a outputFormatAlias) Type() string {
+	return "bool"
+}
+
+// outputTemplateAlias is a [pflag.Value] that assigns a Go text template output format.  This is used
+// to implement the deprecated -f/--format flag.
+type outputTemplateAlias struct {
+	target *outputFormat
+}
+
+// String returns the string representation of the flag value
+func (a outputTemplateAlias) String() string {
+	return a.target.template
+}
+
+// Set assigns a template output format using s as the Go text template
+func (a outputTemplateAlias) Set(s string) error {
+	return a.target.Set(outputTemplate + "=" + s)
+}
+
+// Type returns a string description of the value type
+func (a outputTemplateAlias) Type() string {
+	return "string"
+}
+
+// addOutputFormatFlags registers the -o/--output-format flag, along with the specified aliases, on fset.
+func addOutputFormatFlags(fset *pflag.FlagSet, usage string, aliases ...string) {
+	fset.VarP(&outputFmt, "output-format", "o", usage)
+	for _, alias := range aliases {
+		switch alias {
+		case "json", "list", "dot":
+			kind := alias
+			if kind == "list" {
+				kind = outputTable
+			}
+			fl := fset.VarPF(outputFormatAlias{target: &outputFmt, kind: kind}, alias, "", "")
+			fl.NoOptDefVal = "true"
+			_ = fset.MarkDeprecated(alias, "use -o "+kind+" instead")
+		case "tree":
+			fl := fset.VarPF(outputFormatAlias{target: &outputFmt, kind: outputTree}, alias, "", "shorthand for -o tree")
+			fl.NoOptDefVal = "true"
+		case "format":
+			fset.VarP(outputTemplateAlias{target: &outputFmt}, alias, "f", "")
+			_ = fset.MarkDeprecated(alias, "use -o template=... instead")
+		}
+	}
+}
+
+// resultWriter is an [io.Writer] for command results.  If an output file was specified using the
+// -O/--output CLI flag, results are written to a temporary file in the same directory which then
+// atomically replaces the target file when [resultWriter.Commit] is called.  Otherwise, results are
+// written directly to stdout.
+type resultWriter struct {
+	w    io.Writer
+	tmp  *os.File
+	path string
+}
+
+// newResultWriter returns a [resultWriter] that writes to the file at path, or to stdout if path is empty.
+func newResultWriter(path string) (*resultWriter, error) {
+	if path == "" || path == "-" {
+		return &resultWriter{w: os.Stdout}, nil
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create the output file: %w", err)
+	}
+	// CreateTemp uses 0600, so switch to the usual permissions for a newly created file
+	if err := tmp.Chmod(0o644); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return nil, fmt.Errorf("Unable to create the output file: %w", err)
+	}
+	// don't write terminal escape codes to the file
+	color.NoColor = true
+	return &resultWriter{w: tmp, tmp: tmp, path: path}, nil
+}
+
+// Write satisfies the [io.Writer] interface
+func (rw *resultWriter) Write(p []byte) (int, error) {
+	return rw.w.Write(p)
+}
+
+// Commit completes the output, moving the temporary file into place if writing to a file.
+func (rw *resultWriter) Commit() error {
+	if rw.tmp == nil {
+		return nil
+	}
+	tmp := rw.tmp
+	rw.tmp = nil
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("Unable to write the output file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), rw.path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("Unable to write the output file: %w", err)
+	}
+	return nil
+}
+
+// Abort discards any output written to the temporary file.  This is a no-op if [resultWriter.Commit]
+// has already been called or if writing to stdout.
+func (rw *resultWriter) Abort() {
+	if rw.tmp == nil {
+		return
+	}
+	_ = rw.tmp.Close()
+	_ = os.Remove(rw.tmp.Name())
+	rw.tmp = nil
+}
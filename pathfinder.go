@@ -2,18 +2,25 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"runtime"
+	"slices"
 	"sync"
+	"sync/atomic"
 
 	"golang.org/x/mod/module"
 
+	"github.com/CrowdStrike/perseus/client"
 	"github.com/CrowdStrike/perseus/perseusapi"
-	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
 )
 
+// maxPathFinderQueries is the maximum number of modules whose dependencies are queried while searching for
+// paths, which protects against searches that would never finish, especially when the depth is unlimited
+const maxPathFinderQueries = 10000
+
 // newPathFinder initializes and returns a new [pathFinder] instance using the provided Perseus
 // client, maximum depth, and status callback.
-func newPathFinder(c perseusapiconnect.PerseusServiceClient, maxDepth int, status func(string)) pathFinder {
+func newPathFinder(c *client.Client, maxDepth int, status func(string)) pathFinder {
 	return pathFinder{
 		c:        c,
 		maxDepth: maxDepth,
@@ -21,15 +28,17 @@ func newPathFinder(c perseusapiconnect.PerseusServiceClient, maxDepth int, statu
 	}
 }
 
-// pathFinder queries the Perseus database to contruct dependency paths of up to maxDepth steps between
-// two modules.
+// pathFinder queries the Perseus database to contruct dependency paths of up to maxDepth steps, or any
+// number of steps if maxDepth is 0, between two modules.
 type pathFinder struct {
-	c        perseusapiconnect.PerseusServiceClient
+	c        *client.Client
 	status   func(string)
 	maxDepth int
 
 	sem chan struct{}
 	wg  *sync.WaitGroup
+	// the number of modules whose dependencies have been queried
+	queries *atomic.Int32
 }
 
 // pathFinderResult defines the result items produced by [pathFinder.findPathsBetween].  Each result
@@ -51,6 +60,7 @@ func (pf *pathFinder) findPathsBetween(ctx context.Context, from, to module.Vers
 	}
 	// wait group to monitor outstanding async tasks
 	pf.wg = &sync.WaitGroup{}
+	pf.queries = &atomic.Int32{}
 
 	results := make(chan pathFinderResult)
 	pf.wg.Add(1)
@@ -78,9 +88,13 @@ func (pf *pathFinder) recursiveSearch(ctx context.Context, chain []module.Versio
 		rc <- pathFinderResult{err: ctx.Err()}
 		return
 	default:
+		if pf.queries.Add(1) > maxPathFinderQueries {
+			rc <- pathFinderResult{err: fmt.Errorf("The search was stopped after querying %d modules, specify a smaller --max-depth", maxPathFinderQueries)}
+			return
+		}
 		from := chain[len(chain)-1]
 		// query the graph for direct dependencies of from
-		deps, err := walkDependencies(ctx, pf.c, from, perseusapi.DependencyDirection_dependencies, 1, 1, pf.status)
+		deps, err := walkDependencies(ctx, pf.c, from, perseusapi.DependencyDirection_dependencies, 1, pf.status)
 		if err != nil {
 			rc <- pathFinderResult{err: err}
 			return
@@ -103,8 +117,12 @@ func (pf *pathFinder) recursiveSearch(ctx context.Context, chain []module.Versio
 			}
 		}
 		// recurse down the graph if we haven't hit max yet
-		if depth <= pf.maxDepth {
+		if pf.maxDepth == 0 || depth <= pf.maxDepth {
 			for _, c := range children {
+				// a module that is already in the chain is a cycle, which would never end
+				if slices.Contains(chain, c) {
+					continue
+				}
 				pf.wg.Add(1)
 				go func(c module.Version) {
 					defer pf.wg.Done()
@@ -1,16 +1,19 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.33.0
+// 	protoc-gen-go v1.35.1
 // 	protoc        (unknown)
 // source: perseus.proto
 
 package perseusapi
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -71,6 +74,55 @@ func (ModuleVersionOption) EnumDescriptor() ([]byte, []int) {
 	return file_perseus_proto_rawDescGZIP(), []int{0}
 }
 
+// specifies the order of the versions of each module returned by ListModuleVersions
+type ModuleVersionSort int32
+
+const (
+	// highest to lowest semantic version
+	ModuleVersionSort_semver ModuleVersionSort = 0
+	// most to least recently added to the graph
+	ModuleVersionSort_date ModuleVersionSort = 1
+)
+
+// Enum value maps for ModuleVersionSort.
+var (
+	ModuleVersionSort_name = map[int32]string{
+		0: "semver",
+		1: "date",
+	}
+	ModuleVersionSort_value = map[string]int32{
+		"semver": 0,
+		"date":   1,
+	}
+)
+
+func (x ModuleVersionSort) Enum() *ModuleVersionSort {
+	p := new(ModuleVersionSort)
+	*p = x
+	return p
+}
+
+func (x ModuleVersionSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModuleVersionSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_perseus_proto_enumTypes[1].Descriptor()
+}
+
+func (ModuleVersionSort) Type() protoreflect.EnumType {
+	return &file_perseus_proto_enumTypes[1]
+}
+
+func (x ModuleVersionSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModuleVersionSort.Descriptor instead.
+func (ModuleVersionSort) EnumDescriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{1}
+}
+
 type DependencyDirection int32
 
 const (
@@ -101,11 +153,11 @@ func (x DependencyDirection) String() string {
 }
 
 func (DependencyDirection) Descriptor() protoreflect.EnumDescriptor {
-	return file_perseus_proto_enumTypes[1].Descriptor()
+	return file_perseus_proto_enumTypes[2].Descriptor()
 }
 
 func (DependencyDirection) Type() protoreflect.EnumType {
-	return &file_perseus_proto_enumTypes[1]
+	return &file_perseus_proto_enumTypes[2]
 }
 
 func (x DependencyDirection) Number() protoreflect.EnumNumber {
@@ -114,7 +166,53 @@ func (x DependencyDirection) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DependencyDirection.Descriptor instead.
 func (DependencyDirection) EnumDescriptor() ([]byte, []int) {
-	return file_perseus_proto_rawDescGZIP(), []int{1}
+	return file_perseus_proto_rawDescGZIP(), []int{2}
+}
+
+type RenderFormat int32
+
+const (
+	RenderFormat_svg RenderFormat = 0
+	RenderFormat_png RenderFormat = 1
+)
+
+// Enum value maps for RenderFormat.
+var (
+	RenderFormat_name = map[int32]string{
+		0: "svg",
+		1: "png",
+	}
+	RenderFormat_value = map[string]int32{
+		"svg": 0,
+		"png": 1,
+	}
+)
+
+func (x RenderFormat) Enum() *RenderFormat {
+	p := new(RenderFormat)
+	*p = x
+	return p
+}
+
+func (x RenderFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RenderFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_perseus_proto_enumTypes[3].Descriptor()
+}
+
+func (RenderFormat) Type() protoreflect.EnumType {
+	return &file_perseus_proto_enumTypes[3]
+}
+
+func (x RenderFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RenderFormat.Descriptor instead.
+func (RenderFormat) EnumDescriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{3}
 }
 
 // A Module is the sole entity within the system, uniquely identified by its name.
@@ -128,15 +226,29 @@ type Module struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// A list of 0 or more Semantic Version strings that define released versions of the module
 	Versions []string `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	// The following fields are only populated by QueryDependencies when the request asks for them using
+	// its 'enrich' field, and only if the server has been configured to use the deps.dev API.  They
+	// describe the single version of the module in the results.
+	//
+	// The OpenSSF Scorecard score, from 0 to 10, of the module's source repository, if it is known
+	Scorecard *float64 `protobuf:"fixed64,3,opt,name=scorecard,proto3,oneof" json:"scorecard,omitempty"`
+	// The SPDX identifiers of the licenses of the module version
+	Licenses []string `protobuf:"bytes,4,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	// The IDs of the security advisories that affect the module version, ex: GHSA-xxxx-xxxx-xxxx
+	Advisories []string `protobuf:"bytes,5,rep,name=advisories,proto3" json:"advisories,omitempty"`
+	// The time that each of the versions was added to the graph, in the same order as 'versions', which
+	// approximates its release date.  Only populated by ListModuleVersions when all versions are requested.
+	VersionTimes []*timestamppb.Timestamp `protobuf:"bytes,6,rep,name=version_times,json=versionTimes,proto3" json:"version_times,omitempty"`
+	// The version of the queried module that this module version depends on.  Only populated by
+	// QueryDependencies when a version range is requested.
+	DependencyVersion string `protobuf:"bytes,7,opt,name=dependency_version,json=dependencyVersion,proto3" json:"dependency_version,omitempty"`
 }
 
 func (x *Module) Reset() {
 	*x = Module{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Module) String() string {
@@ -147,7 +259,7 @@ func (*Module) ProtoMessage() {}
 
 func (x *Module) ProtoReflect() protoreflect.Message {
 	mi := &file_perseus_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -176,6 +288,41 @@ func (x *Module) GetVersions() []string {
 	return nil
 }
 
+func (x *Module) GetScorecard() float64 {
+	if x != nil && x.Scorecard != nil {
+		return *x.Scorecard
+	}
+	return 0
+}
+
+func (x *Module) GetLicenses() []string {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+func (x *Module) GetAdvisories() []string {
+	if x != nil {
+		return x.Advisories
+	}
+	return nil
+}
+
+func (x *Module) GetVersionTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.VersionTimes
+	}
+	return nil
+}
+
+func (x *Module) GetDependencyVersion() string {
+	if x != nil {
+		return x.DependencyVersion
+	}
+	return ""
+}
+
 type CreateModuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -186,11 +333,9 @@ type CreateModuleRequest struct {
 
 func (x *CreateModuleRequest) Reset() {
 	*x = CreateModuleRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CreateModuleRequest) String() string {
@@ -201,7 +346,7 @@ func (*CreateModuleRequest) ProtoMessage() {}
 
 func (x *CreateModuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_perseus_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -233,11 +378,9 @@ type CreateModuleResponse struct {
 
 func (x *CreateModuleResponse) Reset() {
 	*x = CreateModuleResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CreateModuleResponse) String() string {
@@ -248,7 +391,7 @@ func (*CreateModuleResponse) ProtoMessage() {}
 
 func (x *CreateModuleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_perseus_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -282,11 +425,9 @@ type ListModulesRequest struct {
 
 func (x *ListModulesRequest) Reset() {
 	*x = ListModulesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListModulesRequest) String() string {
@@ -297,7 +438,7 @@ func (*ListModulesRequest) ProtoMessage() {}
 
 func (x *ListModulesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_perseus_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -344,11 +485,9 @@ type ListModulesResponse struct {
 
 func (x *ListModulesResponse) Reset() {
 	*x = ListModulesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListModulesResponse) String() string {
@@ -359,7 +498,7 @@ func (*ListModulesResponse) ProtoMessage() {}
 
 func (x *ListModulesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_perseus_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -397,23 +536,37 @@ type ListModuleVersionsRequest struct {
 	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
 	// glob pattern for the module(s) to return
 	ModuleFilter string `protobuf:"bytes,5,opt,name=module_filter,json=moduleFilter,proto3" json:"module_filter,omitempty"`
-	// glob pattern for the version(s) to return
+	// glob pattern, such as v1.*, or range of semantic versions, such as ">=v1.4.0 <v2.0.0", ~v1.4, or ^v2, for the
+	// version(s) to return
 	VersionFilter string `protobuf:"bytes,6,opt,name=version_filter,json=versionFilter,proto3" json:"version_filter,omitempty"`
 	// indicates whether or not matching pre-release versions should be returned
 	IncludePrerelease bool `protobuf:"varint,7,opt,name=include_prerelease,json=includePrerelease,proto3" json:"include_prerelease,omitempty"`
+	// indicates that pseudo-versions, such as v0.0.0-20240101120000-abcdef123456, should not be returned,
+	// which excludes them when determining the latest version
+	ExcludePseudo bool `protobuf:"varint,8,opt,name=exclude_pseudo,json=excludePseudo,proto3" json:"exclude_pseudo,omitempty"`
+	// indicates that versions of the other major versions of the matching module(s), such as example.com/foo/v2
+	// for example.com/foo, should also be returned
+	AllMajors bool `protobuf:"varint,9,opt,name=all_majors,json=allMajors,proto3" json:"all_majors,omitempty"`
+	// if specified, only versions that were added to the graph at or before this time are returned
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// if specified, only versions that were added to the graph at or after this time are returned
+	Since *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=since,proto3" json:"since,omitempty"`
 	// indicates which matching version(s) should be returned
 	VersionOption ModuleVersionOption `protobuf:"varint,2,opt,name=version_option,json=versionOption,proto3,enum=crowdstrike.perseus.perseusapi.ModuleVersionOption" json:"version_option,omitempty"`
-	PageToken     string              `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize      int32               `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the order of the versions of each module, which defaults to highest to lowest semantic version
+	Sort ModuleVersionSort `protobuf:"varint,11,opt,name=sort,proto3,enum=crowdstrike.perseus.perseusapi.ModuleVersionSort" json:"sort,omitempty"`
+	// if non-zero, the maximum number of versions to return for each matching module, which are the first
+	// versions in the requested sort order, ex: the 5 most recent releases
+	Limit     int32  `protobuf:"varint,12,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
 func (x *ListModuleVersionsRequest) Reset() {
 	*x = ListModuleVersionsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListModuleVersionsRequest) String() string {
@@ -424,7 +577,7 @@ func (*ListModuleVersionsRequest) ProtoMessage() {}
 
 func (x *ListModuleVersionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_perseus_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -467,6 +620,34 @@ func (x *ListModuleVersionsRequest) GetIncludePrerelease() bool {
 	return false
 }
 
+func (x *ListModuleVersionsRequest) GetExcludePseudo() bool {
+	if x != nil {
+		return x.ExcludePseudo
+	}
+	return false
+}
+
+func (x *ListModuleVersionsRequest) GetAllMajors() bool {
+	if x != nil {
+		return x.AllMajors
+	}
+	return false
+}
+
+func (x *ListModuleVersionsRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *ListModuleVersionsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
 func (x *ListModuleVersionsRequest) GetVersionOption() ModuleVersionOption {
 	if x != nil {
 		return x.VersionOption
@@ -474,6 +655,20 @@ func (x *ListModuleVersionsRequest) GetVersionOption() ModuleVersionOption {
 	return ModuleVersionOption_none
 }
 
+func (x *ListModuleVersionsRequest) GetSort() ModuleVersionSort {
+	if x != nil {
+		return x.Sort
+	}
+	return ModuleVersionSort_semver
+}
+
+func (x *ListModuleVersionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 func (x *ListModuleVersionsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
@@ -499,11 +694,9 @@ type ListModuleVersionsResponse struct {
 
 func (x *ListModuleVersionsResponse) Reset() {
 	*x = ListModuleVersionsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListModuleVersionsResponse) String() string {
@@ -514,7 +707,7 @@ func (*ListModuleVersionsResponse) ProtoMessage() {}
 
 func (x *ListModuleVersionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_perseus_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -551,15 +744,18 @@ type UpdateDependenciesRequest struct {
 	ModuleName   string    `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
 	Version      string    `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	Dependencies []*Module `protobuf:"bytes,3,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// if true, stored dependencies of the module version that are not listed in 'dependencies' are removed
+	Prune bool `protobuf:"varint,4,opt,name=prune,proto3" json:"prune,omitempty"`
+	// if true, the request is validated and the changes that it would make are returned in the response, but
+	// the graph is not updated
+	ValidateOnly bool `protobuf:"varint,5,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 }
 
 func (x *UpdateDependenciesRequest) Reset() {
 	*x = UpdateDependenciesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpdateDependenciesRequest) String() string {
@@ -570,7 +766,7 @@ func (*UpdateDependenciesRequest) ProtoMessage() {}
 
 func (x *UpdateDependenciesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_perseus_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -606,19 +802,38 @@ func (x *UpdateDependenciesRequest) GetDependencies() []*Module {
 	return nil
 }
 
+func (x *UpdateDependenciesRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+func (x *UpdateDependenciesRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
 type UpdateDependenciesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the direct dependencies that would be added to the module version.  Only populated if 'validate_only'
+	// was set in the request.
+	AddedDependencies []*Module `protobuf:"bytes,1,rep,name=added_dependencies,json=addedDependencies,proto3" json:"added_dependencies,omitempty"`
+	// the direct dependencies that would be removed from the module version because 'prune' was set.  Only
+	// populated if 'validate_only' was set in the request.
+	RemovedDependencies []*Module `protobuf:"bytes,2,rep,name=removed_dependencies,json=removedDependencies,proto3" json:"removed_dependencies,omitempty"`
 }
 
 func (x *UpdateDependenciesResponse) Reset() {
 	*x = UpdateDependenciesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpdateDependenciesResponse) String() string {
@@ -629,7 +844,7 @@ func (*UpdateDependenciesResponse) ProtoMessage() {}
 
 func (x *UpdateDependenciesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_perseus_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -644,25 +859,131 @@ func (*UpdateDependenciesResponse) Descriptor() ([]byte, []int) {
 	return file_perseus_proto_rawDescGZIP(), []int{8}
 }
 
+func (x *UpdateDependenciesResponse) GetAddedDependencies() []*Module {
+	if x != nil {
+		return x.AddedDependencies
+	}
+	return nil
+}
+
+func (x *UpdateDependenciesResponse) GetRemovedDependencies() []*Module {
+	if x != nil {
+		return x.RemovedDependencies
+	}
+	return nil
+}
+
+type BatchUpdateDependenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the updates to apply, in order
+	Updates []*UpdateDependenciesRequest `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (x *BatchUpdateDependenciesRequest) Reset() {
+	*x = BatchUpdateDependenciesRequest{}
+	mi := &file_perseus_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateDependenciesRequest) ProtoMessage() {}
+
+func (x *BatchUpdateDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchUpdateDependenciesRequest) GetUpdates() []*UpdateDependenciesRequest {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type BatchUpdateDependenciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BatchUpdateDependenciesResponse) Reset() {
+	*x = BatchUpdateDependenciesResponse{}
+	mi := &file_perseus_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateDependenciesResponse) ProtoMessage() {}
+
+func (x *BatchUpdateDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{10}
+}
+
 type QueryDependenciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModuleName string              `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
-	Version    string              `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Direction  DependencyDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=crowdstrike.perseus.perseusapi.DependencyDirection" json:"direction,omitempty"`
-	PageToken  string              `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize   int32               `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// if specified instead of a version, the results are the modules that depend on any version of the
+	// module in this range of semantic versions, ex: "<v1.56.3", along with the version that each one
+	// depends on.  Only supported for dependents.
+	VersionRange string              `protobuf:"bytes,9,opt,name=version_range,json=versionRange,proto3" json:"version_range,omitempty"`
+	Direction    DependencyDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=crowdstrike.perseus.perseusapi.DependencyDirection" json:"direction,omitempty"`
+	PageToken    string              `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize     int32               `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// if true, the results also include the dependencies of the same version of any module that the
+	// specified module was renamed from or to
+	FollowRenames bool `protobuf:"varint,6,opt,name=follow_renames,json=followRenames,proto3" json:"follow_renames,omitempty"`
+	// if specified, the results are the dependencies or dependents that were declared at this time
+	// rather than the current ones
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// optional deps.dev data to add to each result, any of "scorecard", "licenses" or "advisories"
+	Enrich []string `protobuf:"bytes,8,rep,name=enrich,proto3" json:"enrich,omitempty"`
 }
 
 func (x *QueryDependenciesRequest) Reset() {
 	*x = QueryDependenciesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *QueryDependenciesRequest) String() string {
@@ -672,8 +993,8 @@ func (x *QueryDependenciesRequest) String() string {
 func (*QueryDependenciesRequest) ProtoMessage() {}
 
 func (x *QueryDependenciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_perseus_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_perseus_proto_msgTypes[11]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -685,7 +1006,7 @@ func (x *QueryDependenciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDependenciesRequest.ProtoReflect.Descriptor instead.
 func (*QueryDependenciesRequest) Descriptor() ([]byte, []int) {
-	return file_perseus_proto_rawDescGZIP(), []int{9}
+	return file_perseus_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryDependenciesRequest) GetModuleName() string {
@@ -702,6 +1023,13 @@ func (x *QueryDependenciesRequest) GetVersion() string {
 	return ""
 }
 
+func (x *QueryDependenciesRequest) GetVersionRange() string {
+	if x != nil {
+		return x.VersionRange
+	}
+	return ""
+}
+
 func (x *QueryDependenciesRequest) GetDirection() DependencyDirection {
 	if x != nil {
 		return x.Direction
@@ -723,6 +1051,27 @@ func (x *QueryDependenciesRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *QueryDependenciesRequest) GetFollowRenames() bool {
+	if x != nil {
+		return x.FollowRenames
+	}
+	return false
+}
+
+func (x *QueryDependenciesRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *QueryDependenciesRequest) GetEnrich() []string {
+	if x != nil {
+		return x.Enrich
+	}
+	return nil
+}
+
 type QueryDependenciesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -734,11 +1083,9 @@ type QueryDependenciesResponse struct {
 
 func (x *QueryDependenciesResponse) Reset() {
 	*x = QueryDependenciesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_perseus_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_perseus_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *QueryDependenciesResponse) String() string {
@@ -748,8 +1095,8 @@ func (x *QueryDependenciesResponse) String() string {
 func (*QueryDependenciesResponse) ProtoMessage() {}
 
 func (x *QueryDependenciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_perseus_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_perseus_proto_msgTypes[12]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -761,56 +1108,5096 @@ func (x *QueryDependenciesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDependenciesResponse.ProtoReflect.Descriptor instead.
 func (*QueryDependenciesResponse) Descriptor() ([]byte, []int) {
-	return file_perseus_proto_rawDescGZIP(), []int{10}
+	return file_perseus_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryDependenciesResponse) GetModules() []*Module {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *QueryDependenciesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetDependencyHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GetDependencyHistoryRequest) Reset() {
+	*x = GetDependencyHistoryRequest{}
+	mi := &file_perseus_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependencyHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependencyHistoryRequest) ProtoMessage() {}
+
+func (x *GetDependencyHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependencyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDependencyHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetDependencyHistoryRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *GetDependencyHistoryRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type GetDependencyHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dependencies []*GetDependencyHistoryResponse_Dependency `protobuf:"bytes,1,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (x *GetDependencyHistoryResponse) Reset() {
+	*x = GetDependencyHistoryResponse{}
+	mi := &file_perseus_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependencyHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependencyHistoryResponse) ProtoMessage() {}
+
+func (x *GetDependencyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependencyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDependencyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetDependencyHistoryResponse) GetDependencies() []*GetDependencyHistoryResponse_Dependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type DiffGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// an optional glob pattern specifying which module(s) should be compared
+	ModuleFilter string `protobuf:"bytes,1,opt,name=module_filter,json=moduleFilter,proto3" json:"module_filter,omitempty"`
+	// the start of the window
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	// the end of the window
+	ToTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+}
+
+func (x *DiffGraphRequest) Reset() {
+	*x = DiffGraphRequest{}
+	mi := &file_perseus_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffGraphRequest) ProtoMessage() {}
+
+func (x *DiffGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffGraphRequest.ProtoReflect.Descriptor instead.
+func (*DiffGraphRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DiffGraphRequest) GetModuleFilter() string {
+	if x != nil {
+		return x.ModuleFilter
+	}
+	return ""
+}
+
+func (x *DiffGraphRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *DiffGraphRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+type DiffGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the modules that were part of at least 1 dependency edge at the end of the window but not at the start
+	AddedModules []string `protobuf:"bytes,1,rep,name=added_modules,json=addedModules,proto3" json:"added_modules,omitempty"`
+	// the modules that were part of at least 1 dependency edge at the start of the window but not at the end
+	RemovedModules []string `protobuf:"bytes,2,rep,name=removed_modules,json=removedModules,proto3" json:"removed_modules,omitempty"`
+	// the dependency edges that were declared at the end of the window but not at the start
+	AddedEdges []*DiffGraphResponse_Edge `protobuf:"bytes,3,rep,name=added_edges,json=addedEdges,proto3" json:"added_edges,omitempty"`
+	// the dependency edges that were declared at the start of the window but not at the end
+	RemovedEdges []*DiffGraphResponse_Edge `protobuf:"bytes,4,rep,name=removed_edges,json=removedEdges,proto3" json:"removed_edges,omitempty"`
+}
+
+func (x *DiffGraphResponse) Reset() {
+	*x = DiffGraphResponse{}
+	mi := &file_perseus_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffGraphResponse) ProtoMessage() {}
+
+func (x *DiffGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffGraphResponse.ProtoReflect.Descriptor instead.
+func (*DiffGraphResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiffGraphResponse) GetAddedModules() []string {
+	if x != nil {
+		return x.AddedModules
+	}
+	return nil
+}
+
+func (x *DiffGraphResponse) GetRemovedModules() []string {
+	if x != nil {
+		return x.RemovedModules
+	}
+	return nil
+}
+
+func (x *DiffGraphResponse) GetAddedEdges() []*DiffGraphResponse_Edge {
+	if x != nil {
+		return x.AddedEdges
+	}
+	return nil
+}
+
+func (x *DiffGraphResponse) GetRemovedEdges() []*DiffGraphResponse_Edge {
+	if x != nil {
+		return x.RemovedEdges
+	}
+	return nil
+}
+
+type StreamEdgesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// an optional glob pattern specifying which dependent module(s) should be included
+	ModuleFilter string `protobuf:"bytes,1,opt,name=module_filter,json=moduleFilter,proto3" json:"module_filter,omitempty"`
+	// an optional glob pattern specifying which dependency module(s) should be included
+	DependencyFilter string `protobuf:"bytes,2,opt,name=dependency_filter,json=dependencyFilter,proto3" json:"dependency_filter,omitempty"`
+	// if set, the edges that were declared at this time are returned instead of the current ones
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *StreamEdgesRequest) Reset() {
+	*x = StreamEdgesRequest{}
+	mi := &file_perseus_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEdgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEdgesRequest) ProtoMessage() {}
+
+func (x *StreamEdgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEdgesRequest.ProtoReflect.Descriptor instead.
+func (*StreamEdgesRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StreamEdgesRequest) GetModuleFilter() string {
+	if x != nil {
+		return x.ModuleFilter
+	}
+	return ""
+}
+
+func (x *StreamEdgesRequest) GetDependencyFilter() string {
+	if x != nil {
+		return x.DependencyFilter
+	}
+	return ""
+}
+
+func (x *StreamEdgesRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type StreamEdgesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the dependent module, which always has exactly 1 version
+	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// the dependency, which always has exactly 1 version
+	Dependency *Module `protobuf:"bytes,2,opt,name=dependency,proto3" json:"dependency,omitempty"`
+}
+
+func (x *StreamEdgesResponse) Reset() {
+	*x = StreamEdgesResponse{}
+	mi := &file_perseus_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEdgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEdgesResponse) ProtoMessage() {}
+
+func (x *StreamEdgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEdgesResponse.ProtoReflect.Descriptor instead.
+func (*StreamEdgesResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StreamEdgesResponse) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *StreamEdgesResponse) GetDependency() *Module {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
+type ListTopModulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// an optional glob pattern specifying which module(s) should be ranked
+	ModuleFilter string `protobuf:"bytes,1,opt,name=module_filter,json=moduleFilter,proto3" json:"module_filter,omitempty"`
+	// the metric used to rank the modules, either "dependents" (the default) or "importance"
+	By string `protobuf:"bytes,2,opt,name=by,proto3" json:"by,omitempty"`
+	// the maximum number of modules to return, which defaults to 20
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTopModulesRequest) Reset() {
+	*x = ListTopModulesRequest{}
+	mi := &file_perseus_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopModulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopModulesRequest) ProtoMessage() {}
+
+func (x *ListTopModulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopModulesRequest.ProtoReflect.Descriptor instead.
+func (*ListTopModulesRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTopModulesRequest) GetModuleFilter() string {
+	if x != nil {
+		return x.ModuleFilter
+	}
+	return ""
+}
+
+func (x *ListTopModulesRequest) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+func (x *ListTopModulesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTopModulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Modules []*ListTopModulesResponse_RankedModule `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (x *ListTopModulesResponse) Reset() {
+	*x = ListTopModulesResponse{}
+	mi := &file_perseus_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopModulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopModulesResponse) ProtoMessage() {}
+
+func (x *ListTopModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopModulesResponse.ProtoReflect.Descriptor instead.
+func (*ListTopModulesResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTopModulesResponse) GetModules() []*ListTopModulesResponse_RankedModule {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type GetModuleMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (x *GetModuleMetricsRequest) Reset() {
+	*x = GetModuleMetricsRequest{}
+	mi := &file_perseus_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleMetricsRequest) ProtoMessage() {}
+
+func (x *GetModuleMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetModuleMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetModuleMetricsRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+type GetModuleMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the number of distinct modules that depend directly on any version of the module
+	DirectDependents int32 `protobuf:"varint,1,opt,name=direct_dependents,json=directDependents,proto3" json:"direct_dependents,omitempty"`
+	// the number of distinct modules that any version of the module depends on directly
+	DirectDependencies int32 `protobuf:"varint,2,opt,name=direct_dependencies,json=directDependencies,proto3" json:"direct_dependencies,omitempty"`
+	// the number of distinct modules that depend directly or transitively on any version of the module
+	TransitiveDependents int32 `protobuf:"varint,3,opt,name=transitive_dependents,json=transitiveDependents,proto3" json:"transitive_dependents,omitempty"`
+	// the number of distinct modules that any version of the module depends on directly or transitively
+	TransitiveDependencies int32 `protobuf:"varint,4,opt,name=transitive_dependencies,json=transitiveDependencies,proto3" json:"transitive_dependencies,omitempty"`
+	// the module's PageRank within the module-level dependency graph, scaled so that the average is 1
+	Importance float64 `protobuf:"fixed64,5,opt,name=importance,proto3" json:"importance,omitempty"`
+	// true if the module's source repository has been archived, as last reported by the GitHub or GitLab
+	// API to the server's check-upstreams job
+	Archived bool `protobuf:"varint,6,opt,name=archived,proto3" json:"archived,omitempty"`
+	// the time of the most recent commit to the module's source repository, if it is known
+	LastCommitTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_commit_time,json=lastCommitTime,proto3" json:"last_commit_time,omitempty"`
+}
+
+func (x *GetModuleMetricsResponse) Reset() {
+	*x = GetModuleMetricsResponse{}
+	mi := &file_perseus_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleMetricsResponse) ProtoMessage() {}
+
+func (x *GetModuleMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetModuleMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetModuleMetricsResponse) GetDirectDependents() int32 {
+	if x != nil {
+		return x.DirectDependents
+	}
+	return 0
+}
+
+func (x *GetModuleMetricsResponse) GetDirectDependencies() int32 {
+	if x != nil {
+		return x.DirectDependencies
+	}
+	return 0
+}
+
+func (x *GetModuleMetricsResponse) GetTransitiveDependents() int32 {
+	if x != nil {
+		return x.TransitiveDependents
+	}
+	return 0
+}
+
+func (x *GetModuleMetricsResponse) GetTransitiveDependencies() int32 {
+	if x != nil {
+		return x.TransitiveDependencies
+	}
+	return 0
+}
+
+func (x *GetModuleMetricsResponse) GetImportance() float64 {
+	if x != nil {
+		return x.Importance
+	}
+	return 0
+}
+
+func (x *GetModuleMetricsResponse) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *GetModuleMetricsResponse) GetLastCommitTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCommitTime
+	}
+	return nil
+}
+
+type ListModuleConsumersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// if true, the modules that depend on every major version of the specified module are returned,
+	// ex: example.com/foo/v2 for example.com/foo
+	AllMajors bool `protobuf:"varint,2,opt,name=all_majors,json=allMajors,proto3" json:"all_majors,omitempty"`
+}
+
+func (x *ListModuleConsumersRequest) Reset() {
+	*x = ListModuleConsumersRequest{}
+	mi := &file_perseus_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModuleConsumersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModuleConsumersRequest) ProtoMessage() {}
+
+func (x *ListModuleConsumersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModuleConsumersRequest.ProtoReflect.Descriptor instead.
+func (*ListModuleConsumersRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListModuleConsumersRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ListModuleConsumersRequest) GetAllMajors() bool {
+	if x != nil {
+		return x.AllMajors
+	}
+	return false
+}
+
+type ListModuleConsumersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the consumed versions, ordered by module name then by descending version
+	Versions []*ListModuleConsumersResponse_ConsumedVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListModuleConsumersResponse) Reset() {
+	*x = ListModuleConsumersResponse{}
+	mi := &file_perseus_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModuleConsumersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModuleConsumersResponse) ProtoMessage() {}
+
+func (x *ListModuleConsumersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModuleConsumersResponse.ProtoReflect.Descriptor instead.
+func (*ListModuleConsumersResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListModuleConsumersResponse) GetVersions() []*ListModuleConsumersResponse_ConsumedVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type ListModuleStalenessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// a glob pattern specifying which module(s) should be scored
+	ModuleFilter string `protobuf:"bytes,1,opt,name=module_filter,json=moduleFilter,proto3" json:"module_filter,omitempty"`
+	// if true, every version of the matching modules is scored rather than only the latest version
+	AllVersions bool `protobuf:"varint,2,opt,name=all_versions,json=allVersions,proto3" json:"all_versions,omitempty"`
+}
+
+func (x *ListModuleStalenessRequest) Reset() {
+	*x = ListModuleStalenessRequest{}
+	mi := &file_perseus_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModuleStalenessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModuleStalenessRequest) ProtoMessage() {}
+
+func (x *ListModuleStalenessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModuleStalenessRequest.ProtoReflect.Descriptor instead.
+func (*ListModuleStalenessRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListModuleStalenessRequest) GetModuleFilter() string {
+	if x != nil {
+		return x.ModuleFilter
+	}
+	return ""
+}
+
+func (x *ListModuleStalenessRequest) GetAllVersions() bool {
+	if x != nil {
+		return x.AllVersions
+	}
+	return false
+}
+
+type ListModuleStalenessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the scored module versions, ordered by descending score
+	Modules []*ListModuleStalenessResponse_ModuleStaleness `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (x *ListModuleStalenessResponse) Reset() {
+	*x = ListModuleStalenessResponse{}
+	mi := &file_perseus_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModuleStalenessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModuleStalenessResponse) ProtoMessage() {}
+
+func (x *ListModuleStalenessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModuleStalenessResponse.ProtoReflect.Descriptor instead.
+func (*ListModuleStalenessResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListModuleStalenessResponse) GetModules() []*ListModuleStalenessResponse_ModuleStaleness {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type ListAbandonedModulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// an optional glob pattern specifying which module(s) should be returned, defaults to all modules
+	ModuleFilter string `protobuf:"bytes,1,opt,name=module_filter,json=moduleFilter,proto3" json:"module_filter,omitempty"`
+	// the number of years without a commit after which a module is considered abandoned, defaults to 2
+	InactiveYears int32 `protobuf:"varint,2,opt,name=inactive_years,json=inactiveYears,proto3" json:"inactive_years,omitempty"`
+}
+
+func (x *ListAbandonedModulesRequest) Reset() {
+	*x = ListAbandonedModulesRequest{}
+	mi := &file_perseus_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAbandonedModulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAbandonedModulesRequest) ProtoMessage() {}
+
+func (x *ListAbandonedModulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAbandonedModulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAbandonedModulesRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListAbandonedModulesRequest) GetModuleFilter() string {
+	if x != nil {
+		return x.ModuleFilter
+	}
+	return ""
+}
+
+func (x *ListAbandonedModulesRequest) GetInactiveYears() int32 {
+	if x != nil {
+		return x.InactiveYears
+	}
+	return 0
+}
+
+type ListAbandonedModulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the abandoned modules, ordered by descending number of dependents
+	Modules []*ListAbandonedModulesResponse_AbandonedModule `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (x *ListAbandonedModulesResponse) Reset() {
+	*x = ListAbandonedModulesResponse{}
+	mi := &file_perseus_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAbandonedModulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAbandonedModulesResponse) ProtoMessage() {}
+
+func (x *ListAbandonedModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAbandonedModulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAbandonedModulesResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListAbandonedModulesResponse) GetModules() []*ListAbandonedModulesResponse_AbandonedModule {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type ListGhostVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// an optional glob pattern specifying which module(s) should be returned, defaults to all modules
+	ModuleFilter string `protobuf:"bytes,1,opt,name=module_filter,json=moduleFilter,proto3" json:"module_filter,omitempty"`
+}
+
+func (x *ListGhostVersionsRequest) Reset() {
+	*x = ListGhostVersionsRequest{}
+	mi := &file_perseus_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGhostVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGhostVersionsRequest) ProtoMessage() {}
+
+func (x *ListGhostVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGhostVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListGhostVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListGhostVersionsRequest) GetModuleFilter() string {
+	if x != nil {
+		return x.ModuleFilter
+	}
+	return ""
+}
+
+type ListGhostVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the unavailable versions, ordered by descending number of dependents then by module name and
+	// descending version
+	Versions []*ListGhostVersionsResponse_GhostVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListGhostVersionsResponse) Reset() {
+	*x = ListGhostVersionsResponse{}
+	mi := &file_perseus_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGhostVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGhostVersionsResponse) ProtoMessage() {}
+
+func (x *ListGhostVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGhostVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListGhostVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListGhostVersionsResponse) GetVersions() []*ListGhostVersionsResponse_GhostVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type ResolveModuleSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// proposed upgrades, ex: "golang.org/x/net@v0.25.0", that are added to the requirements of the module
+	// before resolving, as if 'go get' had been run for each of them.  A proposed version that is lower
+	// than the version that would otherwise be selected has no effect.  The module path and version of each
+	// upgrade are validated by the server.
+	Upgrades []string `protobuf:"bytes,3,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+}
+
+func (x *ResolveModuleSetRequest) Reset() {
+	*x = ResolveModuleSetRequest{}
+	mi := &file_perseus_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveModuleSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveModuleSetRequest) ProtoMessage() {}
+
+func (x *ResolveModuleSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveModuleSetRequest.ProtoReflect.Descriptor instead.
+func (*ResolveModuleSetRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResolveModuleSetRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ResolveModuleSetRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ResolveModuleSetRequest) GetUpgrades() []string {
+	if x != nil {
+		return x.Upgrades
+	}
+	return nil
+}
+
+type ResolveModuleSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the requested module version followed by the selected version of every other module in its build
+	// list, ordered by module name
+	Modules []*ResolveModuleSetResponse_SelectedModule `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+}
+
+func (x *ResolveModuleSetResponse) Reset() {
+	*x = ResolveModuleSetResponse{}
+	mi := &file_perseus_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveModuleSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveModuleSetResponse) ProtoMessage() {}
+
+func (x *ResolveModuleSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveModuleSetResponse.ProtoReflect.Descriptor instead.
+func (*ResolveModuleSetResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResolveModuleSetResponse) GetModules() []*ResolveModuleSetResponse_SelectedModule {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type CheckPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// the maximum number of levels of dependencies to check, 0 to check every transitive dependency
+	MaxDepth int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// an optional kind of violation to check for, either "module" for the policy's module rules or
+	// "license" for its license rules.  Both are checked if this is empty.
+	Kind string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *CheckPolicyRequest) Reset() {
+	*x = CheckPolicyRequest{}
+	mi := &file_perseus_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPolicyRequest) ProtoMessage() {}
+
+func (x *CheckPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPolicyRequest.ProtoReflect.Descriptor instead.
+func (*CheckPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CheckPolicyRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *CheckPolicyRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CheckPolicyRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *CheckPolicyRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type CheckPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Violations []*CheckPolicyResponse_PolicyViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *CheckPolicyResponse) Reset() {
+	*x = CheckPolicyResponse{}
+	mi := &file_perseus_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPolicyResponse) ProtoMessage() {}
+
+func (x *CheckPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPolicyResponse.ProtoReflect.Descriptor instead.
+func (*CheckPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CheckPolicyResponse) GetViolations() []*CheckPolicyResponse_PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type ListVulnerabilitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// the maximum number of levels of dependencies to check, 0 to check every transitive dependency
+	MaxDepth int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+}
+
+func (x *ListVulnerabilitiesRequest) Reset() {
+	*x = ListVulnerabilitiesRequest{}
+	mi := &file_perseus_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVulnerabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVulnerabilitiesRequest) ProtoMessage() {}
+
+func (x *ListVulnerabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVulnerabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListVulnerabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListVulnerabilitiesRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ListVulnerabilitiesRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ListVulnerabilitiesRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type ListVulnerabilitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Advisories []*ListVulnerabilitiesResponse_Advisory `protobuf:"bytes,1,rep,name=advisories,proto3" json:"advisories,omitempty"`
+	Findings   []*ListVulnerabilitiesResponse_Finding  `protobuf:"bytes,2,rep,name=findings,proto3" json:"findings,omitempty"`
+	// the base URL of the OSV API that the advisories were retrieved from
+	Database string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+}
+
+func (x *ListVulnerabilitiesResponse) Reset() {
+	*x = ListVulnerabilitiesResponse{}
+	mi := &file_perseus_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVulnerabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVulnerabilitiesResponse) ProtoMessage() {}
+
+func (x *ListVulnerabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVulnerabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListVulnerabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListVulnerabilitiesResponse) GetAdvisories() []*ListVulnerabilitiesResponse_Advisory {
+	if x != nil {
+		return x.Advisories
+	}
+	return nil
+}
+
+func (x *ListVulnerabilitiesResponse) GetFindings() []*ListVulnerabilitiesResponse_Finding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ListVulnerabilitiesResponse) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+type RefreshModulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// a glob pattern specifying which module(s) should be refreshed
+	ModuleFilter string `protobuf:"bytes,1,opt,name=module_filter,json=moduleFilter,proto3" json:"module_filter,omitempty"`
+	// an optional glob pattern specifying which version(s) should be refreshed
+	VersionFilter string `protobuf:"bytes,2,opt,name=version_filter,json=versionFilter,proto3" json:"version_filter,omitempty"`
+	// if true, pre-release versions are also refreshed
+	IncludePrerelease bool `protobuf:"varint,3,opt,name=include_prerelease,json=includePrerelease,proto3" json:"include_prerelease,omitempty"`
+	// if true, only the latest version of each matching module is refreshed
+	LatestOnly bool `protobuf:"varint,4,opt,name=latest_only,json=latestOnly,proto3" json:"latest_only,omitempty"`
+}
+
+func (x *RefreshModulesRequest) Reset() {
+	*x = RefreshModulesRequest{}
+	mi := &file_perseus_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshModulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshModulesRequest) ProtoMessage() {}
+
+func (x *RefreshModulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshModulesRequest.ProtoReflect.Descriptor instead.
+func (*RefreshModulesRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RefreshModulesRequest) GetModuleFilter() string {
+	if x != nil {
+		return x.ModuleFilter
+	}
+	return ""
+}
+
+func (x *RefreshModulesRequest) GetVersionFilter() string {
+	if x != nil {
+		return x.VersionFilter
+	}
+	return ""
+}
+
+func (x *RefreshModulesRequest) GetIncludePrerelease() bool {
+	if x != nil {
+		return x.IncludePrerelease
+	}
+	return false
+}
+
+func (x *RefreshModulesRequest) GetLatestOnly() bool {
+	if x != nil {
+		return x.LatestOnly
+	}
+	return false
+}
+
+type RefreshModulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*RefreshModulesResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *RefreshModulesResponse) Reset() {
+	*x = RefreshModulesResponse{}
+	mi := &file_perseus_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshModulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshModulesResponse) ProtoMessage() {}
+
+func (x *RefreshModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshModulesResponse.ProtoReflect.Descriptor instead.
+func (*RefreshModulesResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RefreshModulesResponse) GetResults() []*RefreshModulesResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CheckGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// if true, problems that can be fixed safely are repaired
+	Repair bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+}
+
+func (x *CheckGraphRequest) Reset() {
+	*x = CheckGraphRequest{}
+	mi := &file_perseus_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGraphRequest) ProtoMessage() {}
+
+func (x *CheckGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGraphRequest.ProtoReflect.Descriptor instead.
+func (*CheckGraphRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CheckGraphRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+type CheckGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issues []*CheckGraphResponse_Issue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *CheckGraphResponse) Reset() {
+	*x = CheckGraphResponse{}
+	mi := &file_perseus_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGraphResponse) ProtoMessage() {}
+
+func (x *CheckGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGraphResponse.ProtoReflect.Descriptor instead.
+func (*CheckGraphResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CheckGraphResponse) GetIssues() []*CheckGraphResponse_Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type MergeModulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the name of the module to be merged, which is removed and recorded as an alias
+	SourceModule string `protobuf:"bytes,1,opt,name=source_module,json=sourceModule,proto3" json:"source_module,omitempty"`
+	// the name of the module that the source module is merged into
+	TargetModule string `protobuf:"bytes,2,opt,name=target_module,json=targetModule,proto3" json:"target_module,omitempty"`
+}
+
+func (x *MergeModulesRequest) Reset() {
+	*x = MergeModulesRequest{}
+	mi := &file_perseus_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeModulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeModulesRequest) ProtoMessage() {}
+
+func (x *MergeModulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeModulesRequest.ProtoReflect.Descriptor instead.
+func (*MergeModulesRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MergeModulesRequest) GetSourceModule() string {
+	if x != nil {
+		return x.SourceModule
+	}
+	return ""
+}
+
+func (x *MergeModulesRequest) GetTargetModule() string {
+	if x != nil {
+		return x.TargetModule
+	}
+	return ""
+}
+
+type MergeModulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the number of versions that were moved from the source module to the target module
+	MovedVersions int32 `protobuf:"varint,1,opt,name=moved_versions,json=movedVersions,proto3" json:"moved_versions,omitempty"`
+	// the number of versions that existed in both modules and were combined
+	MergedVersions int32 `protobuf:"varint,2,opt,name=merged_versions,json=mergedVersions,proto3" json:"merged_versions,omitempty"`
+}
+
+func (x *MergeModulesResponse) Reset() {
+	*x = MergeModulesResponse{}
+	mi := &file_perseus_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeModulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeModulesResponse) ProtoMessage() {}
+
+func (x *MergeModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeModulesResponse.ProtoReflect.Descriptor instead.
+func (*MergeModulesResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MergeModulesResponse) GetMovedVersions() int32 {
+	if x != nil {
+		return x.MovedVersions
+	}
+	return 0
+}
+
+func (x *MergeModulesResponse) GetMergedVersions() int32 {
+	if x != nil {
+		return x.MergedVersions
+	}
+	return 0
+}
+
+type RenameModuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the previous name of the module
+	OldModule string `protobuf:"bytes,1,opt,name=old_module,json=oldModule,proto3" json:"old_module,omitempty"`
+	// the new name of the module, which is created if it does not exist
+	NewModule string `protobuf:"bytes,2,opt,name=new_module,json=newModule,proto3" json:"new_module,omitempty"`
+}
+
+func (x *RenameModuleRequest) Reset() {
+	*x = RenameModuleRequest{}
+	mi := &file_perseus_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameModuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameModuleRequest) ProtoMessage() {}
+
+func (x *RenameModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameModuleRequest.ProtoReflect.Descriptor instead.
+func (*RenameModuleRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RenameModuleRequest) GetOldModule() string {
+	if x != nil {
+		return x.OldModule
+	}
+	return ""
+}
+
+func (x *RenameModuleRequest) GetNewModule() string {
+	if x != nil {
+		return x.NewModule
+	}
+	return ""
+}
+
+type RenameModuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RenameModuleResponse) Reset() {
+	*x = RenameModuleResponse{}
+	mi := &file_perseus_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameModuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameModuleResponse) ProtoMessage() {}
+
+func (x *RenameModuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameModuleResponse.ProtoReflect.Descriptor instead.
+func (*RenameModuleResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{44}
+}
+
+type SetModuleLicenseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// the SPDX identifier of the license, ex: Apache-2.0, or an empty string to clear the license
+	License string `protobuf:"bytes,2,opt,name=license,proto3" json:"license,omitempty"`
+}
+
+func (x *SetModuleLicenseRequest) Reset() {
+	*x = SetModuleLicenseRequest{}
+	mi := &file_perseus_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetModuleLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetModuleLicenseRequest) ProtoMessage() {}
+
+func (x *SetModuleLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetModuleLicenseRequest.ProtoReflect.Descriptor instead.
+func (*SetModuleLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetModuleLicenseRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *SetModuleLicenseRequest) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+type SetModuleLicenseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetModuleLicenseResponse) Reset() {
+	*x = SetModuleLicenseResponse{}
+	mi := &file_perseus_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetModuleLicenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetModuleLicenseResponse) ProtoMessage() {}
+
+func (x *SetModuleLicenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetModuleLicenseResponse.ProtoReflect.Descriptor instead.
+func (*SetModuleLicenseResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{46}
+}
+
+type SubmitIngestionJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the module versions to be ingested, each of which must specify at least 1 version
+	Modules []*Module `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	// an optional description of where the request came from, defaults to "api"
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *SubmitIngestionJobsRequest) Reset() {
+	*x = SubmitIngestionJobsRequest{}
+	mi := &file_perseus_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitIngestionJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitIngestionJobsRequest) ProtoMessage() {}
+
+func (x *SubmitIngestionJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitIngestionJobsRequest.ProtoReflect.Descriptor instead.
+func (*SubmitIngestionJobsRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SubmitIngestionJobsRequest) GetModules() []*Module {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *SubmitIngestionJobsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type SubmitIngestionJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*IngestionJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *SubmitIngestionJobsResponse) Reset() {
+	*x = SubmitIngestionJobsResponse{}
+	mi := &file_perseus_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitIngestionJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitIngestionJobsResponse) ProtoMessage() {}
+
+func (x *SubmitIngestionJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitIngestionJobsResponse.ProtoReflect.Descriptor instead.
+func (*SubmitIngestionJobsResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SubmitIngestionJobsResponse) GetJobs() []*IngestionJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type GetIngestionJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetIngestionJobRequest) Reset() {
+	*x = GetIngestionJobRequest{}
+	mi := &file_perseus_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngestionJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngestionJobRequest) ProtoMessage() {}
+
+func (x *GetIngestionJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngestionJobRequest.ProtoReflect.Descriptor instead.
+func (*GetIngestionJobRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetIngestionJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetIngestionJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *IngestionJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *GetIngestionJobResponse) Reset() {
+	*x = GetIngestionJobResponse{}
+	mi := &file_perseus_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngestionJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngestionJobResponse) ProtoMessage() {}
+
+func (x *GetIngestionJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngestionJobResponse.ProtoReflect.Descriptor instead.
+func (*GetIngestionJobResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetIngestionJobResponse) GetJob() *IngestionJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListIngestionJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// if specified, only jobs with this status are returned
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListIngestionJobsRequest) Reset() {
+	*x = ListIngestionJobsRequest{}
+	mi := &file_perseus_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngestionJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngestionJobsRequest) ProtoMessage() {}
+
+func (x *ListIngestionJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngestionJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListIngestionJobsRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListIngestionJobsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListIngestionJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListIngestionJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListIngestionJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs          []*IngestionJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListIngestionJobsResponse) Reset() {
+	*x = ListIngestionJobsResponse{}
+	mi := &file_perseus_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngestionJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngestionJobsResponse) ProtoMessage() {}
+
+func (x *ListIngestionJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngestionJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListIngestionJobsResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListIngestionJobsResponse) GetJobs() []*IngestionJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListIngestionJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// An IngestionJob is a request to add a single module version to the graph that is processed
+// asynchronously by the server
+type IngestionJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Version    string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// where the job came from, ex: cli, api, or discover-versions
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// the current state of the job, one of: pending, running, succeeded, or failed
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// the number of times the server has tried to process the job
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// a description of the most recent failure, if any
+	Error      string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *IngestionJob) Reset() {
+	*x = IngestionJob{}
+	mi := &file_perseus_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestionJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestionJob) ProtoMessage() {}
+
+func (x *IngestionJob) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestionJob.ProtoReflect.Descriptor instead.
+func (*IngestionJob) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *IngestionJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IngestionJob) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *IngestionJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *IngestionJob) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_perseus_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{54}
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_perseus_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// A Job is a maintenance task that the server runs on a schedule
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the unique name of the job
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the kind of task the job runs, ex: prune-prereleases, check-graph, or refresh-modules
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// the cron expression that defines when the job runs
+	Schedule string `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// the outcome of the most recent run, one of: never, running, succeeded, or failed
+	LastStatus string `protobuf:"bytes,4,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`
+	// a description of the failure if the most recent run failed
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// when the most recent run started
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// how long the most recent run took
+	LastRunDuration *durationpb.Duration `protobuf:"bytes,7,opt,name=last_run_duration,json=lastRunDuration,proto3" json:"last_run_duration,omitempty"`
+	// when the job will next run
+	NextRunTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	// the total number of runs since the server started
+	RunCount int64 `protobuf:"varint,9,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	// the number of runs that failed since the server started
+	FailureCount int64 `protobuf:"varint,10,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_perseus_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Job) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *Job) GetLastStatus() string {
+	if x != nil {
+		return x.LastStatus
+	}
+	return ""
+}
+
+func (x *Job) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Job) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
+}
+
+func (x *Job) GetLastRunDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LastRunDuration
+	}
+	return nil
+}
+
+func (x *Job) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+func (x *Job) GetRunCount() int64 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *Job) GetFailureCount() int64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+type DeleteModuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (x *DeleteModuleRequest) Reset() {
+	*x = DeleteModuleRequest{}
+	mi := &file_perseus_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteModuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteModuleRequest) ProtoMessage() {}
+
+func (x *DeleteModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteModuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteModuleRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteModuleRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+type DeleteModuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteModuleResponse) Reset() {
+	*x = DeleteModuleResponse{}
+	mi := &file_perseus_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteModuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteModuleResponse) ProtoMessage() {}
+
+func (x *DeleteModuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteModuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteModuleResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{58}
+}
+
+type DeleteModuleVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DeleteModuleVersionRequest) Reset() {
+	*x = DeleteModuleVersionRequest{}
+	mi := &file_perseus_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteModuleVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteModuleVersionRequest) ProtoMessage() {}
+
+func (x *DeleteModuleVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteModuleVersionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteModuleVersionRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteModuleVersionRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *DeleteModuleVersionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type DeleteModuleVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteModuleVersionResponse) Reset() {
+	*x = DeleteModuleVersionResponse{}
+	mi := &file_perseus_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteModuleVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteModuleVersionResponse) ProtoMessage() {}
+
+func (x *DeleteModuleVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteModuleVersionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteModuleVersionResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{60}
+}
+
+type UndeleteModuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// if specified, only this deleted version of the module is restored rather than the deleted module
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UndeleteModuleRequest) Reset() {
+	*x = UndeleteModuleRequest{}
+	mi := &file_perseus_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteModuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteModuleRequest) ProtoMessage() {}
+
+func (x *UndeleteModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteModuleRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteModuleRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UndeleteModuleRequest) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *UndeleteModuleRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type UndeleteModuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the number of module versions that were restored
+	RestoredVersions int32 `protobuf:"varint,1,opt,name=restored_versions,json=restoredVersions,proto3" json:"restored_versions,omitempty"`
+	// the number of dependency edges that were restored.  Edges to or from module versions that have been
+	// deleted since are not restored.
+	RestoredDependencies int32 `protobuf:"varint,2,opt,name=restored_dependencies,json=restoredDependencies,proto3" json:"restored_dependencies,omitempty"`
+}
+
+func (x *UndeleteModuleResponse) Reset() {
+	*x = UndeleteModuleResponse{}
+	mi := &file_perseus_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteModuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteModuleResponse) ProtoMessage() {}
+
+func (x *UndeleteModuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteModuleResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteModuleResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UndeleteModuleResponse) GetRestoredVersions() int32 {
+	if x != nil {
+		return x.RestoredVersions
+	}
+	return 0
+}
+
+func (x *UndeleteModuleResponse) GetRestoredDependencies() int32 {
+	if x != nil {
+		return x.RestoredDependencies
+	}
+	return 0
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the unique name of the key, which identifies its holder in the audit log
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// the last time the key was used, if ever
+	LastUsedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	// the time the key was revoked, if it has been
+	RevokeTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=revoke_time,json=revokeTime,proto3" json:"revoke_time,omitempty"`
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_perseus_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+func (x *APIKey) GetRevokeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokeTime
+	}
+	return nil
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_perseus_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// the secret key, which cannot be retrieved again
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_perseus_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_perseus_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{66}
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKeys []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_perseus_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_perseus_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RevokeAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_perseus_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{69}
+}
+
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// the name of the API key that authenticated the operation, "admin" for the server's admin token, or
+	// "anonymous" if authentication is disabled
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// the operation, ex: delete-module
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// what the operation changed, ex: a module path
+	Target  string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Details string `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_perseus_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type ListAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListAuditLogRequest) Reset() {
+	*x = ListAuditLogRequest{}
+	mi := &file_perseus_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogRequest) ProtoMessage() {}
+
+func (x *ListAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListAuditLogRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries       []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListAuditLogResponse) Reset() {
+	*x = ListAuditLogResponse{}
+	mi := &file_perseus_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogResponse) ProtoMessage() {}
+
+func (x *ListAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListAuditLogResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditLogResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RenderGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the graph, in the DOT language, which is limited to 512 KiB and to the node and edge counts enforced
+	// by the server
+	Dot    string       `protobuf:"bytes,1,opt,name=dot,proto3" json:"dot,omitempty"`
+	Format RenderFormat `protobuf:"varint,2,opt,name=format,proto3,enum=crowdstrike.perseus.perseusapi.RenderFormat" json:"format,omitempty"`
+}
+
+func (x *RenderGraphRequest) Reset() {
+	*x = RenderGraphRequest{}
+	mi := &file_perseus_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderGraphRequest) ProtoMessage() {}
+
+func (x *RenderGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderGraphRequest.ProtoReflect.Descriptor instead.
+func (*RenderGraphRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RenderGraphRequest) GetDot() string {
+	if x != nil {
+		return x.Dot
+	}
+	return ""
+}
+
+func (x *RenderGraphRequest) GetFormat() RenderFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RenderFormat_svg
+}
+
+type RenderGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the rendered image
+	Image []byte `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// the media type of the image, ex: image/svg+xml
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+}
+
+func (x *RenderGraphResponse) Reset() {
+	*x = RenderGraphResponse{}
+	mi := &file_perseus_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderGraphResponse) ProtoMessage() {}
+
+func (x *RenderGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderGraphResponse.ProtoReflect.Descriptor instead.
+func (*RenderGraphResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RenderGraphResponse) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *RenderGraphResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type GetServerInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// if true, the response also includes the health of the database and statistics about the graph, which
+	// requires querying the database
+	IncludeStats bool `protobuf:"varint,1,opt,name=include_stats,json=includeStats,proto3" json:"include_stats,omitempty"`
+}
+
+func (x *GetServerInfoRequest) Reset() {
+	*x = GetServerInfoRequest{}
+	mi := &file_perseus_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoRequest) ProtoMessage() {}
+
+func (x *GetServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetServerInfoRequest) GetIncludeStats() bool {
+	if x != nil {
+		return x.IncludeStats
+	}
+	return false
+}
+
+type GetServerInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the version of the server, ex: v1.2.3
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the version of the database schema that the server requires, which is the number of the most recent
+	// script in the migrations folder
+	SchemaVersion int32 `protobuf:"varint,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// the optional features that the server supports, ex: stream-edges or compression-zstd, which include
+	// RPCs added after GetServerInfo and capabilities that depend on the server's configuration
+	Features []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+	// the time that the server process started
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// the health of the database, only populated if 'include_stats' is true
+	Database *DatabaseHealth `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
+	// the size of the graph, only populated if 'include_stats' is true and the database is healthy
+	Graph *GraphStats `protobuf:"bytes,6,opt,name=graph,proto3" json:"graph,omitempty"`
+}
+
+func (x *GetServerInfoResponse) Reset() {
+	*x = GetServerInfoResponse{}
+	mi := &file_perseus_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoResponse) ProtoMessage() {}
+
+func (x *GetServerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetServerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetServerInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetServerInfoResponse) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *GetServerInfoResponse) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetDatabase() *DatabaseHealth {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetGraph() *GraphStats {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+// DatabaseHealth is the outcome of checking the server's connection to its database
+type DatabaseHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// whether the database responded to a ping within the server's health check timeout
+	Healthy bool `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// how long the database took to respond
+	Latency *durationpb.Duration `protobuf:"bytes,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	// the reason that the check failed, if it did
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DatabaseHealth) Reset() {
+	*x = DatabaseHealth{}
+	mi := &file_perseus_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseHealth) ProtoMessage() {}
+
+func (x *DatabaseHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseHealth.ProtoReflect.Descriptor instead.
+func (*DatabaseHealth) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DatabaseHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *DatabaseHealth) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *DatabaseHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// GraphStats describes the size of the dependency graph
+type GraphStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Modules  int64 `protobuf:"varint,1,opt,name=modules,proto3" json:"modules,omitempty"`
+	Versions int64 `protobuf:"varint,2,opt,name=versions,proto3" json:"versions,omitempty"`
+	// the number of dependency edges between module versions that are currently declared
+	Edges int64 `protobuf:"varint,3,opt,name=edges,proto3" json:"edges,omitempty"`
+	// the most recent time that a module version or dependency edge was added, unset if the graph is empty
+	LastIngestTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_ingest_time,json=lastIngestTime,proto3" json:"last_ingest_time,omitempty"`
+}
+
+func (x *GraphStats) Reset() {
+	*x = GraphStats{}
+	mi := &file_perseus_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphStats) ProtoMessage() {}
+
+func (x *GraphStats) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphStats.ProtoReflect.Descriptor instead.
+func (*GraphStats) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GraphStats) GetModules() int64 {
+	if x != nil {
+		return x.Modules
+	}
+	return 0
+}
+
+func (x *GraphStats) GetVersions() int64 {
+	if x != nil {
+		return x.Versions
+	}
+	return 0
+}
+
+func (x *GraphStats) GetEdges() int64 {
+	if x != nil {
+		return x.Edges
+	}
+	return 0
+}
+
+func (x *GraphStats) GetLastIngestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastIngestTime
+	}
+	return nil
+}
+
+type GetStoreStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStoreStatsRequest) Reset() {
+	*x = GetStoreStatsRequest{}
+	mi := &file_perseus_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreStatsRequest) ProtoMessage() {}
+
+func (x *GetStoreStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStoreStatsRequest) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{79}
+}
+
+type GetStoreStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the total size of the database, in bytes
+	DatabaseBytes int64              `protobuf:"varint,1,opt,name=database_bytes,json=databaseBytes,proto3" json:"database_bytes,omitempty"`
+	Tables        []*StoreTableStats `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	Indexes       []*StoreIndexStats `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (x *GetStoreStatsResponse) Reset() {
+	*x = GetStoreStatsResponse{}
+	mi := &file_perseus_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreStatsResponse) ProtoMessage() {}
+
+func (x *GetStoreStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStoreStatsResponse) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetStoreStatsResponse) GetDatabaseBytes() int64 {
+	if x != nil {
+		return x.DatabaseBytes
+	}
+	return 0
+}
+
+func (x *GetStoreStatsResponse) GetTables() []*StoreTableStats {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *GetStoreStatsResponse) GetIndexes() []*StoreIndexStats {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+// StoreTableStats describes the storage used by a database table
+type StoreTableStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the estimated number of live rows, from the database's statistics
+	Rows int64 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	// the estimated number of rows that were updated or deleted and have not been reclaimed by a vacuum
+	DeadRows int64 `protobuf:"varint,3,opt,name=dead_rows,json=deadRows,proto3" json:"dead_rows,omitempty"`
+	// the size of the table, including its indexes, in bytes
+	TotalBytes int64 `protobuf:"varint,4,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// the size of the table's indexes, in bytes
+	IndexBytes int64 `protobuf:"varint,5,opt,name=index_bytes,json=indexBytes,proto3" json:"index_bytes,omitempty"`
+	// the most recent manual or automatic vacuum of the table, unset if it has never been vacuumed
+	LastVacuumTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_vacuum_time,json=lastVacuumTime,proto3" json:"last_vacuum_time,omitempty"`
+	// the most recent manual or automatic analyze of the table, unset if it has never been analyzed
+	LastAnalyzeTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_analyze_time,json=lastAnalyzeTime,proto3" json:"last_analyze_time,omitempty"`
+	// a suggested maintenance operation, ex: "30% of rows are dead, consider a VACUUM", empty if none
+	Hint string `protobuf:"bytes,8,opt,name=hint,proto3" json:"hint,omitempty"`
+}
+
+func (x *StoreTableStats) Reset() {
+	*x = StoreTableStats{}
+	mi := &file_perseus_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreTableStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreTableStats) ProtoMessage() {}
+
+func (x *StoreTableStats) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreTableStats.ProtoReflect.Descriptor instead.
+func (*StoreTableStats) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *StoreTableStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoreTableStats) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *StoreTableStats) GetDeadRows() int64 {
+	if x != nil {
+		return x.DeadRows
+	}
+	return 0
+}
+
+func (x *StoreTableStats) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *StoreTableStats) GetIndexBytes() int64 {
+	if x != nil {
+		return x.IndexBytes
+	}
+	return 0
+}
+
+func (x *StoreTableStats) GetLastVacuumTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastVacuumTime
+	}
+	return nil
+}
+
+func (x *StoreTableStats) GetLastAnalyzeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAnalyzeTime
+	}
+	return nil
+}
+
+func (x *StoreTableStats) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+// StoreIndexStats describes the storage used by a database index
+type StoreIndexStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the table that the index belongs to
+	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// the size of the index, in bytes
+	Bytes int64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// the number of scans of the index since the database's statistics were last reset
+	Scans int64 `protobuf:"varint,4,opt,name=scans,proto3" json:"scans,omitempty"`
+	// a suggested maintenance operation, ex: "larger than its table, consider a REINDEX", empty if none
+	Hint string `protobuf:"bytes,5,opt,name=hint,proto3" json:"hint,omitempty"`
+}
+
+func (x *StoreIndexStats) Reset() {
+	*x = StoreIndexStats{}
+	mi := &file_perseus_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreIndexStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreIndexStats) ProtoMessage() {}
+
+func (x *StoreIndexStats) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreIndexStats.ProtoReflect.Descriptor instead.
+func (*StoreIndexStats) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *StoreIndexStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoreIndexStats) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *StoreIndexStats) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *StoreIndexStats) GetScans() int64 {
+	if x != nil {
+		return x.Scans
+	}
+	return 0
+}
+
+func (x *StoreIndexStats) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+// Dependency describes when a single direct dependency was declared
+type GetDependencyHistoryResponse_Dependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the dependency, which always has exactly 1 version
+	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// when the dependency was first declared
+	FirstSeenTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_seen_time,json=firstSeenTime,proto3" json:"first_seen_time,omitempty"`
+	// when the dependency was most recently declared
+	LastSeenTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_seen_time,json=lastSeenTime,proto3" json:"last_seen_time,omitempty"`
+	// when the dependency was removed, which is not set if it is still declared
+	RemoveTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=remove_time,json=removeTime,proto3" json:"remove_time,omitempty"`
+}
+
+func (x *GetDependencyHistoryResponse_Dependency) Reset() {
+	*x = GetDependencyHistoryResponse_Dependency{}
+	mi := &file_perseus_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependencyHistoryResponse_Dependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependencyHistoryResponse_Dependency) ProtoMessage() {}
+
+func (x *GetDependencyHistoryResponse_Dependency) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependencyHistoryResponse_Dependency.ProtoReflect.Descriptor instead.
+func (*GetDependencyHistoryResponse_Dependency) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *GetDependencyHistoryResponse_Dependency) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *GetDependencyHistoryResponse_Dependency) GetFirstSeenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeenTime
+	}
+	return nil
+}
+
+func (x *GetDependencyHistoryResponse_Dependency) GetLastSeenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenTime
+	}
+	return nil
+}
+
+func (x *GetDependencyHistoryResponse_Dependency) GetRemoveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemoveTime
+	}
+	return nil
+}
+
+// Edge is a direct dependency of one module version on another
+type DiffGraphResponse_Edge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the dependent module, which always has exactly 1 version
+	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// the dependency, which always has exactly 1 version
+	Dependency *Module `protobuf:"bytes,2,opt,name=dependency,proto3" json:"dependency,omitempty"`
+}
+
+func (x *DiffGraphResponse_Edge) Reset() {
+	*x = DiffGraphResponse_Edge{}
+	mi := &file_perseus_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffGraphResponse_Edge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffGraphResponse_Edge) ProtoMessage() {}
+
+func (x *DiffGraphResponse_Edge) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffGraphResponse_Edge.ProtoReflect.Descriptor instead.
+func (*DiffGraphResponse_Edge) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *DiffGraphResponse_Edge) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *DiffGraphResponse_Edge) GetDependency() *Module {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
+// RankedModule is a single module and its metrics
+type ListTopModulesResponse_RankedModule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the number of distinct modules that depend directly on any version of this module
+	DirectDependents int32 `protobuf:"varint,2,opt,name=direct_dependents,json=directDependents,proto3" json:"direct_dependents,omitempty"`
+	// the number of distinct modules that depend directly or transitively on any version of this module
+	TransitiveDependents int32 `protobuf:"varint,3,opt,name=transitive_dependents,json=transitiveDependents,proto3" json:"transitive_dependents,omitempty"`
+	// the module's PageRank within the module-level dependency graph, scaled so that the average is 1,
+	// which is periodically computed by the server's score-modules job
+	Importance float64 `protobuf:"fixed64,4,opt,name=importance,proto3" json:"importance,omitempty"`
+}
+
+func (x *ListTopModulesResponse_RankedModule) Reset() {
+	*x = ListTopModulesResponse_RankedModule{}
+	mi := &file_perseus_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopModulesResponse_RankedModule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopModulesResponse_RankedModule) ProtoMessage() {}
+
+func (x *ListTopModulesResponse_RankedModule) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopModulesResponse_RankedModule.ProtoReflect.Descriptor instead.
+func (*ListTopModulesResponse_RankedModule) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *ListTopModulesResponse_RankedModule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListTopModulesResponse_RankedModule) GetDirectDependents() int32 {
+	if x != nil {
+		return x.DirectDependents
+	}
+	return 0
+}
+
+func (x *ListTopModulesResponse_RankedModule) GetTransitiveDependents() int32 {
+	if x != nil {
+		return x.TransitiveDependents
+	}
+	return 0
+}
+
+func (x *ListTopModulesResponse_RankedModule) GetImportance() float64 {
+	if x != nil {
+		return x.Importance
+	}
+	return 0
+}
+
+// ConsumedVersion is a single version of the module and the modules that depend on it
+type ListModuleConsumersResponse_ConsumedVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the consumed module, which always has exactly 1 version
+	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// the latest version of each module that depends on this version, which always has exactly 1 version
+	Dependents []*Module `protobuf:"bytes,2,rep,name=dependents,proto3" json:"dependents,omitempty"`
+}
+
+func (x *ListModuleConsumersResponse_ConsumedVersion) Reset() {
+	*x = ListModuleConsumersResponse_ConsumedVersion{}
+	mi := &file_perseus_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModuleConsumersResponse_ConsumedVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModuleConsumersResponse_ConsumedVersion) ProtoMessage() {}
+
+func (x *ListModuleConsumersResponse_ConsumedVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModuleConsumersResponse_ConsumedVersion.ProtoReflect.Descriptor instead.
+func (*ListModuleConsumersResponse_ConsumedVersion) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *ListModuleConsumersResponse_ConsumedVersion) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ListModuleConsumersResponse_ConsumedVersion) GetDependents() []*Module {
+	if x != nil {
+		return x.Dependents
+	}
+	return nil
+}
+
+// ModuleStaleness is the staleness of a single module version
+type ListModuleStalenessResponse_ModuleStaleness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the scored module, which always has exactly 1 version
+	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// the number of direct dependencies that are currently declared by the module version
+	Dependencies int32 `protobuf:"varint,2,opt,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// the number of direct dependencies that are older than the latest version of the dependency
+	OutdatedDependencies int32 `protobuf:"varint,3,opt,name=outdated_dependencies,json=outdatedDependencies,proto3" json:"outdated_dependencies,omitempty"`
+	// the average, across all direct dependencies, of the number of years between the consumed version
+	// and the latest version of each dependency, where up-to-date dependencies contribute 0
+	Score float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ListModuleStalenessResponse_ModuleStaleness) Reset() {
+	*x = ListModuleStalenessResponse_ModuleStaleness{}
+	mi := &file_perseus_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModuleStalenessResponse_ModuleStaleness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModuleStalenessResponse_ModuleStaleness) ProtoMessage() {}
+
+func (x *ListModuleStalenessResponse_ModuleStaleness) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModuleStalenessResponse_ModuleStaleness.ProtoReflect.Descriptor instead.
+func (*ListModuleStalenessResponse_ModuleStaleness) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *ListModuleStalenessResponse_ModuleStaleness) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ListModuleStalenessResponse_ModuleStaleness) GetDependencies() int32 {
+	if x != nil {
+		return x.Dependencies
+	}
+	return 0
+}
+
+func (x *ListModuleStalenessResponse_ModuleStaleness) GetOutdatedDependencies() int32 {
+	if x != nil {
+		return x.OutdatedDependencies
+	}
+	return 0
+}
+
+func (x *ListModuleStalenessResponse_ModuleStaleness) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// AbandonedModule describes the source repository of a single abandoned module
+type ListAbandonedModulesResponse_AbandonedModule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the module's name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// true if the repository has been archived by its owner
+	Archived bool `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
+	// the time of the most recent commit to the repository, if it is known
+	LastCommitTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_commit_time,json=lastCommitTime,proto3" json:"last_commit_time,omitempty"`
+	// when the repository was last checked
+	CheckedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checked_time,json=checkedTime,proto3" json:"checked_time,omitempty"`
+	// the number of distinct modules that currently depend directly on any version of the module
+	Dependents int32 `protobuf:"varint,5,opt,name=dependents,proto3" json:"dependents,omitempty"`
+}
+
+func (x *ListAbandonedModulesResponse_AbandonedModule) Reset() {
+	*x = ListAbandonedModulesResponse_AbandonedModule{}
+	mi := &file_perseus_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAbandonedModulesResponse_AbandonedModule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAbandonedModulesResponse_AbandonedModule) ProtoMessage() {}
+
+func (x *ListAbandonedModulesResponse_AbandonedModule) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAbandonedModulesResponse_AbandonedModule.ProtoReflect.Descriptor instead.
+func (*ListAbandonedModulesResponse_AbandonedModule) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *ListAbandonedModulesResponse_AbandonedModule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListAbandonedModulesResponse_AbandonedModule) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *ListAbandonedModulesResponse_AbandonedModule) GetLastCommitTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCommitTime
+	}
+	return nil
+}
+
+func (x *ListAbandonedModulesResponse_AbandonedModule) GetCheckedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedTime
+	}
+	return nil
+}
+
+func (x *ListAbandonedModulesResponse_AbandonedModule) GetDependents() int32 {
+	if x != nil {
+		return x.Dependents
+	}
+	return 0
+}
+
+// GhostVersion is a single module version that can no longer be used
+type ListGhostVersionsResponse_GhostVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the unavailable module, which always has exactly 1 version
+	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// why the version is unavailable, either "missing" if the Go module proxy no longer lists it or
+	// "retracted" if the latest version of the module retracts it
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// when the version was first found to be unavailable for this reason
+	Since *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	// the number of module versions that currently depend directly on this version
+	Dependents int32 `protobuf:"varint,4,opt,name=dependents,proto3" json:"dependents,omitempty"`
+}
+
+func (x *ListGhostVersionsResponse_GhostVersion) Reset() {
+	*x = ListGhostVersionsResponse_GhostVersion{}
+	mi := &file_perseus_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGhostVersionsResponse_GhostVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGhostVersionsResponse_GhostVersion) ProtoMessage() {}
+
+func (x *ListGhostVersionsResponse_GhostVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGhostVersionsResponse_GhostVersion.ProtoReflect.Descriptor instead.
+func (*ListGhostVersionsResponse_GhostVersion) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *ListGhostVersionsResponse_GhostVersion) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ListGhostVersionsResponse_GhostVersion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ListGhostVersionsResponse_GhostVersion) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListGhostVersionsResponse_GhostVersion) GetDependents() int32 {
+	if x != nil {
+		return x.Dependents
+	}
+	return 0
+}
+
+// SelectedModule is the version of a module that Minimal Version Selection selected for the build list
+type ResolveModuleSetResponse_SelectedModule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the selected module, which always has exactly 1 version
+	Module *Module `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// the chain of module versions from the requested module to the selected version through which it
+	// was first required, ex: ["example.com/foo@v1.2.0", "example.com/bar@v1.0.0", "example.com/baz@v1.3.1"]
+	Path []string `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	// the lower versions of the module that are also required within the requirement graph, which the
+	// selected version supersedes, in descending order
+	SupersededVersions []string `protobuf:"bytes,3,rep,name=superseded_versions,json=supersededVersions,proto3" json:"superseded_versions,omitempty"`
+}
+
+func (x *ResolveModuleSetResponse_SelectedModule) Reset() {
+	*x = ResolveModuleSetResponse_SelectedModule{}
+	mi := &file_perseus_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveModuleSetResponse_SelectedModule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveModuleSetResponse_SelectedModule) ProtoMessage() {}
+
+func (x *ResolveModuleSetResponse_SelectedModule) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveModuleSetResponse_SelectedModule.ProtoReflect.Descriptor instead.
+func (*ResolveModuleSetResponse_SelectedModule) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *ResolveModuleSetResponse_SelectedModule) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ResolveModuleSetResponse_SelectedModule) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *ResolveModuleSetResponse_SelectedModule) GetSupersededVersions() []string {
+	if x != nil {
+		return x.SupersededVersions
+	}
+	return nil
+}
+
+// PolicyViolation is a dependency that is banned by a policy rule
+type CheckPolicyResponse_PolicyViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the name of the violated rule
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// either "error" or "warning"
+	Severity string `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	// a description of the violation
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// the module that should be used instead, if any
+	Replacement string `protobuf:"bytes,4,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	// the banned dependency, which always has exactly 1 version
+	Module *Module `protobuf:"bytes,5,opt,name=module,proto3" json:"module,omitempty"`
+	// the chain of module versions from the checked module to the banned dependency, ex: example.com/foo@v1.2.0
+	Path []string `protobuf:"bytes,6,rep,name=path,proto3" json:"path,omitempty"`
+	// either "module" for a violation of a module rule or "license" for a violation of the license rules
+	Kind string `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind,omitempty"`
+	// the SPDX identifier of the banned dependency's license for license violations, if it is known
+	License string `protobuf:"bytes,8,opt,name=license,proto3" json:"license,omitempty"`
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) Reset() {
+	*x = CheckPolicyResponse_PolicyViolation{}
+	mi := &file_perseus_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPolicyResponse_PolicyViolation) ProtoMessage() {}
+
+func (x *CheckPolicyResponse_PolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPolicyResponse_PolicyViolation.ProtoReflect.Descriptor instead.
+func (*CheckPolicyResponse_PolicyViolation) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CheckPolicyResponse_PolicyViolation) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+// Advisory is an OSV advisory that affects at least 1 of the checked module versions
+type ListVulnerabilitiesResponse_Advisory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the advisory ID, ex: GO-2024-2687
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// other IDs of the same vulnerability, ex: CVE-2023-45288
+	Aliases []string `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// a one-line description of the vulnerability
+	Summary string `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	// the complete OSV entry as JSON
+	Osv string `protobuf:"bytes,4,opt,name=osv,proto3" json:"osv,omitempty"`
+}
+
+func (x *ListVulnerabilitiesResponse_Advisory) Reset() {
+	*x = ListVulnerabilitiesResponse_Advisory{}
+	mi := &file_perseus_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVulnerabilitiesResponse_Advisory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVulnerabilitiesResponse_Advisory) ProtoMessage() {}
+
+func (x *ListVulnerabilitiesResponse_Advisory) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVulnerabilitiesResponse_Advisory.ProtoReflect.Descriptor instead.
+func (*ListVulnerabilitiesResponse_Advisory) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *ListVulnerabilitiesResponse_Advisory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *QueryDependenciesResponse) GetModules() []*Module {
+func (x *ListVulnerabilitiesResponse_Advisory) GetAliases() []string {
 	if x != nil {
-		return x.Modules
+		return x.Aliases
 	}
 	return nil
 }
 
-func (x *QueryDependenciesResponse) GetNextPageToken() string {
+func (x *ListVulnerabilitiesResponse_Advisory) GetSummary() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ListVulnerabilitiesResponse_Advisory) GetOsv() string {
+	if x != nil {
+		return x.Osv
+	}
+	return ""
+}
+
+// Finding is a module version that is affected by an advisory
+type ListVulnerabilitiesResponse_Finding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the ID of the advisory
+	AdvisoryId string `protobuf:"bytes,1,opt,name=advisory_id,json=advisoryId,proto3" json:"advisory_id,omitempty"`
+	// the affected module version, which always has exactly 1 version
+	Module *Module `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// the lowest version of the module that fixes the vulnerability, if any
+	FixedVersion string `protobuf:"bytes,3,opt,name=fixed_version,json=fixedVersion,proto3" json:"fixed_version,omitempty"`
+	// the chain of module versions from the checked module to the affected module, ex: example.com/foo@v1.2.0
+	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ListVulnerabilitiesResponse_Finding) Reset() {
+	*x = ListVulnerabilitiesResponse_Finding{}
+	mi := &file_perseus_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVulnerabilitiesResponse_Finding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVulnerabilitiesResponse_Finding) ProtoMessage() {}
+
+func (x *ListVulnerabilitiesResponse_Finding) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVulnerabilitiesResponse_Finding.ProtoReflect.Descriptor instead.
+func (*ListVulnerabilitiesResponse_Finding) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{36, 1}
+}
+
+func (x *ListVulnerabilitiesResponse_Finding) GetAdvisoryId() string {
+	if x != nil {
+		return x.AdvisoryId
+	}
+	return ""
+}
+
+func (x *ListVulnerabilitiesResponse_Finding) GetModule() *Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *ListVulnerabilitiesResponse_Finding) GetFixedVersion() string {
+	if x != nil {
+		return x.FixedVersion
+	}
+	return ""
+}
+
+func (x *ListVulnerabilitiesResponse_Finding) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+// Result is the outcome of refreshing a single module version
+type RefreshModulesResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// the number of direct dependencies stored for the module version
+	DependencyCount int32 `protobuf:"varint,3,opt,name=dependency_count,json=dependencyCount,proto3" json:"dependency_count,omitempty"`
+	// a description of the failure if the module version could not be refreshed
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RefreshModulesResponse_Result) Reset() {
+	*x = RefreshModulesResponse_Result{}
+	mi := &file_perseus_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshModulesResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshModulesResponse_Result) ProtoMessage() {}
+
+func (x *RefreshModulesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshModulesResponse_Result.ProtoReflect.Descriptor instead.
+func (*RefreshModulesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *RefreshModulesResponse_Result) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *RefreshModulesResponse_Result) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RefreshModulesResponse_Result) GetDependencyCount() int32 {
+	if x != nil {
+		return x.DependencyCount
+	}
+	return 0
+}
+
+func (x *RefreshModulesResponse_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Issue describes a single consistency problem
+type CheckGraphResponse_Issue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the kind of problem, ex: ORPHANED_VERSION, DANGLING_DEPENDENCY, DUPLICATE_MODULE, or NON_CANONICAL_VERSION
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// a description of the problem
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// true if the problem was repaired
+	Repaired bool `protobuf:"varint,3,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (x *CheckGraphResponse_Issue) Reset() {
+	*x = CheckGraphResponse_Issue{}
+	mi := &file_perseus_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckGraphResponse_Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGraphResponse_Issue) ProtoMessage() {}
+
+func (x *CheckGraphResponse_Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_perseus_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGraphResponse_Issue.ProtoReflect.Descriptor instead.
+func (*CheckGraphResponse_Issue) Descriptor() ([]byte, []int) {
+	return file_perseus_proto_rawDescGZIP(), []int{40, 0}
+}
+
+func (x *CheckGraphResponse_Issue) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CheckGraphResponse_Issue) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
+func (x *CheckGraphResponse_Issue) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
 var File_perseus_proto protoreflect.FileDescriptor
 
 var file_perseus_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x1e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
 	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x1a,
-	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x38, 0x0a,
-	0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e,
-	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x56,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
-	0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x22, 0x7f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x02, 0x0a, 0x06, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x72, 0x09, 0xc0, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x18, 0x80,
+	0x08, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x92, 0x01,
+	0x08, 0x22, 0x06, 0x72, 0x04, 0xc8, 0xf5, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64,
+	0x22, 0x5d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22,
+	0x56, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x74, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8,
+	0x07, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7f, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x97,
+	0x0a, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72,
+	0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x70, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x73, 0x12, 0x2f,
+	0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x12, 0x66, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77,
+	0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05,
+	0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x3a, 0x91, 0x05, 0xba, 0x48, 0x8d, 0x05, 0x1a, 0x90, 0x01, 0x0a, 0x15,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x72, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x43, 0x65, 0x69, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x72,
+	0x20, 0x61, 0x20, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x1a, 0x32, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x21, 0x3d, 0x20,
+	0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0x1a, 0xb8,
+	0x01, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x39, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x69,
+	0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x27, 0x61, 0x6c, 0x6c,
+	0x27, 0x1a, 0x66, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x3d, 0x3d, 0x20, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70,
+	0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x6c, 0x6c, 0x1a, 0xb2, 0x01, 0x0a, 0x12, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c,
+	0x12, 0x3a, 0x61, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x6e, 0x6c,
+	0x79, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x27, 0x61, 0x6c, 0x6c, 0x27, 0x1a, 0x60, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7c, 0x7c,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x3d, 0x20, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x6c, 0x6c, 0x1a, 0x87,
+	0x01, 0x0a, 0x12, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f,
+	0x61, 0x73, 0x5f, 0x6f, 0x66, 0x12, 0x2f, 0x74, 0x68, 0x65, 0x20, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62,
+	0x65, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x40, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x21, 0x68, 0x61, 0x73,
+	0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x29, 0x20, 0x7c, 0x7c, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x20, 0x3c, 0x3d, 0x20, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64,
 	0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70,
@@ -818,120 +6205,934 @@ var file_perseus_proto_rawDesc = []byte{
 	0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
 	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0xcf, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0e, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x6e, 0x22, 0xfe, 0x02, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x72, 0x09, 0xc0, 0xf5, 0x28, 0x01, 0x10,
+	0x01, 0x18, 0x80, 0x08, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x72, 0x06, 0xc8, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x3a, 0x81,
+	0x01, 0xba, 0x48, 0x7e, 0x1a, 0x7c, 0x0a, 0x19, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2e, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x79, 0x20,
+	0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x31, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x1a, 0x2f, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x2e, 0x61, 0x6c, 0x6c, 0x28, 0x64, 0x2c, 0x20, 0x73, 0x69, 0x7a, 0x65,
+	0x28, 0x64, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x3d, 0x3d, 0x20,
+	0x31, 0x29, 0x22, 0xce, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x11, 0x61, 0x64, 0x64, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
+	0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x13,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x7d, 0xba, 0x48, 0x7a, 0x1a, 0x78, 0x0a,
+	0x1b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x32, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x1a, 0x25, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x61,
+	0x6c, 0x6c, 0x28, 0x75, 0x2c, 0x20, 0x21, 0x75, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x29, 0x22, 0x21, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xca, 0x08, 0x0a, 0x18, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48,
+	0x0b, 0x72, 0x09, 0xc0, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0a, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xd8, 0x01,
+	0x01, 0x72, 0x04, 0xc8, 0xf5, 0x28, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2d, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x02, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x5b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x72,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x61,
+	0x73, 0x5f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x45, 0x0a, 0x06,
+	0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2d, 0xba, 0x48,
+	0x2a, 0x92, 0x01, 0x27, 0x18, 0x01, 0x22, 0x23, 0x72, 0x21, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x72, 0x64, 0x52, 0x08, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x52,
+	0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x65, 0x6e, 0x72,
+	0x69, 0x63, 0x68, 0x3a, 0xe1, 0x04, 0xba, 0x48, 0xdd, 0x04, 0x1a, 0x7e, 0x0a, 0x16, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x73, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x20, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x2d, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x3d, 0x3d, 0x20,
+	0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x21, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x5f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x87, 0x01, 0x0a, 0x10, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x3f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x61,
+	0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x1a, 0x32, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20,
+	0x21, 0x3d, 0x20, 0x27, 0x27, 0x29, 0x20, 0x21, 0x3d, 0x20, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x21, 0x3d,
+	0x20, 0x27, 0x27, 0x29, 0x1a, 0xc4, 0x01, 0x0a, 0x19, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x3a, 0x61, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x69, 0x6e, 0x67, 0x20, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x6b,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x3d, 0x20, 0x63, 0x72,
+	0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x89, 0x01, 0x0a, 0x15,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3e, 0x61, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x72, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x30, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20,
+	0x7c, 0x7c, 0x20, 0x21, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
+	0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x75, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
+	0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x72, 0x09, 0xc0, 0xf5, 0x28, 0x01, 0x10, 0x01,
+	0x18, 0x80, 0x08, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0b, 0xba, 0x48, 0x08, 0x72, 0x06, 0xc8, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9d, 0x03, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x1a, 0x8f, 0x02, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa5, 0x02, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0d, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x74,
+	0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x64, 0xba, 0x48, 0x61, 0x1a, 0x5f, 0x0a,
+	0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x12,
+	0x2e, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x64, 0x1a,
+	0x1d, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x20,
+	0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xa8,
+	0x03, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x57, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x64, 0x67, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x52,
+	0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x45, 0x64, 0x67, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x45, 0x64, 0x67, 0x65, 0x73, 0x1a, 0x8e, 0x01, 0x0a, 0x04, 0x45, 0x64, 0x67,
+	0x65, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x46, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x08, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x35, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72,
+	0x03, 0x18, 0x80, 0x08, 0x52, 0x10, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x46, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x99, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x08, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x2f, 0x0a, 0x02, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba, 0x48,
+	0x1c, 0x72, 0x1a, 0x52, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x02, 0x62,
+	0x79, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d,
+	0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x43, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0xa4, 0x01,
+	0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x22, 0x46, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2b, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08,
+	0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe8, 0x02, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x12, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x17,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x68, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x6a, 0x6f, 0x72,
+	0x73, 0x22, 0xa2, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x67, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
 	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
-	0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x99, 0x01, 0x0a, 0x0f, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e,
+	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x46,
+	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x70, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc7, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x63, 0x72, 0x6f, 0x77,
+	0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x1a,
+	0xc0, 0x01, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
 	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
-	0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
-	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa2, 0x01, 0x0a,
-	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0x7e, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f,
+	0x6e, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x08, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x30, 0x0a, 0x0e, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x79, 0x65, 0x61,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18,
+	0x64, 0x28, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x59, 0x65, 0x61,
+	0x72, 0x73, 0x22, 0xef, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x62, 0x61, 0x6e, 0x64,
+	0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x4c, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69,
+	0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f,
+	0x6e, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0xe6, 0x01, 0x0a, 0x0f,
+	0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12,
+	0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x68, 0x6f, 0x73,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x08, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0xba, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x46, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x68, 0x6f, 0x73, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0xb8, 0x01, 0x0a, 0x0c, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xaf, 0x01, 0x0a,
+	0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba,
+	0x48, 0x0b, 0x72, 0x09, 0xc0, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0a, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x72,
+	0x06, 0xc8, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x3c, 0x0a, 0x08, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x20, 0xba, 0x48, 0x1d, 0x92, 0x01, 0x1a, 0x10, 0x64, 0x18, 0x01, 0x22, 0x14,
+	0x72, 0x12, 0x18, 0x80, 0x0a, 0x32, 0x0d, 0x5e, 0x5b, 0x5e, 0x40, 0x5d, 0x2b, 0x40, 0x5b, 0x5e,
+	0x40, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x95,
+	0x02, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x07, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x63,
+	0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x95,
+	0x01, 0x0a, 0x0e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x64, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x12, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x64, 0x65, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a,
+	0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x72, 0x09, 0xc0, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x18,
+	0x80, 0x08, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0b, 0xba, 0x48, 0x08, 0x72, 0x06, 0xc8, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18,
+	0x64, 0x28, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x2c, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xba, 0x48, 0x15,
+	0x72, 0x13, 0x52, 0x00, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xfc, 0x02, 0x0a, 0x13,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xff, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64,
+	0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0xba, 0x48, 0x0b, 0x72, 0x09, 0xc0, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0a,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba, 0x48, 0x08,
+	0x72, 0x06, 0xc8, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0x88, 0x04, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0a, 0x61, 0x64, 0x76,
+	0x69, 0x73, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x64, 0x76, 0x69, 0x73,
+	0x6f, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x5f, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x43, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x1a, 0x60, 0x0a, 0x08,
+	0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6f, 0x73, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x73, 0x76, 0x1a, 0xa3,
+	0x01, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64,
+	0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72,
 	0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
 	0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xe4, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0xbf, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
+	0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x08, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xf8, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x84, 0x01, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x22, 0xc1,
+	0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x1a, 0x59, 0x0a, 0x05, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x65, 0x64, 0x22, 0x77, 0x0a, 0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x66, 0x0a, 0x14, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x6b, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0a, 0x6f, 0x6c,
+	0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x38, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0xba, 0x48, 0x1b, 0x72, 0x19, 0x18, 0x80, 0x02, 0x32, 0x14, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x2b, 0x28, 0x29, 0x20, 0x2d, 0x5d,
+	0x2a, 0x24, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53,
+	0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42,
+	0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x52, 0x07, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5f, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f,
+	0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62,
+	0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0xa8, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2c, 0xba, 0x48, 0x29, 0x72, 0x27, 0x52, 0x00, 0x52, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a,
+	0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x85, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63,
+	0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb5, 0x02, 0x0a, 0x0c, 0x49, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x22, 0x92, 0x03, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0b,
 	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x72, 0x6f,
+	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0a, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x70, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2b, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08,
+	0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba,
+	0x48, 0x08, 0x72, 0x06, 0xc8, 0xf5, 0x28, 0x01, 0x10, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x6c, 0x0a, 0x15, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x0a, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xd8, 0x01,
+	0x01, 0x72, 0x04, 0xc8, 0xf5, 0x28, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x7a, 0x0a, 0x16, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0xd8, 0x01, 0x0a,
+	0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xba, 0x48,
+	0x1a, 0x72, 0x18, 0x10, 0x01, 0x18, 0x80, 0x01, 0x32, 0x11, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x69, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x61, 0x70, 0x69,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f,
 	0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
-	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
-	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
-	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x34,
-	0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x61,
-	0x6c, 0x6c, 0x10, 0x02, 0x2a, 0x37, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x64,
-	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x10, 0x00, 0x12, 0x0e, 0x0a,
-	0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x10, 0x02, 0x32, 0xe2, 0x06,
-	0x0a, 0x0e, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x95, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x12, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x14, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x58, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x61, 0x70, 0x69,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72,
+	0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x32, 0x0a, 0x13,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x5d, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x83, 0x01,
+	0x0a, 0x12, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x64, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x72, 0x06, 0x10, 0x01, 0x28, 0x80, 0x80, 0x20, 0x52, 0x03,
+	0x64, 0x6f, 0x74, 0x12, 0x4e, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x22, 0xbd, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69,
+	0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x22, 0x75, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x33, 0x0a, 0x07,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x64, 0x67,
+	0x65, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xd2, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e,
 	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61,
-	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x72,
+	0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x63, 0x75, 0x75, 0x6d, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x63, 0x75,
+	0x75, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69,
+	0x6e, 0x74, 0x22, 0x7b, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x2a,
+	0x34, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
+	0x61, 0x6c, 0x6c, 0x10, 0x02, 0x2a, 0x29, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65,
+	0x6d, 0x76, 0x65, 0x72, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x10, 0x01,
+	0x2a, 0x37, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x10, 0x02, 0x2a, 0x20, 0x0a, 0x0c, 0x52, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x73, 0x76, 0x67,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x70, 0x6e, 0x67, 0x10, 0x01, 0x32, 0xb6, 0x30, 0x0a, 0x0e,
+	0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x95,
+	0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69,
+	0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x3a, 0x01, 0x2a, 0x1a, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
 	0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x1a, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64,
-	0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63,
-	0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
-	0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0xac, 0x01, 0x0a, 0x12, 0x4c,
-	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x39, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x63,
-	0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
-	0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
-	0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x2d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x12, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x12, 0x39, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70,
-	0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x63, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x72, 0x6f,
+	0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0xac, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x39, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x63, 0x72, 0x6f,
+	0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2d, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x39,
+	0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x63, 0x72, 0x6f, 0x77,
+	0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x0c, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x22, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2d, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0xcf, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x2e, 0x63, 0x72,
 	0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
-	0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a,
-	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x22, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x6f,
+	0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x63, 0x72,
+	0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x6f,
 	0x64, 0x75, 0x6c, 0x65, 0x2d, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
 	0x73, 0x12, 0xae, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e,
 	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x38, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
@@ -944,31 +7145,351 @@ var file_perseus_proto_rawDesc = []byte{
 	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3,
 	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f,
 	0x64, 0x75, 0x6c, 0x65, 0x73, 0x2d, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x32, 0x10, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5a, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x42, 0xee, 0x02, 0x92, 0x41, 0x74, 0x12, 0x4a, 0x0a, 0x43, 0x50, 0x65,
-	0x72, 0x73, 0x65, 0x75, 0x73, 0x20, 0x2d, 0x20, 0x44, 0x65, 0x66, 0x65, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4b, 0x72, 0x61, 0x6b, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x61,
-	0x74, 0x20, 0x69, 0x73, 0x20, 0x47, 0x6f, 0x20, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x20,
-	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x73, 0x32, 0x03, 0x30, 0x2e, 0x31, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x0a, 0x22,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e,
+	0x65, 0x73, 0x12, 0xbf, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3b, 0x2e, 0x63, 0x72,
+	0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64,
+	0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2d,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x8c, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x66, 0x66, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x12, 0x30, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69,
+	0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
+	0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2d, 0x64,
+	0x69, 0x66, 0x66, 0x12, 0x7a, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x64, 0x67,
+	0x65, 0x73, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
+	0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x64,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x9c, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x35, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x63, 0x72, 0x6f, 0x77,
+	0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x6f, 0x70, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x6f, 0x70, 0x2d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0xa5,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x12, 0x37, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63,
+	0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2d, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x3a,
+	0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x63, 0x72, 0x6f,
+	0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12,
+	0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2d,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x12, 0x3a, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2d, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0xb4, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x62, 0x61, 0x6e, 0x64,
+	0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65,
+	0x64, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x2d, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0xa8, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x68, 0x6f, 0x73,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x2e, 0x63, 0x72, 0x6f, 0x77,
+	0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x68, 0x6f, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x68, 0x6f, 0x73, 0x74, 0x2d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x94,
+	0x01, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x32,
+	0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12,
+	0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2d, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0xa1, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x37, 0x2e, 0x63, 0x72, 0x6f,
+	0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2d, 0x73, 0x65, 0x74, 0x12, 0xaf, 0x01, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x3a, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e,
 	0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61,
-	0x70, 0x69, 0x42, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43,
-	0x72, 0x6f, 0x77, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x65,
-	0x75, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0xa2, 0x02, 0x03,
-	0x43, 0x50, 0x50, 0xaa, 0x02, 0x1e, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
-	0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75,
-	0x73, 0x61, 0x70, 0x69, 0xca, 0x02, 0x1e, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69,
-	0x6b, 0x65, 0x5c, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x5c, 0x50, 0x65, 0x72, 0x73, 0x65,
-	0x75, 0x73, 0x61, 0x70, 0x69, 0xe2, 0x02, 0x2a, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
-	0x69, 0x6b, 0x65, 0x5c, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x5c, 0x50, 0x65, 0x72, 0x73,
-	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x20, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
-	0x3a, 0x3a, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x3a, 0x3a, 0x50, 0x65, 0x72, 0x73, 0x65,
-	0x75, 0x73, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x75, 0x6c, 0x6e,
+	0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x0b,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x32, 0x2e, 0x63, 0x72,
+	0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22,
+	0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2d,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0xa9, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64,
+	0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x36, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a,
+	0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x99, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x12, 0x31, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a,
+	0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2d, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0xa1, 0x01,
+	0x0a, 0x0c, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x33,
+	0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x2d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2d, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0xae, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x2e, 0x63, 0x72, 0x6f,
+	0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2d, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63,
+	0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x2d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0xbe, 0x01, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b,
+	0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0xa9, 0x01, 0x0a, 0x0e,
+	0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x35,
+	0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x2d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64,
+	0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70,
+	0x69, 0x2d, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
+	0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x72, 0x6f,
+	0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x6b, 0x65, 0x79, 0x73, 0x12,
+	0xa2, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x12, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2d, 0x61, 0x70, 0x69,
+	0x2d, 0x6b, 0x65, 0x79, 0x12, 0x9a, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x33, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x72, 0x6f,
+	0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2d, 0x6c, 0x6f,
+	0x67, 0x12, 0x89, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2f,
+	0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x9f, 0x01,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x34, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0xb1, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x3a, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x6a,
+	0x6f, 0x62, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x36, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x37, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x2d, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa8, 0x01,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a,
+	0x6f, 0x62, 0x73, 0x12, 0x38, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e,
+	0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x2d, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x99, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x2e, 0x63, 0x72, 0x6f,
+	0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
+	0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2d,
+	0x69, 0x6e, 0x66, 0x6f, 0x32, 0x10, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5a, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0xee, 0x02, 0x92, 0x41, 0x74, 0x12, 0x4a, 0x0a, 0x43,
+	0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x20, 0x2d, 0x20, 0x44, 0x65, 0x66, 0x65, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4b, 0x72, 0x61, 0x6b, 0x65, 0x6e, 0x20, 0x74,
+	0x68, 0x61, 0x74, 0x20, 0x69, 0x73, 0x20, 0x47, 0x6f, 0x20, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x20, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x73, 0x32, 0x03, 0x30, 0x2e, 0x31, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75,
+	0x73, 0x61, 0x70, 0x69, 0x42, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2f, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0xa2,
+	0x02, 0x03, 0x43, 0x50, 0x50, 0xaa, 0x02, 0x1e, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0xca, 0x02, 0x1e, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
+	0x72, 0x69, 0x6b, 0x65, 0x5c, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x5c, 0x50, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0xe2, 0x02, 0x2a, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x5c, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x5c, 0x50, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x20, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69,
+	0x6b, 0x65, 0x3a, 0x3a, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x3a, 0x3a, 0x50, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -983,47 +7504,271 @@ func file_perseus_proto_rawDescGZIP() []byte {
 	return file_perseus_proto_rawDescData
 }
 
-var file_perseus_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_perseus_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_perseus_proto_goTypes = []interface{}{
-	(ModuleVersionOption)(0),           // 0: crowdstrike.perseus.perseusapi.ModuleVersionOption
-	(DependencyDirection)(0),           // 1: crowdstrike.perseus.perseusapi.DependencyDirection
-	(*Module)(nil),                     // 2: crowdstrike.perseus.perseusapi.Module
-	(*CreateModuleRequest)(nil),        // 3: crowdstrike.perseus.perseusapi.CreateModuleRequest
-	(*CreateModuleResponse)(nil),       // 4: crowdstrike.perseus.perseusapi.CreateModuleResponse
-	(*ListModulesRequest)(nil),         // 5: crowdstrike.perseus.perseusapi.ListModulesRequest
-	(*ListModulesResponse)(nil),        // 6: crowdstrike.perseus.perseusapi.ListModulesResponse
-	(*ListModuleVersionsRequest)(nil),  // 7: crowdstrike.perseus.perseusapi.ListModuleVersionsRequest
-	(*ListModuleVersionsResponse)(nil), // 8: crowdstrike.perseus.perseusapi.ListModuleVersionsResponse
-	(*UpdateDependenciesRequest)(nil),  // 9: crowdstrike.perseus.perseusapi.UpdateDependenciesRequest
-	(*UpdateDependenciesResponse)(nil), // 10: crowdstrike.perseus.perseusapi.UpdateDependenciesResponse
-	(*QueryDependenciesRequest)(nil),   // 11: crowdstrike.perseus.perseusapi.QueryDependenciesRequest
-	(*QueryDependenciesResponse)(nil),  // 12: crowdstrike.perseus.perseusapi.QueryDependenciesResponse
+var file_perseus_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_perseus_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
+var file_perseus_proto_goTypes = []any{
+	(ModuleVersionOption)(0),                             // 0: crowdstrike.perseus.perseusapi.ModuleVersionOption
+	(ModuleVersionSort)(0),                               // 1: crowdstrike.perseus.perseusapi.ModuleVersionSort
+	(DependencyDirection)(0),                             // 2: crowdstrike.perseus.perseusapi.DependencyDirection
+	(RenderFormat)(0),                                    // 3: crowdstrike.perseus.perseusapi.RenderFormat
+	(*Module)(nil),                                       // 4: crowdstrike.perseus.perseusapi.Module
+	(*CreateModuleRequest)(nil),                          // 5: crowdstrike.perseus.perseusapi.CreateModuleRequest
+	(*CreateModuleResponse)(nil),                         // 6: crowdstrike.perseus.perseusapi.CreateModuleResponse
+	(*ListModulesRequest)(nil),                           // 7: crowdstrike.perseus.perseusapi.ListModulesRequest
+	(*ListModulesResponse)(nil),                          // 8: crowdstrike.perseus.perseusapi.ListModulesResponse
+	(*ListModuleVersionsRequest)(nil),                    // 9: crowdstrike.perseus.perseusapi.ListModuleVersionsRequest
+	(*ListModuleVersionsResponse)(nil),                   // 10: crowdstrike.perseus.perseusapi.ListModuleVersionsResponse
+	(*UpdateDependenciesRequest)(nil),                    // 11: crowdstrike.perseus.perseusapi.UpdateDependenciesRequest
+	(*UpdateDependenciesResponse)(nil),                   // 12: crowdstrike.perseus.perseusapi.UpdateDependenciesResponse
+	(*BatchUpdateDependenciesRequest)(nil),               // 13: crowdstrike.perseus.perseusapi.BatchUpdateDependenciesRequest
+	(*BatchUpdateDependenciesResponse)(nil),              // 14: crowdstrike.perseus.perseusapi.BatchUpdateDependenciesResponse
+	(*QueryDependenciesRequest)(nil),                     // 15: crowdstrike.perseus.perseusapi.QueryDependenciesRequest
+	(*QueryDependenciesResponse)(nil),                    // 16: crowdstrike.perseus.perseusapi.QueryDependenciesResponse
+	(*GetDependencyHistoryRequest)(nil),                  // 17: crowdstrike.perseus.perseusapi.GetDependencyHistoryRequest
+	(*GetDependencyHistoryResponse)(nil),                 // 18: crowdstrike.perseus.perseusapi.GetDependencyHistoryResponse
+	(*DiffGraphRequest)(nil),                             // 19: crowdstrike.perseus.perseusapi.DiffGraphRequest
+	(*DiffGraphResponse)(nil),                            // 20: crowdstrike.perseus.perseusapi.DiffGraphResponse
+	(*StreamEdgesRequest)(nil),                           // 21: crowdstrike.perseus.perseusapi.StreamEdgesRequest
+	(*StreamEdgesResponse)(nil),                          // 22: crowdstrike.perseus.perseusapi.StreamEdgesResponse
+	(*ListTopModulesRequest)(nil),                        // 23: crowdstrike.perseus.perseusapi.ListTopModulesRequest
+	(*ListTopModulesResponse)(nil),                       // 24: crowdstrike.perseus.perseusapi.ListTopModulesResponse
+	(*GetModuleMetricsRequest)(nil),                      // 25: crowdstrike.perseus.perseusapi.GetModuleMetricsRequest
+	(*GetModuleMetricsResponse)(nil),                     // 26: crowdstrike.perseus.perseusapi.GetModuleMetricsResponse
+	(*ListModuleConsumersRequest)(nil),                   // 27: crowdstrike.perseus.perseusapi.ListModuleConsumersRequest
+	(*ListModuleConsumersResponse)(nil),                  // 28: crowdstrike.perseus.perseusapi.ListModuleConsumersResponse
+	(*ListModuleStalenessRequest)(nil),                   // 29: crowdstrike.perseus.perseusapi.ListModuleStalenessRequest
+	(*ListModuleStalenessResponse)(nil),                  // 30: crowdstrike.perseus.perseusapi.ListModuleStalenessResponse
+	(*ListAbandonedModulesRequest)(nil),                  // 31: crowdstrike.perseus.perseusapi.ListAbandonedModulesRequest
+	(*ListAbandonedModulesResponse)(nil),                 // 32: crowdstrike.perseus.perseusapi.ListAbandonedModulesResponse
+	(*ListGhostVersionsRequest)(nil),                     // 33: crowdstrike.perseus.perseusapi.ListGhostVersionsRequest
+	(*ListGhostVersionsResponse)(nil),                    // 34: crowdstrike.perseus.perseusapi.ListGhostVersionsResponse
+	(*ResolveModuleSetRequest)(nil),                      // 35: crowdstrike.perseus.perseusapi.ResolveModuleSetRequest
+	(*ResolveModuleSetResponse)(nil),                     // 36: crowdstrike.perseus.perseusapi.ResolveModuleSetResponse
+	(*CheckPolicyRequest)(nil),                           // 37: crowdstrike.perseus.perseusapi.CheckPolicyRequest
+	(*CheckPolicyResponse)(nil),                          // 38: crowdstrike.perseus.perseusapi.CheckPolicyResponse
+	(*ListVulnerabilitiesRequest)(nil),                   // 39: crowdstrike.perseus.perseusapi.ListVulnerabilitiesRequest
+	(*ListVulnerabilitiesResponse)(nil),                  // 40: crowdstrike.perseus.perseusapi.ListVulnerabilitiesResponse
+	(*RefreshModulesRequest)(nil),                        // 41: crowdstrike.perseus.perseusapi.RefreshModulesRequest
+	(*RefreshModulesResponse)(nil),                       // 42: crowdstrike.perseus.perseusapi.RefreshModulesResponse
+	(*CheckGraphRequest)(nil),                            // 43: crowdstrike.perseus.perseusapi.CheckGraphRequest
+	(*CheckGraphResponse)(nil),                           // 44: crowdstrike.perseus.perseusapi.CheckGraphResponse
+	(*MergeModulesRequest)(nil),                          // 45: crowdstrike.perseus.perseusapi.MergeModulesRequest
+	(*MergeModulesResponse)(nil),                         // 46: crowdstrike.perseus.perseusapi.MergeModulesResponse
+	(*RenameModuleRequest)(nil),                          // 47: crowdstrike.perseus.perseusapi.RenameModuleRequest
+	(*RenameModuleResponse)(nil),                         // 48: crowdstrike.perseus.perseusapi.RenameModuleResponse
+	(*SetModuleLicenseRequest)(nil),                      // 49: crowdstrike.perseus.perseusapi.SetModuleLicenseRequest
+	(*SetModuleLicenseResponse)(nil),                     // 50: crowdstrike.perseus.perseusapi.SetModuleLicenseResponse
+	(*SubmitIngestionJobsRequest)(nil),                   // 51: crowdstrike.perseus.perseusapi.SubmitIngestionJobsRequest
+	(*SubmitIngestionJobsResponse)(nil),                  // 52: crowdstrike.perseus.perseusapi.SubmitIngestionJobsResponse
+	(*GetIngestionJobRequest)(nil),                       // 53: crowdstrike.perseus.perseusapi.GetIngestionJobRequest
+	(*GetIngestionJobResponse)(nil),                      // 54: crowdstrike.perseus.perseusapi.GetIngestionJobResponse
+	(*ListIngestionJobsRequest)(nil),                     // 55: crowdstrike.perseus.perseusapi.ListIngestionJobsRequest
+	(*ListIngestionJobsResponse)(nil),                    // 56: crowdstrike.perseus.perseusapi.ListIngestionJobsResponse
+	(*IngestionJob)(nil),                                 // 57: crowdstrike.perseus.perseusapi.IngestionJob
+	(*ListJobsRequest)(nil),                              // 58: crowdstrike.perseus.perseusapi.ListJobsRequest
+	(*ListJobsResponse)(nil),                             // 59: crowdstrike.perseus.perseusapi.ListJobsResponse
+	(*Job)(nil),                                          // 60: crowdstrike.perseus.perseusapi.Job
+	(*DeleteModuleRequest)(nil),                          // 61: crowdstrike.perseus.perseusapi.DeleteModuleRequest
+	(*DeleteModuleResponse)(nil),                         // 62: crowdstrike.perseus.perseusapi.DeleteModuleResponse
+	(*DeleteModuleVersionRequest)(nil),                   // 63: crowdstrike.perseus.perseusapi.DeleteModuleVersionRequest
+	(*DeleteModuleVersionResponse)(nil),                  // 64: crowdstrike.perseus.perseusapi.DeleteModuleVersionResponse
+	(*UndeleteModuleRequest)(nil),                        // 65: crowdstrike.perseus.perseusapi.UndeleteModuleRequest
+	(*UndeleteModuleResponse)(nil),                       // 66: crowdstrike.perseus.perseusapi.UndeleteModuleResponse
+	(*APIKey)(nil),                                       // 67: crowdstrike.perseus.perseusapi.APIKey
+	(*CreateAPIKeyRequest)(nil),                          // 68: crowdstrike.perseus.perseusapi.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),                         // 69: crowdstrike.perseus.perseusapi.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                           // 70: crowdstrike.perseus.perseusapi.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),                          // 71: crowdstrike.perseus.perseusapi.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),                          // 72: crowdstrike.perseus.perseusapi.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),                         // 73: crowdstrike.perseus.perseusapi.RevokeAPIKeyResponse
+	(*AuditEntry)(nil),                                   // 74: crowdstrike.perseus.perseusapi.AuditEntry
+	(*ListAuditLogRequest)(nil),                          // 75: crowdstrike.perseus.perseusapi.ListAuditLogRequest
+	(*ListAuditLogResponse)(nil),                         // 76: crowdstrike.perseus.perseusapi.ListAuditLogResponse
+	(*RenderGraphRequest)(nil),                           // 77: crowdstrike.perseus.perseusapi.RenderGraphRequest
+	(*RenderGraphResponse)(nil),                          // 78: crowdstrike.perseus.perseusapi.RenderGraphResponse
+	(*GetServerInfoRequest)(nil),                         // 79: crowdstrike.perseus.perseusapi.GetServerInfoRequest
+	(*GetServerInfoResponse)(nil),                        // 80: crowdstrike.perseus.perseusapi.GetServerInfoResponse
+	(*DatabaseHealth)(nil),                               // 81: crowdstrike.perseus.perseusapi.DatabaseHealth
+	(*GraphStats)(nil),                                   // 82: crowdstrike.perseus.perseusapi.GraphStats
+	(*GetStoreStatsRequest)(nil),                         // 83: crowdstrike.perseus.perseusapi.GetStoreStatsRequest
+	(*GetStoreStatsResponse)(nil),                        // 84: crowdstrike.perseus.perseusapi.GetStoreStatsResponse
+	(*StoreTableStats)(nil),                              // 85: crowdstrike.perseus.perseusapi.StoreTableStats
+	(*StoreIndexStats)(nil),                              // 86: crowdstrike.perseus.perseusapi.StoreIndexStats
+	(*GetDependencyHistoryResponse_Dependency)(nil),      // 87: crowdstrike.perseus.perseusapi.GetDependencyHistoryResponse.Dependency
+	(*DiffGraphResponse_Edge)(nil),                       // 88: crowdstrike.perseus.perseusapi.DiffGraphResponse.Edge
+	(*ListTopModulesResponse_RankedModule)(nil),          // 89: crowdstrike.perseus.perseusapi.ListTopModulesResponse.RankedModule
+	(*ListModuleConsumersResponse_ConsumedVersion)(nil),  // 90: crowdstrike.perseus.perseusapi.ListModuleConsumersResponse.ConsumedVersion
+	(*ListModuleStalenessResponse_ModuleStaleness)(nil),  // 91: crowdstrike.perseus.perseusapi.ListModuleStalenessResponse.ModuleStaleness
+	(*ListAbandonedModulesResponse_AbandonedModule)(nil), // 92: crowdstrike.perseus.perseusapi.ListAbandonedModulesResponse.AbandonedModule
+	(*ListGhostVersionsResponse_GhostVersion)(nil),       // 93: crowdstrike.perseus.perseusapi.ListGhostVersionsResponse.GhostVersion
+	(*ResolveModuleSetResponse_SelectedModule)(nil),      // 94: crowdstrike.perseus.perseusapi.ResolveModuleSetResponse.SelectedModule
+	(*CheckPolicyResponse_PolicyViolation)(nil),          // 95: crowdstrike.perseus.perseusapi.CheckPolicyResponse.PolicyViolation
+	(*ListVulnerabilitiesResponse_Advisory)(nil),         // 96: crowdstrike.perseus.perseusapi.ListVulnerabilitiesResponse.Advisory
+	(*ListVulnerabilitiesResponse_Finding)(nil),          // 97: crowdstrike.perseus.perseusapi.ListVulnerabilitiesResponse.Finding
+	(*RefreshModulesResponse_Result)(nil),                // 98: crowdstrike.perseus.perseusapi.RefreshModulesResponse.Result
+	(*CheckGraphResponse_Issue)(nil),                     // 99: crowdstrike.perseus.perseusapi.CheckGraphResponse.Issue
+	(*timestamppb.Timestamp)(nil),                        // 100: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                          // 101: google.protobuf.Duration
 }
 var file_perseus_proto_depIdxs = []int32{
-	2,  // 0: crowdstrike.perseus.perseusapi.CreateModuleRequest.module:type_name -> crowdstrike.perseus.perseusapi.Module
-	2,  // 1: crowdstrike.perseus.perseusapi.CreateModuleResponse.module:type_name -> crowdstrike.perseus.perseusapi.Module
-	2,  // 2: crowdstrike.perseus.perseusapi.ListModulesResponse.modules:type_name -> crowdstrike.perseus.perseusapi.Module
-	0,  // 3: crowdstrike.perseus.perseusapi.ListModuleVersionsRequest.version_option:type_name -> crowdstrike.perseus.perseusapi.ModuleVersionOption
-	2,  // 4: crowdstrike.perseus.perseusapi.ListModuleVersionsResponse.modules:type_name -> crowdstrike.perseus.perseusapi.Module
-	2,  // 5: crowdstrike.perseus.perseusapi.UpdateDependenciesRequest.dependencies:type_name -> crowdstrike.perseus.perseusapi.Module
-	1,  // 6: crowdstrike.perseus.perseusapi.QueryDependenciesRequest.direction:type_name -> crowdstrike.perseus.perseusapi.DependencyDirection
-	2,  // 7: crowdstrike.perseus.perseusapi.QueryDependenciesResponse.modules:type_name -> crowdstrike.perseus.perseusapi.Module
-	3,  // 8: crowdstrike.perseus.perseusapi.PerseusService.CreateModule:input_type -> crowdstrike.perseus.perseusapi.CreateModuleRequest
-	5,  // 9: crowdstrike.perseus.perseusapi.PerseusService.ListModules:input_type -> crowdstrike.perseus.perseusapi.ListModulesRequest
-	7,  // 10: crowdstrike.perseus.perseusapi.PerseusService.ListModuleVersions:input_type -> crowdstrike.perseus.perseusapi.ListModuleVersionsRequest
-	9,  // 11: crowdstrike.perseus.perseusapi.PerseusService.UpdateDependencies:input_type -> crowdstrike.perseus.perseusapi.UpdateDependenciesRequest
-	11, // 12: crowdstrike.perseus.perseusapi.PerseusService.QueryDependencies:input_type -> crowdstrike.perseus.perseusapi.QueryDependenciesRequest
-	4,  // 13: crowdstrike.perseus.perseusapi.PerseusService.CreateModule:output_type -> crowdstrike.perseus.perseusapi.CreateModuleResponse
-	6,  // 14: crowdstrike.perseus.perseusapi.PerseusService.ListModules:output_type -> crowdstrike.perseus.perseusapi.ListModulesResponse
-	8,  // 15: crowdstrike.perseus.perseusapi.PerseusService.ListModuleVersions:output_type -> crowdstrike.perseus.perseusapi.ListModuleVersionsResponse
-	10, // 16: crowdstrike.perseus.perseusapi.PerseusService.UpdateDependencies:output_type -> crowdstrike.perseus.perseusapi.UpdateDependenciesResponse
-	12, // 17: crowdstrike.perseus.perseusapi.PerseusService.QueryDependencies:output_type -> crowdstrike.perseus.perseusapi.QueryDependenciesResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	100, // 0: crowdstrike.perseus.perseusapi.Module.version_times:type_name -> google.protobuf.Timestamp
+	4,   // 1: crowdstrike.perseus.perseusapi.CreateModuleRequest.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 2: crowdstrike.perseus.perseusapi.CreateModuleResponse.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 3: crowdstrike.perseus.perseusapi.ListModulesResponse.modules:type_name -> crowdstrike.perseus.perseusapi.Module
+	100, // 4: crowdstrike.perseus.perseusapi.ListModuleVersionsRequest.as_of:type_name -> google.protobuf.Timestamp
+	100, // 5: crowdstrike.perseus.perseusapi.ListModuleVersionsRequest.since:type_name -> google.protobuf.Timestamp
+	0,   // 6: crowdstrike.perseus.perseusapi.ListModuleVersionsRequest.version_option:type_name -> crowdstrike.perseus.perseusapi.ModuleVersionOption
+	1,   // 7: crowdstrike.perseus.perseusapi.ListModuleVersionsRequest.sort:type_name -> crowdstrike.perseus.perseusapi.ModuleVersionSort
+	4,   // 8: crowdstrike.perseus.perseusapi.ListModuleVersionsResponse.modules:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 9: crowdstrike.perseus.perseusapi.UpdateDependenciesRequest.dependencies:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 10: crowdstrike.perseus.perseusapi.UpdateDependenciesResponse.added_dependencies:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 11: crowdstrike.perseus.perseusapi.UpdateDependenciesResponse.removed_dependencies:type_name -> crowdstrike.perseus.perseusapi.Module
+	11,  // 12: crowdstrike.perseus.perseusapi.BatchUpdateDependenciesRequest.updates:type_name -> crowdstrike.perseus.perseusapi.UpdateDependenciesRequest
+	2,   // 13: crowdstrike.perseus.perseusapi.QueryDependenciesRequest.direction:type_name -> crowdstrike.perseus.perseusapi.DependencyDirection
+	100, // 14: crowdstrike.perseus.perseusapi.QueryDependenciesRequest.as_of:type_name -> google.protobuf.Timestamp
+	4,   // 15: crowdstrike.perseus.perseusapi.QueryDependenciesResponse.modules:type_name -> crowdstrike.perseus.perseusapi.Module
+	87,  // 16: crowdstrike.perseus.perseusapi.GetDependencyHistoryResponse.dependencies:type_name -> crowdstrike.perseus.perseusapi.GetDependencyHistoryResponse.Dependency
+	100, // 17: crowdstrike.perseus.perseusapi.DiffGraphRequest.from_time:type_name -> google.protobuf.Timestamp
+	100, // 18: crowdstrike.perseus.perseusapi.DiffGraphRequest.to_time:type_name -> google.protobuf.Timestamp
+	88,  // 19: crowdstrike.perseus.perseusapi.DiffGraphResponse.added_edges:type_name -> crowdstrike.perseus.perseusapi.DiffGraphResponse.Edge
+	88,  // 20: crowdstrike.perseus.perseusapi.DiffGraphResponse.removed_edges:type_name -> crowdstrike.perseus.perseusapi.DiffGraphResponse.Edge
+	100, // 21: crowdstrike.perseus.perseusapi.StreamEdgesRequest.as_of:type_name -> google.protobuf.Timestamp
+	4,   // 22: crowdstrike.perseus.perseusapi.StreamEdgesResponse.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 23: crowdstrike.perseus.perseusapi.StreamEdgesResponse.dependency:type_name -> crowdstrike.perseus.perseusapi.Module
+	89,  // 24: crowdstrike.perseus.perseusapi.ListTopModulesResponse.modules:type_name -> crowdstrike.perseus.perseusapi.ListTopModulesResponse.RankedModule
+	100, // 25: crowdstrike.perseus.perseusapi.GetModuleMetricsResponse.last_commit_time:type_name -> google.protobuf.Timestamp
+	90,  // 26: crowdstrike.perseus.perseusapi.ListModuleConsumersResponse.versions:type_name -> crowdstrike.perseus.perseusapi.ListModuleConsumersResponse.ConsumedVersion
+	91,  // 27: crowdstrike.perseus.perseusapi.ListModuleStalenessResponse.modules:type_name -> crowdstrike.perseus.perseusapi.ListModuleStalenessResponse.ModuleStaleness
+	92,  // 28: crowdstrike.perseus.perseusapi.ListAbandonedModulesResponse.modules:type_name -> crowdstrike.perseus.perseusapi.ListAbandonedModulesResponse.AbandonedModule
+	93,  // 29: crowdstrike.perseus.perseusapi.ListGhostVersionsResponse.versions:type_name -> crowdstrike.perseus.perseusapi.ListGhostVersionsResponse.GhostVersion
+	94,  // 30: crowdstrike.perseus.perseusapi.ResolveModuleSetResponse.modules:type_name -> crowdstrike.perseus.perseusapi.ResolveModuleSetResponse.SelectedModule
+	95,  // 31: crowdstrike.perseus.perseusapi.CheckPolicyResponse.violations:type_name -> crowdstrike.perseus.perseusapi.CheckPolicyResponse.PolicyViolation
+	96,  // 32: crowdstrike.perseus.perseusapi.ListVulnerabilitiesResponse.advisories:type_name -> crowdstrike.perseus.perseusapi.ListVulnerabilitiesResponse.Advisory
+	97,  // 33: crowdstrike.perseus.perseusapi.ListVulnerabilitiesResponse.findings:type_name -> crowdstrike.perseus.perseusapi.ListVulnerabilitiesResponse.Finding
+	98,  // 34: crowdstrike.perseus.perseusapi.RefreshModulesResponse.results:type_name -> crowdstrike.perseus.perseusapi.RefreshModulesResponse.Result
+	99,  // 35: crowdstrike.perseus.perseusapi.CheckGraphResponse.issues:type_name -> crowdstrike.perseus.perseusapi.CheckGraphResponse.Issue
+	4,   // 36: crowdstrike.perseus.perseusapi.SubmitIngestionJobsRequest.modules:type_name -> crowdstrike.perseus.perseusapi.Module
+	57,  // 37: crowdstrike.perseus.perseusapi.SubmitIngestionJobsResponse.jobs:type_name -> crowdstrike.perseus.perseusapi.IngestionJob
+	57,  // 38: crowdstrike.perseus.perseusapi.GetIngestionJobResponse.job:type_name -> crowdstrike.perseus.perseusapi.IngestionJob
+	57,  // 39: crowdstrike.perseus.perseusapi.ListIngestionJobsResponse.jobs:type_name -> crowdstrike.perseus.perseusapi.IngestionJob
+	100, // 40: crowdstrike.perseus.perseusapi.IngestionJob.create_time:type_name -> google.protobuf.Timestamp
+	100, // 41: crowdstrike.perseus.perseusapi.IngestionJob.update_time:type_name -> google.protobuf.Timestamp
+	60,  // 42: crowdstrike.perseus.perseusapi.ListJobsResponse.jobs:type_name -> crowdstrike.perseus.perseusapi.Job
+	100, // 43: crowdstrike.perseus.perseusapi.Job.last_run_time:type_name -> google.protobuf.Timestamp
+	101, // 44: crowdstrike.perseus.perseusapi.Job.last_run_duration:type_name -> google.protobuf.Duration
+	100, // 45: crowdstrike.perseus.perseusapi.Job.next_run_time:type_name -> google.protobuf.Timestamp
+	100, // 46: crowdstrike.perseus.perseusapi.APIKey.create_time:type_name -> google.protobuf.Timestamp
+	100, // 47: crowdstrike.perseus.perseusapi.APIKey.last_used_time:type_name -> google.protobuf.Timestamp
+	100, // 48: crowdstrike.perseus.perseusapi.APIKey.revoke_time:type_name -> google.protobuf.Timestamp
+	67,  // 49: crowdstrike.perseus.perseusapi.CreateAPIKeyResponse.api_key:type_name -> crowdstrike.perseus.perseusapi.APIKey
+	67,  // 50: crowdstrike.perseus.perseusapi.ListAPIKeysResponse.api_keys:type_name -> crowdstrike.perseus.perseusapi.APIKey
+	100, // 51: crowdstrike.perseus.perseusapi.AuditEntry.time:type_name -> google.protobuf.Timestamp
+	74,  // 52: crowdstrike.perseus.perseusapi.ListAuditLogResponse.entries:type_name -> crowdstrike.perseus.perseusapi.AuditEntry
+	3,   // 53: crowdstrike.perseus.perseusapi.RenderGraphRequest.format:type_name -> crowdstrike.perseus.perseusapi.RenderFormat
+	100, // 54: crowdstrike.perseus.perseusapi.GetServerInfoResponse.start_time:type_name -> google.protobuf.Timestamp
+	81,  // 55: crowdstrike.perseus.perseusapi.GetServerInfoResponse.database:type_name -> crowdstrike.perseus.perseusapi.DatabaseHealth
+	82,  // 56: crowdstrike.perseus.perseusapi.GetServerInfoResponse.graph:type_name -> crowdstrike.perseus.perseusapi.GraphStats
+	101, // 57: crowdstrike.perseus.perseusapi.DatabaseHealth.latency:type_name -> google.protobuf.Duration
+	100, // 58: crowdstrike.perseus.perseusapi.GraphStats.last_ingest_time:type_name -> google.protobuf.Timestamp
+	85,  // 59: crowdstrike.perseus.perseusapi.GetStoreStatsResponse.tables:type_name -> crowdstrike.perseus.perseusapi.StoreTableStats
+	86,  // 60: crowdstrike.perseus.perseusapi.GetStoreStatsResponse.indexes:type_name -> crowdstrike.perseus.perseusapi.StoreIndexStats
+	100, // 61: crowdstrike.perseus.perseusapi.StoreTableStats.last_vacuum_time:type_name -> google.protobuf.Timestamp
+	100, // 62: crowdstrike.perseus.perseusapi.StoreTableStats.last_analyze_time:type_name -> google.protobuf.Timestamp
+	4,   // 63: crowdstrike.perseus.perseusapi.GetDependencyHistoryResponse.Dependency.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	100, // 64: crowdstrike.perseus.perseusapi.GetDependencyHistoryResponse.Dependency.first_seen_time:type_name -> google.protobuf.Timestamp
+	100, // 65: crowdstrike.perseus.perseusapi.GetDependencyHistoryResponse.Dependency.last_seen_time:type_name -> google.protobuf.Timestamp
+	100, // 66: crowdstrike.perseus.perseusapi.GetDependencyHistoryResponse.Dependency.remove_time:type_name -> google.protobuf.Timestamp
+	4,   // 67: crowdstrike.perseus.perseusapi.DiffGraphResponse.Edge.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 68: crowdstrike.perseus.perseusapi.DiffGraphResponse.Edge.dependency:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 69: crowdstrike.perseus.perseusapi.ListModuleConsumersResponse.ConsumedVersion.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 70: crowdstrike.perseus.perseusapi.ListModuleConsumersResponse.ConsumedVersion.dependents:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 71: crowdstrike.perseus.perseusapi.ListModuleStalenessResponse.ModuleStaleness.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	100, // 72: crowdstrike.perseus.perseusapi.ListAbandonedModulesResponse.AbandonedModule.last_commit_time:type_name -> google.protobuf.Timestamp
+	100, // 73: crowdstrike.perseus.perseusapi.ListAbandonedModulesResponse.AbandonedModule.checked_time:type_name -> google.protobuf.Timestamp
+	4,   // 74: crowdstrike.perseus.perseusapi.ListGhostVersionsResponse.GhostVersion.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	100, // 75: crowdstrike.perseus.perseusapi.ListGhostVersionsResponse.GhostVersion.since:type_name -> google.protobuf.Timestamp
+	4,   // 76: crowdstrike.perseus.perseusapi.ResolveModuleSetResponse.SelectedModule.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 77: crowdstrike.perseus.perseusapi.CheckPolicyResponse.PolicyViolation.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	4,   // 78: crowdstrike.perseus.perseusapi.ListVulnerabilitiesResponse.Finding.module:type_name -> crowdstrike.perseus.perseusapi.Module
+	5,   // 79: crowdstrike.perseus.perseusapi.PerseusService.CreateModule:input_type -> crowdstrike.perseus.perseusapi.CreateModuleRequest
+	7,   // 80: crowdstrike.perseus.perseusapi.PerseusService.ListModules:input_type -> crowdstrike.perseus.perseusapi.ListModulesRequest
+	9,   // 81: crowdstrike.perseus.perseusapi.PerseusService.ListModuleVersions:input_type -> crowdstrike.perseus.perseusapi.ListModuleVersionsRequest
+	11,  // 82: crowdstrike.perseus.perseusapi.PerseusService.UpdateDependencies:input_type -> crowdstrike.perseus.perseusapi.UpdateDependenciesRequest
+	13,  // 83: crowdstrike.perseus.perseusapi.PerseusService.BatchUpdateDependencies:input_type -> crowdstrike.perseus.perseusapi.BatchUpdateDependenciesRequest
+	15,  // 84: crowdstrike.perseus.perseusapi.PerseusService.QueryDependencies:input_type -> crowdstrike.perseus.perseusapi.QueryDependenciesRequest
+	17,  // 85: crowdstrike.perseus.perseusapi.PerseusService.GetDependencyHistory:input_type -> crowdstrike.perseus.perseusapi.GetDependencyHistoryRequest
+	19,  // 86: crowdstrike.perseus.perseusapi.PerseusService.DiffGraph:input_type -> crowdstrike.perseus.perseusapi.DiffGraphRequest
+	21,  // 87: crowdstrike.perseus.perseusapi.PerseusService.StreamEdges:input_type -> crowdstrike.perseus.perseusapi.StreamEdgesRequest
+	23,  // 88: crowdstrike.perseus.perseusapi.PerseusService.ListTopModules:input_type -> crowdstrike.perseus.perseusapi.ListTopModulesRequest
+	25,  // 89: crowdstrike.perseus.perseusapi.PerseusService.GetModuleMetrics:input_type -> crowdstrike.perseus.perseusapi.GetModuleMetricsRequest
+	27,  // 90: crowdstrike.perseus.perseusapi.PerseusService.ListModuleConsumers:input_type -> crowdstrike.perseus.perseusapi.ListModuleConsumersRequest
+	29,  // 91: crowdstrike.perseus.perseusapi.PerseusService.ListModuleStaleness:input_type -> crowdstrike.perseus.perseusapi.ListModuleStalenessRequest
+	31,  // 92: crowdstrike.perseus.perseusapi.PerseusService.ListAbandonedModules:input_type -> crowdstrike.perseus.perseusapi.ListAbandonedModulesRequest
+	33,  // 93: crowdstrike.perseus.perseusapi.PerseusService.ListGhostVersions:input_type -> crowdstrike.perseus.perseusapi.ListGhostVersionsRequest
+	37,  // 94: crowdstrike.perseus.perseusapi.PerseusService.CheckPolicy:input_type -> crowdstrike.perseus.perseusapi.CheckPolicyRequest
+	35,  // 95: crowdstrike.perseus.perseusapi.PerseusService.ResolveModuleSet:input_type -> crowdstrike.perseus.perseusapi.ResolveModuleSetRequest
+	39,  // 96: crowdstrike.perseus.perseusapi.PerseusService.ListVulnerabilities:input_type -> crowdstrike.perseus.perseusapi.ListVulnerabilitiesRequest
+	77,  // 97: crowdstrike.perseus.perseusapi.PerseusService.RenderGraph:input_type -> crowdstrike.perseus.perseusapi.RenderGraphRequest
+	41,  // 98: crowdstrike.perseus.perseusapi.PerseusService.RefreshModules:input_type -> crowdstrike.perseus.perseusapi.RefreshModulesRequest
+	43,  // 99: crowdstrike.perseus.perseusapi.PerseusService.CheckGraph:input_type -> crowdstrike.perseus.perseusapi.CheckGraphRequest
+	45,  // 100: crowdstrike.perseus.perseusapi.PerseusService.MergeModules:input_type -> crowdstrike.perseus.perseusapi.MergeModulesRequest
+	47,  // 101: crowdstrike.perseus.perseusapi.PerseusService.RenameModule:input_type -> crowdstrike.perseus.perseusapi.RenameModuleRequest
+	49,  // 102: crowdstrike.perseus.perseusapi.PerseusService.SetModuleLicense:input_type -> crowdstrike.perseus.perseusapi.SetModuleLicenseRequest
+	61,  // 103: crowdstrike.perseus.perseusapi.PerseusService.DeleteModule:input_type -> crowdstrike.perseus.perseusapi.DeleteModuleRequest
+	63,  // 104: crowdstrike.perseus.perseusapi.PerseusService.DeleteModuleVersion:input_type -> crowdstrike.perseus.perseusapi.DeleteModuleVersionRequest
+	65,  // 105: crowdstrike.perseus.perseusapi.PerseusService.UndeleteModule:input_type -> crowdstrike.perseus.perseusapi.UndeleteModuleRequest
+	68,  // 106: crowdstrike.perseus.perseusapi.PerseusService.CreateAPIKey:input_type -> crowdstrike.perseus.perseusapi.CreateAPIKeyRequest
+	70,  // 107: crowdstrike.perseus.perseusapi.PerseusService.ListAPIKeys:input_type -> crowdstrike.perseus.perseusapi.ListAPIKeysRequest
+	72,  // 108: crowdstrike.perseus.perseusapi.PerseusService.RevokeAPIKey:input_type -> crowdstrike.perseus.perseusapi.RevokeAPIKeyRequest
+	75,  // 109: crowdstrike.perseus.perseusapi.PerseusService.ListAuditLog:input_type -> crowdstrike.perseus.perseusapi.ListAuditLogRequest
+	58,  // 110: crowdstrike.perseus.perseusapi.PerseusService.ListJobs:input_type -> crowdstrike.perseus.perseusapi.ListJobsRequest
+	83,  // 111: crowdstrike.perseus.perseusapi.PerseusService.GetStoreStats:input_type -> crowdstrike.perseus.perseusapi.GetStoreStatsRequest
+	51,  // 112: crowdstrike.perseus.perseusapi.PerseusService.SubmitIngestionJobs:input_type -> crowdstrike.perseus.perseusapi.SubmitIngestionJobsRequest
+	53,  // 113: crowdstrike.perseus.perseusapi.PerseusService.GetIngestionJob:input_type -> crowdstrike.perseus.perseusapi.GetIngestionJobRequest
+	55,  // 114: crowdstrike.perseus.perseusapi.PerseusService.ListIngestionJobs:input_type -> crowdstrike.perseus.perseusapi.ListIngestionJobsRequest
+	79,  // 115: crowdstrike.perseus.perseusapi.PerseusService.GetServerInfo:input_type -> crowdstrike.perseus.perseusapi.GetServerInfoRequest
+	6,   // 116: crowdstrike.perseus.perseusapi.PerseusService.CreateModule:output_type -> crowdstrike.perseus.perseusapi.CreateModuleResponse
+	8,   // 117: crowdstrike.perseus.perseusapi.PerseusService.ListModules:output_type -> crowdstrike.perseus.perseusapi.ListModulesResponse
+	10,  // 118: crowdstrike.perseus.perseusapi.PerseusService.ListModuleVersions:output_type -> crowdstrike.perseus.perseusapi.ListModuleVersionsResponse
+	12,  // 119: crowdstrike.perseus.perseusapi.PerseusService.UpdateDependencies:output_type -> crowdstrike.perseus.perseusapi.UpdateDependenciesResponse
+	14,  // 120: crowdstrike.perseus.perseusapi.PerseusService.BatchUpdateDependencies:output_type -> crowdstrike.perseus.perseusapi.BatchUpdateDependenciesResponse
+	16,  // 121: crowdstrike.perseus.perseusapi.PerseusService.QueryDependencies:output_type -> crowdstrike.perseus.perseusapi.QueryDependenciesResponse
+	18,  // 122: crowdstrike.perseus.perseusapi.PerseusService.GetDependencyHistory:output_type -> crowdstrike.perseus.perseusapi.GetDependencyHistoryResponse
+	20,  // 123: crowdstrike.perseus.perseusapi.PerseusService.DiffGraph:output_type -> crowdstrike.perseus.perseusapi.DiffGraphResponse
+	22,  // 124: crowdstrike.perseus.perseusapi.PerseusService.StreamEdges:output_type -> crowdstrike.perseus.perseusapi.StreamEdgesResponse
+	24,  // 125: crowdstrike.perseus.perseusapi.PerseusService.ListTopModules:output_type -> crowdstrike.perseus.perseusapi.ListTopModulesResponse
+	26,  // 126: crowdstrike.perseus.perseusapi.PerseusService.GetModuleMetrics:output_type -> crowdstrike.perseus.perseusapi.GetModuleMetricsResponse
+	28,  // 127: crowdstrike.perseus.perseusapi.PerseusService.ListModuleConsumers:output_type -> crowdstrike.perseus.perseusapi.ListModuleConsumersResponse
+	30,  // 128: crowdstrike.perseus.perseusapi.PerseusService.ListModuleStaleness:output_type -> crowdstrike.perseus.perseusapi.ListModuleStalenessResponse
+	32,  // 129: crowdstrike.perseus.perseusapi.PerseusService.ListAbandonedModules:output_type -> crowdstrike.perseus.perseusapi.ListAbandonedModulesResponse
+	34,  // 130: crowdstrike.perseus.perseusapi.PerseusService.ListGhostVersions:output_type -> crowdstrike.perseus.perseusapi.ListGhostVersionsResponse
+	38,  // 131: crowdstrike.perseus.perseusapi.PerseusService.CheckPolicy:output_type -> crowdstrike.perseus.perseusapi.CheckPolicyResponse
+	36,  // 132: crowdstrike.perseus.perseusapi.PerseusService.ResolveModuleSet:output_type -> crowdstrike.perseus.perseusapi.ResolveModuleSetResponse
+	40,  // 133: crowdstrike.perseus.perseusapi.PerseusService.ListVulnerabilities:output_type -> crowdstrike.perseus.perseusapi.ListVulnerabilitiesResponse
+	78,  // 134: crowdstrike.perseus.perseusapi.PerseusService.RenderGraph:output_type -> crowdstrike.perseus.perseusapi.RenderGraphResponse
+	42,  // 135: crowdstrike.perseus.perseusapi.PerseusService.RefreshModules:output_type -> crowdstrike.perseus.perseusapi.RefreshModulesResponse
+	44,  // 136: crowdstrike.perseus.perseusapi.PerseusService.CheckGraph:output_type -> crowdstrike.perseus.perseusapi.CheckGraphResponse
+	46,  // 137: crowdstrike.perseus.perseusapi.PerseusService.MergeModules:output_type -> crowdstrike.perseus.perseusapi.MergeModulesResponse
+	48,  // 138: crowdstrike.perseus.perseusapi.PerseusService.RenameModule:output_type -> crowdstrike.perseus.perseusapi.RenameModuleResponse
+	50,  // 139: crowdstrike.perseus.perseusapi.PerseusService.SetModuleLicense:output_type -> crowdstrike.perseus.perseusapi.SetModuleLicenseResponse
+	62,  // 140: crowdstrike.perseus.perseusapi.PerseusService.DeleteModule:output_type -> crowdstrike.perseus.perseusapi.DeleteModuleResponse
+	64,  // 141: crowdstrike.perseus.perseusapi.PerseusService.DeleteModuleVersion:output_type -> crowdstrike.perseus.perseusapi.DeleteModuleVersionResponse
+	66,  // 142: crowdstrike.perseus.perseusapi.PerseusService.UndeleteModule:output_type -> crowdstrike.perseus.perseusapi.UndeleteModuleResponse
+	69,  // 143: crowdstrike.perseus.perseusapi.PerseusService.CreateAPIKey:output_type -> crowdstrike.perseus.perseusapi.CreateAPIKeyResponse
+	71,  // 144: crowdstrike.perseus.perseusapi.PerseusService.ListAPIKeys:output_type -> crowdstrike.perseus.perseusapi.ListAPIKeysResponse
+	73,  // 145: crowdstrike.perseus.perseusapi.PerseusService.RevokeAPIKey:output_type -> crowdstrike.perseus.perseusapi.RevokeAPIKeyResponse
+	76,  // 146: crowdstrike.perseus.perseusapi.PerseusService.ListAuditLog:output_type -> crowdstrike.perseus.perseusapi.ListAuditLogResponse
+	59,  // 147: crowdstrike.perseus.perseusapi.PerseusService.ListJobs:output_type -> crowdstrike.perseus.perseusapi.ListJobsResponse
+	84,  // 148: crowdstrike.perseus.perseusapi.PerseusService.GetStoreStats:output_type -> crowdstrike.perseus.perseusapi.GetStoreStatsResponse
+	52,  // 149: crowdstrike.perseus.perseusapi.PerseusService.SubmitIngestionJobs:output_type -> crowdstrike.perseus.perseusapi.SubmitIngestionJobsResponse
+	54,  // 150: crowdstrike.perseus.perseusapi.PerseusService.GetIngestionJob:output_type -> crowdstrike.perseus.perseusapi.GetIngestionJobResponse
+	56,  // 151: crowdstrike.perseus.perseusapi.PerseusService.ListIngestionJobs:output_type -> crowdstrike.perseus.perseusapi.ListIngestionJobsResponse
+	80,  // 152: crowdstrike.perseus.perseusapi.PerseusService.GetServerInfo:output_type -> crowdstrike.perseus.perseusapi.GetServerInfoResponse
+	116, // [116:153] is the sub-list for method output_type
+	79,  // [79:116] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_perseus_proto_init() }
@@ -1031,147 +7776,15 @@ func file_perseus_proto_init() {
 	if File_perseus_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_perseus_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Module); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateModuleRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateModuleResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListModulesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListModulesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListModuleVersionsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListModuleVersionsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDependenciesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDependenciesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDependenciesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_perseus_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDependenciesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
+	file_rules_proto_init()
+	file_perseus_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_perseus_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   96,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -47,25 +47,155 @@ const (
 	// PerseusServiceUpdateDependenciesProcedure is the fully-qualified name of the PerseusService's
 	// UpdateDependencies RPC.
 	PerseusServiceUpdateDependenciesProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/UpdateDependencies"
+	// PerseusServiceBatchUpdateDependenciesProcedure is the fully-qualified name of the
+	// PerseusService's BatchUpdateDependencies RPC.
+	PerseusServiceBatchUpdateDependenciesProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/BatchUpdateDependencies"
 	// PerseusServiceQueryDependenciesProcedure is the fully-qualified name of the PerseusService's
 	// QueryDependencies RPC.
 	PerseusServiceQueryDependenciesProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/QueryDependencies"
+	// PerseusServiceGetDependencyHistoryProcedure is the fully-qualified name of the PerseusService's
+	// GetDependencyHistory RPC.
+	PerseusServiceGetDependencyHistoryProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/GetDependencyHistory"
+	// PerseusServiceDiffGraphProcedure is the fully-qualified name of the PerseusService's DiffGraph
+	// RPC.
+	PerseusServiceDiffGraphProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/DiffGraph"
+	// PerseusServiceStreamEdgesProcedure is the fully-qualified name of the PerseusService's
+	// StreamEdges RPC.
+	PerseusServiceStreamEdgesProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/StreamEdges"
+	// PerseusServiceListTopModulesProcedure is the fully-qualified name of the PerseusService's
+	// ListTopModules RPC.
+	PerseusServiceListTopModulesProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListTopModules"
+	// PerseusServiceGetModuleMetricsProcedure is the fully-qualified name of the PerseusService's
+	// GetModuleMetrics RPC.
+	PerseusServiceGetModuleMetricsProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/GetModuleMetrics"
+	// PerseusServiceListModuleConsumersProcedure is the fully-qualified name of the PerseusService's
+	// ListModuleConsumers RPC.
+	PerseusServiceListModuleConsumersProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListModuleConsumers"
+	// PerseusServiceListModuleStalenessProcedure is the fully-qualified name of the PerseusService's
+	// ListModuleStaleness RPC.
+	PerseusServiceListModuleStalenessProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListModuleStaleness"
+	// PerseusServiceListAbandonedModulesProcedure is the fully-qualified name of the PerseusService's
+	// ListAbandonedModules RPC.
+	PerseusServiceListAbandonedModulesProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListAbandonedModules"
+	// PerseusServiceListGhostVersionsProcedure is the fully-qualified name of the PerseusService's
+	// ListGhostVersions RPC.
+	PerseusServiceListGhostVersionsProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListGhostVersions"
+	// PerseusServiceCheckPolicyProcedure is the fully-qualified name of the PerseusService's
+	// CheckPolicy RPC.
+	PerseusServiceCheckPolicyProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/CheckPolicy"
+	// PerseusServiceResolveModuleSetProcedure is the fully-qualified name of the PerseusService's
+	// ResolveModuleSet RPC.
+	PerseusServiceResolveModuleSetProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ResolveModuleSet"
+	// PerseusServiceListVulnerabilitiesProcedure is the fully-qualified name of the PerseusService's
+	// ListVulnerabilities RPC.
+	PerseusServiceListVulnerabilitiesProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListVulnerabilities"
+	// PerseusServiceRenderGraphProcedure is the fully-qualified name of the PerseusService's
+	// RenderGraph RPC.
+	PerseusServiceRenderGraphProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/RenderGraph"
+	// PerseusServiceRefreshModulesProcedure is the fully-qualified name of the PerseusService's
+	// RefreshModules RPC.
+	PerseusServiceRefreshModulesProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/RefreshModules"
+	// PerseusServiceCheckGraphProcedure is the fully-qualified name of the PerseusService's CheckGraph
+	// RPC.
+	PerseusServiceCheckGraphProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/CheckGraph"
+	// PerseusServiceMergeModulesProcedure is the fully-qualified name of the PerseusService's
+	// MergeModules RPC.
+	PerseusServiceMergeModulesProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/MergeModules"
+	// PerseusServiceRenameModuleProcedure is the fully-qualified name of the PerseusService's
+	// RenameModule RPC.
+	PerseusServiceRenameModuleProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/RenameModule"
+	// PerseusServiceSetModuleLicenseProcedure is the fully-qualified name of the PerseusService's
+	// SetModuleLicense RPC.
+	PerseusServiceSetModuleLicenseProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/SetModuleLicense"
+	// PerseusServiceDeleteModuleProcedure is the fully-qualified name of the PerseusService's
+	// DeleteModule RPC.
+	PerseusServiceDeleteModuleProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/DeleteModule"
+	// PerseusServiceDeleteModuleVersionProcedure is the fully-qualified name of the PerseusService's
+	// DeleteModuleVersion RPC.
+	PerseusServiceDeleteModuleVersionProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/DeleteModuleVersion"
+	// PerseusServiceUndeleteModuleProcedure is the fully-qualified name of the PerseusService's
+	// UndeleteModule RPC.
+	PerseusServiceUndeleteModuleProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/UndeleteModule"
+	// PerseusServiceCreateAPIKeyProcedure is the fully-qualified name of the PerseusService's
+	// CreateAPIKey RPC.
+	PerseusServiceCreateAPIKeyProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/CreateAPIKey"
+	// PerseusServiceListAPIKeysProcedure is the fully-qualified name of the PerseusService's
+	// ListAPIKeys RPC.
+	PerseusServiceListAPIKeysProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListAPIKeys"
+	// PerseusServiceRevokeAPIKeyProcedure is the fully-qualified name of the PerseusService's
+	// RevokeAPIKey RPC.
+	PerseusServiceRevokeAPIKeyProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/RevokeAPIKey"
+	// PerseusServiceListAuditLogProcedure is the fully-qualified name of the PerseusService's
+	// ListAuditLog RPC.
+	PerseusServiceListAuditLogProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListAuditLog"
+	// PerseusServiceListJobsProcedure is the fully-qualified name of the PerseusService's ListJobs RPC.
+	PerseusServiceListJobsProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListJobs"
+	// PerseusServiceGetStoreStatsProcedure is the fully-qualified name of the PerseusService's
+	// GetStoreStats RPC.
+	PerseusServiceGetStoreStatsProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/GetStoreStats"
+	// PerseusServiceSubmitIngestionJobsProcedure is the fully-qualified name of the PerseusService's
+	// SubmitIngestionJobs RPC.
+	PerseusServiceSubmitIngestionJobsProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/SubmitIngestionJobs"
+	// PerseusServiceGetIngestionJobProcedure is the fully-qualified name of the PerseusService's
+	// GetIngestionJob RPC.
+	PerseusServiceGetIngestionJobProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/GetIngestionJob"
+	// PerseusServiceListIngestionJobsProcedure is the fully-qualified name of the PerseusService's
+	// ListIngestionJobs RPC.
+	PerseusServiceListIngestionJobsProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/ListIngestionJobs"
+	// PerseusServiceGetServerInfoProcedure is the fully-qualified name of the PerseusService's
+	// GetServerInfo RPC.
+	PerseusServiceGetServerInfoProcedure = "/crowdstrike.perseus.perseusapi.PerseusService/GetServerInfo"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	perseusServiceServiceDescriptor                  = perseusapi.File_perseus_proto.Services().ByName("PerseusService")
-	perseusServiceCreateModuleMethodDescriptor       = perseusServiceServiceDescriptor.Methods().ByName("CreateModule")
-	perseusServiceListModulesMethodDescriptor        = perseusServiceServiceDescriptor.Methods().ByName("ListModules")
-	perseusServiceListModuleVersionsMethodDescriptor = perseusServiceServiceDescriptor.Methods().ByName("ListModuleVersions")
-	perseusServiceUpdateDependenciesMethodDescriptor = perseusServiceServiceDescriptor.Methods().ByName("UpdateDependencies")
-	perseusServiceQueryDependenciesMethodDescriptor  = perseusServiceServiceDescriptor.Methods().ByName("QueryDependencies")
-	healthZServiceServiceDescriptor                  = perseusapi.File_perseus_proto.Services().ByName("HealthZService")
+	perseusServiceServiceDescriptor                       = perseusapi.File_perseus_proto.Services().ByName("PerseusService")
+	perseusServiceCreateModuleMethodDescriptor            = perseusServiceServiceDescriptor.Methods().ByName("CreateModule")
+	perseusServiceListModulesMethodDescriptor             = perseusServiceServiceDescriptor.Methods().ByName("ListModules")
+	perseusServiceListModuleVersionsMethodDescriptor      = perseusServiceServiceDescriptor.Methods().ByName("ListModuleVersions")
+	perseusServiceUpdateDependenciesMethodDescriptor      = perseusServiceServiceDescriptor.Methods().ByName("UpdateDependencies")
+	perseusServiceBatchUpdateDependenciesMethodDescriptor = perseusServiceServiceDescriptor.Methods().ByName("BatchUpdateDependencies")
+	perseusServiceQueryDependenciesMethodDescriptor       = perseusServiceServiceDescriptor.Methods().ByName("QueryDependencies")
+	perseusServiceGetDependencyHistoryMethodDescriptor    = perseusServiceServiceDescriptor.Methods().ByName("GetDependencyHistory")
+	perseusServiceDiffGraphMethodDescriptor               = perseusServiceServiceDescriptor.Methods().ByName("DiffGraph")
+	perseusServiceStreamEdgesMethodDescriptor             = perseusServiceServiceDescriptor.Methods().ByName("StreamEdges")
+	perseusServiceListTopModulesMethodDescriptor          = perseusServiceServiceDescriptor.Methods().ByName("ListTopModules")
+	perseusServiceGetModuleMetricsMethodDescriptor        = perseusServiceServiceDescriptor.Methods().ByName("GetModuleMetrics")
+	perseusServiceListModuleConsumersMethodDescriptor     = perseusServiceServiceDescriptor.Methods().ByName("ListModuleConsumers")
+	perseusServiceListModuleStalenessMethodDescriptor     = perseusServiceServiceDescriptor.Methods().ByName("ListModuleStaleness")
+	perseusServiceListAbandonedModulesMethodDescriptor    = perseusServiceServiceDescriptor.Methods().ByName("ListAbandonedModules")
+	perseusServiceListGhostVersionsMethodDescriptor       = perseusServiceServiceDescriptor.Methods().ByName("ListGhostVersions")
+	perseusServiceCheckPolicyMethodDescriptor             = perseusServiceServiceDescriptor.Methods().ByName("CheckPolicy")
+	perseusServiceResolveModuleSetMethodDescriptor        = perseusServiceServiceDescriptor.Methods().ByName("ResolveModuleSet")
+	perseusServiceListVulnerabilitiesMethodDescriptor     = perseusServiceServiceDescriptor.Methods().ByName("ListVulnerabilities")
+	perseusServiceRenderGraphMethodDescriptor             = perseusServiceServiceDescriptor.Methods().ByName("RenderGraph")
+	perseusServiceRefreshModulesMethodDescriptor          = perseusServiceServiceDescriptor.Methods().ByName("RefreshModules")
+	perseusServiceCheckGraphMethodDescriptor              = perseusServiceServiceDescriptor.Methods().ByName("CheckGraph")
+	perseusServiceMergeModulesMethodDescriptor            = perseusServiceServiceDescriptor.Methods().ByName("MergeModules")
+	perseusServiceRenameModuleMethodDescriptor            = perseusServiceServiceDescriptor.Methods().ByName("RenameModule")
+	perseusServiceSetModuleLicenseMethodDescriptor        = perseusServiceServiceDescriptor.Methods().ByName("SetModuleLicense")
+	perseusServiceDeleteModuleMethodDescriptor            = perseusServiceServiceDescriptor.Methods().ByName("DeleteModule")
+	perseusServiceDeleteModuleVersionMethodDescriptor     = perseusServiceServiceDescriptor.Methods().ByName("DeleteModuleVersion")
+	perseusServiceUndeleteModuleMethodDescriptor          = perseusServiceServiceDescriptor.Methods().ByName("UndeleteModule")
+	perseusServiceCreateAPIKeyMethodDescriptor            = perseusServiceServiceDescriptor.Methods().ByName("CreateAPIKey")
+	perseusServiceListAPIKeysMethodDescriptor             = perseusServiceServiceDescriptor.Methods().ByName("ListAPIKeys")
+	perseusServiceRevokeAPIKeyMethodDescriptor            = perseusServiceServiceDescriptor.Methods().ByName("RevokeAPIKey")
+	perseusServiceListAuditLogMethodDescriptor            = perseusServiceServiceDescriptor.Methods().ByName("ListAuditLog")
+	perseusServiceListJobsMethodDescriptor                = perseusServiceServiceDescriptor.Methods().ByName("ListJobs")
+	perseusServiceGetStoreStatsMethodDescriptor           = perseusServiceServiceDescriptor.Methods().ByName("GetStoreStats")
+	perseusServiceSubmitIngestionJobsMethodDescriptor     = perseusServiceServiceDescriptor.Methods().ByName("SubmitIngestionJobs")
+	perseusServiceGetIngestionJobMethodDescriptor         = perseusServiceServiceDescriptor.Methods().ByName("GetIngestionJob")
+	perseusServiceListIngestionJobsMethodDescriptor       = perseusServiceServiceDescriptor.Methods().ByName("ListIngestionJobs")
+	perseusServiceGetServerInfoMethodDescriptor           = perseusServiceServiceDescriptor.Methods().ByName("GetServerInfo")
+	healthZServiceServiceDescriptor                       = perseusapi.File_perseus_proto.Services().ByName("HealthZService")
 )
 
 // PerseusServiceClient is a client for the crowdstrike.perseus.perseusapi.PerseusService service.
 type PerseusServiceClient interface {
 	// Adds a module, along with any versions provided, to the system
+	//
+	// If the request has an Idempotency-Key header, retries of the same request with the same key return the
+	// original response rather than being processed again.
 	CreateModule(context.Context, *connect.Request[perseusapi.CreateModuleRequest]) (*connect.Response[perseusapi.CreateModuleResponse], error)
 	// Lists known modules.
 	//
@@ -78,12 +208,137 @@ type PerseusServiceClient interface {
 	// Adds or updates the direct dependencies of specific version of a module.
 	//
 	// When invoking this API, the 'versions' attribute of each specified dependency must contain exactly 1 item
+	//
+	// If 'prune' is true, any stored dependencies of the module version that are not in the request are removed.
+	//
+	// If 'validate_only' is true, the response lists the dependencies that would be added and removed but the
+	// graph is not changed.
+	//
+	// If the request has an Idempotency-Key header, retries of the same request with the same key return the
+	// original response rather than being processed again.
 	UpdateDependencies(context.Context, *connect.Request[perseusapi.UpdateDependenciesRequest]) (*connect.Response[perseusapi.UpdateDependenciesResponse], error)
+	// Adds or updates the direct dependencies of several module versions atomically, ex: all of the modules in
+	// a repository.  Either every update is applied or, if any of them fails, none of them are.
+	//
+	// Each update is processed the same as by UpdateDependencies, except that 'validate_only' is not supported.
+	//
+	// If the request has an Idempotency-Key header, retries of the same request with the same key return the
+	// original response rather than being processed again.
+	BatchUpdateDependencies(context.Context, *connect.Request[perseusapi.BatchUpdateDependenciesRequest]) (*connect.Response[perseusapi.BatchUpdateDependenciesResponse], error)
 	// Queries direct dependencies of a specific version of a module.
 	//
 	// The 'direction' indicate whether or not the returned list contains dependencies (things the
-	// specified module depends on) or dependents (things that depend on the specified module).
+	// specified module depends on) or dependents (things that depend on the specified module).  Dependents
+	// can also be queried for a range of versions, ex: every module that consumes a version affected by a
+	// vulnerability.
 	QueryDependencies(context.Context, *connect.Request[perseusapi.QueryDependenciesRequest]) (*connect.Response[perseusapi.QueryDependenciesResponse], error)
+	// Returns every direct dependency that a specific version of a module has declared, including those
+	// that were removed when its dependencies were replaced, along with when each was first and most
+	// recently seen and when it was removed.
+	GetDependencyHistory(context.Context, *connect.Request[perseusapi.GetDependencyHistoryRequest]) (*connect.Response[perseusapi.GetDependencyHistoryResponse], error)
+	// Compares the dependency graph at 2 points in time and returns the modules and dependency edges that
+	// were added or removed in between.
+	DiffGraph(context.Context, *connect.Request[perseusapi.DiffGraphRequest]) (*connect.Response[perseusapi.DiffGraphResponse], error)
+	// Streams every direct dependency edge that matches the filters as an individual message so that
+	// external systems can consume the whole graph without paging.  This RPC is only available over the
+	// Connect and gRPC protocols because REST transcoding does not support server streams.
+	StreamEdges(context.Context, *connect.Request[perseusapi.StreamEdgesRequest]) (*connect.ServerStreamForClient[perseusapi.StreamEdgesResponse], error)
+	// Ranks modules by the number of distinct modules that depend on them, directly or transitively, or by
+	// their importance score to identify the most critical libraries.
+	ListTopModules(context.Context, *connect.Request[perseusapi.ListTopModulesRequest]) (*connect.Response[perseusapi.ListTopModulesResponse], error)
+	// Returns the fan-in and fan-out of a module, which is the number of distinct modules that depend on
+	// it and that it depends on, both directly and transitively.
+	GetModuleMetrics(context.Context, *connect.Request[perseusapi.GetModuleMetricsRequest]) (*connect.Response[perseusapi.GetModuleMetricsResponse], error)
+	// Lists the modules that currently depend on any version of the specified module, grouped by the
+	// version that they consume.  Only the latest version of each dependent module is considered, so that
+	// the results reflect the version that each project is using today.
+	ListModuleConsumers(context.Context, *connect.Request[perseusapi.ListModuleConsumersRequest]) (*connect.Response[perseusapi.ListModuleConsumersResponse], error)
+	// Computes a staleness score for the latest version, or every version, of the modules matching a glob
+	// pattern, which is the average number of years that each direct dependency is behind the latest
+	// version of that dependency.
+	ListModuleStaleness(context.Context, *connect.Request[perseusapi.ListModuleStalenessRequest]) (*connect.Response[perseusapi.ListModuleStalenessResponse], error)
+	// Lists the modules matching a glob pattern whose source repositories have been archived or have not
+	// had a commit in a number of years, as last reported by the GitHub or GitLab API to the server's
+	// check-upstreams job.
+	ListAbandonedModules(context.Context, *connect.Request[perseusapi.ListAbandonedModulesRequest]) (*connect.Response[perseusapi.ListAbandonedModulesResponse], error)
+	// Lists the stored versions of the modules matching a glob pattern that can no longer be used because
+	// the Go module proxy no longer lists them, ex: their tags were deleted, or because the latest version of
+	// the module retracts them, as last found by the server's verify-versions job.
+	ListGhostVersions(context.Context, *connect.Request[perseusapi.ListGhostVersionsRequest]) (*connect.Response[perseusapi.ListGhostVersionsResponse], error)
+	// Evaluates the server's dependency policy against the transitive dependencies of a specific version of
+	// a module and returns the dependencies that are banned.  This is intended for use as a CI gate.
+	CheckPolicy(context.Context, *connect.Request[perseusapi.CheckPolicyRequest]) (*connect.Response[perseusapi.CheckPolicyResponse], error)
+	// Computes the build list of a specific version of a module by running Minimal Version Selection over
+	// the stored requirements, which approximates 'go list -m all' without checking out the module's code.
+	// Replacements, exclusions, and module graph pruning are not applied since they are not stored.
+	ResolveModuleSet(context.Context, *connect.Request[perseusapi.ResolveModuleSetRequest]) (*connect.Response[perseusapi.ResolveModuleSetResponse], error)
+	// Returns the security advisories from the OSV database that affect a specific version of a module or
+	// any of its transitive dependencies.
+	ListVulnerabilities(context.Context, *connect.Request[perseusapi.ListVulnerabilitiesRequest]) (*connect.Response[perseusapi.ListVulnerabilitiesResponse], error)
+	// Lays out a graph in the DOT language, such as the output of 'perseus query ancestors -o dot', with
+	// Graphviz and returns the resulting image, so that clients don't need a local Graphviz installation.
+	RenderGraph(context.Context, *connect.Request[perseusapi.RenderGraphRequest]) (*connect.Response[perseusapi.RenderGraphResponse], error)
+	// Re-fetches the go.mod files for the versions of modules matching the specified filters from the
+	// Go module proxy and replaces the stored direct dependencies of each, repairing any missing or stale
+	// dependency edges.
+	//
+	// This is an administrative operation intended for use after module proxy outages or partial ingest
+	// failures.
+	RefreshModules(context.Context, *connect.Request[perseusapi.RefreshModulesRequest]) (*connect.Response[perseusapi.RefreshModulesResponse], error)
+	// Scans the graph for consistency problems: orphaned module versions, dangling dependency edges,
+	// duplicate modules whose names differ only in case, and versions that are not in canonical form.
+	//
+	// If 'repair' is true, problems that can be fixed safely are repaired.
+	CheckGraph(context.Context, *connect.Request[perseusapi.CheckGraphRequest]) (*connect.Response[perseusapi.CheckGraphResponse], error)
+	// Merges two modules that refer to the same code, such as names that differ only in case or a vanity
+	// import path and its canonical path.  All versions and dependency edges of the source module are
+	// moved to the target module, the source module is removed, and its name is recorded as an alias of
+	// the target so that future updates that reference it are applied to the target module.
+	MergeModules(context.Context, *connect.Request[perseusapi.MergeModulesRequest]) (*connect.Response[perseusapi.MergeModulesResponse], error)
+	// Records that a module was renamed, such as when a project moves to a new organization.  Unlike
+	// MergeModules, both modules and their dependencies are kept, and dependency queries can optionally
+	// follow the rename so that the history of the module isn't split across two disconnected nodes.
+	RenameModule(context.Context, *connect.Request[perseusapi.RenameModuleRequest]) (*connect.Response[perseusapi.RenameModuleResponse], error)
+	// Records the SPDX identifier of a module's license, such as "Apache-2.0", which is evaluated by the
+	// license rules of the server's dependency policy.  An empty license clears the recorded value.
+	SetModuleLicense(context.Context, *connect.Request[perseusapi.SetModuleLicenseRequest]) (*connect.Response[perseusapi.SetModuleLicenseResponse], error)
+	// Removes a module along with all of its versions, their dependency edges, and any aliases or renames
+	// that refer to it.  The module can be restored by UndeleteModule until its tombstone is purged.
+	DeleteModule(context.Context, *connect.Request[perseusapi.DeleteModuleRequest]) (*connect.Response[perseusapi.DeleteModuleResponse], error)
+	// Removes a single version of a module along with its dependency edges in both directions.  The version
+	// can be restored by UndeleteModule until its tombstone is purged.
+	DeleteModuleVersion(context.Context, *connect.Request[perseusapi.DeleteModuleVersionRequest]) (*connect.Response[perseusapi.DeleteModuleVersionResponse], error)
+	// Restores the most recently deleted module with the specified name, or a single deleted version of it,
+	// along with the dependency edges, aliases, and renames that were removed with it.  Deletions can be
+	// reversed until the server's purge-deleted job removes their tombstones.
+	UndeleteModule(context.Context, *connect.Request[perseusapi.UndeleteModuleRequest]) (*connect.Response[perseusapi.UndeleteModuleResponse], error)
+	// Creates a new API key that can be used to call the administrative operations.  The key itself is only
+	// returned by this call, the server only stores its hash.
+	CreateAPIKey(context.Context, *connect.Request[perseusapi.CreateAPIKeyRequest]) (*connect.Response[perseusapi.CreateAPIKeyResponse], error)
+	// Returns all API keys, including revoked keys, ordered by name.
+	ListAPIKeys(context.Context, *connect.Request[perseusapi.ListAPIKeysRequest]) (*connect.Response[perseusapi.ListAPIKeysResponse], error)
+	// Revokes an API key so that it can no longer be used.
+	RevokeAPIKey(context.Context, *connect.Request[perseusapi.RevokeAPIKeyRequest]) (*connect.Response[perseusapi.RevokeAPIKeyResponse], error)
+	// Returns the changes made by administrative operations, most recent first.
+	ListAuditLog(context.Context, *connect.Request[perseusapi.ListAuditLogRequest]) (*connect.Response[perseusapi.ListAuditLogResponse], error)
+	// Returns the schedule and the status of the most recent run of each of the server's scheduled
+	// maintenance jobs.
+	ListJobs(context.Context, *connect.Request[perseusapi.ListJobsRequest]) (*connect.Response[perseusapi.ListJobsResponse], error)
+	// Returns the storage used by the server's database for capacity planning, including the estimated number
+	// of rows and the size of each table and index.  Tables and indexes that may need maintenance, such as a
+	// VACUUM or a REINDEX, are flagged with a hint.
+	GetStoreStats(context.Context, *connect.Request[perseusapi.GetStoreStatsRequest]) (*connect.Response[perseusapi.GetStoreStatsResponse], error)
+	// Adds the specified module versions to the ingestion queue.  Each version is processed asynchronously
+	// by the server, which downloads its go.mod file from the Go module proxy and updates the graph with
+	// its direct dependencies.  One ingestion job is returned for each module version.
+	SubmitIngestionJobs(context.Context, *connect.Request[perseusapi.SubmitIngestionJobsRequest]) (*connect.Response[perseusapi.SubmitIngestionJobsResponse], error)
+	// Returns the current state of a single ingestion job.
+	GetIngestionJob(context.Context, *connect.Request[perseusapi.GetIngestionJobRequest]) (*connect.Response[perseusapi.GetIngestionJobResponse], error)
+	// Returns ingestion jobs, most recent first, optionally filtered by status.
+	ListIngestionJobs(context.Context, *connect.Request[perseusapi.ListIngestionJobsRequest]) (*connect.Response[perseusapi.ListIngestionJobsResponse], error)
+	// Returns the server's version, the database schema version it requires, and the optional features
+	// that it supports, which clients use to detect incompatible versions.
+	GetServerInfo(context.Context, *connect.Request[perseusapi.GetServerInfoRequest]) (*connect.Response[perseusapi.GetServerInfoResponse], error)
 }
 
 // NewPerseusServiceClient constructs a client for the crowdstrike.perseus.perseusapi.PerseusService
@@ -120,22 +375,246 @@ func NewPerseusServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(perseusServiceUpdateDependenciesMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		batchUpdateDependencies: connect.NewClient[perseusapi.BatchUpdateDependenciesRequest, perseusapi.BatchUpdateDependenciesResponse](
+			httpClient,
+			baseURL+PerseusServiceBatchUpdateDependenciesProcedure,
+			connect.WithSchema(perseusServiceBatchUpdateDependenciesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		queryDependencies: connect.NewClient[perseusapi.QueryDependenciesRequest, perseusapi.QueryDependenciesResponse](
 			httpClient,
 			baseURL+PerseusServiceQueryDependenciesProcedure,
 			connect.WithSchema(perseusServiceQueryDependenciesMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getDependencyHistory: connect.NewClient[perseusapi.GetDependencyHistoryRequest, perseusapi.GetDependencyHistoryResponse](
+			httpClient,
+			baseURL+PerseusServiceGetDependencyHistoryProcedure,
+			connect.WithSchema(perseusServiceGetDependencyHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		diffGraph: connect.NewClient[perseusapi.DiffGraphRequest, perseusapi.DiffGraphResponse](
+			httpClient,
+			baseURL+PerseusServiceDiffGraphProcedure,
+			connect.WithSchema(perseusServiceDiffGraphMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		streamEdges: connect.NewClient[perseusapi.StreamEdgesRequest, perseusapi.StreamEdgesResponse](
+			httpClient,
+			baseURL+PerseusServiceStreamEdgesProcedure,
+			connect.WithSchema(perseusServiceStreamEdgesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTopModules: connect.NewClient[perseusapi.ListTopModulesRequest, perseusapi.ListTopModulesResponse](
+			httpClient,
+			baseURL+PerseusServiceListTopModulesProcedure,
+			connect.WithSchema(perseusServiceListTopModulesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getModuleMetrics: connect.NewClient[perseusapi.GetModuleMetricsRequest, perseusapi.GetModuleMetricsResponse](
+			httpClient,
+			baseURL+PerseusServiceGetModuleMetricsProcedure,
+			connect.WithSchema(perseusServiceGetModuleMetricsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listModuleConsumers: connect.NewClient[perseusapi.ListModuleConsumersRequest, perseusapi.ListModuleConsumersResponse](
+			httpClient,
+			baseURL+PerseusServiceListModuleConsumersProcedure,
+			connect.WithSchema(perseusServiceListModuleConsumersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listModuleStaleness: connect.NewClient[perseusapi.ListModuleStalenessRequest, perseusapi.ListModuleStalenessResponse](
+			httpClient,
+			baseURL+PerseusServiceListModuleStalenessProcedure,
+			connect.WithSchema(perseusServiceListModuleStalenessMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAbandonedModules: connect.NewClient[perseusapi.ListAbandonedModulesRequest, perseusapi.ListAbandonedModulesResponse](
+			httpClient,
+			baseURL+PerseusServiceListAbandonedModulesProcedure,
+			connect.WithSchema(perseusServiceListAbandonedModulesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listGhostVersions: connect.NewClient[perseusapi.ListGhostVersionsRequest, perseusapi.ListGhostVersionsResponse](
+			httpClient,
+			baseURL+PerseusServiceListGhostVersionsProcedure,
+			connect.WithSchema(perseusServiceListGhostVersionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		checkPolicy: connect.NewClient[perseusapi.CheckPolicyRequest, perseusapi.CheckPolicyResponse](
+			httpClient,
+			baseURL+PerseusServiceCheckPolicyProcedure,
+			connect.WithSchema(perseusServiceCheckPolicyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resolveModuleSet: connect.NewClient[perseusapi.ResolveModuleSetRequest, perseusapi.ResolveModuleSetResponse](
+			httpClient,
+			baseURL+PerseusServiceResolveModuleSetProcedure,
+			connect.WithSchema(perseusServiceResolveModuleSetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listVulnerabilities: connect.NewClient[perseusapi.ListVulnerabilitiesRequest, perseusapi.ListVulnerabilitiesResponse](
+			httpClient,
+			baseURL+PerseusServiceListVulnerabilitiesProcedure,
+			connect.WithSchema(perseusServiceListVulnerabilitiesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		renderGraph: connect.NewClient[perseusapi.RenderGraphRequest, perseusapi.RenderGraphResponse](
+			httpClient,
+			baseURL+PerseusServiceRenderGraphProcedure,
+			connect.WithSchema(perseusServiceRenderGraphMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		refreshModules: connect.NewClient[perseusapi.RefreshModulesRequest, perseusapi.RefreshModulesResponse](
+			httpClient,
+			baseURL+PerseusServiceRefreshModulesProcedure,
+			connect.WithSchema(perseusServiceRefreshModulesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		checkGraph: connect.NewClient[perseusapi.CheckGraphRequest, perseusapi.CheckGraphResponse](
+			httpClient,
+			baseURL+PerseusServiceCheckGraphProcedure,
+			connect.WithSchema(perseusServiceCheckGraphMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		mergeModules: connect.NewClient[perseusapi.MergeModulesRequest, perseusapi.MergeModulesResponse](
+			httpClient,
+			baseURL+PerseusServiceMergeModulesProcedure,
+			connect.WithSchema(perseusServiceMergeModulesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		renameModule: connect.NewClient[perseusapi.RenameModuleRequest, perseusapi.RenameModuleResponse](
+			httpClient,
+			baseURL+PerseusServiceRenameModuleProcedure,
+			connect.WithSchema(perseusServiceRenameModuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setModuleLicense: connect.NewClient[perseusapi.SetModuleLicenseRequest, perseusapi.SetModuleLicenseResponse](
+			httpClient,
+			baseURL+PerseusServiceSetModuleLicenseProcedure,
+			connect.WithSchema(perseusServiceSetModuleLicenseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteModule: connect.NewClient[perseusapi.DeleteModuleRequest, perseusapi.DeleteModuleResponse](
+			httpClient,
+			baseURL+PerseusServiceDeleteModuleProcedure,
+			connect.WithSchema(perseusServiceDeleteModuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteModuleVersion: connect.NewClient[perseusapi.DeleteModuleVersionRequest, perseusapi.DeleteModuleVersionResponse](
+			httpClient,
+			baseURL+PerseusServiceDeleteModuleVersionProcedure,
+			connect.WithSchema(perseusServiceDeleteModuleVersionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		undeleteModule: connect.NewClient[perseusapi.UndeleteModuleRequest, perseusapi.UndeleteModuleResponse](
+			httpClient,
+			baseURL+PerseusServiceUndeleteModuleProcedure,
+			connect.WithSchema(perseusServiceUndeleteModuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIKey: connect.NewClient[perseusapi.CreateAPIKeyRequest, perseusapi.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+PerseusServiceCreateAPIKeyProcedure,
+			connect.WithSchema(perseusServiceCreateAPIKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKeys: connect.NewClient[perseusapi.ListAPIKeysRequest, perseusapi.ListAPIKeysResponse](
+			httpClient,
+			baseURL+PerseusServiceListAPIKeysProcedure,
+			connect.WithSchema(perseusServiceListAPIKeysMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[perseusapi.RevokeAPIKeyRequest, perseusapi.RevokeAPIKeyResponse](
+			httpClient,
+			baseURL+PerseusServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(perseusServiceRevokeAPIKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditLog: connect.NewClient[perseusapi.ListAuditLogRequest, perseusapi.ListAuditLogResponse](
+			httpClient,
+			baseURL+PerseusServiceListAuditLogProcedure,
+			connect.WithSchema(perseusServiceListAuditLogMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listJobs: connect.NewClient[perseusapi.ListJobsRequest, perseusapi.ListJobsResponse](
+			httpClient,
+			baseURL+PerseusServiceListJobsProcedure,
+			connect.WithSchema(perseusServiceListJobsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getStoreStats: connect.NewClient[perseusapi.GetStoreStatsRequest, perseusapi.GetStoreStatsResponse](
+			httpClient,
+			baseURL+PerseusServiceGetStoreStatsProcedure,
+			connect.WithSchema(perseusServiceGetStoreStatsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		submitIngestionJobs: connect.NewClient[perseusapi.SubmitIngestionJobsRequest, perseusapi.SubmitIngestionJobsResponse](
+			httpClient,
+			baseURL+PerseusServiceSubmitIngestionJobsProcedure,
+			connect.WithSchema(perseusServiceSubmitIngestionJobsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getIngestionJob: connect.NewClient[perseusapi.GetIngestionJobRequest, perseusapi.GetIngestionJobResponse](
+			httpClient,
+			baseURL+PerseusServiceGetIngestionJobProcedure,
+			connect.WithSchema(perseusServiceGetIngestionJobMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listIngestionJobs: connect.NewClient[perseusapi.ListIngestionJobsRequest, perseusapi.ListIngestionJobsResponse](
+			httpClient,
+			baseURL+PerseusServiceListIngestionJobsProcedure,
+			connect.WithSchema(perseusServiceListIngestionJobsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getServerInfo: connect.NewClient[perseusapi.GetServerInfoRequest, perseusapi.GetServerInfoResponse](
+			httpClient,
+			baseURL+PerseusServiceGetServerInfoProcedure,
+			connect.WithSchema(perseusServiceGetServerInfoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // perseusServiceClient implements PerseusServiceClient.
 type perseusServiceClient struct {
-	createModule       *connect.Client[perseusapi.CreateModuleRequest, perseusapi.CreateModuleResponse]
-	listModules        *connect.Client[perseusapi.ListModulesRequest, perseusapi.ListModulesResponse]
-	listModuleVersions *connect.Client[perseusapi.ListModuleVersionsRequest, perseusapi.ListModuleVersionsResponse]
-	updateDependencies *connect.Client[perseusapi.UpdateDependenciesRequest, perseusapi.UpdateDependenciesResponse]
-	queryDependencies  *connect.Client[perseusapi.QueryDependenciesRequest, perseusapi.QueryDependenciesResponse]
+	createModule            *connect.Client[perseusapi.CreateModuleRequest, perseusapi.CreateModuleResponse]
+	listModules             *connect.Client[perseusapi.ListModulesRequest, perseusapi.ListModulesResponse]
+	listModuleVersions      *connect.Client[perseusapi.ListModuleVersionsRequest, perseusapi.ListModuleVersionsResponse]
+	updateDependencies      *connect.Client[perseusapi.UpdateDependenciesRequest, perseusapi.UpdateDependenciesResponse]
+	batchUpdateDependencies *connect.Client[perseusapi.BatchUpdateDependenciesRequest, perseusapi.BatchUpdateDependenciesResponse]
+	queryDependencies       *connect.Client[perseusapi.QueryDependenciesRequest, perseusapi.QueryDependenciesResponse]
+	getDependencyHistory    *connect.Client[perseusapi.GetDependencyHistoryRequest, perseusapi.GetDependencyHistoryResponse]
+	diffGraph               *connect.Client[perseusapi.DiffGraphRequest, perseusapi.DiffGraphResponse]
+	streamEdges             *connect.Client[perseusapi.StreamEdgesRequest, perseusapi.StreamEdgesResponse]
+	listTopModules          *connect.Client[perseusapi.ListTopModulesRequest, perseusapi.ListTopModulesResponse]
+	getModuleMetrics        *connect.Client[perseusapi.GetModuleMetricsRequest, perseusapi.GetModuleMetricsResponse]
+	listModuleConsumers     *connect.Client[perseusapi.ListModuleConsumersRequest, perseusapi.ListModuleConsumersResponse]
+	listModuleStaleness     *connect.Client[perseusapi.ListModuleStalenessRequest, perseusapi.ListModuleStalenessResponse]
+	listAbandonedModules    *connect.Client[perseusapi.ListAbandonedModulesRequest, perseusapi.ListAbandonedModulesResponse]
+	listGhostVersions       *connect.Client[perseusapi.ListGhostVersionsRequest, perseusapi.ListGhostVersionsResponse]
+	checkPolicy             *connect.Client[perseusapi.CheckPolicyRequest, perseusapi.CheckPolicyResponse]
+	resolveModuleSet        *connect.Client[perseusapi.ResolveModuleSetRequest, perseusapi.ResolveModuleSetResponse]
+	listVulnerabilities     *connect.Client[perseusapi.ListVulnerabilitiesRequest, perseusapi.ListVulnerabilitiesResponse]
+	renderGraph             *connect.Client[perseusapi.RenderGraphRequest, perseusapi.RenderGraphResponse]
+	refreshModules          *connect.Client[perseusapi.RefreshModulesRequest, perseusapi.RefreshModulesResponse]
+	checkGraph              *connect.Client[perseusapi.CheckGraphRequest, perseusapi.CheckGraphResponse]
+	mergeModules            *connect.Client[perseusapi.MergeModulesRequest, perseusapi.MergeModulesResponse]
+	renameModule            *connect.Client[perseusapi.RenameModuleRequest, perseusapi.RenameModuleResponse]
+	setModuleLicense        *connect.Client[perseusapi.SetModuleLicenseRequest, perseusapi.SetModuleLicenseResponse]
+	deleteModule            *connect.Client[perseusapi.DeleteModuleRequest, perseusapi.DeleteModuleResponse]
+	deleteModuleVersion     *connect.Client[perseusapi.DeleteModuleVersionRequest, perseusapi.DeleteModuleVersionResponse]
+	undeleteModule          *connect.Client[perseusapi.UndeleteModuleRequest, perseusapi.UndeleteModuleResponse]
+	createAPIKey            *connect.Client[perseusapi.CreateAPIKeyRequest, perseusapi.CreateAPIKeyResponse]
+	listAPIKeys             *connect.Client[perseusapi.ListAPIKeysRequest, perseusapi.ListAPIKeysResponse]
+	revokeAPIKey            *connect.Client[perseusapi.RevokeAPIKeyRequest, perseusapi.RevokeAPIKeyResponse]
+	listAuditLog            *connect.Client[perseusapi.ListAuditLogRequest, perseusapi.ListAuditLogResponse]
+	listJobs                *connect.Client[perseusapi.ListJobsRequest, perseusapi.ListJobsResponse]
+	getStoreStats           *connect.Client[perseusapi.GetStoreStatsRequest, perseusapi.GetStoreStatsResponse]
+	submitIngestionJobs     *connect.Client[perseusapi.SubmitIngestionJobsRequest, perseusapi.SubmitIngestionJobsResponse]
+	getIngestionJob         *connect.Client[perseusapi.GetIngestionJobRequest, perseusapi.GetIngestionJobResponse]
+	listIngestionJobs       *connect.Client[perseusapi.ListIngestionJobsRequest, perseusapi.ListIngestionJobsResponse]
+	getServerInfo           *connect.Client[perseusapi.GetServerInfoRequest, perseusapi.GetServerInfoResponse]
 }
 
 // CreateModule calls crowdstrike.perseus.perseusapi.PerseusService.CreateModule.
@@ -158,15 +637,179 @@ func (c *perseusServiceClient) UpdateDependencies(ctx context.Context, req *conn
 	return c.updateDependencies.CallUnary(ctx, req)
 }
 
+// BatchUpdateDependencies calls
+// crowdstrike.perseus.perseusapi.PerseusService.BatchUpdateDependencies.
+func (c *perseusServiceClient) BatchUpdateDependencies(ctx context.Context, req *connect.Request[perseusapi.BatchUpdateDependenciesRequest]) (*connect.Response[perseusapi.BatchUpdateDependenciesResponse], error) {
+	return c.batchUpdateDependencies.CallUnary(ctx, req)
+}
+
 // QueryDependencies calls crowdstrike.perseus.perseusapi.PerseusService.QueryDependencies.
 func (c *perseusServiceClient) QueryDependencies(ctx context.Context, req *connect.Request[perseusapi.QueryDependenciesRequest]) (*connect.Response[perseusapi.QueryDependenciesResponse], error) {
 	return c.queryDependencies.CallUnary(ctx, req)
 }
 
+// GetDependencyHistory calls crowdstrike.perseus.perseusapi.PerseusService.GetDependencyHistory.
+func (c *perseusServiceClient) GetDependencyHistory(ctx context.Context, req *connect.Request[perseusapi.GetDependencyHistoryRequest]) (*connect.Response[perseusapi.GetDependencyHistoryResponse], error) {
+	return c.getDependencyHistory.CallUnary(ctx, req)
+}
+
+// DiffGraph calls crowdstrike.perseus.perseusapi.PerseusService.DiffGraph.
+func (c *perseusServiceClient) DiffGraph(ctx context.Context, req *connect.Request[perseusapi.DiffGraphRequest]) (*connect.Response[perseusapi.DiffGraphResponse], error) {
+	return c.diffGraph.CallUnary(ctx, req)
+}
+
+// StreamEdges calls crowdstrike.perseus.perseusapi.PerseusService.StreamEdges.
+func (c *perseusServiceClient) StreamEdges(ctx context.Context, req *connect.Request[perseusapi.StreamEdgesRequest]) (*connect.ServerStreamForClient[perseusapi.StreamEdgesResponse], error) {
+	return c.streamEdges.CallServerStream(ctx, req)
+}
+
+// ListTopModules calls crowdstrike.perseus.perseusapi.PerseusService.ListTopModules.
+func (c *perseusServiceClient) ListTopModules(ctx context.Context, req *connect.Request[perseusapi.ListTopModulesRequest]) (*connect.Response[perseusapi.ListTopModulesResponse], error) {
+	return c.listTopModules.CallUnary(ctx, req)
+}
+
+// GetModuleMetrics calls crowdstrike.perseus.perseusapi.PerseusService.GetModuleMetrics.
+func (c *perseusServiceClient) GetModuleMetrics(ctx context.Context, req *connect.Request[perseusapi.GetModuleMetricsRequest]) (*connect.Response[perseusapi.GetModuleMetricsResponse], error) {
+	return c.getModuleMetrics.CallUnary(ctx, req)
+}
+
+// ListModuleConsumers calls crowdstrike.perseus.perseusapi.PerseusService.ListModuleConsumers.
+func (c *perseusServiceClient) ListModuleConsumers(ctx context.Context, req *connect.Request[perseusapi.ListModuleConsumersRequest]) (*connect.Response[perseusapi.ListModuleConsumersResponse], error) {
+	return c.listModuleConsumers.CallUnary(ctx, req)
+}
+
+// ListModuleStaleness calls crowdstrike.perseus.perseusapi.PerseusService.ListModuleStaleness.
+func (c *perseusServiceClient) ListModuleStaleness(ctx context.Context, req *connect.Request[perseusapi.ListModuleStalenessRequest]) (*connect.Response[perseusapi.ListModuleStalenessResponse], error) {
+	return c.listModuleStaleness.CallUnary(ctx, req)
+}
+
+// ListAbandonedModules calls crowdstrike.perseus.perseusapi.PerseusService.ListAbandonedModules.
+func (c *perseusServiceClient) ListAbandonedModules(ctx context.Context, req *connect.Request[perseusapi.ListAbandonedModulesRequest]) (*connect.Response[perseusapi.ListAbandonedModulesResponse], error) {
+	return c.listAbandonedModules.CallUnary(ctx, req)
+}
+
+// ListGhostVersions calls crowdstrike.perseus.perseusapi.PerseusService.ListGhostVersions.
+func (c *perseusServiceClient) ListGhostVersions(ctx context.Context, req *connect.Request[perseusapi.ListGhostVersionsRequest]) (*connect.Response[perseusapi.ListGhostVersionsResponse], error) {
+	return c.listGhostVersions.CallUnary(ctx, req)
+}
+
+// CheckPolicy calls crowdstrike.perseus.perseusapi.PerseusService.CheckPolicy.
+func (c *perseusServiceClient) CheckPolicy(ctx context.Context, req *connect.Request[perseusapi.CheckPolicyRequest]) (*connect.Response[perseusapi.CheckPolicyResponse], error) {
+	return c.checkPolicy.CallUnary(ctx, req)
+}
+
+// ResolveModuleSet calls crowdstrike.perseus.perseusapi.PerseusService.ResolveModuleSet.
+func (c *perseusServiceClient) ResolveModuleSet(ctx context.Context, req *connect.Request[perseusapi.ResolveModuleSetRequest]) (*connect.Response[perseusapi.ResolveModuleSetResponse], error) {
+	return c.resolveModuleSet.CallUnary(ctx, req)
+}
+
+// ListVulnerabilities calls crowdstrike.perseus.perseusapi.PerseusService.ListVulnerabilities.
+func (c *perseusServiceClient) ListVulnerabilities(ctx context.Context, req *connect.Request[perseusapi.ListVulnerabilitiesRequest]) (*connect.Response[perseusapi.ListVulnerabilitiesResponse], error) {
+	return c.listVulnerabilities.CallUnary(ctx, req)
+}
+
+// RenderGraph calls crowdstrike.perseus.perseusapi.PerseusService.RenderGraph.
+func (c *perseusServiceClient) RenderGraph(ctx context.Context, req *connect.Request[perseusapi.RenderGraphRequest]) (*connect.Response[perseusapi.RenderGraphResponse], error) {
+	return c.renderGraph.CallUnary(ctx, req)
+}
+
+// RefreshModules calls crowdstrike.perseus.perseusapi.PerseusService.RefreshModules.
+func (c *perseusServiceClient) RefreshModules(ctx context.Context, req *connect.Request[perseusapi.RefreshModulesRequest]) (*connect.Response[perseusapi.RefreshModulesResponse], error) {
+	return c.refreshModules.CallUnary(ctx, req)
+}
+
+// CheckGraph calls crowdstrike.perseus.perseusapi.PerseusService.CheckGraph.
+func (c *perseusServiceClient) CheckGraph(ctx context.Context, req *connect.Request[perseusapi.CheckGraphRequest]) (*connect.Response[perseusapi.CheckGraphResponse], error) {
+	return c.checkGraph.CallUnary(ctx, req)
+}
+
+// MergeModules calls crowdstrike.perseus.perseusapi.PerseusService.MergeModules.
+func (c *perseusServiceClient) MergeModules(ctx context.Context, req *connect.Request[perseusapi.MergeModulesRequest]) (*connect.Response[perseusapi.MergeModulesResponse], error) {
+	return c.mergeModules.CallUnary(ctx, req)
+}
+
+// RenameModule calls crowdstrike.perseus.perseusapi.PerseusService.RenameModule.
+func (c *perseusServiceClient) RenameModule(ctx context.Context, req *connect.Request[perseusapi.RenameModuleRequest]) (*connect.Response[perseusapi.RenameModuleResponse], error) {
+	return c.renameModule.CallUnary(ctx, req)
+}
+
+// SetModuleLicense calls crowdstrike.perseus.perseusapi.PerseusService.SetModuleLicense.
+func (c *perseusServiceClient) SetModuleLicense(ctx context.Context, req *connect.Request[perseusapi.SetModuleLicenseRequest]) (*connect.Response[perseusapi.SetModuleLicenseResponse], error) {
+	return c.setModuleLicense.CallUnary(ctx, req)
+}
+
+// DeleteModule calls crowdstrike.perseus.perseusapi.PerseusService.DeleteModule.
+func (c *perseusServiceClient) DeleteModule(ctx context.Context, req *connect.Request[perseusapi.DeleteModuleRequest]) (*connect.Response[perseusapi.DeleteModuleResponse], error) {
+	return c.deleteModule.CallUnary(ctx, req)
+}
+
+// DeleteModuleVersion calls crowdstrike.perseus.perseusapi.PerseusService.DeleteModuleVersion.
+func (c *perseusServiceClient) DeleteModuleVersion(ctx context.Context, req *connect.Request[perseusapi.DeleteModuleVersionRequest]) (*connect.Response[perseusapi.DeleteModuleVersionResponse], error) {
+	return c.deleteModuleVersion.CallUnary(ctx, req)
+}
+
+// UndeleteModule calls crowdstrike.perseus.perseusapi.PerseusService.UndeleteModule.
+func (c *perseusServiceClient) UndeleteModule(ctx context.Context, req *connect.Request[perseusapi.UndeleteModuleRequest]) (*connect.Response[perseusapi.UndeleteModuleResponse], error) {
+	return c.undeleteModule.CallUnary(ctx, req)
+}
+
+// CreateAPIKey calls crowdstrike.perseus.perseusapi.PerseusService.CreateAPIKey.
+func (c *perseusServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[perseusapi.CreateAPIKeyRequest]) (*connect.Response[perseusapi.CreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// ListAPIKeys calls crowdstrike.perseus.perseusapi.PerseusService.ListAPIKeys.
+func (c *perseusServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[perseusapi.ListAPIKeysRequest]) (*connect.Response[perseusapi.ListAPIKeysResponse], error) {
+	return c.listAPIKeys.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls crowdstrike.perseus.perseusapi.PerseusService.RevokeAPIKey.
+func (c *perseusServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[perseusapi.RevokeAPIKeyRequest]) (*connect.Response[perseusapi.RevokeAPIKeyResponse], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// ListAuditLog calls crowdstrike.perseus.perseusapi.PerseusService.ListAuditLog.
+func (c *perseusServiceClient) ListAuditLog(ctx context.Context, req *connect.Request[perseusapi.ListAuditLogRequest]) (*connect.Response[perseusapi.ListAuditLogResponse], error) {
+	return c.listAuditLog.CallUnary(ctx, req)
+}
+
+// ListJobs calls crowdstrike.perseus.perseusapi.PerseusService.ListJobs.
+func (c *perseusServiceClient) ListJobs(ctx context.Context, req *connect.Request[perseusapi.ListJobsRequest]) (*connect.Response[perseusapi.ListJobsResponse], error) {
+	return c.listJobs.CallUnary(ctx, req)
+}
+
+// GetStoreStats calls crowdstrike.perseus.perseusapi.PerseusService.GetStoreStats.
+func (c *perseusServiceClient) GetStoreStats(ctx context.Context, req *connect.Request[perseusapi.GetStoreStatsRequest]) (*connect.Response[perseusapi.GetStoreStatsResponse], error) {
+	return c.getStoreStats.CallUnary(ctx, req)
+}
+
+// SubmitIngestionJobs calls crowdstrike.perseus.perseusapi.PerseusService.SubmitIngestionJobs.
+func (c *perseusServiceClient) SubmitIngestionJobs(ctx context.Context, req *connect.Request[perseusapi.SubmitIngestionJobsRequest]) (*connect.Response[perseusapi.SubmitIngestionJobsResponse], error) {
+	return c.submitIngestionJobs.CallUnary(ctx, req)
+}
+
+// GetIngestionJob calls crowdstrike.perseus.perseusapi.PerseusService.GetIngestionJob.
+func (c *perseusServiceClient) GetIngestionJob(ctx context.Context, req *connect.Request[perseusapi.GetIngestionJobRequest]) (*connect.Response[perseusapi.GetIngestionJobResponse], error) {
+	return c.getIngestionJob.CallUnary(ctx, req)
+}
+
+// ListIngestionJobs calls crowdstrike.perseus.perseusapi.PerseusService.ListIngestionJobs.
+func (c *perseusServiceClient) ListIngestionJobs(ctx context.Context, req *connect.Request[perseusapi.ListIngestionJobsRequest]) (*connect.Response[perseusapi.ListIngestionJobsResponse], error) {
+	return c.listIngestionJobs.CallUnary(ctx, req)
+}
+
+// GetServerInfo calls crowdstrike.perseus.perseusapi.PerseusService.GetServerInfo.
+func (c *perseusServiceClient) GetServerInfo(ctx context.Context, req *connect.Request[perseusapi.GetServerInfoRequest]) (*connect.Response[perseusapi.GetServerInfoResponse], error) {
+	return c.getServerInfo.CallUnary(ctx, req)
+}
+
 // PerseusServiceHandler is an implementation of the crowdstrike.perseus.perseusapi.PerseusService
 // service.
 type PerseusServiceHandler interface {
 	// Adds a module, along with any versions provided, to the system
+	//
+	// If the request has an Idempotency-Key header, retries of the same request with the same key return the
+	// original response rather than being processed again.
 	CreateModule(context.Context, *connect.Request[perseusapi.CreateModuleRequest]) (*connect.Response[perseusapi.CreateModuleResponse], error)
 	// Lists known modules.
 	//
@@ -179,12 +822,137 @@ type PerseusServiceHandler interface {
 	// Adds or updates the direct dependencies of specific version of a module.
 	//
 	// When invoking this API, the 'versions' attribute of each specified dependency must contain exactly 1 item
+	//
+	// If 'prune' is true, any stored dependencies of the module version that are not in the request are removed.
+	//
+	// If 'validate_only' is true, the response lists the dependencies that would be added and removed but the
+	// graph is not changed.
+	//
+	// If the request has an Idempotency-Key header, retries of the same request with the same key return the
+	// original response rather than being processed again.
 	UpdateDependencies(context.Context, *connect.Request[perseusapi.UpdateDependenciesRequest]) (*connect.Response[perseusapi.UpdateDependenciesResponse], error)
+	// Adds or updates the direct dependencies of several module versions atomically, ex: all of the modules in
+	// a repository.  Either every update is applied or, if any of them fails, none of them are.
+	//
+	// Each update is processed the same as by UpdateDependencies, except that 'validate_only' is not supported.
+	//
+	// If the request has an Idempotency-Key header, retries of the same request with the same key return the
+	// original response rather than being processed again.
+	BatchUpdateDependencies(context.Context, *connect.Request[perseusapi.BatchUpdateDependenciesRequest]) (*connect.Response[perseusapi.BatchUpdateDependenciesResponse], error)
 	// Queries direct dependencies of a specific version of a module.
 	//
 	// The 'direction' indicate whether or not the returned list contains dependencies (things the
-	// specified module depends on) or dependents (things that depend on the specified module).
+	// specified module depends on) or dependents (things that depend on the specified module).  Dependents
+	// can also be queried for a range of versions, ex: every module that consumes a version affected by a
+	// vulnerability.
 	QueryDependencies(context.Context, *connect.Request[perseusapi.QueryDependenciesRequest]) (*connect.Response[perseusapi.QueryDependenciesResponse], error)
+	// Returns every direct dependency that a specific version of a module has declared, including those
+	// that were removed when its dependencies were replaced, along with when each was first and most
+	// recently seen and when it was removed.
+	GetDependencyHistory(context.Context, *connect.Request[perseusapi.GetDependencyHistoryRequest]) (*connect.Response[perseusapi.GetDependencyHistoryResponse], error)
+	// Compares the dependency graph at 2 points in time and returns the modules and dependency edges that
+	// were added or removed in between.
+	DiffGraph(context.Context, *connect.Request[perseusapi.DiffGraphRequest]) (*connect.Response[perseusapi.DiffGraphResponse], error)
+	// Streams every direct dependency edge that matches the filters as an individual message so that
+	// external systems can consume the whole graph without paging.  This RPC is only available over the
+	// Connect and gRPC protocols because REST transcoding does not support server streams.
+	StreamEdges(context.Context, *connect.Request[perseusapi.StreamEdgesRequest], *connect.ServerStream[perseusapi.StreamEdgesResponse]) error
+	// Ranks modules by the number of distinct modules that depend on them, directly or transitively, or by
+	// their importance score to identify the most critical libraries.
+	ListTopModules(context.Context, *connect.Request[perseusapi.ListTopModulesRequest]) (*connect.Response[perseusapi.ListTopModulesResponse], error)
+	// Returns the fan-in and fan-out of a module, which is the number of distinct modules that depend on
+	// it and that it depends on, both directly and transitively.
+	GetModuleMetrics(context.Context, *connect.Request[perseusapi.GetModuleMetricsRequest]) (*connect.Response[perseusapi.GetModuleMetricsResponse], error)
+	// Lists the modules that currently depend on any version of the specified module, grouped by the
+	// version that they consume.  Only the latest version of each dependent module is considered, so that
+	// the results reflect the version that each project is using today.
+	ListModuleConsumers(context.Context, *connect.Request[perseusapi.ListModuleConsumersRequest]) (*connect.Response[perseusapi.ListModuleConsumersResponse], error)
+	// Computes a staleness score for the latest version, or every version, of the modules matching a glob
+	// pattern, which is the average number of years that each direct dependency is behind the latest
+	// version of that dependency.
+	ListModuleStaleness(context.Context, *connect.Request[perseusapi.ListModuleStalenessRequest]) (*connect.Response[perseusapi.ListModuleStalenessResponse], error)
+	// Lists the modules matching a glob pattern whose source repositories have been archived or have not
+	// had a commit in a number of years, as last reported by the GitHub or GitLab API to the server's
+	// check-upstreams job.
+	ListAbandonedModules(context.Context, *connect.Request[perseusapi.ListAbandonedModulesRequest]) (*connect.Response[perseusapi.ListAbandonedModulesResponse], error)
+	// Lists the stored versions of the modules matching a glob pattern that can no longer be used because
+	// the Go module proxy no longer lists them, ex: their tags were deleted, or because the latest version of
+	// the module retracts them, as last found by the server's verify-versions job.
+	ListGhostVersions(context.Context, *connect.Request[perseusapi.ListGhostVersionsRequest]) (*connect.Response[perseusapi.ListGhostVersionsResponse], error)
+	// Evaluates the server's dependency policy against the transitive dependencies of a specific version of
+	// a module and returns the dependencies that are banned.  This is intended for use as a CI gate.
+	CheckPolicy(context.Context, *connect.Request[perseusapi.CheckPolicyRequest]) (*connect.Response[perseusapi.CheckPolicyResponse], error)
+	// Computes the build list of a specific version of a module by running Minimal Version Selection over
+	// the stored requirements, which approximates 'go list -m all' without checking out the module's code.
+	// Replacements, exclusions, and module graph pruning are not applied since they are not stored.
+	ResolveModuleSet(context.Context, *connect.Request[perseusapi.ResolveModuleSetRequest]) (*connect.Response[perseusapi.ResolveModuleSetResponse], error)
+	// Returns the security advisories from the OSV database that affect a specific version of a module or
+	// any of its transitive dependencies.
+	ListVulnerabilities(context.Context, *connect.Request[perseusapi.ListVulnerabilitiesRequest]) (*connect.Response[perseusapi.ListVulnerabilitiesResponse], error)
+	// Lays out a graph in the DOT language, such as the output of 'perseus query ancestors -o dot', with
+	// Graphviz and returns the resulting image, so that clients don't need a local Graphviz installation.
+	RenderGraph(context.Context, *connect.Request[perseusapi.RenderGraphRequest]) (*connect.Response[perseusapi.RenderGraphResponse], error)
+	// Re-fetches the go.mod files for the versions of modules matching the specified filters from the
+	// Go module proxy and replaces the stored direct dependencies of each, repairing any missing or stale
+	// dependency edges.
+	//
+	// This is an administrative operation intended for use after module proxy outages or partial ingest
+	// failures.
+	RefreshModules(context.Context, *connect.Request[perseusapi.RefreshModulesRequest]) (*connect.Response[perseusapi.RefreshModulesResponse], error)
+	// Scans the graph for consistency problems: orphaned module versions, dangling dependency edges,
+	// duplicate modules whose names differ only in case, and versions that are not in canonical form.
+	//
+	// If 'repair' is true, problems that can be fixed safely are repaired.
+	CheckGraph(context.Context, *connect.Request[perseusapi.CheckGraphRequest]) (*connect.Response[perseusapi.CheckGraphResponse], error)
+	// Merges two modules that refer to the same code, such as names that differ only in case or a vanity
+	// import path and its canonical path.  All versions and dependency edges of the source module are
+	// moved to the target module, the source module is removed, and its name is recorded as an alias of
+	// the target so that future updates that reference it are applied to the target module.
+	MergeModules(context.Context, *connect.Request[perseusapi.MergeModulesRequest]) (*connect.Response[perseusapi.MergeModulesResponse], error)
+	// Records that a module was renamed, such as when a project moves to a new organization.  Unlike
+	// MergeModules, both modules and their dependencies are kept, and dependency queries can optionally
+	// follow the rename so that the history of the module isn't split across two disconnected nodes.
+	RenameModule(context.Context, *connect.Request[perseusapi.RenameModuleRequest]) (*connect.Response[perseusapi.RenameModuleResponse], error)
+	// Records the SPDX identifier of a module's license, such as "Apache-2.0", which is evaluated by the
+	// license rules of the server's dependency policy.  An empty license clears the recorded value.
+	SetModuleLicense(context.Context, *connect.Request[perseusapi.SetModuleLicenseRequest]) (*connect.Response[perseusapi.SetModuleLicenseResponse], error)
+	// Removes a module along with all of its versions, their dependency edges, and any aliases or renames
+	// that refer to it.  The module can be restored by UndeleteModule until its tombstone is purged.
+	DeleteModule(context.Context, *connect.Request[perseusapi.DeleteModuleRequest]) (*connect.Response[perseusapi.DeleteModuleResponse], error)
+	// Removes a single version of a module along with its dependency edges in both directions.  The version
+	// can be restored by UndeleteModule until its tombstone is purged.
+	DeleteModuleVersion(context.Context, *connect.Request[perseusapi.DeleteModuleVersionRequest]) (*connect.Response[perseusapi.DeleteModuleVersionResponse], error)
+	// Restores the most recently deleted module with the specified name, or a single deleted version of it,
+	// along with the dependency edges, aliases, and renames that were removed with it.  Deletions can be
+	// reversed until the server's purge-deleted job removes their tombstones.
+	UndeleteModule(context.Context, *connect.Request[perseusapi.UndeleteModuleRequest]) (*connect.Response[perseusapi.UndeleteModuleResponse], error)
+	// Creates a new API key that can be used to call the administrative operations.  The key itself is only
+	// returned by this call, the server only stores its hash.
+	CreateAPIKey(context.Context, *connect.Request[perseusapi.CreateAPIKeyRequest]) (*connect.Response[perseusapi.CreateAPIKeyResponse], error)
+	// Returns all API keys, including revoked keys, ordered by name.
+	ListAPIKeys(context.Context, *connect.Request[perseusapi.ListAPIKeysRequest]) (*connect.Response[perseusapi.ListAPIKeysResponse], error)
+	// Revokes an API key so that it can no longer be used.
+	RevokeAPIKey(context.Context, *connect.Request[perseusapi.RevokeAPIKeyRequest]) (*connect.Response[perseusapi.RevokeAPIKeyResponse], error)
+	// Returns the changes made by administrative operations, most recent first.
+	ListAuditLog(context.Context, *connect.Request[perseusapi.ListAuditLogRequest]) (*connect.Response[perseusapi.ListAuditLogResponse], error)
+	// Returns the schedule and the status of the most recent run of each of the server's scheduled
+	// maintenance jobs.
+	ListJobs(context.Context, *connect.Request[perseusapi.ListJobsRequest]) (*connect.Response[perseusapi.ListJobsResponse], error)
+	// Returns the storage used by the server's database for capacity planning, including the estimated number
+	// of rows and the size of each table and index.  Tables and indexes that may need maintenance, such as a
+	// VACUUM or a REINDEX, are flagged with a hint.
+	GetStoreStats(context.Context, *connect.Request[perseusapi.GetStoreStatsRequest]) (*connect.Response[perseusapi.GetStoreStatsResponse], error)
+	// Adds the specified module versions to the ingestion queue.  Each version is processed asynchronously
+	// by the server, which downloads its go.mod file from the Go module proxy and updates the graph with
+	// its direct dependencies.  One ingestion job is returned for each module version.
+	SubmitIngestionJobs(context.Context, *connect.Request[perseusapi.SubmitIngestionJobsRequest]) (*connect.Response[perseusapi.SubmitIngestionJobsResponse], error)
+	// Returns the current state of a single ingestion job.
+	GetIngestionJob(context.Context, *connect.Request[perseusapi.GetIngestionJobRequest]) (*connect.Response[perseusapi.GetIngestionJobResponse], error)
+	// Returns ingestion jobs, most recent first, optionally filtered by status.
+	ListIngestionJobs(context.Context, *connect.Request[perseusapi.ListIngestionJobsRequest]) (*connect.Response[perseusapi.ListIngestionJobsResponse], error)
+	// Returns the server's version, the database schema version it requires, and the optional features
+	// that it supports, which clients use to detect incompatible versions.
+	GetServerInfo(context.Context, *connect.Request[perseusapi.GetServerInfoRequest]) (*connect.Response[perseusapi.GetServerInfoResponse], error)
 }
 
 // NewPerseusServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -217,12 +985,204 @@ func NewPerseusServiceHandler(svc PerseusServiceHandler, opts ...connect.Handler
 		connect.WithSchema(perseusServiceUpdateDependenciesMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	perseusServiceBatchUpdateDependenciesHandler := connect.NewUnaryHandler(
+		PerseusServiceBatchUpdateDependenciesProcedure,
+		svc.BatchUpdateDependencies,
+		connect.WithSchema(perseusServiceBatchUpdateDependenciesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	perseusServiceQueryDependenciesHandler := connect.NewUnaryHandler(
 		PerseusServiceQueryDependenciesProcedure,
 		svc.QueryDependencies,
 		connect.WithSchema(perseusServiceQueryDependenciesMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	perseusServiceGetDependencyHistoryHandler := connect.NewUnaryHandler(
+		PerseusServiceGetDependencyHistoryProcedure,
+		svc.GetDependencyHistory,
+		connect.WithSchema(perseusServiceGetDependencyHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceDiffGraphHandler := connect.NewUnaryHandler(
+		PerseusServiceDiffGraphProcedure,
+		svc.DiffGraph,
+		connect.WithSchema(perseusServiceDiffGraphMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceStreamEdgesHandler := connect.NewServerStreamHandler(
+		PerseusServiceStreamEdgesProcedure,
+		svc.StreamEdges,
+		connect.WithSchema(perseusServiceStreamEdgesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListTopModulesHandler := connect.NewUnaryHandler(
+		PerseusServiceListTopModulesProcedure,
+		svc.ListTopModules,
+		connect.WithSchema(perseusServiceListTopModulesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceGetModuleMetricsHandler := connect.NewUnaryHandler(
+		PerseusServiceGetModuleMetricsProcedure,
+		svc.GetModuleMetrics,
+		connect.WithSchema(perseusServiceGetModuleMetricsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListModuleConsumersHandler := connect.NewUnaryHandler(
+		PerseusServiceListModuleConsumersProcedure,
+		svc.ListModuleConsumers,
+		connect.WithSchema(perseusServiceListModuleConsumersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListModuleStalenessHandler := connect.NewUnaryHandler(
+		PerseusServiceListModuleStalenessProcedure,
+		svc.ListModuleStaleness,
+		connect.WithSchema(perseusServiceListModuleStalenessMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListAbandonedModulesHandler := connect.NewUnaryHandler(
+		PerseusServiceListAbandonedModulesProcedure,
+		svc.ListAbandonedModules,
+		connect.WithSchema(perseusServiceListAbandonedModulesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListGhostVersionsHandler := connect.NewUnaryHandler(
+		PerseusServiceListGhostVersionsProcedure,
+		svc.ListGhostVersions,
+		connect.WithSchema(perseusServiceListGhostVersionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceCheckPolicyHandler := connect.NewUnaryHandler(
+		PerseusServiceCheckPolicyProcedure,
+		svc.CheckPolicy,
+		connect.WithSchema(perseusServiceCheckPolicyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceResolveModuleSetHandler := connect.NewUnaryHandler(
+		PerseusServiceResolveModuleSetProcedure,
+		svc.ResolveModuleSet,
+		connect.WithSchema(perseusServiceResolveModuleSetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListVulnerabilitiesHandler := connect.NewUnaryHandler(
+		PerseusServiceListVulnerabilitiesProcedure,
+		svc.ListVulnerabilities,
+		connect.WithSchema(perseusServiceListVulnerabilitiesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceRenderGraphHandler := connect.NewUnaryHandler(
+		PerseusServiceRenderGraphProcedure,
+		svc.RenderGraph,
+		connect.WithSchema(perseusServiceRenderGraphMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceRefreshModulesHandler := connect.NewUnaryHandler(
+		PerseusServiceRefreshModulesProcedure,
+		svc.RefreshModules,
+		connect.WithSchema(perseusServiceRefreshModulesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceCheckGraphHandler := connect.NewUnaryHandler(
+		PerseusServiceCheckGraphProcedure,
+		svc.CheckGraph,
+		connect.WithSchema(perseusServiceCheckGraphMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceMergeModulesHandler := connect.NewUnaryHandler(
+		PerseusServiceMergeModulesProcedure,
+		svc.MergeModules,
+		connect.WithSchema(perseusServiceMergeModulesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceRenameModuleHandler := connect.NewUnaryHandler(
+		PerseusServiceRenameModuleProcedure,
+		svc.RenameModule,
+		connect.WithSchema(perseusServiceRenameModuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceSetModuleLicenseHandler := connect.NewUnaryHandler(
+		PerseusServiceSetModuleLicenseProcedure,
+		svc.SetModuleLicense,
+		connect.WithSchema(perseusServiceSetModuleLicenseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceDeleteModuleHandler := connect.NewUnaryHandler(
+		PerseusServiceDeleteModuleProcedure,
+		svc.DeleteModule,
+		connect.WithSchema(perseusServiceDeleteModuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceDeleteModuleVersionHandler := connect.NewUnaryHandler(
+		PerseusServiceDeleteModuleVersionProcedure,
+		svc.DeleteModuleVersion,
+		connect.WithSchema(perseusServiceDeleteModuleVersionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceUndeleteModuleHandler := connect.NewUnaryHandler(
+		PerseusServiceUndeleteModuleProcedure,
+		svc.UndeleteModule,
+		connect.WithSchema(perseusServiceUndeleteModuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		PerseusServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(perseusServiceCreateAPIKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListAPIKeysHandler := connect.NewUnaryHandler(
+		PerseusServiceListAPIKeysProcedure,
+		svc.ListAPIKeys,
+		connect.WithSchema(perseusServiceListAPIKeysMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		PerseusServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(perseusServiceRevokeAPIKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListAuditLogHandler := connect.NewUnaryHandler(
+		PerseusServiceListAuditLogProcedure,
+		svc.ListAuditLog,
+		connect.WithSchema(perseusServiceListAuditLogMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListJobsHandler := connect.NewUnaryHandler(
+		PerseusServiceListJobsProcedure,
+		svc.ListJobs,
+		connect.WithSchema(perseusServiceListJobsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceGetStoreStatsHandler := connect.NewUnaryHandler(
+		PerseusServiceGetStoreStatsProcedure,
+		svc.GetStoreStats,
+		connect.WithSchema(perseusServiceGetStoreStatsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceSubmitIngestionJobsHandler := connect.NewUnaryHandler(
+		PerseusServiceSubmitIngestionJobsProcedure,
+		svc.SubmitIngestionJobs,
+		connect.WithSchema(perseusServiceSubmitIngestionJobsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceGetIngestionJobHandler := connect.NewUnaryHandler(
+		PerseusServiceGetIngestionJobProcedure,
+		svc.GetIngestionJob,
+		connect.WithSchema(perseusServiceGetIngestionJobMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceListIngestionJobsHandler := connect.NewUnaryHandler(
+		PerseusServiceListIngestionJobsProcedure,
+		svc.ListIngestionJobs,
+		connect.WithSchema(perseusServiceListIngestionJobsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	perseusServiceGetServerInfoHandler := connect.NewUnaryHandler(
+		PerseusServiceGetServerInfoProcedure,
+		svc.GetServerInfo,
+		connect.WithSchema(perseusServiceGetServerInfoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/crowdstrike.perseus.perseusapi.PerseusService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PerseusServiceCreateModuleProcedure:
@@ -233,8 +1193,72 @@ func NewPerseusServiceHandler(svc PerseusServiceHandler, opts ...connect.Handler
 			perseusServiceListModuleVersionsHandler.ServeHTTP(w, r)
 		case PerseusServiceUpdateDependenciesProcedure:
 			perseusServiceUpdateDependenciesHandler.ServeHTTP(w, r)
+		case PerseusServiceBatchUpdateDependenciesProcedure:
+			perseusServiceBatchUpdateDependenciesHandler.ServeHTTP(w, r)
 		case PerseusServiceQueryDependenciesProcedure:
 			perseusServiceQueryDependenciesHandler.ServeHTTP(w, r)
+		case PerseusServiceGetDependencyHistoryProcedure:
+			perseusServiceGetDependencyHistoryHandler.ServeHTTP(w, r)
+		case PerseusServiceDiffGraphProcedure:
+			perseusServiceDiffGraphHandler.ServeHTTP(w, r)
+		case PerseusServiceStreamEdgesProcedure:
+			perseusServiceStreamEdgesHandler.ServeHTTP(w, r)
+		case PerseusServiceListTopModulesProcedure:
+			perseusServiceListTopModulesHandler.ServeHTTP(w, r)
+		case PerseusServiceGetModuleMetricsProcedure:
+			perseusServiceGetModuleMetricsHandler.ServeHTTP(w, r)
+		case PerseusServiceListModuleConsumersProcedure:
+			perseusServiceListModuleConsumersHandler.ServeHTTP(w, r)
+		case PerseusServiceListModuleStalenessProcedure:
+			perseusServiceListModuleStalenessHandler.ServeHTTP(w, r)
+		case PerseusServiceListAbandonedModulesProcedure:
+			perseusServiceListAbandonedModulesHandler.ServeHTTP(w, r)
+		case PerseusServiceListGhostVersionsProcedure:
+			perseusServiceListGhostVersionsHandler.ServeHTTP(w, r)
+		case PerseusServiceCheckPolicyProcedure:
+			perseusServiceCheckPolicyHandler.ServeHTTP(w, r)
+		case PerseusServiceResolveModuleSetProcedure:
+			perseusServiceResolveModuleSetHandler.ServeHTTP(w, r)
+		case PerseusServiceListVulnerabilitiesProcedure:
+			perseusServiceListVulnerabilitiesHandler.ServeHTTP(w, r)
+		case PerseusServiceRenderGraphProcedure:
+			perseusServiceRenderGraphHandler.ServeHTTP(w, r)
+		case PerseusServiceRefreshModulesProcedure:
+			perseusServiceRefreshModulesHandler.ServeHTTP(w, r)
+		case PerseusServiceCheckGraphProcedure:
+			perseusServiceCheckGraphHandler.ServeHTTP(w, r)
+		case PerseusServiceMergeModulesProcedure:
+			perseusServiceMergeModulesHandler.ServeHTTP(w, r)
+		case PerseusServiceRenameModuleProcedure:
+			perseusServiceRenameModuleHandler.ServeHTTP(w, r)
+		case PerseusServiceSetModuleLicenseProcedure:
+			perseusServiceSetModuleLicenseHandler.ServeHTTP(w, r)
+		case PerseusServiceDeleteModuleProcedure:
+			perseusServiceDeleteModuleHandler.ServeHTTP(w, r)
+		case PerseusServiceDeleteModuleVersionProcedure:
+			perseusServiceDeleteModuleVersionHandler.ServeHTTP(w, r)
+		case PerseusServiceUndeleteModuleProcedure:
+			perseusServiceUndeleteModuleHandler.ServeHTTP(w, r)
+		case PerseusServiceCreateAPIKeyProcedure:
+			perseusServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case PerseusServiceListAPIKeysProcedure:
+			perseusServiceListAPIKeysHandler.ServeHTTP(w, r)
+		case PerseusServiceRevokeAPIKeyProcedure:
+			perseusServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case PerseusServiceListAuditLogProcedure:
+			perseusServiceListAuditLogHandler.ServeHTTP(w, r)
+		case PerseusServiceListJobsProcedure:
+			perseusServiceListJobsHandler.ServeHTTP(w, r)
+		case PerseusServiceGetStoreStatsProcedure:
+			perseusServiceGetStoreStatsHandler.ServeHTTP(w, r)
+		case PerseusServiceSubmitIngestionJobsProcedure:
+			perseusServiceSubmitIngestionJobsHandler.ServeHTTP(w, r)
+		case PerseusServiceGetIngestionJobProcedure:
+			perseusServiceGetIngestionJobHandler.ServeHTTP(w, r)
+		case PerseusServiceListIngestionJobsProcedure:
+			perseusServiceListIngestionJobsHandler.ServeHTTP(w, r)
+		case PerseusServiceGetServerInfoProcedure:
+			perseusServiceGetServerInfoHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -260,10 +1284,138 @@ func (UnimplementedPerseusServiceHandler) UpdateDependencies(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.UpdateDependencies is not implemented"))
 }
 
+func (UnimplementedPerseusServiceHandler) BatchUpdateDependencies(context.Context, *connect.Request[perseusapi.BatchUpdateDependenciesRequest]) (*connect.Response[perseusapi.BatchUpdateDependenciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.BatchUpdateDependencies is not implemented"))
+}
+
 func (UnimplementedPerseusServiceHandler) QueryDependencies(context.Context, *connect.Request[perseusapi.QueryDependenciesRequest]) (*connect.Response[perseusapi.QueryDependenciesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.QueryDependencies is not implemented"))
 }
 
+func (UnimplementedPerseusServiceHandler) GetDependencyHistory(context.Context, *connect.Request[perseusapi.GetDependencyHistoryRequest]) (*connect.Response[perseusapi.GetDependencyHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.GetDependencyHistory is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) DiffGraph(context.Context, *connect.Request[perseusapi.DiffGraphRequest]) (*connect.Response[perseusapi.DiffGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.DiffGraph is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) StreamEdges(context.Context, *connect.Request[perseusapi.StreamEdgesRequest], *connect.ServerStream[perseusapi.StreamEdgesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.StreamEdges is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListTopModules(context.Context, *connect.Request[perseusapi.ListTopModulesRequest]) (*connect.Response[perseusapi.ListTopModulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListTopModules is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) GetModuleMetrics(context.Context, *connect.Request[perseusapi.GetModuleMetricsRequest]) (*connect.Response[perseusapi.GetModuleMetricsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.GetModuleMetrics is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListModuleConsumers(context.Context, *connect.Request[perseusapi.ListModuleConsumersRequest]) (*connect.Response[perseusapi.ListModuleConsumersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListModuleConsumers is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListModuleStaleness(context.Context, *connect.Request[perseusapi.ListModuleStalenessRequest]) (*connect.Response[perseusapi.ListModuleStalenessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListModuleStaleness is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListAbandonedModules(context.Context, *connect.Request[perseusapi.ListAbandonedModulesRequest]) (*connect.Response[perseusapi.ListAbandonedModulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListAbandonedModules is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListGhostVersions(context.Context, *connect.Request[perseusapi.ListGhostVersionsRequest]) (*connect.Response[perseusapi.ListGhostVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListGhostVersions is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) CheckPolicy(context.Context, *connect.Request[perseusapi.CheckPolicyRequest]) (*connect.Response[perseusapi.CheckPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.CheckPolicy is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ResolveModuleSet(context.Context, *connect.Request[perseusapi.ResolveModuleSetRequest]) (*connect.Response[perseusapi.ResolveModuleSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ResolveModuleSet is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListVulnerabilities(context.Context, *connect.Request[perseusapi.ListVulnerabilitiesRequest]) (*connect.Response[perseusapi.ListVulnerabilitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListVulnerabilities is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) RenderGraph(context.Context, *connect.Request[perseusapi.RenderGraphRequest]) (*connect.Response[perseusapi.RenderGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.RenderGraph is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) RefreshModules(context.Context, *connect.Request[perseusapi.RefreshModulesRequest]) (*connect.Response[perseusapi.RefreshModulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.RefreshModules is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) CheckGraph(context.Context, *connect.Request[perseusapi.CheckGraphRequest]) (*connect.Response[perseusapi.CheckGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.CheckGraph is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) MergeModules(context.Context, *connect.Request[perseusapi.MergeModulesRequest]) (*connect.Response[perseusapi.MergeModulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.MergeModules is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) RenameModule(context.Context, *connect.Request[perseusapi.RenameModuleRequest]) (*connect.Response[perseusapi.RenameModuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.RenameModule is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) SetModuleLicense(context.Context, *connect.Request[perseusapi.SetModuleLicenseRequest]) (*connect.Response[perseusapi.SetModuleLicenseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.SetModuleLicense is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) DeleteModule(context.Context, *connect.Request[perseusapi.DeleteModuleRequest]) (*connect.Response[perseusapi.DeleteModuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.DeleteModule is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) DeleteModuleVersion(context.Context, *connect.Request[perseusapi.DeleteModuleVersionRequest]) (*connect.Response[perseusapi.DeleteModuleVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.DeleteModuleVersion is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) UndeleteModule(context.Context, *connect.Request[perseusapi.UndeleteModuleRequest]) (*connect.Response[perseusapi.UndeleteModuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.UndeleteModule is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) CreateAPIKey(context.Context, *connect.Request[perseusapi.CreateAPIKeyRequest]) (*connect.Response[perseusapi.CreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListAPIKeys(context.Context, *connect.Request[perseusapi.ListAPIKeysRequest]) (*connect.Response[perseusapi.ListAPIKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListAPIKeys is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) RevokeAPIKey(context.Context, *connect.Request[perseusapi.RevokeAPIKeyRequest]) (*connect.Response[perseusapi.RevokeAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListAuditLog(context.Context, *connect.Request[perseusapi.ListAuditLogRequest]) (*connect.Response[perseusapi.ListAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListAuditLog is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListJobs(context.Context, *connect.Request[perseusapi.ListJobsRequest]) (*connect.Response[perseusapi.ListJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListJobs is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) GetStoreStats(context.Context, *connect.Request[perseusapi.GetStoreStatsRequest]) (*connect.Response[perseusapi.GetStoreStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.GetStoreStats is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) SubmitIngestionJobs(context.Context, *connect.Request[perseusapi.SubmitIngestionJobsRequest]) (*connect.Response[perseusapi.SubmitIngestionJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.SubmitIngestionJobs is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) GetIngestionJob(context.Context, *connect.Request[perseusapi.GetIngestionJobRequest]) (*connect.Response[perseusapi.GetIngestionJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.GetIngestionJob is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) ListIngestionJobs(context.Context, *connect.Request[perseusapi.ListIngestionJobsRequest]) (*connect.Response[perseusapi.ListIngestionJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.ListIngestionJobs is not implemented"))
+}
+
+func (UnimplementedPerseusServiceHandler) GetServerInfo(context.Context, *connect.Request[perseusapi.GetServerInfoRequest]) (*connect.Response[perseusapi.GetServerInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crowdstrike.perseus.perseusapi.PerseusService.GetServerInfo is not implemented"))
+}
+
 // HealthZServiceClient is a client for the crowdstrike.perseus.perseusapi.HealthZService service.
 type HealthZServiceClient interface {
 }
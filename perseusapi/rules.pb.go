@@ -0,0 +1,149 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: rules.proto
+
+package perseusapi
+
+import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var file_rules_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*validate.StringRules)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         83800,
+		Name:          "crowdstrike.perseus.perseusapi.module_path",
+		Tag:           "varint,83800,opt,name=module_path",
+		Filename:      "rules.proto",
+	},
+	{
+		ExtendedType:  (*validate.StringRules)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         83801,
+		Name:          "crowdstrike.perseus.perseusapi.module_version",
+		Tag:           "varint,83801,opt,name=module_version",
+		Filename:      "rules.proto",
+	},
+}
+
+// Extension fields to validate.StringRules.
+var (
+	// the value must be a valid Go module path, such as github.com/CrowdStrike/perseus
+	//
+	// optional bool module_path = 83800;
+	E_ModulePath = &file_rules_proto_extTypes[0]
+	// the value must be a semantic version that the server can convert to the canonical form of a Go module
+	// version, which includes shorthand versions, such as v1.2, and versions without the "v" prefix
+	//
+	// optional bool module_version = 83801;
+	E_ModuleVersion = &file_rules_proto_extTypes[1]
+)
+
+var File_rules_proto protoreflect.FileDescriptor
+
+var file_rules_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x63,
+	0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x75, 0x73, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x1a, 0x1b, 0x62,
+	0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3a, 0xc0, 0x01, 0x0a, 0x0b, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x19, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0xd8, 0x8e, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x81, 0x01,
+	0xc2, 0x48, 0x7e, 0x0a, 0x7c, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x24, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x20, 0x47, 0x6f, 0x20, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x1a,
+	0x40, 0x21, 0x72, 0x75, 0x6c, 0x65, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28, 0x27, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x7e, 0x2d, 0x5d, 0x2b, 0x28, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x7e, 0x2b, 0x2d, 0x5d, 0x2b, 0x29, 0x2a, 0x24, 0x27,
+	0x29, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x3a, 0xc0, 0x02,
+	0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0xd9, 0x8e, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0xfb, 0x01, 0xc2, 0x48, 0xf7, 0x01, 0x0a, 0xf4, 0x01, 0x0a, 0x15, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74,
+	0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x20, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x73, 0x75, 0x63, 0x68, 0x20, 0x61, 0x73, 0x20,
+	0x76, 0x31, 0x2e, 0x32, 0x2e, 0x33, 0x20, 0x6f, 0x72, 0x20, 0x76, 0x31, 0x2e, 0x32, 0x1a, 0xa0,
+	0x01, 0x21, 0x72, 0x75, 0x6c, 0x65, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28, 0x27, 0x5e, 0x76, 0x3f, 0x28, 0x30, 0x7c, 0x5b, 0x31,
+	0x2d, 0x39, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x29, 0x28, 0x5b, 0x2e, 0x5d, 0x28, 0x30,
+	0x7c, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x29, 0x28, 0x5b, 0x2e,
+	0x5d, 0x28, 0x30, 0x7c, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x29,
+	0x28, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x2d, 0x5d, 0x2b, 0x28,
+	0x5b, 0x2e, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x2d, 0x5d, 0x2b,
+	0x29, 0x2a, 0x29, 0x3f, 0x28, 0x5b, 0x2b, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x2d, 0x5d, 0x2b, 0x28, 0x5b, 0x2e, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x2d, 0x5d, 0x2b, 0x29, 0x2a, 0x29, 0x3f, 0x29, 0x3f, 0x29, 0x3f, 0x24, 0x27,
+	0x29, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0xf5, 0x01, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
+	0x72, 0x69, 0x6b, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x42, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x2f, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+	0xa2, 0x02, 0x03, 0x43, 0x50, 0x50, 0xaa, 0x02, 0x1e, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74,
+	0x72, 0x69, 0x6b, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x2e, 0x50, 0x65, 0x72,
+	0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0xca, 0x02, 0x1e, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x5c, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x5c, 0x50, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0xe2, 0x02, 0x2a, 0x43, 0x72, 0x6f, 0x77, 0x64,
+	0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x5c, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x5c, 0x50,
+	0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x20, 0x43, 0x72, 0x6f, 0x77, 0x64, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x3a, 0x3a, 0x50, 0x65, 0x72, 0x73, 0x65, 0x75, 0x73, 0x3a, 0x3a, 0x50, 0x65,
+	0x72, 0x73, 0x65, 0x75, 0x73, 0x61, 0x70, 0x69,
+}
+
+var file_rules_proto_goTypes = []any{
+	(*validate.StringRules)(nil), // 0: buf.validate.StringRules
+}
+var file_rules_proto_depIdxs = []int32{
+	0, // 0: crowdstrike.perseus.perseusapi.module_path:extendee -> buf.validate.StringRules
+	0, // 1: crowdstrike.perseus.perseusapi.module_version:extendee -> buf.validate.StringRules
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	0, // [0:2] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rules_proto_init() }
+func file_rules_proto_init() {
+	if File_rules_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rules_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_rules_proto_goTypes,
+		DependencyIndexes: file_rules_proto_depIdxs,
+		ExtensionInfos:    file_rules_proto_extTypes,
+	}.Build()
+	File_rules_proto = out.File
+	file_rules_proto_rawDesc = nil
+	file_rules_proto_goTypes = nil
+	file_rules_proto_depIdxs = nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// cliConfigFile defines the contents of the CLI configuration file, which contains a set of named
+// profiles.
+//
+// Example:
+//
+//	default-profile: staging
+//	profiles:
+//	  staging:
+//	    server-addr: perseus.staging.example.com:443
+//	  internal:
+//	    server-addr: perseus.corp.example.com:443
+//	    ca-file: /etc/ssl/corp-ca.pem
+//	    cert-file: /etc/perseus/client.pem
+//	    key-file: /etc/perseus/client-key.pem
+//	  local:
+//	    server-addr: http://localhost:31138
+//	    insecure: true
+//	    format: list
+type cliConfigFile struct {
+	// the name of the profile to use if none is specified via --profile or $PERSEUS_PROFILE
+	DefaultProfile string `yaml:"default-profile"`
+	// the available profiles, by name
+	Profiles map[string]cliProfile `yaml:"profiles"`
+}
+
+// cliProfile defines the settings for a single named profile in the CLI configuration file.
+type cliProfile struct {
+	// the TCP host/port of the Perseus server
+	ServerAddr string `yaml:"server-addr"`
+	// do not use TLS when connecting if true
+	Insecure bool `yaml:"insecure"`
+	// the path to a PEM file with the certificate authorities that issue the server's certificate
+	CAFile string `yaml:"ca-file"`
+	// the paths to the PEM files with the client certificate and its private key
+	CertFile string `yaml:"cert-file"`
+	KeyFile  string `yaml:"key-file"`
+	// the default output format, one of "json", "yaml", "table", "tree", or "dot"
+	Format string `yaml:"format"`
+	// the maximum amount of time to wait for the server, ex: 30s
+	Timeout time.Duration `yaml:"timeout"`
+	// how long to cache responses to read-only queries on disk, ex: 10m
+	CacheTTL time.Duration `yaml:"cache-ttl"`
+	// how long an idle connection to the server is kept open, ex: 5m
+	IdleTimeout time.Duration `yaml:"idle-timeout"`
+	// how long a connection may go without receiving data before an HTTP/2 ping is sent, ex: 30s
+	PingInterval time.Duration `yaml:"ping-interval"`
+	// the maximum number of requests in flight on each connection
+	MaxStreams int `yaml:"max-streams"`
+	// the maximum amount of time to establish a connection, ex: 5s
+	ConnectTimeout time.Duration `yaml:"connect-timeout"`
+	// the algorithm used to compress requests and responses, one of "gzip", "zstd", or "none"
+	Compression string `yaml:"compression"`
+}
+
+// cliConfigFilePath returns the location of the CLI configuration file, which is config.yaml in the
+// perseus/ folder under $XDG_CONFIG_HOME, or ~/.config if that is not set.
+func cliConfigFilePath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("unable to determine the user's home directory: %w", err)
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "perseus", "config.yaml"), nil
+}
+
+// loadCLIConfigFile reads and parses the CLI configuration file.  A missing file is not an error and
+// results in an empty configuration.
+func loadCLIConfigFile() (cliConfigFile, error) {
+	var conf cliConfigFile
+	path, err := cliConfigFilePath()
+	if err != nil {
+		return conf, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return conf, nil
+		}
+		return conf, fmt.Errorf("unable to read the CLI configuration file: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &conf); err != nil {
+		return conf, fmt.Errorf("unable to parse the CLI configuration file %q: %w", path, err)
+	}
+	return conf, nil
+}
+
+// readClientConfigProfile loads the profile selected by the --profile CLI flag, or the default profile
+// from the CLI configuration file, and returns a list of 0 or more config options.
+func readClientConfigProfile(fset *pflag.FlagSet) ([]clientOption, error) {
+	conf, err := loadCLIConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	name, _ := fset.GetString("profile")
+	if name == "" {
+		name = conf.DefaultProfile
+	}
+	if name == "" {
+		return nil, nil
+	}
+	p, ok := conf.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("the profile %q does not exist in the CLI configuration file", name)
+	}
+
+	var opts []clientOption
+	if p.ServerAddr != "" {
+		opts = append(opts, withServerAddress(p.ServerAddr))
+	}
+	if p.Insecure {
+		opts = append(opts, withInsecureDial())
+	}
+	if p.CAFile != "" {
+		opts = append(opts, withCAFile(p.CAFile))
+	}
+	if p.CertFile != "" {
+		opts = append(opts, withCertFile(p.CertFile))
+	}
+	if p.KeyFile != "" {
+		opts = append(opts, withKeyFile(p.KeyFile))
+	}
+	if p.Format != "" {
+		opts = append(opts, withOutputFormat(p.Format))
+	}
+	if p.Timeout != 0 {
+		opts = append(opts, withTimeout(p.Timeout))
+	}
+	if p.CacheTTL != 0 {
+		opts = append(opts, withCacheTTL(p.CacheTTL))
+	}
+	if p.IdleTimeout != 0 {
+		opts = append(opts, withIdleTimeout(p.IdleTimeout))
+	}
+	if p.PingInterval != 0 {
+		opts = append(opts, withPingInterval(p.PingInterval))
+	}
+	if p.MaxStreams != 0 {
+		opts = append(opts, withMaxStreams(p.MaxStreams))
+	}
+	if p.ConnectTimeout != 0 {
+		opts = append(opts, withConnectTimeout(p.ConnectTimeout))
+	}
+	if p.Compression != "" {
+		opts = append(opts, withCompression(p.Compression))
+	}
+	return opts, nil
+}
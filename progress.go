@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// progressBarWidth is the number of characters used to render the bar for interactive progress output
+const progressBarWidth = 30
+
+// bulkProgress tracks and reports the progress of an operation that processes many modules, such as
+// backfilling all versions of a module.
+//
+// When stdout is an interactive terminal, progress is rendered as a single, continuously updated
+// progress bar.  Otherwise, a machine-readable JSON object is written for each completed item so that
+// automation can track the run.  No progress is reported if --quiet was specified.  In all cases,
+// [bulkProgress.Finish] writes a summary of the run, including each failure.
+//
+// All methods are safe for concurrent use.
+type bulkProgress struct {
+	mu          sync.Mutex
+	w           io.Writer
+	interactive bool
+	quiet       bool
+	start       time.Time
+
+	total     int
+	completed int
+	failures  []bulkFailure
+}
+
+// bulkFailure records a single failed item of a bulk operation
+type bulkFailure struct {
+	item string
+	err  error
+}
+
+// progressLine is the machine-readable progress record emitted for each completed item when not
+// writing to a terminal
+type progressLine struct {
+	Item      string `json:"item"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	Completed int    `json:"completed"`
+	Failed    int    `json:"failed"`
+	Total     int    `json:"total"`
+}
+
+// newBulkProgress initializes and returns a [bulkProgress] for an operation with total items.
+func newBulkProgress(total int) *bulkProgress {
+	return &bulkProgress{
+		w:           os.Stdout,
+		interactive: spinnerEnabled(),
+		quiet:       quietMode,
+		start:       time.Now(),
+		total:       total,
+	}
+}
+
+// Succeeded records that item was processed successfully.
+func (p *bulkProgress) Succeeded(item string) {
+	p.record(item, nil)
+}
+
+// Failed records that processing item failed with err.
+func (p *bulkProgress) Failed(item string, err error) {
+	p.record(item, err)
+}
+
+// FailedCount returns the number of items that have failed so far.
+func (p *bulkProgress) FailedCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.failures)
+}
+
+// record updates the counts and reports the progress for a completed item
+func (p *bulkProgress) record(item string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.completed++
+	if err != nil {
+		p.failures = append(p.failures, bulkFailure{item: item, err: err})
+	}
+	switch {
+	case p.quiet:
+		return
+	case p.interactive:
+		p.renderBar(item)
+	default:
+		line := progressLine{
+			Item:      item,
+			Status:    "ok",
+			Completed: p.completed,
+			Failed:    len(p.failures),
+			Total:     p.total,
+		}
+		if err != nil {
+			line.Status, line.Error = "failed", err.Error()
+		}
+		data, _ := json.Marshal(line)
+		fmt.Fprintln(p.w, string(data))
+	}
+}
+
+// renderBar redraws the interactive progress bar.  p.mu must be held by the caller.
+func (p *bulkProgress) renderBar(item string) {
+	filled := progressBarWidth
+	if p.total > 0 {
+		filled = progressBarWidth * p.completed / p.total
+	}
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
+	failed := ""
+	if n := len(p.failures); n > 0 {
+		failed = fmt.Sprintf(" (%d failed)", n)
+	}
+	// \r returns to the start of the line and \x1b[2K clears it
+	fmt.Fprintf(p.w, "\r\x1b[2K%s %d/%d%s %s", bar, p.completed, p.total, failed, item)
+}
+
+// Finish completes the progress output and writes a summary of the run.  The returned error is non-nil
+// if any items failed.
+func (p *bulkProgress) Finish() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.interactive && !p.quiet {
+		fmt.Fprint(p.w, "\r\x1b[2K")
+	}
+	if !p.quiet || len(p.failures) > 0 {
+		fmt.Fprintf(p.w, "Processed %d of %d item(s) in %s: %d succeeded, %d failed\n",
+			p.completed, p.total, time.Since(p.start).Round(time.Millisecond), p.completed-len(p.failures), len(p.failures))
+	}
+	for _, f := range p.failures {
+		fmt.Fprintf(p.w, "  - %s: %v\n", f.item, f.err)
+	}
+	if len(p.failures) > 0 {
+		return fmt.Errorf("%d of %d item(s) failed", len(p.failures), p.total)
+	}
+	return nil
+}
@@ -3,10 +3,13 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"text/template"
@@ -18,23 +21,46 @@ import (
 	"github.com/theckman/yacspin"
 	"golang.org/x/mod/module"
 	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
 
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/internal/modver"
 	"github.com/CrowdStrike/perseus/perseusapi"
-	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
 )
 
 const (
-	goTemplateArgUsage = `provides a Go text template to format the output.
+	outputFormatArgUsage = `specifies the output format, one of:
+	json                JSON (the default)
+	yaml                YAML
+	table               a tabular list
+	tree                an indented, colorized tree (not supported for list-modules or list-module-versions)
+	dot                 a DOT directed graph (not supported for list-modules or list-module-versions)
+	govulncheck         the JSON stream produced by 'govulncheck -json' (only supported for vulns)
+	template=[template] a Go text template that is applied to each result
+
 Each result is an instance of the following struct:
 	type Item struct {
 		// the module name and version, ex: github.com/CrowdStrike/perseus and v0.11.38
 		Path, Version string
 		// true if this module is a direct dependency of the "root" module, false if not
 		IsDirect bool
-		// the number of dependency links between this module and the "root" module
+		// the number of dependency links between this module and the "root" module, using the
+		// shortest path if there is more than 1
 		// - direct dependencies have a degree of 1, dependencies of direct dependencies
 		//   have a degree of 2, etc.
 		Degree int
+		// the number of paths between this module and the "root" module within the maximum depth
+		Occurrences int
+		// the deps.dev data requested by --with-scorecard, --with-licenses or --with-advisories, or nil
+		Insights *struct {
+			Scorecard  *float64
+			Licenses   []string
+			Advisories []string
+		}
+		// the pkg.go.dev URL for the module version, empty for modules matching $GOPRIVATE
+		DocsURL string
+		// the web URL of the module's source repository, empty if it is not known
+		SourceURL string
 	}
 The Name() method also returns a string containing "[Path]@[Version]".`
 	listModuleVersionsExampleUsage = `  # list all known versions of Perseus
@@ -44,13 +70,55 @@ The Name() method also returns a string containing "[Path]@[Version]".`
   perseus query lmv 'github.com/CrowdStrike/*' --include-prerelease
 
   # list the highest v1.x version of all CrowdStrike GitHub modules
-  perseus q lmv 'github.com/CrowdStrike/*' -v 'v1.*' --latest`
+  perseus q lmv 'github.com/CrowdStrike/*' -v 'v1.*' --latest
+
+  # list every v1.x version of a module from v1.4.0 onward
+  perseus q lmv github.com/CrowdStrike/perseus -v '^v1.4'
+
+  # list the highest version of every major version of a module, ex: github.com/foo/bar and github.com/foo/bar/v2
+  perseus q lmv github.com/foo/bar --latest --all-majors
+
+  # list the highest tagged version of a module, including pre-releases but not pseudo-versions
+  perseus q lmv github.com/CrowdStrike/perseus --latest -p --exclude-pseudo
+
+  # list the 5 most recent releases of a module
+  perseus q lmv github.com/CrowdStrike/perseus --sort date --limit 5
+
+  # list every version of a module that was released this quarter
+  perseus q lmv github.com/CrowdStrike/perseus --since 2024-07-01`
 )
 
+// the supported sort orders for the 'query list-module-versions' CLI sub-command
+var versionSortOrders = []string{"semver", "date"}
+
 func tty() bool {
 	return isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
 }
 
+// spinnerEnabled returns true if the progress spinner should be displayed.  The spinner is only shown
+// when writing to a TTY and is suppressed by the --quiet and --no-spinner flags as well as in CI
+// environments or when $NO_COLOR is set.
+func spinnerEnabled() bool {
+	if quietMode || noSpinner || !tty() {
+		return false
+	}
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	if ci, _ := strconv.ParseBool(os.Getenv("CI")); ci {
+		return false
+	}
+	return true
+}
+
+// infof writes an informational message to stdout unless --quiet was specified.
+func infof(format string, args ...any) {
+	if quietMode {
+		return
+	}
+	fmt.Printf(format, args...)
+}
+
 // createQueryCommand initializes and returns a *cobra.Command that implements the 'query' CLI sub-command
 func createQueryCommand() *cobra.Command {
 	cmd := cobra.Command{
@@ -60,13 +128,19 @@ func createQueryCommand() *cobra.Command {
 		SilenceUsage: true,
 	}
 	fset := cmd.PersistentFlags()
-	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the TCP host and port of the Perseus server (default is $PERSEUS_SERVER_ADDR environment variable)")
-	fset.BoolVar(&formatAsJSON, "json", false, "specifies that the output should be formatted as JSON")
-	fset.BoolVar(&formatAsList, "list", false, "specifies that the output should be formatted as a tabular list")
-	fset.BoolVar(&formatAsDotGraph, "dot", false, "specifies that the output should be a DOT directed graph (not supported for list-modules or list-module-versions)")
-	fset.StringVarP(&formatTemplate, "format", "f", "", goTemplateArgUsage)
-	fset.IntVar(&maxDepth, "max-depth", 4, "specifies the maximum number of levels to be returned")
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
+	addOutputFormatFlags(fset, outputFormatArgUsage, "tree", "json", "list", "dot", "format")
+	fset.StringVarP(&outputPath, "output", "O", "", "write the results to the specified file, which is replaced atomically, instead of stdout")
+	fset.IntVar(&maxDepth, "max-depth", 4, "specifies the maximum number of levels to be returned, 0 for no limit")
 	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.BoolVar(&showLinks, "links", false, "include the pkg.go.dev and source repository URLs of each module in table output")
+	fset.Var(&dotStyleOpts, "dot-style", "comma-separated options for DOT output: theme=(crowdstrike|light|dark|mono), hide-versions, show-owners, highlight-root, mark-vulnerable, mark-deprecated")
+	fset.StringVar(&renderFormat, "render", "", "render DOT output as an image on the server, one of: svg, png, so that no local Graphviz installation is needed")
+	fset.Duration("timeout", 0, "the maximum amount of time to wait for the Perseus server, ex: 30s or 2m (default is $PERSEUS_TIMEOUT environment variable or no limit)")
+	addRetryFlags(fset)
+	addConnectionFlags(fset)
+	fset.Duration("cache-ttl", 0, "cache the responses to read-only queries on disk for the specified amount of time, ex: 10m, so that repeated queries are not re-fetched (default is $PERSEUS_CACHE_TTL environment variable or no caching)")
 
 	listModulesCmd := cobra.Command{
 		Use:          "list-modules [pattern]",
@@ -78,16 +152,22 @@ func createQueryCommand() *cobra.Command {
 	cmd.AddCommand(&listModulesCmd)
 
 	listVersionsCmd := cobra.Command{
-		Use:          "list-module-versions [--versions=(version glob)] (module glob)",
+		Use:          "list-module-versions [--versions=(version glob or range)] (module glob)",
 		Example:      listModuleVersionsExampleUsage,
 		Aliases:      []string{"lmv"},
 		Short:        "Outputs a list of module versions that match the provided glob pattern(s)",
 		RunE:         runListModuleVersionsCmd,
 		SilenceUsage: true,
 	}
-	listVersionsCmd.Flags().StringP("versions", "v", "", "optional glob pattern specifying which module version(s) should be returned")
+	listVersionsCmd.Flags().StringP("versions", "v", "", "optional glob pattern, ex: 'v1.*', or semantic version range, ex: '>=v1.4.0 <v2.0.0', '~v1.4', or '^v2', specifying which module version(s) should be returned")
 	listVersionsCmd.Flags().Bool("latest", false, "specifies that only the latest/highest version matching the provided pattern should be returned")
 	listVersionsCmd.Flags().BoolP("include-prerelease", "p", false, "specifies that pre-release versions should be returned")
+	listVersionsCmd.Flags().Bool("all-majors", false, "specifies that the versions of every major version of the matching module(s) should be returned, ex: example.com/foo/v2 for example.com/foo")
+	listVersionsCmd.Flags().Bool("exclude-pseudo", false, "specifies that pseudo-versions, which are pre-releases, should not be returned or considered by --latest")
+	listVersionsCmd.Flags().Var(asOfValue{&asOf}, "as-of", "only return the versions that were known at the specified date or RFC 3339 timestamp, ex: 2024-06-01 or 2024-06-01T12:00:00Z")
+	listVersionsCmd.Flags().Var(asOfValue{&since}, "since", "only return the versions that were added at or after the specified date or RFC 3339 timestamp, ex: 2024-06-01 or 2024-06-01T12:00:00Z")
+	listVersionsCmd.Flags().String("sort", "semver", "the order of the versions of each module, either 'semver' (highest first) or 'date' (most recently added first)")
+	listVersionsCmd.Flags().Int("limit", 0, "the maximum number of versions to return for each module, ex: --sort date --limit 5 for the 5 most recent releases (default is no limit)")
 	cmd.AddCommand(&listVersionsCmd)
 
 	descendantsCmd := cobra.Command{
@@ -97,6 +177,12 @@ func createQueryCommand() *cobra.Command {
 		RunE:         runQueryModuleGraphCmd,
 		SilenceUsage: true,
 	}
+	descendantsCmd.Flags().Bool("all-majors", false, "include the modules that depend on any major version of the specified module, ex: example.com/foo/v2 for example.com/foo")
+	descendantsCmd.Flags().BoolVar(&followRenames, "follow-renames", false, "include the results for any module that the specified modules were renamed from or to")
+	descendantsCmd.Flags().Var(asOfValue{&asOf}, "as-of", "query the graph as it was at the specified date or RFC 3339 timestamp, ex: 2024-06-01 or 2024-06-01T12:00:00Z")
+	descendantsCmd.Flags().BoolVar(&withScorecard, "with-scorecard", false, "include the OpenSSF Scorecard score of each module's source repository from deps.dev")
+	descendantsCmd.Flags().BoolVar(&withLicenses, "with-licenses", false, "include the licenses of each module version from deps.dev")
+	descendantsCmd.Flags().BoolVar(&withAdvisories, "with-advisories", false, "include the security advisories that affect each module version from deps.dev")
 	cmd.AddCommand(&descendantsCmd)
 
 	ancestorsCmd := cobra.Command{
@@ -106,8 +192,213 @@ func createQueryCommand() *cobra.Command {
 		RunE:         runQueryModuleGraphCmd,
 		SilenceUsage: true,
 	}
+	ancestorsCmd.Flags().Bool("all-majors", false, "include the dependencies of every major version of the specified module, ex: example.com/foo/v2 for example.com/foo")
+	ancestorsCmd.Flags().BoolVar(&followRenames, "follow-renames", false, "include the results for any module that the specified modules were renamed from or to")
+	ancestorsCmd.Flags().Var(asOfValue{&asOf}, "as-of", "query the graph as it was at the specified date or RFC 3339 timestamp, ex: 2024-06-01 or 2024-06-01T12:00:00Z")
+	ancestorsCmd.Flags().BoolVar(&withScorecard, "with-scorecard", false, "include the OpenSSF Scorecard score of each module's source repository from deps.dev")
+	ancestorsCmd.Flags().BoolVar(&withLicenses, "with-licenses", false, "include the licenses of each module version from deps.dev")
+	ancestorsCmd.Flags().BoolVar(&withAdvisories, "with-advisories", false, "include the security advisories that affect each module version from deps.dev")
 	cmd.AddCommand(&ancestorsCmd)
 
+	historyCmd := cobra.Command{
+		Use:          "history module@version",
+		Example:      queryHistoryExampleUsage,
+		Short:        "Outputs when each direct dependency of the specified module version was added and, if it was dropped, removed",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryHistoryCmd,
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(&historyCmd)
+
+	graphDiffCmd := cobra.Command{
+		Use:          "graph-diff --from (date) [--to (date)] [module glob]",
+		Example:      queryGraphDiffExampleUsage,
+		Short:        "Outputs the modules and dependencies that were added or removed between 2 dates",
+		Args:         cobra.MaximumNArgs(1),
+		RunE:         runQueryGraphDiffCmd,
+		SilenceUsage: true,
+	}
+	graphDiffCmd.Flags().Var(asOfValue{&diffFrom}, "from", "the start of the window, as a date or RFC 3339 timestamp, ex: 2024-01-01")
+	graphDiffCmd.Flags().Var(asOfValue{&diffTo}, "to", "the end of the window, as a date or RFC 3339 timestamp (default is now)")
+	cmd.AddCommand(&graphDiffCmd)
+
+	topCmd := cobra.Command{
+		Use:          "top [--by dependents|importance] [--limit N] [module glob]",
+		Example:      queryTopExampleUsage,
+		Short:        "Outputs the modules with the most direct and transitive dependents or the highest importance scores",
+		Args:         cobra.MaximumNArgs(1),
+		RunE:         runQueryTopCmd,
+		SilenceUsage: true,
+	}
+	topCmd.Flags().String("by", "dependents", "the metric used to rank the modules, either 'dependents' or 'importance'")
+	topCmd.Flags().Int("limit", 20, "the maximum number of modules to show")
+	cmd.AddCommand(&topCmd)
+
+	conflictsCmd := cobra.Command{
+		Use:          "conflicts module[@version]",
+		Example:      queryConflictsExampleUsage,
+		Short:        "Outputs the module families with multiple major versions and the modules with divergent versions in the transitive dependencies of a module",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryConflictsCmd,
+		SilenceUsage: true,
+	}
+	conflictsCmd.Flags().Int("max-minor-drift", 5, "flag versions of the same module whose minor versions differ by more than this")
+	cmd.AddCommand(&conflictsCmd)
+
+	laggardsCmd := cobra.Command{
+		Use:          "laggards module",
+		Example:      queryLaggardsExampleUsage,
+		Short:        "Outputs the modules that depend on the specified module grouped by the version they consume, highlighting those too far behind the latest version",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryLaggardsCmd,
+		SilenceUsage: true,
+	}
+	laggardsCmd.Flags().Bool("all-majors", false, "include the modules that depend on any major version of the specified module, ex: example.com/foo/v2 for example.com/foo")
+	laggardsCmd.Flags().Int("max-minors-behind", 2, "highlight the versions that are more than this many minor versions behind the latest version")
+	laggardsCmd.Flags().Int("max-majors-behind", 0, "highlight the versions that are more than this many major versions behind the latest version")
+	laggardsCmd.Flags().Bool("laggards-only", false, "only output the versions that are too far behind the latest version")
+	cmd.AddCommand(&laggardsCmd)
+
+	stalenessCmd := cobra.Command{
+		Use:          "staleness [--all-versions] [--sort score|outdated|module] (module glob)",
+		Example:      queryStalenessExampleUsage,
+		Short:        "Outputs a score for each matching module based on how far its direct dependencies are behind their latest versions",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryStalenessCmd,
+		SilenceUsage: true,
+	}
+	stalenessCmd.Flags().Bool("all-versions", false, "score every version of the matching modules rather than only the latest version")
+	stalenessCmd.Flags().String("sort", "score", "the order of the results, either 'score', 'outdated' or 'module'")
+	cmd.AddCommand(&stalenessCmd)
+
+	abandonedCmd := cobra.Command{
+		Use:          "abandoned [--inactive-years N] [module glob]",
+		Example:      queryAbandonedExampleUsage,
+		Short:        "Outputs the modules whose source repositories are archived or have not had a recent commit",
+		Args:         cobra.MaximumNArgs(1),
+		RunE:         runQueryAbandonedCmd,
+		SilenceUsage: true,
+	}
+	abandonedCmd.Flags().Int("inactive-years", 2, "the number of years without a commit after which a module is considered abandoned")
+	cmd.AddCommand(&abandonedCmd)
+
+	ghostsCmd := cobra.Command{
+		Use:          "ghosts [--in-use] [module glob]",
+		Example:      queryGhostsExampleUsage,
+		Short:        "Outputs the module versions that have been removed from the module proxy or retracted",
+		Args:         cobra.MaximumNArgs(1),
+		RunE:         runQueryGhostsCmd,
+		SilenceUsage: true,
+	}
+	ghostsCmd.Flags().Bool("in-use", false, "only output the versions that other modules still depend on")
+	cmd.AddCommand(&ghostsCmd)
+
+	consumersCmd := cobra.Command{
+		Use:          "consumers module (version range)",
+		Example:      queryConsumersExampleUsage,
+		Short:        "Outputs the modules that directly depend on a version of the specified module in a range, ex: the versions affected by a vulnerability",
+		Args:         cobra.ExactArgs(2),
+		RunE:         runQueryConsumersCmd,
+		SilenceUsage: true,
+	}
+	consumersCmd.Flags().Var(asOfValue{&asOf}, "as-of", "query the graph as it was at the specified date or RFC 3339 timestamp, ex: 2024-06-01 or 2024-06-01T12:00:00Z")
+	cmd.AddCommand(&consumersCmd)
+
+	recentCmd := cobra.Command{
+		Use:          "recent [--days N] [module glob]",
+		Example:      queryRecentExampleUsage,
+		Short:        "Outputs the module versions that were added to the graph in the last N days",
+		Args:         cobra.MaximumNArgs(1),
+		RunE:         runQueryRecentCmd,
+		SilenceUsage: true,
+	}
+	recentCmd.Flags().Int("days", 7, "report the versions that were added in this many days before now")
+	recentCmd.Flags().BoolP("include-prerelease", "p", false, "specifies that pre-release versions should be returned")
+	recentCmd.Flags().Bool("exclude-pseudo", false, "specifies that pseudo-versions, which are pre-releases, should not be returned")
+	cmd.AddCommand(&recentCmd)
+
+	timelineCmd := cobra.Command{
+		Use:          "timeline module",
+		Example:      queryTimelineExampleUsage,
+		Short:        "Outputs each version of a module with when it was published and how many dependents use it",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryTimelineCmd,
+		SilenceUsage: true,
+	}
+	timelineCmd.Flags().BoolP("include-prerelease", "p", false, "specifies that pre-release versions should be returned")
+	timelineCmd.Flags().Bool("exclude-pseudo", false, "specifies that pseudo-versions, which are pre-releases, should not be returned")
+	cmd.AddCommand(&timelineCmd)
+
+	upgradesCmd := cobra.Command{
+		Use:          "upgrade-candidates module[@version]",
+		Example:      queryUpgradeCandidatesExampleUsage,
+		Short:        "Outputs the newer patch, minor and major versions available for each direct dependency of a module",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryUpgradeCandidatesCmd,
+		SilenceUsage: true,
+	}
+	upgradesCmd.Flags().Bool("patch-only", false, "only list newer versions with the same major and minor version")
+	upgradesCmd.Flags().Bool("minor-only", false, "only list newer versions with the same major version")
+	upgradesCmd.Flags().Bool("proxy", false, "also check the Go module proxies in $GOPROXY for versions that are not in the graph")
+	upgradesCmd.Flags().Bool("emit-script", false, "output a shell script that applies the upgrades with 'go get' and 'go mod tidy' instead of the list of candidates")
+	upgradesCmd.Flags().Bool("single-command", false, "with --emit-script, upgrade every dependency with a single 'go get' command")
+	cmd.AddCommand(&upgradesCmd)
+
+	violationsCmd := cobra.Command{
+		Use:          "violations module[@version]",
+		Example:      queryViolationsExampleUsage,
+		Short:        "Outputs the transitive dependencies of a module that are banned by the server's dependency policy and fails if there are any",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryViolationsCmd,
+		SilenceUsage: true,
+	}
+	violationsCmd.Flags().Bool("fail-on-warning", false, "fail if there are any violations, including those with a severity of 'warning'")
+	cmd.AddCommand(&violationsCmd)
+
+	licenseViolationsCmd := cobra.Command{
+		Use:          "license-violations module[@version]",
+		Example:      queryLicenseViolationsExampleUsage,
+		Short:        "Outputs the transitive dependencies of a module whose licenses are banned by the server's dependency policy and fails if there are any",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryLicenseViolationsCmd,
+		SilenceUsage: true,
+	}
+	licenseViolationsCmd.Flags().Bool("fail-on-warning", false, "fail if there are any violations, including those with a severity of 'warning'")
+	cmd.AddCommand(&licenseViolationsCmd)
+
+	vulnsCmd := cobra.Command{
+		Use:          "vulns module[@version]",
+		Example:      queryVulnsExampleUsage,
+		Short:        "Outputs the known vulnerabilities, from the OSV database, that affect a module or its transitive dependencies",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryVulnsCmd,
+		SilenceUsage: true,
+	}
+	// shadows the deprecated -f/--format template alias, which doesn't apply to this command
+	vulnsCmd.Flags().Var(&outputFmt, "format", "specifies the output format, one of json, yaml, table or govulncheck, the same as -o")
+	cmd.AddCommand(&vulnsCmd)
+
+	resolveCmd := cobra.Command{
+		Use:          "resolve module[@version]",
+		Example:      queryResolveExampleUsage,
+		Short:        "Outputs the module versions that Minimal Version Selection chooses when building a module, like 'go list -m all'",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryResolveCmd,
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(&resolveCmd)
+
+	whatIfCmd := cobra.Command{
+		Use:          "what-if module[@version] --upgrade module@version",
+		Example:      queryWhatIfExampleUsage,
+		Short:        "Outputs the modules whose selected versions would change if the proposed upgrades were applied to a module",
+		Args:         cobra.ExactArgs(1),
+		RunE:         runQueryWhatIfCmd,
+		SilenceUsage: true,
+	}
+	whatIfCmd.Flags().StringSlice("upgrade", nil, "a proposed upgrade, ex: golang.org/x/net@v0.25.0, which may be repeated or comma-separated")
+	cmd.AddCommand(&whatIfCmd)
+
 	return &cmd
 }
 
@@ -121,19 +412,19 @@ func runListModulesCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("The module match pattern must be provided")
 	}
 
-	if formatAsDotGraph {
-		return fmt.Errorf("DOT graph output is not supported for this command")
-	}
-	formatAsJSON = formatAsJSON || !(formatAsList || formatTemplate != "")
-	if !xor(formatAsJSON, formatAsList, formatTemplate != "") {
-		return fmt.Errorf("Only one of --json, --list, or --format may be specified")
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable, outputTemplate)
+	if err != nil {
+		return err
 	}
 
 	updateSpinner, stopSpinner := startSpinner()
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := conf.newContext()
 	defer cancel()
-	ps := conf.getClient()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
 
 	results, err := listModules(ctx, ps, args[0], updateSpinner)
 	stopSpinner()
@@ -141,7 +432,15 @@ func runListModulesCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err = writeResults(os.Stdout, results); err != nil {
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeResults(out, format, results); err != nil {
+		return err
+	}
+	if err = out.Commit(); err != nil {
 		return err
 	}
 	return nil
@@ -156,37 +455,64 @@ func runListModuleVersionsCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("The module match pattern must be provided")
 	}
 
-	if formatAsDotGraph {
-		return fmt.Errorf("DOT graph output is not supported for this command")
+	sortBy, _ := cmd.Flags().GetString("sort")
+	if !slices.Contains(versionSortOrders, sortBy) {
+		return fmt.Errorf("Invalid sort order %q, must be one of %s", sortBy, strings.Join(versionSortOrders, ", "))
+	}
+	limit, _ := cmd.Flags().GetInt("limit")
+	if limit < 0 || limit > 1000 {
+		return fmt.Errorf("The limit must be between 0 and 1000")
+	}
+	latest, err := cmd.Flags().GetBool("latest")
+	if err != nil {
+		logger.Error(err, "unable to read 'latest' CLI flag")
+	}
+	if latest && (limit > 0 || sortBy != "semver") {
+		return fmt.Errorf("The --sort and --limit flags cannot be used with --latest")
 	}
-	formatAsJSON = formatAsJSON || !(formatAsList || formatTemplate != "")
-	if !xor(formatAsJSON, formatAsList, formatTemplate != "") {
-		return fmt.Errorf("Only one of --json, --list, or --format may be specified")
+	if !since.IsZero() && !asOf.IsZero() && since.After(asOf) {
+		return fmt.Errorf("The --since time must not be after the --as-of time")
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable, outputTemplate)
+	if err != nil {
+		return err
 	}
 
 	updateSpinner, stopSpinner := startSpinner()
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := conf.newContext()
 	defer cancel()
-	ps := conf.getClient()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
 
 	versionFilter, err := cmd.Flags().GetString("versions")
 	if err != nil {
 		logger.Error(err, "unable to read 'version' CLI flag")
 	}
-	latest, err := cmd.Flags().GetBool("latest")
-	if err != nil {
-		logger.Error(err, "unable to read 'latest' CLI flag")
-	}
 	includePrerelease, err := cmd.Flags().GetBool("include-prerelease")
 	if err != nil {
 		logger.Error(err, "unable to read 'include-prerelease' CLI flag")
 	}
+	excludePseudo, err := cmd.Flags().GetBool("exclude-pseudo")
+	if err != nil {
+		logger.Error(err, "unable to read 'exclude-pseudo' CLI flag")
+	}
+	allMajors, err := cmd.Flags().GetBool("all-majors")
+	if err != nil {
+		logger.Error(err, "unable to read 'all-majors' CLI flag")
+	}
 	results, err := listModuleVersions(ctx, ps, listModuleVersionsRequest{
 		modulePattern:     args[0],
+		allMajors:         allMajors,
 		versionPattern:    versionFilter,
 		latestOnly:        latest,
 		includePrerelease: includePrerelease,
+		excludePseudo:     excludePseudo,
+		sortByDate:        sortBy == "date",
+		limit:             limit,
 		updateStatus:      updateSpinner,
 	})
 	stopSpinner()
@@ -198,7 +524,15 @@ func runListModuleVersionsCmd(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	if err = writeResults(os.Stdout, results); err != nil {
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeResults(out, format, results); err != nil {
+		return err
+	}
+	if err = out.Commit(); err != nil {
 		return err
 	}
 
@@ -230,18 +564,49 @@ func runQueryModuleGraphCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("The specified module name %q is invalid: %w", rootMod, err)
 	}
 
-	formatAsJSON = formatAsJSON || !(formatAsList || formatAsDotGraph || formatTemplate != "")
-	if !xor(formatAsJSON, formatAsList, formatAsDotGraph, formatTemplate != "") {
-		return fmt.Errorf("Only one of --json, --list, --dot, or --format may be specified")
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable, outputTree, outputDot, outputTemplate)
+	if err != nil {
+		return err
+	}
+	imgFormat, err := parseRenderFormat(renderFormat, format)
+	if err != nil {
+		return err
+	}
+	if len(dotStyleOpts.opts) > 0 && format != outputDot {
+		return fmt.Errorf("The --dot-style flag is only supported with -o dot")
+	}
+	if dotStyleOpts.markVulnerable {
+		// vulnerable modules are identified by the deps.dev security advisories
+		withAdvisories = true
+	}
+
+	allMajors, _ := cmd.Flags().GetBool("all-majors")
+	if allMajors && rootMod.Version != "" && rootMod.Version != "latest" {
+		return fmt.Errorf("The --all-majors flag uses the latest version of each major version and cannot be combined with a specific version")
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := conf.newContext()
 	defer cancel()
-	ps := conf.getClient()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if renderFormat != "" {
+		// check before walking the graph, which can take a while, that the server can render it
+		if err := requireFeature(ctx, ps, client.FeatureRenderGraph, "rendering graphs"); err != nil {
+			return err
+		}
+	}
 
-	switch rootMod.Version {
-	case "", "latest":
-		rootMod.Version, err = lookupLatestModuleVersion(ctx, ps, rootMod.Path)
+	roots := []module.Version{rootMod}
+	switch {
+	case allMajors:
+		roots, err = ps.LatestFamilyVersions(ctx, rootMod.Path, asOf)
+		if err != nil {
+			return err
+		}
+	case rootMod.Version == "", rootMod.Version == "latest":
+		roots[0].Version, err = ps.LatestVersion(ctx, rootMod.Path, asOf)
 		if err != nil {
 			return err
 		}
@@ -251,8 +616,8 @@ func runQueryModuleGraphCmd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	if maxDepth <= 0 {
-		maxDepth = 1
+	if maxDepth < 0 {
+		return fmt.Errorf("The --max-depth flag must be 0, for no limit, or greater")
 	}
 	dir := perseusapi.DependencyDirection_dependencies
 	if strings.HasPrefix(cmd.Use, "descendants") {
@@ -260,74 +625,130 @@ func runQueryModuleGraphCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	updateSpinner, stopSpinner := startSpinner()
-	tree, err := walkDependencies(ctx, ps, rootMod, dir, 1, maxDepth, updateSpinner)
+	tree, flat, err := walkModuleFamily(ctx, ps, roots, dir, maxDepth, updateSpinner)
 	if err != nil {
 		return err
 	}
 
-	switch {
-	case formatTemplate != "":
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		stopSpinner()
+		return err
+	}
+	defer out.Abort()
+
+	switch format {
+	case outputTemplate:
 		tt := template.New("item")
-		tt, err = tt.Parse(formatTemplate)
+		tt, err = tt.Parse(outputFmt.template)
 		if err != nil {
 			stopSpinner()
 			return fmt.Errorf("Invalid Go text template specified: %w", err)
 		}
-		list := flattenTree(tree, updateSpinner)
+		list := flattenTree(flat, updateSpinner)
 		stopSpinner()
+		addLinks(list)
 		for _, e := range list {
-			if err := tt.Execute(os.Stdout, e); err != nil {
+			if err := tt.Execute(out, e); err != nil {
 				return fmt.Errorf("Error applying Go text template: %w", err)
 			}
-			os.Stdout.WriteString("\n")
+			_, _ = io.WriteString(out, "\n")
 		}
 
-	case formatAsList:
+	case outputTable:
 		col1Label := "Dependent"
 		if strings.HasPrefix(cmd.Use, "ancestors") {
 			col1Label = "Dependency"
 		}
-		list := flattenTree(tree, updateSpinner)
+		list := flattenTree(flat, updateSpinner)
 		stopSpinner()
-		tw := tabwriter.NewWriter(os.Stdout, 10, 4, 2, ' ', 0)
-		defer func() { _ = tw.Flush() }()
-		if _, err := tw.Write([]byte(col1Label + "\tDirect\n")); err != nil {
+		addLinks(list)
+		tw := tabwriter.NewWriter(out, 10, 4, 2, ' ', 0)
+		header := col1Label + "\tDirect"
+		if withScorecard {
+			header += "\tScorecard"
+		}
+		if withLicenses {
+			header += "\tLicenses"
+		}
+		if withAdvisories {
+			header += "\tAdvisories"
+		}
+		if showLinks {
+			header += "\tDocs\tSource"
+		}
+		if _, err := tw.Write([]byte(header + "\n")); err != nil {
 			return fmt.Errorf("Error writing tabular output: %w", err)
 		}
 		for _, e := range list {
-			if _, err := tw.Write([]byte(fmt.Sprintf("%s\t%v\n", e.Name(), e.IsDirect))); err != nil {
+			if _, err := tw.Write([]byte(fmt.Sprintf("%s\t%v%s%s\n", e.Name(), e.IsDirect, e.Insights.tableColumns(), e.linkColumns()))); err != nil {
 				return fmt.Errorf("Error writing tabular output: %w", err)
 			}
 		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputTree:
+		stopSpinner()
+		if err := writeTree(out, tree); err != nil {
+			return err
+		}
 
-	case formatAsDotGraph:
+	case outputDot:
+		var deprecated map[string]bool
+		if dotStyleOpts.markDeprecated {
+			updateSpinner("querying abandoned modules")
+			if deprecated, err = lookupDeprecatedModules(ctx, ps); err != nil {
+				stopSpinner()
+				return err
+			}
+		}
 		updateSpinner("generating DOT graph")
-		g := generateDotGraph(ctx, tree, dir)
+		g := generateDotGraph(ctx, tree, dir, &dotStyleOpts, deprecated)
+		if renderFormat == "" {
+			stopSpinner()
+			_, _ = io.WriteString(out, g)
+			break
+		}
+		updateSpinner("rendering the graph")
+		img, err := renderGraph(ctx, ps, g, imgFormat)
+		stopSpinner()
+		if err != nil {
+			return err
+		}
+		_, _ = out.Write(img)
+
+	case outputYAML:
+		updateSpinner("generating YAML")
+		formattedTree, err := yaml.Marshal(tree)
 		stopSpinner()
-		os.Stdout.Write([]byte(g))
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = out.Write(formattedTree)
 
 	default:
 		// default to JSON output if no other option was specified
 		updateSpinner("generating JSON")
 		formattedTree, _ := json.Marshal(tree)
 		stopSpinner()
-		os.Stdout.WriteString(string(formattedTree))
-		os.Stdout.WriteString("\n")
+		_, _ = out.Write(formattedTree)
+		_, _ = io.WriteString(out, "\n")
 	}
 
-	return nil
+	return out.Commit()
 }
 
 // parseSharedQueryOpts reads the process environment variables and CLI flags to populate a clientConfig
 // instance
 func parseSharedQueryOpts(cmd *cobra.Command, _ []string) (clientConfig, error) {
 	// parse parameters and setup options
-	var (
-		opts []clientOption
-		conf clientConfig
-	)
-	opts = append(opts, readClientConfigEnv()...)
-	opts = append(opts, readClientConfigFlags(cmd.Flags())...)
+	conf := newClientConfig()
+	opts, err := readClientConfigOptions(cmd.Flags())
+	if err != nil {
+		return clientConfig{}, err
+	}
 	for _, fn := range opts {
 		if err := fn(&conf); err != nil {
 			return clientConfig{}, fmt.Errorf("could not apply client config option: %w", err)
@@ -337,187 +758,183 @@ func parseSharedQueryOpts(cmd *cobra.Command, _ []string) (clientConfig, error)
 	if conf.serverAddr == "" {
 		return clientConfig{}, fmt.Errorf("the Perseus server address must be specified")
 	}
-
 	return conf, nil
 }
 
-// lookupLatestModuleVersion invokes the Perseus API to retrieve the highest known semantic version for
-// the specified module.
-func lookupLatestModuleVersion(ctx context.Context, c perseusapiconnect.PerseusServiceClient, modulePath string) (version string, err error) {
-	req := connect.NewRequest(&perseusapi.ListModuleVersionsRequest{
-		ModuleName:    modulePath,
-		VersionOption: perseusapi.ModuleVersionOption_latest,
-	})
-	resp, err := retryOp(func() (*connect.Response[perseusapi.ListModuleVersionsResponse], error) {
-		return c.ListModuleVersions(ctx, req)
-	})
-	if err != nil {
-		return "", err
+// asOfValue is a [pflag.Value] for the --as-of, --from, and --to flags that accepts either a date,
+// which is interpreted as midnight UTC, or an RFC 3339 timestamp
+type asOfValue struct {
+	t *time.Time
+}
+
+func (v asOfValue) String() string {
+	if v.t == nil || v.t.IsZero() {
+		return ""
 	}
-	if len(resp.Msg.Modules) == 0 || len(resp.Msg.Modules[0].Versions) == 0 {
-		return "", fmt.Errorf("No version found for module %s", modulePath)
+	return v.t.Format(time.RFC3339)
+}
+
+func (v asOfValue) Set(s string) error {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		if t, err = time.Parse(time.RFC3339, s); err != nil {
+			return fmt.Errorf("%q is not a date or an RFC 3339 timestamp", s)
+		}
 	}
+	*v.t = t
+	return nil
+}
 
-	return resp.Msg.Modules[0].Versions[0], nil
+func (v asOfValue) Type() string {
+	return "date"
+}
+
+// walkModuleFamily walks the dependencies of each of the specified root modules, which are the major
+// versions of the same project, to the specified maximum depth.
+//
+// For a single root the returned tree is the result of [walkDependencies].  Otherwise, the root of the
+// returned tree is the module family with the tree for each major version as a child.  The second return
+// value is the tree that should be used to generate flattened outputs, in which the dependencies of all
+// major versions are combined so that they are reported as direct dependencies.
+func walkModuleFamily(ctx context.Context, ps *client.Client, roots []module.Version,
+	direction perseusapi.DependencyDirection, maxDepth int, status func(string)) (tree, flat dependencyTreeNode, err error) {
+	if len(roots) == 1 {
+		tree, err = walkDependencies(ctx, ps, roots[0], direction, maxDepth, status)
+		return tree, tree, err
+	}
+	tree.Module = module.Version{Path: modver.Family(roots[0].Path)}
+	flat.Module = tree.Module
+	for _, root := range roots {
+		node, err := walkDependencies(ctx, ps, root, direction, maxDepth, status)
+		if err != nil {
+			return dependencyTreeNode{}, dependencyTreeNode{}, err
+		}
+		tree.Deps = append(tree.Deps, node)
+		flat.Deps = append(flat.Deps, node.Deps...)
+	}
+	return tree, flat, nil
 }
 
 // dependencyTreeNode defines the information returned by walkDependencies
 type dependencyTreeNode struct {
 	// the module name and version
-	Module module.Version `json:"module"`
+	Module module.Version `json:"module" yaml:"module"`
+	// the deps.dev data for the module version, if requested
+	Insights *moduleInsights `json:"insights,omitempty" yaml:"insights,omitempty"`
 	// is this module a direct or indirect dependency of the "root" module being queried against
-	Direct bool `json:"-"`
+	Direct bool `json:"-" yaml:"-"`
 	// a list of one or more child dependencies of this module
-	Deps []dependencyTreeNode `json:"deps,omitempty"`
+	Deps []dependencyTreeNode `json:"deps,omitempty" yaml:"deps,omitempty"`
 }
 
-// walkDependencies invokes the Perseus API to retrieve a list of directly dependencies for mod,
-// recursing to the specified maximum depth
-func walkDependencies(ctx context.Context, client perseusapiconnect.PerseusServiceClient, mod module.Version,
-	direction perseusapi.DependencyDirection, depth, maxDepth int, status func(string)) (node dependencyTreeNode, err error) {
-	select {
-	case <-ctx.Done():
-		return node, ctx.Err()
-	default:
+// walkDependencies invokes the Perseus API to retrieve the dependencies, or dependents, of mod, recursing
+// to the specified maximum depth, or every level if it is 0, using the --follow-renames, --as-of, and
+// deps.dev CLI flags
+func walkDependencies(ctx context.Context, ps *client.Client, mod module.Version,
+	direction perseusapi.DependencyDirection, maxDepth int, status func(string)) (dependencyTreeNode, error) {
+	if maxDepth == 0 {
+		maxDepth = client.UnlimitedDepth
 	}
-	if depth > maxDepth {
-		return node, nil
-	}
-
-	node.Module = mod
-	node.Direct = (depth == 1)
-	status("processing " + node.Module.String())
-	req := connect.NewRequest(&perseusapi.QueryDependenciesRequest{
-		ModuleName: mod.Path,
-		Version:    mod.Version,
-		Direction:  direction,
+	root, err := ps.WalkDependencies(ctx, mod, client.WalkOptions{
+		Direction:     direction,
+		MaxDepth:      maxDepth,
+		FollowRenames: followRenames,
+		AsOf:          asOf,
+		Enrich:        enrichFields(),
+		Status:        status,
 	})
-	for done := false; !done; done = (req.Msg.PageToken != "") {
-		resp, err := retryOp(func() (*connect.Response[perseusapi.QueryDependenciesResponse], error) {
-			return client.QueryDependencies(ctx, req)
-		})
-		if err != nil {
-			return dependencyTreeNode{}, err
-		}
-		for _, dep := range resp.Msg.Modules {
-			dn := dependencyTreeNode{
-				Module: module.Version{
-					Path:    dep.GetName(),
-					Version: dep.Versions[0],
-				},
-			}
-			ndeps, err := walkDependencies(ctx, client, dn.Module, direction, depth+1, maxDepth, status)
-			if err != nil {
-				return dependencyTreeNode{}, err
-			}
-			if len(ndeps.Deps) > 0 {
-				dn.Deps = append(dn.Deps, ndeps.Deps...)
-			}
-			node.Deps = append(node.Deps, dn)
-
+	if err != nil {
+		if errors.Is(err, client.ErrTreeTooLarge) {
+			return dependencyTreeNode{}, fmt.Errorf("Unable to walk the graph, specify a smaller --max-depth: %w", err)
 		}
-		req.Msg.PageToken = resp.Msg.NextPageToken
+		return dependencyTreeNode{}, err
+	}
+	tree := newDependencyTree(root)
+	tree.Direct = true
+	return tree, nil
+}
+
+// newDependencyTree converts the tree returned by [client.Client.WalkDependencies] to its CLI representation
+func newDependencyTree(n client.DependencyNode) dependencyTreeNode {
+	node := dependencyTreeNode{Module: n.Module}
+	if n.Info != nil {
+		node.Insights = newModuleInsights(n.Info)
+	}
+	for _, d := range n.Deps {
+		node.Deps = append(node.Deps, newDependencyTree(d))
 	}
-	return node, nil
+	return node
 }
 
 // listModules invokes the Perseus API to retrieve a list of all modules that match the provided filter
-func listModules(ctx context.Context, ps perseusapiconnect.PerseusServiceClient, filter string, status func(string)) (results []dependencyItem, err error) {
-	req := connect.NewRequest(&perseusapi.ListModulesRequest{
-		Filter: filter,
-	})
-	for done := false; !done; {
-		status("retrieving modules")
-		resp, err := retryOp(func() (*connect.Response[perseusapi.ListModulesResponse], error) {
-			return ps.ListModules(ctx, req)
-		})
+func listModules(ctx context.Context, ps *client.Client, filter string, status func(string)) (results []dependencyItem, err error) {
+	status("retrieving modules")
+	for mod, err := range ps.ListModules(ctx, filter).All() {
 		if err != nil {
 			return nil, fmt.Errorf("Unable to list modules matching the provided filter: %w", err)
 		}
-		for _, mod := range resp.Msg.Modules {
-			item := dependencyItem{
-				Path:    mod.GetName(),
-				Version: "-", // show a dash if we don't have a version
-			}
-			if vers := mod.GetVersions(); len(vers) > 0 {
-				item.Version = vers[0]
-			}
-			results = append(results, item)
+		item := dependencyItem{
+			Path:    mod.GetName(),
+			Version: "-", // show a dash if we don't have a version
 		}
-		req.Msg.PageToken = resp.Msg.GetNextPageToken()
-		done = (req.Msg.PageToken != "")
+		if vers := mod.GetVersions(); len(vers) > 0 {
+			item.Version = vers[0]
+		}
+		results = append(results, item)
 	}
 	return results, nil
 }
 
 type listModuleVersionsRequest struct {
 	modulePattern     string
+	allMajors         bool
 	versionPattern    string
 	latestOnly        bool
 	includePrerelease bool
+	excludePseudo     bool
+	sortByDate        bool
+	limit             int
 	updateStatus      func(string)
 }
 
 // listModuleVersions invokes the Perseus API to retrieve a list of module versions that match the provided
 // filter options.
-func listModuleVersions(ctx context.Context, ps perseusapiconnect.PerseusServiceClient, req listModuleVersionsRequest) (results []dependencyItem, err error) {
-	var pageToken string
-	for done := false; !done; {
-		req.updateStatus("retrieving module versions")
-		apiRequest := connect.NewRequest(&perseusapi.ListModuleVersionsRequest{
-			ModuleFilter:      req.modulePattern,
-			VersionFilter:     req.versionPattern,
-			IncludePrerelease: req.includePrerelease,
-			VersionOption:     perseusapi.ModuleVersionOption_all,
-			PageToken:         pageToken,
-		})
-		if req.latestOnly {
-			apiRequest.Msg.VersionOption = perseusapi.ModuleVersionOption_latest
-		}
-		req.updateStatus(fmt.Sprintf("retreiving versions for modules matching %q", req.modulePattern))
-		resp, err := retryOp(func() (*connect.Response[perseusapi.ListModuleVersionsResponse], error) {
-			return ps.ListModuleVersions(ctx, apiRequest)
-		})
+func listModuleVersions(ctx context.Context, ps *client.Client, req listModuleVersionsRequest) (results []dependencyItem, err error) {
+	req.updateStatus(fmt.Sprintf("retreiving versions for modules matching %q", req.modulePattern))
+	versions := ps.ListModuleVersions(ctx, client.ModuleVersionQuery{
+		ModuleFilter:      req.modulePattern,
+		AllMajors:         req.allMajors,
+		VersionFilter:     req.versionPattern,
+		IncludePrerelease: req.includePrerelease,
+		ExcludePseudo:     req.excludePseudo,
+		LatestOnly:        req.latestOnly,
+		AsOf:              asOf,
+		Since:             since,
+		SortByDate:        req.sortByDate,
+		Limit:             req.limit,
+	})
+	for mv, err := range versions.All() {
 		if err != nil {
-			return nil, fmt.Errorf("todo: %w", err)
-		}
-
-		// API response is 1 result per module with a list of versions
-		// - flatten to 1 dependencyItem per module/version pair
-		for _, mod := range resp.Msg.Modules {
-			for _, ver := range mod.Versions {
-				results = append(results, dependencyItem{
-					Path:    mod.GetName(),
-					Version: ver,
-				})
-			}
+			return nil, fmt.Errorf("Unable to list the module versions matching %q: %w", req.modulePattern, err)
 		}
-		pageToken = resp.Msg.GetNextPageToken()
-		done = (pageToken != "")
+		results = append(results, dependencyItem{
+			Path:    mv.Path,
+			Version: mv.Version,
+		})
 	}
 	return results, nil
 }
 
 // flattenTree converts the nested tree of module dependencies into a flat list of unique modules
-// sorted by module name then by highest to lowest semantic version
+// sorted by module name then by highest to lowest semantic version.  A module version that appears in the
+// tree more than once is reported with the degree of its shortest path from the root.
 func flattenTree(tree dependencyTreeNode, updateStatus func(string)) []dependencyItem {
-	var (
-		uniqueMods = make(map[string]struct{})
-		items      []dependencyItem
-	)
-	for _, dep := range tree.Deps {
-		updateStatus("processing " + dep.Module.String())
-		di := dependencyItem{
-			Path:     dep.Module.Path,
-			Version:  dep.Module.Version,
-			IsDirect: true,
-			Degree:   1,
-		}
-		items = append(items, di)
-		uniqueMods[dep.Module.String()] = struct{}{}
-		if len(dep.Deps) > 0 {
-			items = append(items, processChildren(dep.Deps, uniqueMods, 2, updateStatus)...)
-		}
+	uniqueMods := make(map[module.Version]*dependencyItem)
+	processChildren(tree.Deps, uniqueMods, 1, updateStatus)
+
+	items := make([]dependencyItem, 0, len(uniqueMods))
+	for _, di := range uniqueMods {
+		items = append(items, *di)
 	}
 	updateStatus("sorting results")
 	sort.Slice(items, func(i, j int) bool {
@@ -531,51 +948,81 @@ func flattenTree(tree dependencyTreeNode, updateStatus func(string)) []dependenc
 	return items
 }
 
-// processChildren flattens the dependency tree of deps into a list of unique modules
-func processChildren(deps []dependencyTreeNode, uniqueMods map[string]struct{}, depth int, updateStatus func(string)) []dependencyItem {
-	var items []dependencyItem
+// processChildren records each module version in the dependency tree of deps, which are at the specified
+// depth, in uniqueMods along with the minimum depth at which it appears and the number of times it appears
+func processChildren(deps []dependencyTreeNode, uniqueMods map[module.Version]*dependencyItem, depth int, updateStatus func(string)) {
 	for _, d := range deps {
-		modName := d.Module.String()
-		updateStatus("processing " + modName)
-		if _, exists := uniqueMods[modName]; !exists {
-			di := dependencyItem{
+		di, exists := uniqueMods[d.Module]
+		if !exists {
+			updateStatus("processing " + d.Module.String())
+			di = &dependencyItem{
 				Path:     d.Module.Path,
 				Version:  d.Module.Version,
-				IsDirect: (depth == 1),
 				Degree:   depth,
+				Insights: d.Insights,
 			}
-			items = append(items, di)
-			uniqueMods[modName] = struct{}{}
-			if len(d.Deps) > 0 {
-				items = append(items, processChildren(d.Deps, uniqueMods, depth+1, updateStatus)...)
-			}
+			uniqueMods[d.Module] = di
+		}
+		di.Occurrences++
+		di.Degree = min(di.Degree, depth)
+		di.IsDirect = (di.Degree == 1)
+		if len(d.Deps) > 0 {
+			processChildren(d.Deps, uniqueMods, depth+1, updateStatus)
 		}
 	}
-	return items
 }
 
-// generateDotGraph constructs a DOT digraph for the specified dependency tree
-func generateDotGraph(_ context.Context, tree dependencyTreeNode, dir perseusapi.DependencyDirection) string {
+// generateDotGraph constructs a DOT digraph for the specified dependency tree, drawn using the provided
+// style.  deprecated holds the paths of the modules whose source repositories are archived or abandoned
+// and is only used if the style marks deprecated modules.
+func generateDotGraph(_ context.Context, tree dependencyTreeNode, dir perseusapi.DependencyDirection, style *dotStyle, deprecated map[string]bool) string {
 	rankDir, arrowDir := "RL", ""
 	if dir == perseusapi.DependencyDirection_dependencies {
 		rankDir, arrowDir = "LR", " [dir=back]"
 	}
+	theme := style.colors()
 	var sb strings.Builder
 	sb.WriteString(`digraph G {
-    bgcolor="#414142";
+    bgcolor="` + theme.background + `";
 	rankdir="` + rankDir + `";
 	subgraph cluster_D {
         label="";
-        node [shape=box style="rounded,filled" fontname=Arial fontsize=14 margin=.25 fillcolor="#F3F3F4" fontcolor="#58595B"]
-        edge [color="#EC3525"]
-		bgcolor="#58595B";
+        node [shape=box style="rounded,filled" fontname=Arial fontsize=14 margin=.25 fillcolor="` + theme.nodeFill + `" fontcolor="` + theme.nodeFont + `"]
+        edge [color="` + theme.edge + `"]
+		bgcolor="` + theme.cluster + `";
         style="rounded";
 `)
+	// the queried module is the root of the tree unless --all-majors was specified, in which case the
+	// root is the module family and its children are the latest version of each major version
+	roots := []dependencyTreeNode{tree}
+	if tree.Module.Version == "" {
+		roots = tree.Deps
+	}
+	isRoot := func(m module.Version) bool {
+		return slices.ContainsFunc(roots, func(n dependencyTreeNode) bool { return n.Module == m })
+	}
+	declared := make(map[module.Version]struct{})
+	declare := func(n dependencyTreeNode) {
+		if !style.customizesNodes() {
+			return
+		}
+		if _, exists := declared[n.Module]; exists {
+			return
+		}
+		declared[n.Module] = struct{}{}
+		vulnerable := n.Insights != nil && len(n.Insights.Advisories) > 0
+		attrs := style.nodeAttrs(n.Module, isRoot(n.Module), vulnerable, deprecated[n.Module.Path])
+		if attrs != "" {
+			sb.WriteString(fmt.Sprintf("\t\t%q%s\n", n.Module, attrs))
+		}
+	}
+
 	stack := []dependencyTreeNode{tree}
 	uniq := make(map[string]struct{})
 	for len(stack) > 0 {
 		node := stack[0]
 		stack = stack[1:]
+		declare(node)
 		for _, dep := range node.Deps {
 			// skip existing edges
 			// . the same 2 module/version nodes can appear at multiple places within the overall tree
@@ -586,6 +1033,7 @@ func generateDotGraph(_ context.Context, tree dependencyTreeNode, dir perseusapi
 			}
 			uniq[edgeKey] = struct{}{}
 
+			declare(dep)
 			sb.WriteString(fmt.Sprintf("\t\t%q -> %q%s\n", dep.Module, node.Module, arrowDir))
 			if len(dep.Deps) > 0 {
 				stack = append(stack, dep)
@@ -596,17 +1044,106 @@ func generateDotGraph(_ context.Context, tree dependencyTreeNode, dir perseusapi
 	return sb.String()
 }
 
+// lookupDeprecatedModules returns the set of module paths whose source repositories have been archived
+// by their owners or have had no commits for 2 years, based on the server's upstream checks
+func lookupDeprecatedModules(ctx context.Context, ps *client.Client) (map[string]bool, error) {
+	resp, err := ps.API().ListAbandonedModules(ctx, connect.NewRequest(&perseusapi.ListAbandonedModulesRequest{
+		ModuleFilter:  "*",
+		InactiveYears: 2,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to query the abandoned modules: %w", err)
+	}
+	deprecated := make(map[string]bool, len(resp.Msg.GetModules()))
+	for _, m := range resp.Msg.GetModules() {
+		deprecated[m.GetName()] = true
+	}
+	return deprecated, nil
+}
+
 // dependencyItem represents the metadata associated with a particular module
 type dependencyItem struct {
 	// the module path, ex: github.com/CrowdStrike/perseus
-	Path string
+	Path string `yaml:"path"`
 	// the module version, ex: v1.11.38
-	Version string
+	Version string `yaml:"version"`
 	// is this module a direct or indirect dependency of the "root" module being queried against
-	IsDirect bool
-	// the number of dependency links between this module and the "root" module being queried against
+	IsDirect bool `yaml:"isDirect"`
+	// the number of dependency links between this module and the "root" module being queried against,
+	// using the shortest path if there is more than 1
 	// . IsDirect = (Degree == 1)
-	Degree int
+	Degree int `yaml:"degree"`
+	// the number of paths between this module and the "root" module, within the maximum depth, which is
+	// 0 for results that aren't part of a dependency tree
+	Occurrences int `yaml:"occurrences,omitempty"`
+	// the deps.dev data for the module version, if requested
+	Insights *moduleInsights `yaml:"insights,omitempty"`
+	// the pkg.go.dev URL for the module version, empty for private modules
+	DocsURL string `yaml:"docsURL,omitempty"`
+	// the web URL of the module's source repository, empty if it is not known
+	SourceURL string `yaml:"sourceURL,omitempty"`
+}
+
+// moduleInsights holds the deps.dev data for a module version that is returned when the --with-scorecard,
+// --with-licenses, or --with-advisories flags are specified
+type moduleInsights struct {
+	// the OpenSSF Scorecard score, from 0 to 10, of the module's source repository, if it is known
+	Scorecard *float64 `json:"scorecard,omitempty" yaml:"scorecard,omitempty"`
+	// the SPDX identifiers of the module version's licenses
+	Licenses []string `json:"licenses,omitempty" yaml:"licenses,omitempty"`
+	// the IDs of the security advisories that affect the module version
+	Advisories []string `json:"advisories,omitempty" yaml:"advisories,omitempty"`
+}
+
+// newModuleInsights returns the deps.dev data in m, or nil if there is none
+func newModuleInsights(m *perseusapi.Module) *moduleInsights {
+	if m.Scorecard == nil && len(m.GetLicenses()) == 0 && len(m.GetAdvisories()) == 0 {
+		return nil
+	}
+	return &moduleInsights{
+		Scorecard:  m.Scorecard,
+		Licenses:   m.GetLicenses(),
+		Advisories: m.GetAdvisories(),
+	}
+}
+
+// tableColumns returns the tab-separated values of the requested deps.dev data, with a leading tab, for
+// the table output of the 'query ancestors' and 'query descendants' CLI sub-commands
+func (mi *moduleInsights) tableColumns() string {
+	if mi == nil {
+		mi = &moduleInsights{}
+	}
+	var sb strings.Builder
+	if withScorecard {
+		if mi.Scorecard != nil {
+			sb.WriteString(fmt.Sprintf("\t%.1f", *mi.Scorecard))
+		} else {
+			sb.WriteString("\t-")
+		}
+	}
+	if withLicenses {
+		sb.WriteString("\t" + strings.Join(mi.Licenses, ","))
+	}
+	if withAdvisories {
+		sb.WriteString("\t" + strings.Join(mi.Advisories, ","))
+	}
+	return sb.String()
+}
+
+// enrichFields returns the deps.dev data that should be requested based on the --with-scorecard,
+// --with-licenses, and --with-advisories CLI flags
+func enrichFields() []string {
+	var fields []string
+	if withScorecard {
+		fields = append(fields, "scorecard")
+	}
+	if withLicenses {
+		fields = append(fields, "licenses")
+	}
+	if withAdvisories {
+		fields = append(fields, "advisories")
+	}
+	return fields
 }
 
 // Name returns the full name of the dependency in "[name]@[version]" format
@@ -614,6 +1151,22 @@ func (d dependencyItem) Name() string {
 	return d.Path + "@" + d.Version
 }
 
+// linkColumns returns the tab-separated documentation and source repository URLs, with a leading tab, for
+// table output if the --links CLI flag was specified, or an empty string if not
+func (d dependencyItem) linkColumns() string {
+	if !showLinks {
+		return ""
+	}
+	docs, src := d.DocsURL, d.SourceURL
+	if docs == "" {
+		docs = "-"
+	}
+	if src == "" {
+		src = "-"
+	}
+	return "\t" + docs + "\t" + src
+}
+
 // xor implements a boolean exclusive OR for a set of values.  This is necessary because Go does not
 // provide XOR operators (boolean or bitwise)
 func xor(vs ...bool) bool {
@@ -638,8 +1191,8 @@ func startSpinner() (update func(string), done func()) {
 	update = func(string) {}
 	done = func() {}
 
-	// no-op if we're not writing to a TTY
-	if tty() {
+	// no-op if we're not writing to a TTY or the spinner has been disabled
+	if spinnerEnabled() {
 		spinner, _ := yacspin.New(yacspin.Config{
 			CharSet:         yacspin.CharSets[11],
 			Frequency:       300 * time.Millisecond,
@@ -661,13 +1214,14 @@ func startSpinner() (update func(string), done func()) {
 }
 
 // writeResults writes the contents of results to the provided io.Writer based on the configured output options
-func writeResults(w io.Writer, results []dependencyItem) error {
+func writeResults(w io.Writer, format string, results []dependencyItem) error {
+	addLinks(results)
 	var err error
-	switch {
-	case formatTemplate != "":
+	switch format {
+	case outputTemplate:
 		// apply the provided text template
 		tt := template.New("item")
-		tt, err = tt.Parse(formatTemplate)
+		tt, err = tt.Parse(outputFmt.template)
 		if err != nil {
 			return fmt.Errorf("Invalid Go text template specified: %w", err)
 		}
@@ -678,19 +1232,30 @@ func writeResults(w io.Writer, results []dependencyItem) error {
 			fmt.Fprintln(w)
 		}
 
-	case formatAsList:
+	case outputTable:
 		// output a tabular list
 		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
 		defer func() { _ = tw.Flush() }()
-		if _, err := tw.Write([]byte("Module\tVersion\n")); err != nil {
+		header := "Module\tVersion"
+		if showLinks {
+			header += "\tDocs\tSource"
+		}
+		if _, err := tw.Write([]byte(header + "\n")); err != nil {
 			return fmt.Errorf("Error writing tabular output: %w", err)
 		}
 		for _, e := range results {
-			if _, err := tw.Write([]byte(fmt.Sprintf("%s\t%s\n", e.Path, e.Version))); err != nil {
+			if _, err := tw.Write([]byte(fmt.Sprintf("%s\t%s%s\n", e.Path, e.Version, e.linkColumns()))); err != nil {
 				return fmt.Errorf("Error writing tabular output: %w", err)
 			}
 		}
 
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
 	default:
 		// output JSON
 		output, _ := json.Marshal(results)
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+)
+
+func TestFlattenTree(t *testing.T) {
+	node := func(path, version string, deps ...dependencyTreeNode) dependencyTreeNode {
+		return dependencyTreeNode{Module: module.Version{Path: path, Version: version}, Deps: deps}
+	}
+	// example.com/shared is an indirect dependency via example.com/a, which is visited first, and a direct
+	// dependency of the root
+	tree := node("example.com/app", "v1.0.0",
+		node("example.com/a", "v1.1.0",
+			node("example.com/b", "v0.2.0",
+				node("example.com/shared", "v1.0.0"))),
+		node("example.com/shared", "v1.0.0"),
+		node("example.com/shared", "v0.9.0"),
+	)
+
+	got := flattenTree(tree, func(string) {})
+	expected := []dependencyItem{
+		{Path: "example.com/a", Version: "v1.1.0", IsDirect: true, Degree: 1, Occurrences: 1},
+		{Path: "example.com/b", Version: "v0.2.0", IsDirect: false, Degree: 2, Occurrences: 1},
+		{Path: "example.com/shared", Version: "v1.0.0", IsDirect: true, Degree: 1, Occurrences: 2},
+		{Path: "example.com/shared", Version: "v0.9.0", IsDirect: true, Degree: 1, Occurrences: 1},
+	}
+	assert.Equal(t, expected, got)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+)
+
+const queryRecentExampleUsage = `  # list the module versions that were added to the graph in the last week
+  perseus query recent -o table
+
+  # list the CrowdStrike module versions, including pre-releases, that were added in the last 30 days
+  perseus query recent 'github.com/CrowdStrike/*' --days 30 -p`
+
+// recentVersionItem defines the information returned by the 'query recent' CLI sub-command for each
+// module version that was added to the graph
+type recentVersionItem struct {
+	// the module path, ex: github.com/CrowdStrike/perseus
+	Path string `yaml:"path"`
+	// the module version, ex: v1.11.38
+	Version string `yaml:"version"`
+	// when the version was added to the graph
+	Added time.Time `yaml:"added"`
+}
+
+// runQueryRecentCmd implements the logic behind the 'query recent' CLI sub-command
+func runQueryRecentCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	filter := "*"
+	if len(args) > 0 {
+		filter = args[0]
+	}
+	days, _ := cmd.Flags().GetInt("days")
+	if days < 1 || days > 3650 {
+		return fmt.Errorf("The --days flag must be between 1 and 3650")
+	}
+	includePrerelease, _ := cmd.Flags().GetBool("include-prerelease")
+	excludePseudo, _ := cmd.Flags().GetBool("exclude-pseudo")
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner(fmt.Sprintf("querying the module versions added in the last %d days", days))
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	cutoff := time.Now().AddDate(0, 0, -days)
+	versions := ps.ListVersionTimes(ctx, client.ModuleVersionQuery{
+		ModuleFilter:      filter,
+		IncludePrerelease: includePrerelease,
+		ExcludePseudo:     excludePseudo,
+		Since:             cutoff,
+		SortByDate:        true,
+	})
+	results := make([]recentVersionItem, 0)
+	for vt, err := range versions.All() {
+		if err != nil {
+			stopSpinner()
+			return fmt.Errorf("Unable to list the module versions added since %s: %w", cutoff.Format(time.DateOnly), err)
+		}
+		results = append(results, recentVersionItem{
+			Path:    vt.Path,
+			Version: vt.Version.Version,
+			Added:   vt.Added,
+		})
+	}
+	stopSpinner()
+	if len(results) == 0 {
+		infof("no module versions matching %q were added in the last %d days\n", filter, days)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeRecentVersions(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// writeRecentVersions writes the contents of results to the provided io.Writer in the specified format
+func writeRecentVersions(w io.Writer, format string, results []recentVersionItem) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Module\tVersion\tAdded\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		modules := make(map[string]struct{})
+		for _, v := range results {
+			modules[v.Path] = struct{}{}
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", v.Path, v.Version, v.Added.Local().Format(time.DateTime)); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		fmt.Fprintf(w, "\n%d versions of %d modules\n", len(results), len(modules))
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+// parseRenderFormat validates the value of the --render CLI flag, which is only supported for DOT output,
+// and returns the corresponding image format
+func parseRenderFormat(s, outputFormat string) (perseusapi.RenderFormat, error) {
+	if s == "" {
+		return perseusapi.RenderFormat_svg, nil
+	}
+	if outputFormat != outputDot {
+		return 0, fmt.Errorf("The --render flag is only supported with -o dot")
+	}
+	f, ok := perseusapi.RenderFormat_value[s]
+	if !ok {
+		return 0, fmt.Errorf("Invalid --render format %q, must be svg or png", s)
+	}
+	return perseusapi.RenderFormat(f), nil
+}
+
+// renderGraph asks the server to render the DOT graph as an image in the specified format
+func renderGraph(ctx context.Context, ps *client.Client, dot string, format perseusapi.RenderFormat) ([]byte, error) {
+	req := connect.NewRequest(&perseusapi.RenderGraphRequest{
+		Dot:    dot,
+		Format: format,
+	})
+	resp, err := ps.API().RenderGraph(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to render the graph: %w", err)
+	}
+	return resp.Msg.GetImage(), nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryResolveExampleUsage = `  # list the module versions that are selected when building the latest version of a module, like 'go list -m all'
+  perseus query resolve github.com/example/foo -o table
+
+  # show which dependency pulls in the selected version of each module
+  perseus query resolve github.com/example/foo@v1.4.0 -o yaml`
+
+// selectedModuleItem defines the information returned by the 'query resolve' CLI sub-command for each
+// module in the build list
+type selectedModuleItem struct {
+	// the module path, ex: github.com/CrowdStrike/perseus
+	Path string `yaml:"path"`
+	// the selected version, ex: v1.11.38
+	Version string `yaml:"version"`
+	// lower versions of the module that are required by other modules in the graph
+	SupersededVersions []string `yaml:"supersededVersions,omitempty"`
+	// the chain of module versions from the resolved module to the selected version
+	Via []string `yaml:"via"`
+}
+
+// runQueryResolveCmd implements the logic behind the 'query resolve' CLI sub-command
+func runQueryResolveCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	rootMod := module.Version{}
+	rootMod.Path, rootMod.Version, _ = strings.Cut(args[0], "@")
+	if err := module.CheckPath(rootMod.Path); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", rootMod.Path, err)
+	}
+	if rootMod.Version != "" && rootMod.Version != "latest" && !semver.IsValid(rootMod.Version) {
+		return fmt.Errorf("%s is not a valid Go module semantic version string", rootMod.Version)
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if err := requireFeature(ctx, ps, client.FeatureResolveModuleSet, "resolving the build list of a module"); err != nil {
+		return err
+	}
+	if rootMod.Version == "" || rootMod.Version == "latest" {
+		if rootMod.Version, err = ps.LatestVersion(ctx, rootMod.Path, asOf); err != nil {
+			return err
+		}
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("resolving the build list of " + rootMod.String())
+	resp, err := ps.API().ResolveModuleSet(ctx, connect.NewRequest(&perseusapi.ResolveModuleSetRequest{
+		ModuleName: rootMod.Path,
+		Version:    rootMod.Version,
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to resolve the build list of %s: %w", rootMod, err)
+	}
+
+	results := make([]selectedModuleItem, 0, len(resp.Msg.GetModules()))
+	for _, m := range resp.Msg.GetModules() {
+		results = append(results, selectedModuleItem{
+			Path:               m.GetModule().GetName(),
+			Version:            m.GetModule().GetVersions()[0],
+			SupersededVersions: m.GetSupersededVersions(),
+			Via:                m.GetPath(),
+		})
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeSelectedModules(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// writeSelectedModules writes the contents of results to the provided io.Writer in the specified format
+func writeSelectedModules(w io.Writer, format string, results []selectedModuleItem) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Module\tVersion\tSuperseded\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, m := range results {
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", m.Path, m.Version, strings.Join(m.SupersededVersions, ", ")); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/internal/seed"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const adminSeedExampleUsage = `  # generate a graph of 100 modules whose versions each have about 5 direct dependencies
+  perseus admin seed
+
+  # generate a larger graph for performance testing under a custom prefix
+  perseus admin seed --modules 5000 --fanout 8 --prefix seed.example.com/perf
+
+  # generate a different, but still reproducible, graph
+  perseus admin seed --seed 42`
+
+// seedBatchSize is the number of module versions that are sent in each BatchUpdateDependencies request
+// by 'admin seed', which keeps each of the server's transactions short
+const seedBatchSize = 200
+
+// runAdminSeedCmd implements the logic behind the 'admin seed' CLI sub-command
+func runAdminSeedCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	fset := cmd.Flags()
+	count, _ := fset.GetInt("modules")
+	fanout, _ := fset.GetInt("fanout")
+	maxVersions, _ := fset.GetInt("versions")
+	prefix, _ := fset.GetString("prefix")
+	randSeed, _ := fset.GetUint64("seed")
+	if count < 1 {
+		return fmt.Errorf("The --modules flag must be at least 1")
+	}
+	if fanout < 0 {
+		return fmt.Errorf("The --fanout flag must not be negative")
+	}
+	if maxVersions < 1 {
+		return fmt.Errorf("The --versions flag must be at least 1")
+	}
+	prefix = strings.TrimSuffix(prefix, "/")
+	if err := module.CheckPath(prefix); err != nil {
+		return fmt.Errorf("Invalid module path prefix: %w", err)
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if err := requireFeature(ctx, ps, client.FeatureBatchUpdates, "batched updates"); err != nil {
+		return err
+	}
+
+	generated := seed.Generate(seed.Options{
+		Prefix:      prefix,
+		Modules:     count,
+		Fanout:      fanout,
+		MaxVersions: maxVersions,
+		Seed:        randSeed,
+	})
+	updates := make([]*perseusapi.UpdateDependenciesRequest, len(generated))
+	edges := 0
+	for i, g := range generated {
+		updates[i] = newUpdateRequest(g.Module, g.Deps)
+		edges += len(g.Deps)
+	}
+
+	// the batches are sent one at a time because concurrent transactions that update the same modules
+	// would contend for the same rows
+	progress := newBulkProgress((len(updates) + seedBatchSize - 1) / seedBatchSize)
+	for start := 0; start < len(updates); start += seedBatchSize {
+		batch := updates[start:min(start+seedBatchSize, len(updates))]
+		item := fmt.Sprintf("module versions %d-%d", start+1, start+len(batch))
+		req := connect.NewRequest(&perseusapi.BatchUpdateDependenciesRequest{Updates: batch})
+		if _, err := ps.API().BatchUpdateDependencies(ctx, req); err != nil {
+			progress.Failed(item, fmt.Errorf("Unable to update the Perseus graph: %w", err))
+			continue
+		}
+		progress.Succeeded(item)
+	}
+	if err := progress.Finish(); err != nil {
+		return err
+	}
+	infof("generated %d modules with %d versions and %d dependencies under %s\n", count, len(updates), edges, prefix)
+	return nil
+}
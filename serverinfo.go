@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/mod/semver"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi/perseusapiconnect"
+)
+
+// serverInfoTimeout is the maximum amount of time to wait for the server's version when checking for
+// incompatibilities, which is not worth delaying a command for
+const serverInfoTimeout = 5 * time.Second
+
+// serverChecked ensures that the server's version is only checked once per process
+var serverChecked sync.Once
+
+// serverCheckInterceptor returns a Connect interceptor that invokes check before the first RPC so that
+// an incompatible server is reported before a command starts rather than when it fails part way through.
+func serverCheckInterceptor(check func(context.Context)) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().Procedure != perseusapiconnect.PerseusServiceGetServerInfoProcedure {
+				check(ctx)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// checkServer logs a warning, at most once per process, if the version or features of the server are
+// incompatible with this version of the CLI or its configuration.  Failures to retrieve the server's
+// version are ignored since the command itself will report them.
+func (conf *clientConfig) checkServer(ctx context.Context, ps *client.Client) {
+	serverChecked.Do(func() {
+		ctx, cancel := context.WithTimeout(ctx, serverInfoTimeout)
+		defer cancel()
+		info, err := ps.ServerInfo(ctx)
+		switch {
+		case errors.Is(err, client.ErrNoServerInfo):
+			logger.Info("the Perseus server is older than the CLI and some commands may fail, upgrade the server to use them", "cli", BuildVersion)
+			return
+		case err != nil:
+			logger.Debug("unable to retrieve the server version", "err", err)
+			return
+		}
+		if msg := versionSkew(BuildVersion, info.Version); msg != "" {
+			logger.Info(msg, "cli", BuildVersion, "server", info.Version)
+		}
+		if conf.compression == client.CompressionZstd && !info.Supports(client.FeatureCompressionZstd) {
+			logger.Info("the Perseus server does not support zstd compression and will reject large requests, use --compression gzip")
+		}
+	})
+}
+
+// versionSkew returns a warning if the server version is incompatible with the CLI version, or an empty
+// string if it is not or if either is a development build whose compatibility is unknown.
+func versionSkew(cliVersion, serverVersion string) string {
+	for _, v := range []string{cliVersion, serverVersion} {
+		if !semver.IsValid(v) || semver.MajorMinor(v) == "v0.0" {
+			return ""
+		}
+	}
+	switch {
+	case semver.Major(cliVersion) != semver.Major(serverVersion):
+		return "the Perseus server is a different major version than the CLI and they may not be compatible"
+	case semver.Compare(semver.MajorMinor(serverVersion), semver.MajorMinor(cliVersion)) < 0:
+		return "the Perseus server is older than the CLI and some commands may fail, upgrade the server to use them"
+	default:
+		return ""
+	}
+}
+
+// requireFeature returns an error if the server reports that it does not support the specified feature,
+// which is described by desc, so that a command fails before doing any work.  Servers that predate the
+// GetServerInfo RPC don't report their features, so the command is attempted anyway.
+func requireFeature(ctx context.Context, ps *client.Client, feature, desc string) error {
+	info, err := ps.ServerInfo(ctx)
+	if err != nil {
+		logger.Debug("unable to retrieve the server features", "feature", feature, "err", err)
+		return nil
+	}
+	if !info.Supports(feature) {
+		return fmt.Errorf("The Perseus server (%s) does not support %s, upgrade the server to use it", info.Version, desc)
+	}
+	return nil
+}
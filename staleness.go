@@ -0,0 +1,152 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryStalenessExampleUsage = `  # show the stalest CrowdStrike modules first
+  perseus query staleness 'github.com/CrowdStrike/*' -o table
+
+  # score every version of a module and sort by the number of outdated dependencies
+  perseus query staleness github.com/example/foo --all-versions --sort outdated`
+
+// the supported sort orders for the 'query staleness' CLI sub-command
+var stalenessSortOrders = []string{"score", "outdated", "module"}
+
+// stalenessItem defines the information returned by the 'query staleness' CLI sub-command for each
+// module version
+type stalenessItem struct {
+	// the module path, ex: github.com/CrowdStrike/perseus
+	Path    string `yaml:"path"`
+	Version string `yaml:"version"`
+	// the number of direct dependencies that are currently declared by the module version
+	Dependencies int `yaml:"dependencies"`
+	// the number of direct dependencies that are older than the latest version of the dependency
+	OutdatedDependencies int `yaml:"outdatedDependencies"`
+	// the average number of years that each direct dependency is behind its latest version
+	Score float64 `yaml:"score"`
+}
+
+// runQueryStalenessCmd implements the logic behind the 'query staleness' CLI sub-command
+func runQueryStalenessCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	allVersions, _ := cmd.Flags().GetBool("all-versions")
+	sortBy, _ := cmd.Flags().GetString("sort")
+	if !slices.Contains(stalenessSortOrders, sortBy) {
+		return fmt.Errorf("Invalid sort order %q, must be one of %s", sortBy, strings.Join(stalenessSortOrders, ", "))
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("computing module staleness")
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().ListModuleStaleness(ctx, connect.NewRequest(&perseusapi.ListModuleStalenessRequest{
+		ModuleFilter: args[0],
+		AllVersions:  allVersions,
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to compute the module staleness: %w", err)
+	}
+	results := make([]stalenessItem, 0, len(resp.Msg.GetModules()))
+	for _, m := range resp.Msg.GetModules() {
+		results = append(results, stalenessItem{
+			Path:                 m.GetModule().GetName(),
+			Version:              m.GetModule().GetVersions()[0],
+			Dependencies:         int(m.GetDependencies()),
+			OutdatedDependencies: int(m.GetOutdatedDependencies()),
+			Score:                m.GetScore(),
+		})
+	}
+	sortStaleness(results, sortBy)
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeStaleness(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// sortStaleness sorts results by the specified order.  Ties are broken by module path then by descending
+// version.
+func sortStaleness(results []stalenessItem, sortBy string) {
+	slices.SortFunc(results, func(a, b stalenessItem) int {
+		var c int
+		switch sortBy {
+		case "score":
+			c = cmp.Compare(b.Score, a.Score)
+		case "outdated":
+			c = cmp.Compare(b.OutdatedDependencies, a.OutdatedDependencies)
+		}
+		if c != 0 {
+			return c
+		}
+		if c = strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return semver.Compare(b.Version, a.Version)
+	})
+}
+
+// writeStaleness writes the contents of results to the provided io.Writer in the specified format
+func writeStaleness(w io.Writer, format string, results []stalenessItem) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Module\tVersion\tDependencies\tOutdated\tScore\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, e := range results {
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%.2f\n", e.Path, e.Version, e.Dependencies, e.OutdatedDependencies, e.Score); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryTimelineExampleUsage = `  # show the release history of a module and how many dependents use each version
+  perseus query timeline github.com/example/foo -o table
+
+  # include pre-releases, but not pseudo-versions
+  perseus query timeline github.com/example/foo -p --exclude-pseudo`
+
+// timelineItem defines the information returned by the 'query timeline' CLI sub-command for each version
+// of the module
+type timelineItem struct {
+	// the module version, ex: v1.11.38
+	Version string `yaml:"version"`
+	// when the version was added to the graph, which approximates when it was published
+	Published time.Time `yaml:"published"`
+	// the number of days between the previous release and this one, which is not set for the first release
+	DaysSincePrevious *int `yaml:"daysSincePrevious,omitempty"`
+	// the number of modules whose latest version depends on this version
+	Dependents int `yaml:"dependents"`
+}
+
+// runQueryTimelineCmd implements the logic behind the 'query timeline' CLI sub-command
+func runQueryTimelineCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	modulePath := args[0]
+	if err := module.CheckPath(modulePath); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", modulePath, err)
+	}
+	includePrerelease, _ := cmd.Flags().GetBool("include-prerelease")
+	excludePseudo, _ := cmd.Flags().GetBool("exclude-pseudo")
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("querying the versions of " + modulePath)
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	versions := ps.ListVersionTimes(ctx, client.ModuleVersionQuery{
+		ModuleFilter:      modulePath,
+		IncludePrerelease: includePrerelease,
+		ExcludePseudo:     excludePseudo,
+	})
+	results := make([]timelineItem, 0)
+	for vt, err := range versions.All() {
+		if err != nil {
+			stopSpinner()
+			return fmt.Errorf("Unable to list the versions of %s: %w", modulePath, err)
+		}
+		results = append(results, timelineItem{Version: vt.Version.Version, Published: vt.Added})
+	}
+
+	updateSpinner("querying the dependents of " + modulePath)
+	resp, err := ps.API().ListModuleConsumers(ctx, connect.NewRequest(&perseusapi.ListModuleConsumersRequest{
+		ModuleName: modulePath,
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to query the dependents of %s: %w", modulePath, err)
+	}
+	adoption := make(map[string]int, len(resp.Msg.GetVersions()))
+	for _, cv := range resp.Msg.GetVersions() {
+		adoption[cv.GetModule().GetVersions()[0]] = len(cv.GetDependents())
+	}
+	buildTimeline(results, adoption)
+	if len(results) == 0 {
+		infof("no versions of %s were found\n", modulePath)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeTimeline(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// buildTimeline orders results from the oldest to the newest release, then sets the number of days since
+// the previous release and the number of dependents of each version from adoption, which maps versions to
+// dependent counts
+func buildTimeline(results []timelineItem, adoption map[string]int) {
+	sort.Slice(results, func(i, j int) bool {
+		if !results[i].Published.Equal(results[j].Published) {
+			return results[i].Published.Before(results[j].Published)
+		}
+		return semver.Compare(results[i].Version, results[j].Version) < 0
+	})
+	for i := range results {
+		results[i].Dependents = adoption[results[i].Version]
+		if i > 0 {
+			days := int(results[i].Published.Sub(results[i-1].Published).Hours() / 24)
+			results[i].DaysSincePrevious = &days
+		}
+	}
+}
+
+// writeTimeline writes the contents of results to the provided io.Writer in the specified format
+func writeTimeline(w io.Writer, format string, results []timelineItem) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Version\tPublished\tDays Since Previous\tDependents\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, v := range results {
+			days := "-"
+			if v.DaysSincePrevious != nil {
+				days = fmt.Sprint(*v.DaysSincePrevious)
+			}
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%d\n", v.Version, v.Published.Local().Format(time.DateOnly), days, v.Dependents); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		if n := len(results); n > 1 {
+			span := results[n-1].Published.Sub(results[0].Published).Hours() / 24
+			fmt.Fprintf(w, "\n%d releases, 1 every %.1f days on average\n", n, span/float64(n-1))
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
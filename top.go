@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryTopExampleUsage = `  # show the 20 modules with the most dependents
+  perseus query top -o table
+
+  # show the 50 most critical CrowdStrike libraries
+  perseus query top 'github.com/CrowdStrike/*' --by dependents --limit 50 -o table
+
+  # show the modules with the highest importance scores
+  perseus query top --by importance -o table`
+
+// topModuleItem defines the information returned by the 'query top' CLI sub-command for each module
+type topModuleItem struct {
+	// the module path, ex: github.com/CrowdStrike/perseus
+	Path string `yaml:"path"`
+	// the number of distinct modules that depend directly on the module
+	DirectDependents int `yaml:"directDependents"`
+	// the number of distinct modules that depend directly or transitively on the module
+	TransitiveDependents int `yaml:"transitiveDependents"`
+	// the module's PageRank within the dependency graph, scaled so that the average is 1
+	Importance float64 `yaml:"importance"`
+}
+
+// runQueryTopCmd implements the logic behind the 'query top' CLI sub-command
+func runQueryTopCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	var filter string
+	if len(args) > 0 {
+		filter = args[0]
+	}
+	by, _ := cmd.Flags().GetString("by")
+	limit, _ := cmd.Flags().GetInt("limit")
+	if limit < 1 || limit > 1000 {
+		return fmt.Errorf("The --limit flag must be between 1 and 1000")
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("ranking modules")
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	resp, err := ps.API().ListTopModules(ctx, connect.NewRequest(&perseusapi.ListTopModulesRequest{
+		ModuleFilter: filter,
+		By:           by,
+		Limit:        int32(limit), //nolint: gosec // validated above
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to rank the modules: %w", err)
+	}
+	results := make([]topModuleItem, 0, len(resp.Msg.GetModules()))
+	for _, m := range resp.Msg.GetModules() {
+		results = append(results, topModuleItem{
+			Path:                 m.GetName(),
+			DirectDependents:     int(m.GetDirectDependents()),
+			TransitiveDependents: int(m.GetTransitiveDependents()),
+			Importance:           m.GetImportance(),
+		})
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeTopModules(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// writeTopModules writes the contents of results to the provided io.Writer in the specified format
+func writeTopModules(w io.Writer, format string, results []topModuleItem) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Rank\tModule\tDirect Dependents\tTransitive Dependents\tImportance\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for i, e := range results {
+			if _, err := fmt.Fprintf(tw, "%d\t%s\t%d\t%d\t%.2f\n", i+1, e.Path, e.DirectDependents, e.TransitiveDependents, e.Importance); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
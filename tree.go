@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fatih/color"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// colors used when rendering a dependency tree
+//
+// color output is automatically disabled if stdout is not a terminal or $NO_COLOR is set.
+var (
+	treeRootColor     = color.New(color.Bold)
+	treeDirectColor   = color.New(color.FgGreen, color.Bold)
+	treeIndirectColor = color.New(color.Faint)
+	treeReleaseColor  = color.New(color.FgCyan)
+	treePreviewColor  = color.New(color.FgYellow)
+)
+
+// writeTree writes tree to w as an indented tree, similar to the output of the Unix 'tree' command.
+// Direct dependencies of the root module are highlighted, as are versions, with pre-release and
+// pseudo-versions shown in a different color than releases.
+//
+// Each module is only expanded the first time it appears in the output.  Subsequent occurrences are
+// collapsed and marked with "(*)" to keep the output for large graphs readable.
+func writeTree(w io.Writer, tree dependencyTreeNode) error {
+	if _, err := fmt.Fprintln(w, formatTreeModule(tree.Module, treeRootColor)); err != nil {
+		return fmt.Errorf("Error writing tree output: %w", err)
+	}
+	seen := map[module.Version]struct{}{tree.Module: {}}
+	return writeTreeChildren(w, tree.Deps, "", 1, seen)
+}
+
+// writeTreeChildren recursively writes the provided child nodes at the specified depth
+func writeTreeChildren(w io.Writer, deps []dependencyTreeNode, prefix string, depth int, seen map[module.Version]struct{}) error {
+	for i, dep := range deps {
+		branch, indent := "├── ", "│   "
+		if i == len(deps)-1 {
+			branch, indent = "└── ", "    "
+		}
+		c := treeIndirectColor
+		if depth == 1 {
+			c = treeDirectColor
+		}
+		line := prefix + branch + formatTreeModule(dep.Module, c)
+
+		_, dup := seen[dep.Module]
+		if dup && len(dep.Deps) > 0 {
+			line += " (*)"
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return fmt.Errorf("Error writing tree output: %w", err)
+		}
+		if dup {
+			continue
+		}
+		seen[dep.Module] = struct{}{}
+		if err := writeTreeChildren(w, dep.Deps, prefix+indent, depth+1, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatTreeModule returns the colorized "[path]@[version]" string for mod.  In a terminal, the path is
+// also a link to the module's pkg.go.dev page, or to its source repository for private modules.
+func formatTreeModule(mod module.Version, pathColor *color.Color) string {
+	url := docsURL(mod)
+	if url == "" {
+		url = sourceURL(mod.Path)
+	}
+	path := hyperlink(url, pathColor.Sprint(mod.Path))
+	if mod.Version == "" {
+		return path
+	}
+	vc := treeReleaseColor
+	if semver.Prerelease(mod.Version) != "" {
+		vc = treePreviewColor
+	}
+	return path + "@" + vc.Sprint(mod.Version)
+}
@@ -2,11 +2,18 @@ package main
 
 import (
 	"context"
+	"debug/buildinfo"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"path"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -15,26 +22,45 @@ import (
 	"golang.org/x/mod/module"
 	"golang.org/x/mod/semver"
 
+	"github.com/CrowdStrike/perseus/client"
 	"github.com/CrowdStrike/perseus/internal/git"
 	"github.com/CrowdStrike/perseus/internal/modproxy"
+	"github.com/CrowdStrike/perseus/internal/modver"
 	"github.com/CrowdStrike/perseus/perseusapi"
 )
 
 var (
 	moduleVersion     versionArg
 	includePrerelease bool
+	pruneDeps         bool
+	resumeBulk        bool
+	checkpointPath    string
+	updateConcurrency int
+	updateQPS         float64
+	proxyCacheDir     string
+	privateDirect     bool
+	dryRun            bool
+	atomicUpdate      bool
 )
 
 const updateExampleUsage = `perseus update -p . --version v0.11.38
 	perseus update --path $HOME/dev/go/foo --version v1.0.0
 	perseus update -p $HOME/dev/go/bar
+	perseus update -p $HOME/dev/go/monorepo --recursive
+	perseus update -p $HOME/dev/go/monorepo --recursive --atomic
 	perseus update --module golang.org/x/sys
-	perseus update -m github.com/rs/zerolog -v v1.28.0`
+	perseus update -m github.com/rs/zerolog -v v1.28.0
+	perseus update -m github.com/rs/zerolog --all-versions
+	perseus update -m github.com/rs/zerolog --all-versions --async
+	perseus update --binary ./bin/myservice
+	perseus update --from-file modules.txt
+	perseus update --image ghcr.io/example/myservice:v1.2.3
+	perseus update -p . --prune --dry-run`
 
 // createUpdateCommand initializes and returns a *cobra.Command that implements the 'update' CLI sub-command
 func createUpdateCommand() *cobra.Command {
 	cmd := cobra.Command{
-		Use:          "update (-p|--path path/to/go/module/on/disk | -m|--module github.com/example/foo)",
+		Use:          "update (-p|--path path/to/go/module/on/disk | -m|--module github.com/example/foo | --binary path/to/go/binary | --image registry/app:tag | --from-file modules.txt)",
 		Short:        "Processes a Go module and updates the Perseus graph with its direct dependencies",
 		Example:      updateExampleUsage,
 		RunE:         runUpdateCmd,
@@ -42,11 +68,30 @@ func createUpdateCommand() *cobra.Command {
 	}
 	fset := cmd.Flags()
 	fset.VarP(&moduleVersion, "version", "v", "specifies the version of the Go module to be processed.")
-	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the TCP host and port of the Perseus server (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("server-addr", os.Getenv("PERSEUS_SERVER_ADDR"), "the URL of the Perseus server, or a comma-separated list of URLs or a srv:// DNS SRV name to balance requests across replicas (default is $PERSEUS_SERVER_ADDR environment variable)")
+	fset.String("profile", os.Getenv("PERSEUS_PROFILE"), "the name of a profile in the CLI config file to use (default is $PERSEUS_PROFILE environment variable)")
 	fset.BoolVar(&includePrerelease, "prerelease", false, "if specified, include pre-release tags when processing the module")
+	fset.BoolVar(&pruneDeps, "prune", false, "remove any dependencies stored in the Perseus graph for the module version that are not in its current go.mod")
 	fset.StringP("path", "p", "", "specifies the local path on disk to a Go module repository")
 	fset.StringP("module", "m", "", "specifies the module path of a public Go module")
+	fset.BoolP("recursive", "r", false, "process every Go module in the folder specified by --path and its sub-folders, excluding vendor and testdata folders")
+	fset.BoolVar(&atomicUpdate, "atomic", false, "with --recursive, send the updates for all of the modules in a single request that the server applies atomically, so either every module is updated or none are")
+	fset.String("binary", "", "specifies the path to a compiled Go binary whose embedded build info should be processed")
+	fset.String("from-file", "", "specifies a file, or - for stdin, that lists public Go modules to be processed, one 'module[@version]' per line")
+	fset.String("image", "", "specifies a container image, either a registry reference or the path to a 'docker save' tarball, whose Go binaries should be processed")
+	fset.Bool("all-versions", false, "process every version of the public Go module specified by --module that is available from the module proxy")
+	fset.BoolVar(&resumeBulk, "resume", false, "skip the modules that were processed successfully by a previous, interrupted run of --from-file or --all-versions")
+	fset.StringVar(&checkpointPath, "checkpoint", "", "the file used to record the progress of --from-file and --all-versions so that the run can be resumed (default is a file in the user's cache folder)")
+	fset.IntVar(&updateConcurrency, "concurrency", defaultUpdateConcurrency, "the maximum number of modules that are processed at the same time by --from-file, --all-versions, --image, and --recursive")
+	fset.Float64Var(&updateQPS, "qps", 0, "the maximum number of requests per second sent to the Go module proxy by --from-file and --all-versions, 0 for no limit")
+	fset.StringVar(&proxyCacheDir, "proxy-cache", os.Getenv("PERSEUS_PROXY_CACHE"), "the path to a folder used to cache Go module proxy responses so that repeated runs are faster (default is $PERSEUS_PROXY_CACHE environment variable or no caching)")
+	fset.BoolVar(&privateDirect, "private-direct", false, "fetch private modules, those matching $GOPRIVATE or $GONOPROXY, directly from their Git repositories if no private module proxy has them")
+	fset.BoolVar(&dryRun, "dry-run", false, "validate the updates with the Perseus server and print the dependencies that would be added or removed without changing the graph")
+	fset.Bool("async", false, "queue the version(s) of the public Go module specified by --module to be processed by the server instead of processing them locally")
 	fset.BoolVar(&disableTLS, "insecure", false, "do not use TLS when connecting to the Perseus server")
+	fset.Duration("timeout", 0, "the maximum amount of time to wait for the Perseus server, ex: 30s or 2m (default is $PERSEUS_TIMEOUT environment variable or 5s)")
+	addRetryFlags(fset)
+	addConnectionFlags(fset)
 
 	return &cmd
 }
@@ -54,12 +99,11 @@ func createUpdateCommand() *cobra.Command {
 // runUpdateCmd implements the 'update' CLI sub-command.
 func runUpdateCmd(cmd *cobra.Command, args []string) error {
 	// parse parameters and setup options
-	var (
-		opts []clientOption
-		conf clientConfig
-	)
-	opts = append(opts, readClientConfigEnv()...)
-	opts = append(opts, readClientConfigFlags(cmd.Flags())...)
+	conf := newClientConfig()
+	opts, err := readClientConfigOptions(cmd.Flags())
+	if err != nil {
+		return err
+	}
 	for _, fn := range opts {
 		if err := fn(&conf); err != nil {
 			return fmt.Errorf("Could not apply client config option: %w", err)
@@ -72,17 +116,74 @@ func runUpdateCmd(cmd *cobra.Command, args []string) error {
 	}
 	filePath, _ := cmd.Flags().GetString("path")
 	modPath, _ := cmd.Flags().GetString("module")
-	if filePath == "" && modPath == "" {
-		return fmt.Errorf("Either a local path (--path) or a module path (--module) must be specified")
+	if modPath, _, err = unescapeModule(modPath, ""); err != nil {
+		return fmt.Errorf("Invalid module path: %w", err)
+	}
+	binPath, _ := cmd.Flags().GetString("binary")
+	image, _ := cmd.Flags().GetString("image")
+	listPath, _ := cmd.Flags().GetString("from-file")
+	if filePath == "" && modPath == "" && binPath == "" && image == "" && listPath == "" {
+		return fmt.Errorf("A local path (--path), a module path (--module), a binary (--binary), a container image (--image), or a module list (--from-file) must be specified")
+	}
+	if !xor(filePath != "", modPath != "", binPath != "", image != "", listPath != "") {
+		return fmt.Errorf("Only one of a local path (--path), a module path (--module), a binary (--binary), a container image (--image), or a module list (--from-file) can be specified")
 	}
-	if !xor(filePath != "", modPath != "") {
-		return fmt.Errorf("Either a local path (--path) or a module path (--module) can be specified, but not both")
+	allVersions, _ := cmd.Flags().GetBool("all-versions")
+	if allVersions && (modPath == "" || moduleVersion != "") {
+		return fmt.Errorf("The --all-versions flag requires a module path (--module) and no version")
+	}
+	if updateConcurrency < 1 {
+		return fmt.Errorf("The --concurrency flag must be at least 1")
+	}
+	if updateQPS < 0 {
+		return fmt.Errorf("The --qps flag must not be negative")
+	}
+	if (resumeBulk || checkpointPath != "") && !allVersions && listPath == "" {
+		return fmt.Errorf("The --resume and --checkpoint flags require --from-file or --all-versions")
+	}
+	if dryRun {
+		if async, _ := cmd.Flags().GetBool("async"); async {
+			return fmt.Errorf("The --dry-run flag cannot be combined with --async")
+		}
+		if resumeBulk || checkpointPath != "" {
+			return fmt.Errorf("The --dry-run flag cannot be combined with --resume or --checkpoint")
+		}
+		if atomicUpdate {
+			return fmt.Errorf("The --dry-run flag cannot be combined with --atomic")
+		}
+		if err := requireDryRunSupport(conf); err != nil {
+			return err
+		}
+	}
+	if recursive, _ := cmd.Flags().GetBool("recursive"); atomicUpdate && !recursive {
+		return fmt.Errorf("The --atomic flag requires --recursive")
+	}
+	if async, _ := cmd.Flags().GetBool("async"); async {
+		if modPath == "" {
+			return fmt.Errorf("The --async flag requires a module path (--module)")
+		}
+		return queueModuleVersions(conf, modPath, allVersions)
+	}
+	if allVersions {
+		return backfillModuleVersions(conf, modPath)
+	}
+	if image != "" {
+		return updateFromImage(conf, image)
+	}
+	if listPath != "" {
+		if moduleVersion != "" {
+			return fmt.Errorf("The --from-file flag cannot be combined with --version, specify versions in the file instead")
+		}
+		return updateFromFile(conf, listPath)
+	}
+	if recursive, _ := cmd.Flags().GetBool("recursive"); recursive {
+		if filePath == "" || moduleVersion != "" {
+			return fmt.Errorf("The --recursive flag requires a local path (--path) and no version")
+		}
+		return updateModulesInTree(conf, filePath)
 	}
 
-	var (
-		info moduleInfo
-		err  error
-	)
+	var info moduleInfo
 	switch {
 	case filePath != "":
 		// read module dependencies from source code on disk
@@ -90,6 +191,9 @@ func runUpdateCmd(cmd *cobra.Command, args []string) error {
 	case modPath != "":
 		// read module dependencies from the module proxy
 		info, err = getModuleInfoFromProxy(modPath)
+	case binPath != "":
+		// read the main module and all of its dependencies from the build info embedded in the binary
+		info, err = getModuleInfoFromBinary(binPath)
 	}
 	if err != nil {
 		return err
@@ -110,6 +214,256 @@ func runUpdateCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// backfillModuleVersions processes every version of the specified module that is available from the
+// system-configured Go module proxy/proxies and updates the Perseus graph with each one, reporting
+// progress as it goes.  Pre-release versions are skipped unless --prerelease was specified.
+func backfillModuleVersions(conf clientConfig, modulePath string) error {
+	versions, err := listProxyVersions(modulePath)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		infof("no versions of %s to process\n", modulePath)
+		return nil
+	}
+
+	cp, err := openBulkCheckpoint("all-versions:" + modulePath)
+	if err != nil {
+		return err
+	}
+	var mods []module.Version
+	for _, v := range versions {
+		if mod := (module.Version{Path: modulePath, Version: v}); !cp.Done(mod.String()) {
+			mods = append(mods, mod)
+		}
+	}
+
+	progress := newBulkProgress(len(mods))
+	limiter := newProxyLimiter()
+	forEachConcurrently(mods, func(mod module.Version) {
+		_ = limiter.Wait(context.Background())
+		info, err := parseModulePath(mod.Path, mod.Version)
+		if err != nil {
+			progress.Failed(mod.String(), err)
+			return
+		}
+		if err := applyUpdates(conf, mod, info.Deps); err != nil {
+			progress.Failed(mod.String(), fmt.Errorf("Unable to update the Perseus graph: %w", err))
+			return
+		}
+		if err := cp.Record(mod.String()); err != nil {
+			logger.Error(err, "unable to record the completed module version", "module", mod)
+		}
+		progress.Succeeded(mod.String())
+	})
+	return finishBulkCheckpoint(cp, progress.Finish())
+}
+
+// updateFromImage updates the Perseus graph with the modules and dependencies of each Go binary in the
+// specified container image, reporting progress as it goes.
+func updateFromImage(conf clientConfig, image string) error {
+	updateSpinner, stopSpinner := startSpinner()
+	updateSpinner(fmt.Sprintf("reading Go binaries from %s", image))
+	infos, err := getModuleInfosFromImage(image)
+	stopSpinner()
+	if err != nil {
+		return err
+	}
+	if len(infos) == 0 {
+		infof("no Go binaries with module versions were found in %s\n", image)
+		return nil
+	}
+
+	progress := newBulkProgress(len(infos))
+	forEachConcurrently(infos, func(info moduleInfo) {
+		mod := module.Version{Path: info.Name, Version: info.Version}
+		if err := applyUpdates(conf, mod, info.Deps); err != nil {
+			progress.Failed(mod.String(), fmt.Errorf("Unable to update the Perseus graph: %w", err))
+			return
+		}
+		progress.Succeeded(mod.String())
+	})
+	return progress.Finish()
+}
+
+// updateModulesInTree updates the Perseus graph with every Go module in the folder tree rooted at dir,
+// reporting progress as it goes.  The version of each module is determined from the Git tags on the
+// current commit, using the module's folder within the repository as the tag prefix.
+func updateModulesInTree(conf clientConfig, dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("Invalid path %s: %w", dir, err)
+	}
+	dirs, err := findModuleDirs(dir)
+	if err != nil {
+		return err
+	}
+	if len(dirs) == 0 {
+		infof("no Go modules were found in %s\n", dir)
+		return nil
+	}
+	repo, err := git.Open(dir)
+	if err != nil {
+		return err
+	}
+	repoRoot, err := repo.Root()
+	if err != nil {
+		return err
+	}
+
+	// the module versions are resolved one at a time because the Git repository cannot be read
+	// concurrently, then the updates are sent to the server in parallel
+	progress := newBulkProgress(len(dirs))
+	var resolved []moduleInfo
+	for _, d := range dirs {
+		info, err := parseModuleDir(d)
+		if err != nil {
+			progress.Failed(d, err)
+			continue
+		}
+		rel, err := filepath.Rel(repoRoot, d)
+		if err != nil {
+			progress.Failed(info.Name, err)
+			continue
+		}
+		prefix := ""
+		if rel != "." {
+			prefix = filepath.ToSlash(rel) + "/"
+		}
+		tags, err := repo.PrefixedVersionTags(prefix)
+		if err != nil {
+			progress.Failed(info.Name, fmt.Errorf("unable to read version tags from the repo: %w", err))
+			continue
+		}
+		switch len(tags) {
+		case 1:
+			info.Version = tags[0]
+		case 0:
+			progress.Failed(info.Name, fmt.Errorf("no %sv* semver tags exist at the current commit", prefix))
+			continue
+		default:
+			progress.Failed(info.Name, fmt.Errorf("multiple %sv* semver tags exist at the current commit: %v", prefix, tags))
+			continue
+		}
+
+		if !includePrerelease && semver.Prerelease(info.Version) != "" {
+			progress.Succeeded(info.Name + "@" + info.Version + " (skipped pre-release)")
+			continue
+		}
+		resolved = append(resolved, info)
+	}
+	if atomicUpdate {
+		applyBatchUpdates(conf, resolved, progress)
+		return progress.Finish()
+	}
+	forEachConcurrently(resolved, func(info moduleInfo) {
+		mod := module.Version{Path: info.Name, Version: info.Version}
+		if err := applyUpdates(conf, mod, info.Deps); err != nil {
+			progress.Failed(mod.String(), fmt.Errorf("Unable to update the Perseus graph: %w", err))
+			return
+		}
+		progress.Succeeded(mod.String())
+	})
+	return progress.Finish()
+}
+
+// findModuleDirs returns the folders under root, including root itself, that contain a go.mod file.
+// Like the go command, folders named vendor or testdata and those whose names begin with '.' or '_' are
+// skipped.
+func findModuleDirs(root string) ([]string, error) {
+	var dirs []string
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if p != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Name() == "go.mod" && d.Type().IsRegular() {
+			dirs = append(dirs, filepath.Dir(p))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to search %s for Go modules: %w", root, err)
+	}
+	return dirs, nil
+}
+
+// queueModuleVersions submits the specified module to the server's ingestion queue, either the version
+// specified by --version, the current version, or every version available from the module proxy.
+func queueModuleVersions(conf clientConfig, modulePath string, allVersions bool) error {
+	var versions []string
+	switch {
+	case allVersions:
+		var err error
+		if versions, err = listProxyVersions(modulePath); err != nil {
+			return err
+		}
+	case moduleVersion != "":
+		versions = []string{string(moduleVersion)}
+	default:
+		v, err := moduleProxy().GetCurrentVersion(modulePath, includePrerelease)
+		if err != nil {
+			return fmt.Errorf("Unable to determine the current version of module %s: %w", modulePath, err)
+		}
+		versions = []string{v}
+	}
+	if len(versions) == 0 {
+		infof("no versions of %s to process\n", modulePath)
+		return nil
+	}
+	return submitIngestionJobs(conf, modulePath, versions)
+}
+
+// listProxyVersions returns the sorted list of versions of the specified module that are available from
+// the system-configured Go module proxy/proxies.  Pre-release versions are excluded unless --prerelease
+// was specified.
+func listProxyVersions(modulePath string) ([]string, error) {
+	versions, err := moduleProxy().GetModuleVersions(modulePath)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list the versions of module %s: %w", modulePath, err)
+	}
+	// the proxy response may contain blank lines and is not guaranteed to be sorted
+	versions = slices.DeleteFunc(versions, func(v string) bool {
+		return !semver.IsValid(v) || (!includePrerelease && semver.Prerelease(v) != "")
+	})
+	semver.Sort(versions)
+	return versions, nil
+}
+
+// moduleProxy returns the client used to read public modules from the Go module proxies configured in
+// $GOPROXY, caching the responses in the folder specified by --proxy-cache, if any.  Private modules are
+// never requested from public proxies.
+func moduleProxy() modproxy.Proxy {
+	return modproxy.NewFromEnv(http.DefaultClient).
+		WithCache(proxyCacheDir, modproxy.DefaultListCacheTTL).
+		WithPrivateDirect(privateDirect)
+}
+
+// unescapeModule converts a module path and version that were copied from a module proxy URL or the
+// module cache, where upper-case letters are encoded as '!' followed by the lower-case letter, back to
+// their original form so that each module is always recorded under the same name.  Values that are not
+// case-encoded are returned unchanged.
+func unescapeModule(modPath, version string) (string, string, error) {
+	var err error
+	if strings.Contains(modPath, "!") {
+		if modPath, err = module.UnescapePath(modPath); err != nil {
+			return "", "", err
+		}
+	}
+	if strings.Contains(version, "!") {
+		if version, err = module.UnescapeVersion(version); err != nil {
+			return "", "", err
+		}
+	}
+	return modPath, version, nil
+}
+
 // getModuleInfoFromDir extracts the current direct dependencies of a Go module by inspecting the source
 // code on disk at dir.
 func getModuleInfoFromDir(dir string) (moduleInfo, error) {
@@ -135,8 +489,9 @@ func getModuleInfoFromDir(dir string) (moduleInfo, error) {
 		}
 	}
 
-	if !includePrerelease && semver.Prerelease(string(moduleVersion)) != "" {
-		fmt.Printf("skipping pre-release tag %s\n", moduleVersion)
+	// pseudo-versions are only ever specified explicitly so they are never skipped
+	if !includePrerelease && semver.Prerelease(string(moduleVersion)) != "" && !modver.IsPseudo(string(moduleVersion)) {
+		infof("skipping pre-release tag %s\n", moduleVersion)
 		return moduleInfo{}, nil
 	}
 
@@ -155,6 +510,64 @@ func getModuleInfoFromDir(dir string) (moduleInfo, error) {
 	return info, nil
 }
 
+// getModuleInfoFromBinary extracts the main module and the complete set of dependencies that were
+// compiled into the Go binary at binPath from its embedded build info.
+//
+// Replaced dependencies are recorded using the replacement module unless it is a local directory, in
+// which case the original module is used.  If the binary was not built from a tagged module version,
+// ex: with 'go build' in a local checkout, the version must be specified using --version.
+func getModuleInfoFromBinary(binPath string) (moduleInfo, error) {
+	bi, err := buildinfo.ReadFile(binPath)
+	if err != nil {
+		return moduleInfo{}, fmt.Errorf("Unable to read the Go build info from %s: %w", binPath, err)
+	}
+	return moduleInfoFromBuildInfo(bi, binPath)
+}
+
+// moduleInfoFromBuildInfo converts the build info read from the Go binary at binPath to a moduleInfo.
+// See [getModuleInfoFromBinary] for details.
+func moduleInfoFromBuildInfo(bi *debug.BuildInfo, binPath string) (moduleInfo, error) {
+	if bi.Main.Path == "" {
+		return moduleInfo{}, fmt.Errorf("The binary %s was not built from a Go module", binPath)
+	}
+
+	info := moduleInfo{Name: bi.Main.Path, Version: moduleVersion.String()}
+	if info.Version == "" {
+		// build metadata, such as the "+dirty" suffix added for uncommitted changes, is not valid in a
+		// module version
+		info.Version = module.CanonicalVersion(bi.Main.Version)
+		if info.Version == "" {
+			return moduleInfo{}, fmt.Errorf("The binary %s does not contain a valid module version (%q). Please specify a version explicitly.", binPath, bi.Main.Version)
+		}
+	}
+	if !includePrerelease && semver.Prerelease(info.Version) != "" && moduleVersion == "" {
+		// pseudo-versions are pre-releases but are the norm for binaries so only skip real pre-release tags
+		if !modver.IsPseudo(info.Version) {
+			infof("skipping pre-release version %s\n", info.Version)
+			return moduleInfo{}, nil
+		}
+	}
+
+	for _, d := range bi.Deps {
+		dep := module.Version{Path: d.Path, Version: d.Version}
+		if r := d.Replace; r != nil && r.Version != "" {
+			dep = module.Version{Path: r.Path, Version: r.Version}
+		}
+		if err := module.Check(dep.Path, dep.Version); err != nil {
+			logger.Debug("skipping invalid dependency", "module", dep.String(), "err", err)
+			continue
+		}
+		info.Deps = append(info.Deps, dep)
+	}
+	if logLevel.debugMode {
+		fmt.Printf("Processing Go binary %s (module %s@%s)...\nDependencies:\n", binPath, info.Name, info.Version)
+		for _, d := range info.Deps {
+			fmt.Printf("\t%s\n", d)
+		}
+	}
+	return info, nil
+}
+
 // getModuleInfoFromProxy extracts the current direct dependencies of a Go module by querying the
 // system-configured Go module proxy/proxies.
 func getModuleInfoFromProxy(modulePath string) (moduleInfo, error) {
@@ -165,14 +578,14 @@ func getModuleInfoFromProxy(modulePath string) (moduleInfo, error) {
 	// get @latest from the proxy if no version was specified
 	v = moduleVersion.String()
 	if v == "" {
-		v, err = modproxy.GetCurrentVersion(http.DefaultClient, modulePath, includePrerelease)
+		v, err = moduleProxy().GetCurrentVersion(modulePath, includePrerelease)
 		if err != nil {
 			return moduleInfo{}, fmt.Errorf("unable to determine @latest for module %s: %w", modulePath, err)
 		}
 	}
 
-	if !includePrerelease && semver.Prerelease(v) != "" {
-		fmt.Printf("skipping pre-release tag %s\n", v)
+	if !includePrerelease && semver.Prerelease(v) != "" && !modver.IsPseudo(v) {
+		infof("skipping pre-release tag %s\n", v)
 		return moduleInfo{}, nil
 	}
 
@@ -195,26 +608,134 @@ func applyUpdates(conf clientConfig, mod module.Version, deps []module.Version)
 	// create the client and call the server
 	// . be sure we don't hang "forever".  5s is a bit over 2X the cumulative retry delays (1900 ms)
 	//   so this shouldn't generate any pre-mature aborts
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if conf.timeout == 0 {
+		conf.timeout = 5 * time.Second
+	}
+	ctx, cancel := conf.newContext()
 	defer cancel()
-	client := conf.getClient()
-	req := connect.NewRequest(&perseusapi.UpdateDependenciesRequest{
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	req := connect.NewRequest(newUpdateRequest(mod, deps))
+	req.Msg.ValidateOnly = dryRun
+
+	resp, err := ps.API().UpdateDependencies(ctx, req)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		printDependencyChanges(mod, resp.Msg)
+	}
+	return nil
+}
+
+// applyBatchUpdates calls the Perseus server to update the dependencies of all of the modules in infos
+// with a single BatchUpdateDependencies request, which the server applies atomically, and records the
+// outcome for each module in progress.  Nothing is sent if any other module has already failed so that
+// the graph is never left with only some of the modules updated.
+func applyBatchUpdates(conf clientConfig, infos []moduleInfo, progress *bulkProgress) {
+	if len(infos) == 0 {
+		return
+	}
+	var err error
+	if progress.FailedCount() > 0 {
+		err = errors.New("Not updated because other modules could not be processed")
+	} else if err = sendBatchUpdate(conf, infos); err != nil {
+		err = fmt.Errorf("Unable to update the Perseus graph: %w", err)
+	}
+	for _, info := range infos {
+		item := info.Name + "@" + info.Version
+		if err != nil {
+			progress.Failed(item, err)
+		} else {
+			progress.Succeeded(item)
+		}
+	}
+}
+
+// sendBatchUpdate sends a single BatchUpdateDependencies request with the dependencies of each module in
+// infos
+func sendBatchUpdate(conf clientConfig, infos []moduleInfo) error {
+	// a batch takes longer for the server to apply than a single update, so allow more time by default
+	if conf.timeout == 0 {
+		conf.timeout = 30 * time.Second
+	}
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	req := connect.NewRequest(&perseusapi.BatchUpdateDependenciesRequest{})
+	for _, info := range infos {
+		mod := module.Version{Path: info.Name, Version: info.Version}
+		req.Msg.Updates = append(req.Msg.Updates, newUpdateRequest(mod, info.Deps))
+	}
+	_, err = ps.API().BatchUpdateDependencies(ctx, req)
+	return err
+}
+
+// newUpdateRequest returns an UpdateDependenciesRequest that sets the dependencies of mod to deps
+func newUpdateRequest(mod module.Version, deps []module.Version) *perseusapi.UpdateDependenciesRequest {
+	// send canonical versions, such as v1.2.0 for a v1.2 tag, so they pass the server's validation.  the
+	// server reports any version that cannot be canonicalized.
+	if cv, err := modver.Canonical(mod.Path, mod.Version); err == nil {
+		mod.Version = cv
+	}
+	req := &perseusapi.UpdateDependenciesRequest{
 		ModuleName: mod.Path,
 		Version:    mod.Version,
-	})
-	req.Msg.Dependencies = make([]*perseusapi.Module, len(deps))
+		Prune:      pruneDeps,
+	}
+	req.Dependencies = make([]*perseusapi.Module, len(deps))
 	for i, d := range deps {
-		req.Msg.Dependencies[i] = &perseusapi.Module{
+		req.Dependencies[i] = &perseusapi.Module{
 			Name:     d.Path,
 			Versions: []string{d.Version},
 		}
 	}
+	return req
+}
 
-	_, err = retryOp(func() (struct{}, error) {
-		_, err := client.UpdateDependencies(ctx, req)
-		return struct{}{}, err
-	})
-	return err
+// requireDryRunSupport returns an error unless the Perseus server supports validate-only updates.  Unlike
+// other optional features, the check fails if the server's features can't be determined because an older
+// server would silently ignore the request's validate_only field and update the graph.
+func requireDryRunSupport(conf clientConfig) error {
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	info, err := ps.ServerInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("Unable to confirm that the Perseus server supports --dry-run: %w", err)
+	}
+	if !info.Supports(client.FeatureUpdateValidateOnly) {
+		return fmt.Errorf("The Perseus server (%s) does not support --dry-run, upgrade the server to use it", info.Version)
+	}
+	return nil
+}
+
+// printDependencyChanges writes the dependencies of mod that a dry-run update would add and remove to
+// stdout.  The output for each module is written at once so that it isn't interleaved with that of other
+// modules that are processed concurrently.
+func printDependencyChanges(mod module.Version, resp *perseusapi.UpdateDependenciesResponse) {
+	added, removed := resp.GetAddedDependencies(), resp.GetRemovedDependencies()
+	var sb strings.Builder
+	if len(added) == 0 && len(removed) == 0 {
+		fmt.Fprintf(&sb, "%s: no changes\n", mod)
+	} else {
+		fmt.Fprintf(&sb, "%s: would add %d and remove %d dependencies\n", mod, len(added), len(removed))
+	}
+	for _, m := range added {
+		fmt.Fprintf(&sb, "  + %s@%s\n", m.GetName(), m.GetVersions()[0])
+	}
+	for _, m := range removed {
+		fmt.Fprintf(&sb, "  - %s@%s\n", m.GetName(), m.GetVersions()[0])
+	}
+	fmt.Print(sb.String())
 }
 
 // moduleInfo represents the relevant Go module metadata for this application.
@@ -276,7 +797,7 @@ func parseModulePath(m, v string) (info moduleInfo, err error) {
 	}
 
 	var mf *modfile.File
-	mf, err = modproxy.GetModFile(http.DefaultClient, m, v)
+	mf, err = moduleProxy().GetModFile(m, v)
 	if err != nil {
 		return info, err
 	}
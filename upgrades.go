@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/internal/modproxy"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryUpgradeCandidatesExampleUsage = `  # list the available upgrades for the direct dependencies of the latest version of a module
+  perseus query upgrade-candidates github.com/example/foo -o table
+
+  # only list patch upgrades, also checking the Go module proxy for versions that Perseus has not seen
+  perseus query upgrade-candidates github.com/example/foo@v1.4.0 --patch-only --proxy
+
+  # generate a shell script that applies the upgrades with a single 'go get' command
+  perseus query upgrade-candidates github.com/example/foo --minor-only --emit-script --single-command -O upgrade.sh`
+
+// upgradeCandidate defines the information returned by the 'query upgrade-candidates' CLI sub-command for
+// each direct dependency that has a newer version available
+type upgradeCandidate struct {
+	// the dependency's module path, ex: github.com/CrowdStrike/perseus
+	Path string `yaml:"path"`
+	// the version of the dependency that is currently required
+	Version string `yaml:"version"`
+	// the newest version with the same major and minor version, if it is newer than the current version
+	Patch string `yaml:"patch,omitempty"`
+	// the newest version with the same major version and a higher minor version
+	Minor string `yaml:"minor,omitempty"`
+	// the module path and newest version of the newest higher major version, ex: example.com/foo/v2@v2.1.0
+	Major string `yaml:"major,omitempty"`
+}
+
+// runQueryUpgradeCandidatesCmd implements the logic behind the 'query upgrade-candidates' CLI sub-command
+func runQueryUpgradeCandidatesCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	rootMod := module.Version{}
+	rootMod.Path, rootMod.Version, _ = strings.Cut(args[0], "@")
+	if err := module.CheckPath(rootMod.Path); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", rootMod.Path, err)
+	}
+	if rootMod.Version != "" && rootMod.Version != "latest" && !semver.IsValid(rootMod.Version) {
+		return fmt.Errorf("%s is not a valid Go module semantic version string", rootMod.Version)
+	}
+	patchOnly, _ := cmd.Flags().GetBool("patch-only")
+	minorOnly, _ := cmd.Flags().GetBool("minor-only")
+	if patchOnly && minorOnly {
+		return fmt.Errorf("The --patch-only and --minor-only flags cannot be combined")
+	}
+	useProxy, _ := cmd.Flags().GetBool("proxy")
+	emitScript, _ := cmd.Flags().GetBool("emit-script")
+	singleCommand, _ := cmd.Flags().GetBool("single-command")
+	if singleCommand && !emitScript {
+		return fmt.Errorf("The --single-command flag can only be used with --emit-script")
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if rootMod.Version == "" || rootMod.Version == "latest" {
+		if rootMod.Version, err = ps.LatestVersion(ctx, rootMod.Path, asOf); err != nil {
+			return err
+		}
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	tree, err := walkDependencies(ctx, ps, rootMod, perseusapi.DependencyDirection_dependencies, 1, updateSpinner)
+	if err != nil {
+		return err
+	}
+	var proxy *modproxy.Proxy
+	if useProxy {
+		p := modproxy.NewFromEnv(&http.Client{})
+		proxy = &p
+	}
+	var results []upgradeCandidate
+	for _, dep := range tree.Deps {
+		updateSpinner("checking for newer versions of " + dep.Module.Path)
+		c, err := findUpgradeCandidate(ctx, ps, proxy, dep.Module, !patchOnly, !patchOnly && !minorOnly)
+		if err != nil {
+			return err
+		}
+		if c.Patch != "" || c.Minor != "" || c.Major != "" {
+			results = append(results, c)
+		}
+	}
+	stopSpinner()
+	if len(results) == 0 {
+		infof("no upgrades are available for the direct dependencies of %s\n", rootMod)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if emitScript {
+		writeUpgradeScript(out, rootMod, results, singleCommand)
+		return out.Commit()
+	}
+	if err = writeUpgradeCandidates(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// findUpgradeCandidate returns the newer versions of dep that are known to the Perseus graph and, if proxy
+// is not nil, to the Go module proxy.  Minor and major upgrades are only included if the corresponding
+// arguments are true.  Pre-release versions are never considered.
+func findUpgradeCandidate(ctx context.Context, ps *client.Client, proxy *modproxy.Proxy, dep module.Version, minor, major bool) (upgradeCandidate, error) {
+	c := upgradeCandidate{Path: dep.Path, Version: dep.Version}
+	known, err := listModuleVersions(ctx, ps, listModuleVersionsRequest{
+		modulePattern: dep.Path,
+		updateStatus:  func(string) {},
+	})
+	if err != nil {
+		return c, fmt.Errorf("Unable to list the versions of %s: %w", dep.Path, err)
+	}
+	versions := make([]string, 0, len(known))
+	for _, v := range known {
+		if v.Path == dep.Path {
+			versions = append(versions, v.Version)
+		}
+	}
+	if proxy != nil {
+		pv, err := proxy.GetModuleVersions(dep.Path)
+		if err != nil {
+			return c, fmt.Errorf("Unable to list the versions of %s from the Go module proxy: %w", dep.Path, err)
+		}
+		versions = append(versions, pv...)
+	}
+	c.Patch, c.Minor = newerVersions(dep.Version, versions)
+	if !minor {
+		c.Minor = ""
+	}
+	if major {
+		family, err := ps.LatestFamilyVersions(ctx, dep.Path, asOf)
+		if err != nil {
+			return c, err
+		}
+		best := dep.Version
+		for _, mv := range family {
+			if semver.Major(mv.Version) != semver.Major(dep.Version) && semver.Compare(mv.Version, best) > 0 {
+				c.Major, best = mv.String(), mv.Version
+			}
+		}
+	}
+	return c, nil
+}
+
+// newerVersions returns the newest release in versions with the same major and minor version as current
+// and the newest release with the same major version and a higher minor version.  Either return value is
+// empty if there is no such version.
+func newerVersions(current string, versions []string) (patch, minor string) {
+	for _, v := range versions {
+		if !semver.IsValid(v) || semver.Prerelease(v) != "" || semver.Compare(v, current) <= 0 {
+			continue
+		}
+		switch {
+		case semver.MajorMinor(v) == semver.MajorMinor(current):
+			if patch == "" || semver.Compare(v, patch) > 0 {
+				patch = v
+			}
+		case semver.Major(v) == semver.Major(current):
+			if minor == "" || semver.Compare(v, minor) > 0 {
+				minor = v
+			}
+		}
+	}
+	return patch, minor
+}
+
+// writeUpgradeCandidates writes the contents of results to the provided io.Writer in the specified format
+func writeUpgradeCandidates(w io.Writer, format string, results []upgradeCandidate) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Module\tCurrent\tPatch\tMinor\tMajor\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, c := range results {
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", c.Path, c.Version, c.Patch, c.Minor, c.Major); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// writeUpgradeScript writes a shell script to w that upgrades each dependency in results to its newest
+// minor or patch version using 'go get', followed by 'go mod tidy'.  If single is true, all of the
+// dependencies are upgraded by a single 'go get' command.
+//
+// New major versions have a different module path and require code changes, so they are only listed in
+// comments.
+func writeUpgradeScript(w io.Writer, root module.Version, results []upgradeCandidate, single bool) {
+	fmt.Fprintln(w, "#!/bin/sh")
+	fmt.Fprintf(w, "# upgrades for the direct dependencies of %s generated by perseus\n", root)
+	fmt.Fprintln(w, "set -e")
+	var targets []string
+	for _, c := range results {
+		if c.Major != "" {
+			fmt.Fprintf(w, "# %s has a new major version, %s, which requires code changes\n", c.Path, c.Major)
+		}
+		v := c.Minor
+		if v == "" {
+			v = c.Patch
+		}
+		if v != "" {
+			targets = append(targets, c.Path+"@"+v)
+		}
+	}
+	if len(targets) == 0 {
+		return
+	}
+	if single {
+		fmt.Fprintf(w, "go get %s && go mod tidy\n", strings.Join(targets, " "))
+		return
+	}
+	for _, t := range targets {
+		fmt.Fprintf(w, "go get %s && go mod tidy\n", t)
+	}
+}
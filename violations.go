@@ -0,0 +1,191 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryViolationsExampleUsage = `  # check the latest version of a module against the server's dependency policy
+  perseus query violations github.com/example/foo -o table
+
+  # use as a CI gate, failing on warnings as well as errors
+  perseus query violations github.com/example/foo@v1.4.0 --fail-on-warning`
+
+const queryLicenseViolationsExampleUsage = `  # check the licenses of the transitive dependencies of the latest version of a module
+  perseus query license-violations github.com/example/foo -o table`
+
+// policyViolation defines the information returned by the 'query violations' CLI sub-command for each
+// dependency that is banned by the server's dependency policy
+type policyViolation struct {
+	// either "module" for a violation of a module rule or "license" for a violation of the license rules
+	Kind string `yaml:"kind"`
+	// the name of the violated rule
+	Rule string `yaml:"rule"`
+	// either "error" or "warning"
+	Severity string `yaml:"severity"`
+	Message  string `yaml:"message"`
+	// the module that should be used instead, if any
+	Replacement string `yaml:"replacement,omitempty"`
+	// the dependency's license for license violations, if it is known
+	License string `yaml:"license,omitempty"`
+	Path    string `yaml:"path"`
+	Version string `yaml:"version"`
+	// the chain of module versions from the checked module to the banned dependency
+	Via []string `yaml:"via"`
+}
+
+// runQueryViolationsCmd implements the logic behind the 'query violations' CLI sub-command
+func runQueryViolationsCmd(cmd *cobra.Command, args []string) error {
+	return runPolicyCheck(cmd, args, "")
+}
+
+// runQueryLicenseViolationsCmd implements the logic behind the 'query license-violations' CLI sub-command
+func runQueryLicenseViolationsCmd(cmd *cobra.Command, args []string) error {
+	return runPolicyCheck(cmd, args, "license")
+}
+
+// runPolicyCheck checks the transitive dependencies of the module version in args against the server's
+// dependency policy and outputs the violations of the specified kind, or of every kind if kind is empty.
+// An error is returned if there are any violations with a severity of "error" or, if --fail-on-warning is
+// specified, any violations at all.
+func runPolicyCheck(cmd *cobra.Command, args []string, kind string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	rootMod := module.Version{}
+	rootMod.Path, rootMod.Version, _ = strings.Cut(args[0], "@")
+	if err := module.CheckPath(rootMod.Path); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", rootMod.Path, err)
+	}
+	if rootMod.Version != "" && rootMod.Version != "latest" && !semver.IsValid(rootMod.Version) {
+		return fmt.Errorf("%s is not a valid Go module semantic version string", rootMod.Version)
+	}
+	failOnWarning, _ := cmd.Flags().GetBool("fail-on-warning")
+	// every transitive dependency is checked unless a maximum depth is specified explicitly
+	depth := 0
+	if cmd.Flags().Changed("max-depth") {
+		if maxDepth < 0 || maxDepth > 100 {
+			return fmt.Errorf("The --max-depth flag must be between 0, for no limit, and 100")
+		}
+		depth = maxDepth
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if rootMod.Version == "" || rootMod.Version == "latest" {
+		if rootMod.Version, err = ps.LatestVersion(ctx, rootMod.Path, asOf); err != nil {
+			return err
+		}
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("checking the dependencies of " + rootMod.String())
+	resp, err := ps.API().CheckPolicy(ctx, connect.NewRequest(&perseusapi.CheckPolicyRequest{
+		ModuleName: rootMod.Path,
+		Version:    rootMod.Version,
+		MaxDepth:   int32(depth), //nolint: gosec // validated above
+		Kind:       kind,
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to check the dependency policy: %w", err)
+	}
+	var (
+		results []policyViolation
+		failed  int
+	)
+	for _, v := range resp.Msg.GetViolations() {
+		results = append(results, policyViolation{
+			Kind:        v.GetKind(),
+			License:     v.GetLicense(),
+			Rule:        v.GetRule(),
+			Severity:    v.GetSeverity(),
+			Message:     v.GetMessage(),
+			Replacement: v.GetReplacement(),
+			Path:        v.GetModule().GetName(),
+			Version:     v.GetModule().GetVersions()[0],
+			Via:         v.GetPath(),
+		})
+		if v.GetSeverity() == "error" || failOnWarning {
+			failed++
+		}
+	}
+	if len(results) == 0 {
+		infof("no policy violations found for %s\n", rootMod)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeViolations(out, format, results); err != nil {
+		return err
+	}
+	if err = out.Commit(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%s has %d dependency policy violation(s)", rootMod, failed)
+	}
+	return nil
+}
+
+// writeViolations writes the contents of results to the provided io.Writer in the specified format
+func writeViolations(w io.Writer, format string, results []policyViolation) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Severity\tRule\tModule\tVia\tMessage\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, v := range results {
+			// the first element of the path is the checked module and the last is the banned dependency
+			via := "-"
+			if len(v.Via) > 2 {
+				via = strings.Join(v.Via[1:len(v.Via)-1], " > ")
+			}
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s@%s\t%s\t%s\n", v.Severity, v.Rule, v.Path, v.Version, via, v.Message); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryVulnsExampleUsage = `  # list the known vulnerabilities that affect the latest version of a module or its dependencies
+  perseus query vulns github.com/example/foo -o table
+
+  # generate output that can be consumed by tools that parse 'govulncheck -json'
+  perseus query vulns github.com/example/foo@v1.4.0 --format govulncheck`
+
+// vulnFinding defines the information returned by the 'query vulns' CLI sub-command for each module version
+// that is affected by a known vulnerability
+type vulnFinding struct {
+	// the OSV advisory ID, ex: GO-2024-2687
+	ID string `yaml:"id"`
+	// other IDs of the same vulnerability, ex: CVE-2023-45288
+	Aliases []string `yaml:"aliases,omitempty"`
+	Summary string   `yaml:"summary"`
+	Path    string   `yaml:"path"`
+	Version string   `yaml:"version"`
+	// the lowest version of the module that fixes the vulnerability, if any
+	FixedVersion string `yaml:"fixedVersion,omitempty"`
+	// the chain of module versions from the checked module to the affected module
+	Via []string `yaml:"via"`
+}
+
+// runQueryVulnsCmd implements the logic behind the 'query vulns' CLI sub-command
+func runQueryVulnsCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	rootMod := module.Version{}
+	rootMod.Path, rootMod.Version, _ = strings.Cut(args[0], "@")
+	if err := module.CheckPath(rootMod.Path); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", rootMod.Path, err)
+	}
+	if rootMod.Version != "" && rootMod.Version != "latest" && !semver.IsValid(rootMod.Version) {
+		return fmt.Errorf("%s is not a valid Go module semantic version string", rootMod.Version)
+	}
+	// every transitive dependency is checked unless a maximum depth is specified explicitly
+	depth := 0
+	if cmd.Flags().Changed("max-depth") {
+		if maxDepth < 0 || maxDepth > 100 {
+			return fmt.Errorf("The --max-depth flag must be between 0, for no limit, and 100")
+		}
+		depth = maxDepth
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable, outputGovulncheck)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if rootMod.Version == "" || rootMod.Version == "latest" {
+		if rootMod.Version, err = ps.LatestVersion(ctx, rootMod.Path, asOf); err != nil {
+			return err
+		}
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("checking the dependencies of " + rootMod.String() + " for vulnerabilities")
+	resp, err := ps.API().ListVulnerabilities(ctx, connect.NewRequest(&perseusapi.ListVulnerabilitiesRequest{
+		ModuleName: rootMod.Path,
+		Version:    rootMod.Version,
+		MaxDepth:   int32(depth), //nolint: gosec // validated above
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to query the vulnerabilities: %w", err)
+	}
+	if len(resp.Msg.GetFindings()) == 0 {
+		infof("no known vulnerabilities found for %s\n", rootMod)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if format == outputGovulncheck {
+		err = writeGovulncheck(out, resp.Msg)
+	} else {
+		err = writeVulns(out, format, newVulnFindings(resp.Msg))
+	}
+	if err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// newVulnFindings converts the findings in resp to the CLI output representation
+func newVulnFindings(resp *perseusapi.ListVulnerabilitiesResponse) []vulnFinding {
+	advisories := make(map[string]*perseusapi.ListVulnerabilitiesResponse_Advisory, len(resp.GetAdvisories()))
+	for _, a := range resp.GetAdvisories() {
+		advisories[a.GetId()] = a
+	}
+	results := make([]vulnFinding, 0, len(resp.GetFindings()))
+	for _, f := range resp.GetFindings() {
+		a := advisories[f.GetAdvisoryId()]
+		results = append(results, vulnFinding{
+			ID:           f.GetAdvisoryId(),
+			Aliases:      a.GetAliases(),
+			Summary:      a.GetSummary(),
+			Path:         f.GetModule().GetName(),
+			Version:      f.GetModule().GetVersions()[0],
+			FixedVersion: f.GetFixedVersion(),
+			Via:          f.GetPath(),
+		})
+	}
+	return results
+}
+
+// writeVulns writes the contents of results to the provided io.Writer in the specified format
+func writeVulns(w io.Writer, format string, results []vulnFinding) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("ID\tModule\tFixed In\tVia\tSummary\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, v := range results {
+			fixed := "-"
+			if v.FixedVersion != "" {
+				fixed = v.FixedVersion
+			}
+			// the first element of the path is the checked module and the last is the affected module
+			via := "-"
+			if len(v.Via) > 2 {
+				via = strings.Join(v.Via[1:len(v.Via)-1], " > ")
+			}
+			if _, err := fmt.Fprintf(tw, "%s\t%s@%s\t%s\t%s\t%s\n", v.ID, v.Path, v.Version, fixed, via, v.Summary); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// govulncheckMessage is a single message in the stream of JSON objects written by 'govulncheck -json',
+// exactly 1 field of which is set.  Only the subset of the protocol that applies to module-level scans is
+// implemented.
+type govulncheckMessage struct {
+	Config  *govulncheckConfig  `json:"config,omitempty"`
+	OSV     json.RawMessage     `json:"osv,omitempty"`
+	Finding *govulncheckFinding `json:"finding,omitempty"`
+}
+
+// govulncheckConfig describes the scan
+type govulncheckConfig struct {
+	ProtocolVersion string `json:"protocol_version"`
+	ScannerName     string `json:"scanner_name"`
+	ScannerVersion  string `json:"scanner_version"`
+	DB              string `json:"db"`
+	ScanLevel       string `json:"scan_level"`
+}
+
+// govulncheckFinding is a module version that is affected by an advisory
+type govulncheckFinding struct {
+	OSV          string             `json:"osv"`
+	FixedVersion string             `json:"fixed_version,omitempty"`
+	Trace        []govulncheckFrame `json:"trace"`
+}
+
+// govulncheckFrame is an element of the trace of a finding, which for module-level findings is only the
+// affected module
+type govulncheckFrame struct {
+	Module  string `json:"module"`
+	Version string `json:"version,omitempty"`
+}
+
+// writeGovulncheck writes the contents of resp to the provided io.Writer in the format produced by
+// 'govulncheck -json -scan module', a config message followed by each advisory and then each finding
+func writeGovulncheck(w io.Writer, resp *perseusapi.ListVulnerabilitiesResponse) error {
+	msgs := []govulncheckMessage{{
+		Config: &govulncheckConfig{
+			ProtocolVersion: "v1.0.0",
+			ScannerName:     "perseus",
+			ScannerVersion:  BuildVersion,
+			DB:              resp.GetDatabase(),
+			ScanLevel:       "module",
+		},
+	}}
+	for _, a := range resp.GetAdvisories() {
+		msgs = append(msgs, govulncheckMessage{OSV: json.RawMessage(a.GetOsv())})
+	}
+	for _, f := range resp.GetFindings() {
+		msgs = append(msgs, govulncheckMessage{
+			Finding: &govulncheckFinding{
+				OSV:          f.GetAdvisoryId(),
+				FixedVersion: f.GetFixedVersion(),
+				Trace: []govulncheckFrame{{
+					Module:  f.GetModule().GetName(),
+					Version: f.GetModule().GetVersions()[0],
+				}},
+			},
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	for _, m := range msgs {
+		if err := enc.Encode(m); err != nil {
+			return fmt.Errorf("Error generating govulncheck output: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CrowdStrike/perseus/client"
+	"github.com/CrowdStrike/perseus/perseusapi"
+)
+
+const queryWhatIfExampleUsage = `  # show which modules would be pulled forward by upgrading gRPC in the latest version of a module
+  perseus query what-if github.com/example/foo --upgrade google.golang.org/grpc@v1.64.0 -o table
+
+  # preview several upgrades at once
+  perseus query what-if github.com/example/foo@v1.4.0 --upgrade golang.org/x/net@v0.25.0 --upgrade golang.org/x/sys@v0.20.0`
+
+// buildListChange defines the information returned by the 'query what-if' CLI sub-command for each module
+// whose selected version would change if the proposed upgrades were applied
+type buildListChange struct {
+	// the module path, ex: github.com/CrowdStrike/perseus
+	Path string `yaml:"path"`
+	// the currently selected version, empty if the module is not currently in the build list
+	Current string `yaml:"current,omitempty"`
+	// the version that would be selected, empty if the module would leave the build list
+	Proposed string `yaml:"proposed,omitempty"`
+	// the chain of module versions through which the proposed version is required
+	Via []string `yaml:"via,omitempty"`
+}
+
+// runQueryWhatIfCmd implements the logic behind the 'query what-if' CLI sub-command
+func runQueryWhatIfCmd(cmd *cobra.Command, args []string) error {
+	conf, err := parseSharedQueryOpts(cmd, args)
+	if err != nil {
+		return err
+	}
+	rootMod := module.Version{}
+	rootMod.Path, rootMod.Version, _ = strings.Cut(args[0], "@")
+	if err := module.CheckPath(rootMod.Path); err != nil {
+		return fmt.Errorf("The specified module name %q is invalid: %w", rootMod.Path, err)
+	}
+	if rootMod.Version != "" && rootMod.Version != "latest" && !semver.IsValid(rootMod.Version) {
+		return fmt.Errorf("%s is not a valid Go module semantic version string", rootMod.Version)
+	}
+	upgrades, _ := cmd.Flags().GetStringSlice("upgrade")
+	if len(upgrades) == 0 {
+		return fmt.Errorf("At least one --upgrade module@version must be specified")
+	}
+	for _, u := range upgrades {
+		path, ver, _ := strings.Cut(u, "@")
+		if err := module.Check(path, ver); err != nil {
+			return fmt.Errorf("The proposed upgrade %q is invalid: %w", u, err)
+		}
+		if path == rootMod.Path {
+			return fmt.Errorf("The proposed upgrade %q cannot be for the module being analyzed", u)
+		}
+	}
+
+	format, err := outputFmt.resolve(conf.outputFormat, outputJSON, outputYAML, outputTable)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := conf.newContext()
+	defer cancel()
+	ps, err := conf.getClient()
+	if err != nil {
+		return err
+	}
+	if err := requireFeature(ctx, ps, client.FeatureResolveUpgrades, "analyzing proposed upgrades"); err != nil {
+		return err
+	}
+	if rootMod.Version == "" || rootMod.Version == "latest" {
+		if rootMod.Version, err = ps.LatestVersion(ctx, rootMod.Path, asOf); err != nil {
+			return err
+		}
+	}
+
+	updateSpinner, stopSpinner := startSpinner()
+	defer stopSpinner()
+	updateSpinner("resolving the build list of " + rootMod.String())
+	current, err := ps.API().ResolveModuleSet(ctx, connect.NewRequest(&perseusapi.ResolveModuleSetRequest{
+		ModuleName: rootMod.Path,
+		Version:    rootMod.Version,
+	}))
+	if err != nil {
+		stopSpinner()
+		return fmt.Errorf("Unable to resolve the build list of %s: %w", rootMod, err)
+	}
+	updateSpinner("resolving the build list of " + rootMod.String() + " with the proposed upgrades")
+	proposed, err := ps.API().ResolveModuleSet(ctx, connect.NewRequest(&perseusapi.ResolveModuleSetRequest{
+		ModuleName: rootMod.Path,
+		Version:    rootMod.Version,
+		Upgrades:   upgrades,
+	}))
+	stopSpinner()
+	if err != nil {
+		return fmt.Errorf("Unable to resolve the build list of %s with the proposed upgrades: %w", rootMod, err)
+	}
+
+	results := diffBuildLists(current.Msg.GetModules(), proposed.Msg.GetModules())
+	if len(results) == 0 {
+		infof("the proposed upgrades would not change the build list of %s\n", rootMod)
+	}
+
+	out, err := newResultWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	defer out.Abort()
+	if err = writeBuildListChanges(out, format, results); err != nil {
+		return err
+	}
+	return out.Commit()
+}
+
+// diffBuildLists returns the modules whose selected version differs between the current and proposed
+// build lists, ordered by module path
+func diffBuildLists(current, proposed []*perseusapi.ResolveModuleSetResponse_SelectedModule) []buildListChange {
+	changes := make(map[string]*buildListChange)
+	for _, m := range current {
+		changes[m.GetModule().GetName()] = &buildListChange{
+			Path:    m.GetModule().GetName(),
+			Current: m.GetModule().GetVersions()[0],
+		}
+	}
+	for _, m := range proposed {
+		c, found := changes[m.GetModule().GetName()]
+		if !found {
+			c = &buildListChange{Path: m.GetModule().GetName()}
+			changes[c.Path] = c
+		}
+		c.Proposed = m.GetModule().GetVersions()[0]
+		c.Via = m.GetPath()
+	}
+
+	results := make([]buildListChange, 0)
+	for _, c := range changes {
+		if c.Current != c.Proposed {
+			results = append(results, *c)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Path < results[j].Path
+	})
+	return results
+}
+
+// writeBuildListChanges writes the contents of results to the provided io.Writer in the specified format
+func writeBuildListChanges(w io.Writer, format string, results []buildListChange) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		if _, err := tw.Write([]byte("Module\tCurrent\tProposed\tVia\n")); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+		for _, c := range results {
+			current, proposed, via := c.Current, c.Proposed, "-"
+			if current == "" {
+				current = "-"
+			}
+			if proposed == "" {
+				proposed = "-"
+			}
+			// the first element of the path is the analyzed module and the last is the changed module itself
+			if len(c.Via) > 2 {
+				via = strings.Join(c.Via[1:len(c.Via)-1], " -> ")
+			}
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", c.Path, current, proposed, via); err != nil {
+				return fmt.Errorf("Error writing tabular output: %w", err)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("Error writing tabular output: %w", err)
+		}
+
+	case outputYAML:
+		output, err := yaml.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("Error generating YAML output: %w", err)
+		}
+		_, _ = w.Write(output)
+
+	default:
+		output, _ := json.Marshal(results)
+		_, _ = w.Write(output)
+		fmt.Fprintln(w)
+	}
+	return nil
+}